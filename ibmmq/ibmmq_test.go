@@ -16,7 +16,29 @@ limitations under the License.
 package ibmmq
 
 import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Tests for mqistr.go
@@ -338,3 +360,1682 @@ func TestGetAttrInfoConcurrentCalls(t *testing.T) {
 		<-doneCh
 	}
 }
+
+// Tests for mqisyncpoint.go
+func TestBackoffPolicyDelay(t *testing.T) {
+	p := NewBackoffPolicy()
+	p.InitialDelay = 100 * time.Millisecond
+	p.MaxDelay = 1 * time.Second
+	p.Multiplier = 2
+
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, 1 * time.Second, 1 * time.Second}
+	for i, e := range expected {
+		back := p.delay(i + 1)
+		if back != e {
+			t.Logf("Attempt %d. Expected: %v. Got: %v", i+1, e, back)
+			t.Fail()
+		}
+	}
+}
+
+func TestSyncpointOptions(t *testing.T) {
+	pmo := syncpointPMOOptions(MQPMO_NO_SYNCPOINT | MQPMO_NEW_MSG_ID)
+	if pmo != MQPMO_SYNCPOINT|MQPMO_NEW_MSG_ID {
+		t.Logf("PMO options wrong. Got: %x", pmo)
+		t.Fail()
+	}
+
+	gmo := syncpointGMOOptions(MQGMO_NO_SYNCPOINT | MQGMO_WAIT)
+	if gmo != MQGMO_SYNCPOINT|MQGMO_WAIT {
+		t.Logf("GMO options wrong. Got: %x", gmo)
+		t.Fail()
+	}
+
+	// Browse cannot be done under syncpoint so should be left alone
+	gmo = syncpointGMOOptions(MQGMO_BROWSE_NEXT | MQGMO_NO_SYNCPOINT)
+	if gmo != MQGMO_BROWSE_NEXT|MQGMO_NO_SYNCPOINT {
+		t.Logf("GMO browse options wrong. Got: %x", gmo)
+		t.Fail()
+	}
+}
+
+func TestCommitOutcomeUnknown(t *testing.T) {
+	interrupted := &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_CALL_INTERRUPTED, verb: "MQCMIT"}
+	backedOut := &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_BACKED_OUT, verb: "MQCMIT"}
+
+	if !isCommitOutcomeUnknown(interrupted) || isCommitOutcomeUnknown(backedOut) {
+		t.Logf("Wrong classification of commit failures")
+		t.Fail()
+	}
+
+	// A commit that may have happened must not cause the work to be repeated
+	var err error = &CommitOutcomeUnknownError{Err: interrupted}
+	if isTransientSyncpointError(err) {
+		t.Logf("Unknown commit outcome treated as retryable")
+		t.Fail()
+	}
+	if !isTransientSyncpointError(backedOut) {
+		t.Logf("Backed out commit not treated as retryable")
+		t.Fail()
+	}
+	if isTransientSyncpointError(&MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_Q_FULL, verb: "MQPUT"}) {
+		t.Logf("Full queue treated as a reason to retry the unit of work")
+		t.Fail()
+	}
+
+	var tx Tx
+	if err = tx.Put1(NewMQOD(), NewMQMD(), nil, nil); err == nil {
+		t.Logf("Put1 allowed on a Tx without a connection")
+		t.Fail()
+	}
+}
+
+func TestBackoffPolicyJitter(t *testing.T) {
+	p := NewBackoffPolicy()
+	p.InitialDelay = 1 * time.Second
+	p.Jitter = 0.5
+
+	for i := 0; i < 100; i++ {
+		d := p.jitteredDelay(1)
+		if d < 500*time.Millisecond || d > 1500*time.Millisecond {
+			t.Logf("Jittered delay out of range: %v", d)
+			t.Fail()
+		}
+	}
+
+	// The default policy gives the same delays as before Jitter was added
+	p = NewBackoffPolicy()
+	p.InitialDelay = 1 * time.Second
+	if d := p.jitteredDelay(1); d != 1*time.Second {
+		t.Logf("Delay without jitter. Expected: 1s. Got: %v", d)
+		t.Fail()
+	}
+}
+
+// Tests for mqipoison.go
+func TestPoisonThreshold(t *testing.T) {
+	tests := []struct {
+		backoutCount int32
+		threshold    int32
+		expected     bool
+	}{
+		{0, 0, false},
+		{5, 0, false}, // No BOTHRESH set on the queue
+		{0, 3, false},
+		{2, 3, false},
+		{3, 3, true},
+		{4, 3, true},
+		{1, 1, true},
+	}
+
+	for _, tc := range tests {
+		got := overBackoutThreshold(tc.backoutCount, tc.threshold)
+		if got != tc.expected {
+			t.Logf("BackoutCount %d Threshold %d. Expected: %v. Got: %v", tc.backoutCount, tc.threshold, tc.expected, got)
+			t.Fail()
+		}
+	}
+}
+
+func TestPoisonDestination(t *testing.T) {
+	tests := []struct {
+		name      string
+		boQName   string
+		deadQ     string
+		addDLH    bool
+		expQ      string
+		expDLQ    bool
+		expAddDLH bool
+	}{
+		{"BOQNAME", "APP.BACKOUT", "DLQ", false, "APP.BACKOUT", false, false},
+		{"BOQNAME with DLH", "APP.BACKOUT", "DLQ", true, "APP.BACKOUT", false, true},
+		{"DEADQ", "", "DLQ", false, "DLQ", true, true},
+		{"No destination", "", "", false, "", true, true},
+	}
+
+	for _, tc := range tests {
+		h := NewPoisonHandler(nil)
+		h.AddDLHToBackoutQ = tc.addDLH
+		deadQCalled := false
+		deadQ := func() string {
+			deadQCalled = true
+			return tc.deadQ
+		}
+
+		q, dlq, addDLH := h.destination(&poisonQueueInfo{threshold: 3, boQName: tc.boQName}, deadQ)
+		if q != tc.expQ || dlq != tc.expDLQ || addDLH != tc.expAddDLH {
+			t.Logf("%s: Expected: %s/%v/%v. Got: %s/%v/%v", tc.name, tc.expQ, tc.expDLQ, tc.expAddDLH, q, dlq, addDLH)
+			t.Fail()
+		}
+		// The DEADQ should only be looked up when it is going to be used
+		if deadQCalled != tc.expDLQ {
+			t.Logf("%s: DEADQ lookup done: %v", tc.name, deadQCalled)
+			t.Fail()
+		}
+	}
+}
+
+func TestPoisonContextRetry(t *testing.T) {
+	retry := []int32{MQRC_CONTEXT_HANDLE_ERROR, MQRC_NOT_AUTHORIZED}
+	for _, rc := range retry {
+		if !retryWithDefaultContext(&MQReturn{MQCC: MQCC_FAILED, MQRC: rc}) {
+			t.Logf("Reason %d should retry with default context", rc)
+			t.Fail()
+		}
+	}
+	if retryWithDefaultContext(&MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_Q_FULL}) || retryWithDefaultContext(nil) {
+		t.Logf("Unexpected retry with default context")
+		t.Fail()
+	}
+}
+
+// Tests for mqigroup.go
+func TestSplitSegments(t *testing.T) {
+	type seg = segment
+	tests := []struct {
+		name      string
+		length    int
+		segSize   int
+		baseFlags int32
+		expected  []seg
+	}{
+		{"Unknown MAXMSGL", 100, 0, 0, []seg{{0, 100, 0}}},
+		{"Fits", 100, 100, 0, []seg{{0, 100, 0}}},
+		{"Empty", 0, 10, 0, []seg{{0, 0, 0}}},
+		{"Exact multiple", 30, 10, 0, []seg{
+			{0, 10, MQMF_SEGMENT}, {10, 20, MQMF_SEGMENT}, {20, 30, MQMF_LAST_SEGMENT}}},
+		{"Short last segment", 25, 10, 0, []seg{
+			{0, 10, MQMF_SEGMENT}, {10, 20, MQMF_SEGMENT}, {20, 25, MQMF_LAST_SEGMENT}}},
+		{"Group flags kept", 15, 10, MQMF_MSG_IN_GROUP, []seg{
+			{0, 10, MQMF_MSG_IN_GROUP | MQMF_SEGMENT}, {10, 15, MQMF_MSG_IN_GROUP | MQMF_LAST_SEGMENT}}},
+		{"Last in group", 11, 10, MQMF_LAST_MSG_IN_GROUP, []seg{
+			{0, 10, MQMF_LAST_MSG_IN_GROUP | MQMF_SEGMENT}, {10, 11, MQMF_LAST_MSG_IN_GROUP | MQMF_LAST_SEGMENT}}},
+	}
+
+	for _, tc := range tests {
+		got := splitSegments(tc.length, tc.segSize, tc.baseFlags)
+		if len(got) != len(tc.expected) {
+			t.Logf("%s: Expected %d segments. Got: %+v", tc.name, len(tc.expected), got)
+			t.Fail()
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.expected[i] {
+				t.Logf("%s: Segment %d. Expected: %+v. Got: %+v", tc.name, i, tc.expected[i], got[i])
+				t.Fail()
+			}
+		}
+	}
+}
+
+func TestSegmentBufferSize(t *testing.T) {
+	for _, l := range []int{0, 1, defaultGetBufferSize - 1} {
+		if n := segmentBufferSize(l); n != defaultGetBufferSize {
+			t.Logf("First segment length %d. Got buffer size %d", l, n)
+			t.Fail()
+		}
+	}
+	if n := segmentBufferSize(100000); n != 100000 {
+		t.Logf("Large first segment. Got buffer size %d", n)
+		t.Fail()
+	}
+}
+
+// Tests for mqistream.go
+
+// A piece of a logical message as the fake MQGET returns it
+type streamPiece struct {
+	data    string
+	segment rune
+	group   rune
+}
+
+func fakeStreamReader(pieces []streamPiece, gets *int) *MessageReader {
+	r := NewMessageReader(MQObject{}, nil)
+	r.get = func(md *MQMD, gmo *MQGMO, initialSize int, maxSize int) ([]byte, error) {
+		if *gets >= len(pieces) {
+			return nil, &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_NO_MSG_AVAILABLE, verb: "MQGET"}
+		}
+		if maxSize != r.MaxPieceSize {
+			return nil, &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_TRUNCATED_MSG_FAILED, verb: "MQGET"}
+		}
+		p := pieces[*gets]
+		*gets++
+		gmo.SegmentStatus = p.segment
+		gmo.GroupStatus = p.group
+		return []byte(p.data), nil
+	}
+	return r
+}
+
+func TestMessageReader(t *testing.T) {
+	tests := []struct {
+		name   string
+		pieces []streamPiece
+	}{
+		{"Single message", []streamPiece{{"hello", MQSS_NOT_A_SEGMENT, MQGS_NOT_IN_GROUP}}},
+		{"Empty message", []streamPiece{{"", MQSS_NOT_A_SEGMENT, MQGS_NOT_IN_GROUP}}},
+		{"Segments", []streamPiece{
+			{"abcdefg", MQSS_SEGMENT, MQGS_NOT_IN_GROUP},
+			{"", MQSS_SEGMENT, MQGS_NOT_IN_GROUP},
+			{"hijk", MQSS_SEGMENT, MQGS_NOT_IN_GROUP},
+			{"lmn", MQSS_LAST_SEGMENT, MQGS_NOT_IN_GROUP}}},
+		{"Group", []streamPiece{
+			{"one", MQSS_NOT_A_SEGMENT, MQGS_MSG_IN_GROUP},
+			{"two", MQSS_NOT_A_SEGMENT, MQGS_MSG_IN_GROUP},
+			{"three", MQSS_NOT_A_SEGMENT, MQGS_LAST_MSG_IN_GROUP}}},
+		{"Segmented group member", []streamPiece{
+			{"one", MQSS_SEGMENT, MQGS_MSG_IN_GROUP},
+			{"two", MQSS_LAST_SEGMENT, MQGS_MSG_IN_GROUP},
+			{"three", MQSS_NOT_A_SEGMENT, MQGS_LAST_MSG_IN_GROUP}}},
+	}
+
+	for _, tc := range tests {
+		expected := ""
+		for _, p := range tc.pieces {
+			expected += p.data
+		}
+
+		// Short reads of a few bytes at a time must still return everything
+		for _, size := range []int{1, 3, 1024} {
+			gets := 0
+			r := fakeStreamReader(tc.pieces, &gets)
+			got := ""
+			buf := make([]byte, size)
+			var err error
+			for i := 0; i < 1000; i++ {
+				var n int
+				n, err = r.Read(buf)
+				if n > size || (n == 0 && err == nil) {
+					t.Logf("%s: Read of %d bytes returned n=%d err=%v", tc.name, size, n, err)
+					t.Fail()
+					break
+				}
+				got += string(buf[:n])
+				if err != nil {
+					break
+				}
+			}
+			if err != io.EOF || got != expected {
+				t.Logf("%s: Read size %d. Expected %q EOF. Got %q %v", tc.name, size, expected, got, err)
+				t.Fail()
+			}
+
+			// EOF is at the end of the logical message, without another MQGET
+			if gets != len(tc.pieces) {
+				t.Logf("%s: Expected %d gets. Got %d", tc.name, len(tc.pieces), gets)
+				t.Fail()
+			}
+			if n, err := r.Read(buf); n != 0 || err != io.EOF {
+				t.Logf("%s: Read after EOF returned %d %v", tc.name, n, err)
+				t.Fail()
+			}
+		}
+	}
+
+	// A failure part way through is reported, after the data that was already read
+	gets := 0
+	r := fakeStreamReader([]streamPiece{{"abc", MQSS_SEGMENT, MQGS_NOT_IN_GROUP}}, &gets)
+	b, err := io.ReadAll(r)
+	if string(b) != "abc" || err == nil || err == io.EOF {
+		t.Logf("Incomplete message. Got %q %v", b, err)
+		t.Fail()
+	}
+
+	if r = NewMessageReader(MQObject{}, nil); r.MaxPieceSize <= 0 {
+		t.Logf("MessageReader has no default MaxPieceSize")
+		t.Fail()
+	}
+}
+
+func TestMessageWriter(t *testing.T) {
+	type put struct {
+		data  string
+		flags int32
+	}
+
+	tests := []struct {
+		name     string
+		useGroup bool
+		writes   []string
+		expected []put
+	}{
+		{"Empty", false, nil, []put{{"", 0}}},
+		{"One chunk", false, []string{"ab", "c"}, []put{{"abc", 0}}},
+		{"Exactly one chunk", false, []string{"abcd"}, []put{{"abcd", 0}}},
+		{"Segments", false, []string{"a", "bcdefghi", "j"}, []put{
+			{"abcd", MQMF_SEGMENT}, {"efgh", MQMF_SEGMENT}, {"ij", MQMF_LAST_SEGMENT}}},
+		{"Segments exact multiple", false, []string{"abcdefgh"}, []put{
+			{"abcd", MQMF_SEGMENT}, {"efgh", MQMF_LAST_SEGMENT}}},
+		{"Group", true, []string{"abcdef"}, []put{
+			{"abcd", MQMF_MSG_IN_GROUP}, {"ef", MQMF_LAST_MSG_IN_GROUP}}},
+		{"Group of one", true, []string{"ab"}, []put{{"ab", MQMF_LAST_MSG_IN_GROUP}}},
+	}
+
+	for _, tc := range tests {
+		var puts []put
+		w := NewMessageWriter(MQObject{}, nil)
+		w.ChunkSize = 4
+		w.UseGroup = tc.useGroup
+		w.put = func(md *MQMD, pmo *MQPMO, buf []byte) error {
+			if pmo.Options&MQPMO_LOGICAL_ORDER == 0 {
+				t.Logf("%s: Put without MQPMO_LOGICAL_ORDER", tc.name)
+				t.Fail()
+			}
+			puts = append(puts, put{string(buf), md.MsgFlags})
+			return nil
+		}
+
+		for _, s := range tc.writes {
+			if n, err := w.Write([]byte(s)); n != len(s) || err != nil {
+				t.Logf("%s: Write returned %d %v", tc.name, n, err)
+				t.Fail()
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Logf("%s: Close failed %v", tc.name, err)
+			t.Fail()
+		}
+		if len(puts) != len(tc.expected) {
+			t.Logf("%s: Expected: %+v. Got: %+v", tc.name, tc.expected, puts)
+			t.Fail()
+			continue
+		}
+		for i := range puts {
+			if puts[i] != tc.expected[i] {
+				t.Logf("%s: Put %d. Expected: %+v. Got: %+v", tc.name, i, tc.expected[i], puts[i])
+				t.Fail()
+			}
+		}
+
+		if _, err := w.Write([]byte("x")); err != io.ErrClosedPipe {
+			t.Logf("%s: Write after Close returned %v", tc.name, err)
+			t.Fail()
+		}
+	}
+}
+
+// Tests for mqibuffer.go
+func TestPooledBuffer(t *testing.T) {
+	bp := getPooledBuffer(1000)
+	if cap(*bp) != minPooledBufferSize || len(*bp) != 0 {
+		t.Logf("Buffer wrong size. Len: %d Cap: %d", len(*bp), cap(*bp))
+		t.Fail()
+	}
+	*bp = append(*bp, 1, 2, 3)
+	putPooledBuffer(bp)
+
+	// Whether or not we get a reused buffer, it must be empty and big enough
+	for _, size := range []int{1000, 5000, defaultGetBufferSize, maxPooledBufferSize} {
+		bp = getPooledBuffer(size)
+		if cap(*bp) < size || cap(*bp) >= 2*size+minPooledBufferSize || len(*bp) != 0 {
+			t.Logf("Buffer for %d wrong size. Len: %d Cap: %d", size, len(*bp), cap(*bp))
+			t.Fail()
+		}
+		putPooledBuffer(bp)
+	}
+
+	// Big buffers are exactly the size asked for
+	bp = getPooledBuffer(maxPooledBufferSize + 1)
+	if cap(*bp) != maxPooledBufferSize+1 {
+		t.Logf("Large buffer wrong size. Cap: %d", cap(*bp))
+		t.Fail()
+	}
+	if bufferClass(maxPooledBufferSize) != len(getBufferPools)-1 {
+		t.Logf("Wrong number of size classes")
+		t.Fail()
+	}
+}
+
+func TestPinToMsgToken(t *testing.T) {
+	gmo := NewMQGMO()
+	gmo.MatchOptions = MQMO_MATCH_MSG_ID | MQMO_MATCH_CORREL_ID
+
+	// No token was returned so the original selection has to be used
+	if pinToMsgToken(gmo) || gmo.MatchOptions != MQMO_MATCH_MSG_ID|MQMO_MATCH_CORREL_ID {
+		t.Logf("Pinned to an empty MsgToken. MatchOptions: %x", gmo.MatchOptions)
+		t.Fail()
+	}
+
+	gmo.MsgToken = make([]byte, MQ_MSG_TOKEN_LENGTH)
+	gmo.MsgToken[3] = 1
+	if !pinToMsgToken(gmo) || gmo.MatchOptions != MQMO_MATCH_MSG_TOKEN || gmo.Version < MQGMO_VERSION_3 {
+		t.Logf("Not pinned to MsgToken. Version: %d MatchOptions: %x", gmo.Version, gmo.MatchOptions)
+		t.Fail()
+	}
+}
+
+// Tests for mqierrors.go
+func TestErrorsIs(t *testing.T) {
+	var err error = &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_NO_MSG_AVAILABLE, verb: "MQGET"}
+
+	if !errors.Is(err, ErrNoMsgAvailable) {
+		t.Logf("Error %v does not match ErrNoMsgAvailable", err)
+		t.Fail()
+	}
+	if errors.Is(err, ErrQueueFull) {
+		t.Logf("Error %v matches ErrQueueFull", err)
+		t.Fail()
+	}
+
+	wrapped := fmt.Errorf("reading queue: %w", err)
+	if !errors.Is(wrapped, ErrNoMsgAvailable) {
+		t.Logf("Wrapped error %v does not match ErrNoMsgAvailable", wrapped)
+		t.Fail()
+	}
+}
+
+func TestClassifyReason(t *testing.T) {
+	classes := map[int32]ReasonClass{
+		MQRC_NONE:                ReasonClassNone,
+		MQRC_BACKED_OUT:          ReasonClassRetryable,
+		MQRC_Q_FULL:              ReasonClassRetryable,
+		MQRC_CONNECTION_BROKEN:   ReasonClassConnectionLost,
+		MQRC_Q_MGR_QUIESCING:     ReasonClassConnectionLost,
+		MQRC_NOT_AUTHORIZED:      ReasonClassConfiguration,
+		MQRC_UNKNOWN_OBJECT_NAME: ReasonClassConfiguration,
+		MQRC_NO_MSG_AVAILABLE:    ReasonClassOther,
+		MQRC_OPTIONS_ERROR:       ReasonClassOther,
+		MQRC_HCONN_ERROR:         ReasonClassOther,
+	}
+	for rc, c := range classes {
+		if ClassifyReason(rc) != c {
+			t.Logf("Reason %d. Expected: %v. Got: %v", rc, c, ClassifyReason(rc))
+			t.Fail()
+		}
+	}
+
+	err := &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_HOST_NOT_AVAILABLE, verb: "MQCONNX"}
+	if !IsConnectionLost(err) || IsRetryable(err) || IsConfigurationError(err) {
+		t.Logf("Wrong classification for %v", err)
+		t.Fail()
+	}
+	if IsRetryable(fmt.Errorf("Not an MQ error")) || IsRetryable(nil) {
+		t.Logf("Non-MQ error classified as retryable")
+		t.Fail()
+	}
+}
+
+// Tests for mqievents.go
+func TestConnEventType(t *testing.T) {
+	types := map[int32]ConnEventType{
+		MQRC_RECONNECTING:      ConnEventReconnecting,
+		MQRC_RECONNECTED:       ConnEventReconnected,
+		MQRC_RECONNECT_FAILED:  ConnEventReconnectFailed,
+		MQRC_Q_MGR_QUIESCING:   ConnEventQMgrQuiescing,
+		MQRC_CONNECTION_BROKEN: ConnEventConnectionBroken,
+	}
+	for rc, e := range types {
+		got, ok := connEventType(rc)
+		if !ok || got != e {
+			t.Logf("Reason %d. Expected: %v. Got: %v", rc, e, got)
+			t.Fail()
+		}
+	}
+	if _, ok := connEventType(MQRC_NO_MSG_AVAILABLE); ok {
+		t.Logf("Unexpected event type for MQRC_NO_MSG_AVAILABLE")
+		t.Fail()
+	}
+
+	// Sending after the channel has been closed must not panic
+	ce := &connEvents{ch: make(chan ConnEvent, 1)}
+	ce.send(ConnEvent{Type: ConnEventReconnecting})
+	ce.send(ConnEvent{Type: ConnEventReconnected})
+	ce.closed = true
+	close(ce.ch)
+	ce.send(ConnEvent{Type: ConnEventConnectionBroken})
+	if ev := <-ce.ch; ev.Type != ConnEventReconnecting {
+		t.Logf("Wrong event. Got: %v", ev.Type)
+		t.Fail()
+	}
+}
+
+// Tests for mqireconnect.go
+func TestStateLostError(t *testing.T) {
+	var err error = &StateLostError{Verb: "MQPUT", Err: &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_CONNECTION_BROKEN, verb: "MQPUT"}}
+
+	var stateLost *StateLostError
+	if !errors.As(err, &stateLost) {
+		t.Logf("Error is not a StateLostError")
+		t.Fail()
+	}
+	if !errors.Is(err, ErrConnectionBroken) || !IsConnectionLost(err) {
+		t.Logf("Original reason not found in %v", err)
+		t.Fail()
+	}
+}
+
+func TestReconnectingSub(t *testing.T) {
+	r := &ReconnectingQueueManager{}
+
+	// An unmanaged subscription with no destination object used to leave the
+	// destination without a ReconnectingQueueManager
+	sd := NewMQSD()
+	sd.ObjectString = "A/B"
+	o := r.newSubscription(sd, nil)
+	if o.rqm != r || o.dest == nil || o.dest.rqm != r || o.dest.managed {
+		t.Logf("Unmanaged subscription not recorded properly: %+v", o)
+		t.Fail()
+	}
+
+	dest := &ReconnectingObject{}
+	sd.Options = MQSO_CREATE | MQSO_MANAGED
+	o = r.newSubscription(sd, dest)
+	if o.dest != dest || dest.rqm != r || !dest.managed || o.sd == sd {
+		t.Logf("Managed subscription not recorded properly: %+v", o)
+		t.Fail()
+	}
+}
+
+func TestReconnectIsLost(t *testing.T) {
+	r := &ReconnectingQueueManager{generation: 1}
+	hconnErr := &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_HCONN_ERROR}
+
+	if !r.isLost(&MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_CONNECTION_BROKEN}, 1) {
+		t.Logf("Connection broken not treated as lost")
+		t.Fail()
+	}
+	// A handle error only means a lost connection if the connection has been replaced
+	if r.isLost(hconnErr, 1) || !r.isLost(hconnErr, 0) {
+		t.Logf("Handle error wrongly classified")
+		t.Fail()
+	}
+	if r.isLost(&MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_Q_FULL}, 0) {
+		t.Logf("Queue full treated as lost connection")
+		t.Fail()
+	}
+}
+
+// Tests for mqipool.go
+func TestPoolCNO(t *testing.T) {
+	cno := NewMQCNO()
+	cno.Options = MQCNO_HANDLE_SHARE_NONE | MQCNO_CLIENT_BINDING
+	pcno := poolCNO(cno)
+	if pcno.Options != MQCNO_HANDLE_SHARE_BLOCK|MQCNO_CLIENT_BINDING {
+		t.Logf("CNO options wrong. Got: %x", pcno.Options)
+		t.Fail()
+	}
+	if cno.Options != MQCNO_HANDLE_SHARE_NONE|MQCNO_CLIENT_BINDING {
+		t.Logf("Application CNO was modified. Got: %x", cno.Options)
+		t.Fail()
+	}
+
+	cno.Options = MQCNO_HANDLE_SHARE_NO_BLOCK
+	pcno = poolCNO(cno)
+	if pcno.Options != MQCNO_HANDLE_SHARE_NO_BLOCK {
+		t.Logf("NO_BLOCK option not kept. Got: %x", pcno.Options)
+		t.Fail()
+	}
+
+	od := NewMQOD()
+	od.ObjectName = "Q1"
+	if poolObjectKey(od, MQOO_OUTPUT) == poolObjectKey(od, MQOO_INPUT_SHARED) {
+		t.Logf("Object key does not include the open options")
+		t.Fail()
+	}
+}
+
+func TestPoolCachedOD(t *testing.T) {
+	opened := NewMQOD()
+	opened.ObjectName = "ALIAS.Q"
+	opened.ResolvedQName = "REAL.Q"
+	opened.ResolvedQMgrName = "QM1"
+	opened.ResolvedType = MQOT_Q
+
+	// A second open of the same object gives the same output as the first one did
+	od := NewMQOD()
+	od.ObjectName = "ALIAS.Q"
+	copyODOutput(od, opened)
+	if od.ResolvedQName != "REAL.Q" || od.ResolvedQMgrName != "QM1" || od.ResolvedType != MQOT_Q || od.ObjectName != "ALIAS.Q" {
+		t.Logf("MQOD output fields not copied. Got: %+v", od)
+		t.Fail()
+	}
+
+	p := NewPool("QM1", nil)
+	if p.HealthCheck != PoolHealthCheckInq || !p.BackoutOnRelease {
+		t.Logf("Wrong pool defaults. HealthCheck: %d BackoutOnRelease: %v", p.HealthCheck, p.BackoutOnRelease)
+		t.Fail()
+	}
+}
+
+func TestPoolClosed(t *testing.T) {
+	p := NewPool("QM1", nil)
+	p.Close()
+	if _, err := p.Get(context.Background()); err != ErrPoolClosed {
+		t.Logf("Get from closed pool. Expected: %v. Got: %v", ErrPoolClosed, err)
+		t.Fail()
+	}
+}
+
+func TestPoolConnectWarning(t *testing.T) {
+	// A connection that comes with a warning is kept, not leaked
+	warn := &MQReturn{MQCC: MQCC_WARNING, MQRC: MQRC_SSL_ALREADY_INITIALIZED, verb: "MQCONNX"}
+	if !isWarning(warn) || !isWarning(fmt.Errorf("wrapped: %w", warn)) {
+		t.Logf("Warning not recognised")
+		t.Fail()
+	}
+	if isWarning(&MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_NOT_AUTHORIZED}) || isWarning(nil) {
+		t.Logf("Failure treated as a warning")
+		t.Fail()
+	}
+}
+
+// Tests for mqiconnstr.go
+func TestParseConnString(t *testing.T) {
+	qMgrName, cno, err := ParseConnString("mq://app:secret@host1(1414),host2:1415/QM1?channel=APP.SVRCONN&cipher=ANY_TLS13&keyrepo=/etc/mq/key&reconnect=qmgr")
+	if err != nil {
+		t.Logf("Parse failed: %v", err)
+		t.FailNow()
+	}
+	if qMgrName != "QM1" {
+		t.Logf("QMgr name wrong. Got: %s", qMgrName)
+		t.Fail()
+	}
+	if cno.ClientConn == nil || cno.ClientConn.ConnectionName != "host1(1414),host2(1415)" || cno.ClientConn.ChannelName != "APP.SVRCONN" ||
+		cno.ClientConn.SSLCipherSpec != "ANY_TLS13" {
+		t.Logf("MQCD wrong. Got: %+v", cno.ClientConn)
+		t.Fail()
+	}
+	if cno.SSLConfig == nil || cno.SSLConfig.KeyRepository != "/etc/mq/key" {
+		t.Logf("MQSCO wrong. Got: %+v", cno.SSLConfig)
+		t.Fail()
+	}
+	if cno.SecurityParms == nil || cno.SecurityParms.UserId != "app" || cno.SecurityParms.Password != "secret" {
+		t.Logf("MQCSP wrong. Got: %+v", cno.SecurityParms)
+		t.Fail()
+	}
+	if cno.Options != MQCNO_CLIENT_BINDING|MQCNO_RECONNECT_Q_MGR {
+		t.Logf("CNO options wrong. Got: %x", cno.Options)
+		t.Fail()
+	}
+
+	s := FormatConnString(qMgrName, cno)
+	expected := "mq://app:REDACTED@host1(1414),host2(1415)/QM1?channel=APP.SVRCONN&cipher=ANY_TLS13&keyrepo=%2Fetc%2Fmq%2Fkey&reconnect=qmgr"
+	if s != expected {
+		t.Logf("Format wrong.\nExpected: %s\nGot:      %s", expected, s)
+		t.Fail()
+	}
+
+	bad := []string{
+		"http://host(1414)/QM1?channel=A",
+		"mq://host(1414)/QM1",
+		"mq://host(1414)/QM1?channel=A&ccdt=https://example.com/ccdt.json",
+		"mq://[::1/QM1?channel=A",
+		"mq://[::1]x/QM1?channel=A",
+		"mq://host(1414)/QM1?channel=A&nosuch=1",
+		"mq://host(1414)/QM1?channel=A&reconnect=maybe",
+	}
+	for _, b := range bad {
+		if _, _, err := ParseConnString(b); err == nil {
+			t.Logf("No error for %s", b)
+			t.Fail()
+		}
+	}
+
+	_, cno, err = ParseConnString("mq://[::1]:1414,[fe80::1],host3/QM1?channel=A")
+	if err != nil || cno.ClientConn.ConnectionName != "::1(1414),fe80::1,host3" {
+		t.Logf("IPv6 hosts wrong. Got: %+v %v", cno, err)
+		t.Fail()
+	}
+}
+
+func TestParseConnStringCCDT(t *testing.T) {
+	ccdt := `{"channel":[
+	  {"name":"APP.SVRCONN","clientConnection":{"connection":[{"host":"ccdthost","port":1414}],"queueManager":"QM1"},
+	   "connectionManagement":{"heartbeatInterval":60,"sharingConversations":5},
+	   "transmissionSecurity":{"cipherSpecification":"ANY_TLS12","certificatePeerName":"CN=QM1"}},
+	  {"name":"OTHER.SVRCONN","clientConnection":{"connection":[{"host":"otherhost"}],"queueManager":"QM2"}}]}`
+	file := filepath.Join(t.TempDir(), "ccdt.json")
+	if err := os.WriteFile(file, []byte(ccdt), 0600); err != nil {
+		t.Fatalf("Cannot write CCDT: %v", err)
+	}
+
+	// The CCDT gives the channel attributes and the hosts replace its CONNAME
+	_, cno, err := ParseConnString("mq://host1:1415,[::1]:1416/QM1?ccdt=" + url.QueryEscape(file) + "&heartbeat=30")
+	if err != nil {
+		t.Logf("Parse failed: %v", err)
+		t.FailNow()
+	}
+	cd := cno.ClientConn
+	if cd == nil || cd.ChannelName != "APP.SVRCONN" || cd.ConnectionName != "host1(1415),::1(1416)" ||
+		cd.SSLCipherSpec != "ANY_TLS12" || cd.SSLPeerName != "CN=QM1" || cd.HeartbeatInterval != 30 || cd.SharingConversations != 5 {
+		t.Logf("MQCD wrong. Got: %+v", cd)
+		t.Fail()
+	}
+	if cno.CCDTUrl != "" || cno.Options&MQCNO_CLIENT_BINDING == 0 {
+		t.Logf("CNO wrong. CCDTUrl: %s Options: %x", cno.CCDTUrl, cno.Options)
+		t.Fail()
+	}
+
+	_, cno, err = ParseConnString("mq://host1/?ccdt=file://" + url.QueryEscape(file) + "&channel=OTHER.SVRCONN")
+	if err != nil || cno.ClientConn.ChannelName != "OTHER.SVRCONN" || cno.ClientConn.ConnectionName != "host1" {
+		t.Logf("Channel selection wrong. Got: %+v %v", cno, err)
+		t.Fail()
+	}
+
+	// Without a queue manager name or channel, the choice is ambiguous
+	if _, _, err = ParseConnString("mq://host1?ccdt=" + url.QueryEscape(file)); err == nil {
+		t.Logf("No error for ambiguous channel")
+		t.Fail()
+	}
+}
+
+// Tests for mqiconfig.go
+func TestLoadConnConfig(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "mq.yaml")
+	yaml := "# Test configuration\nqmgr: QM1\napplName: \"From file\"\nuserId: app # A comment\ncipherSpec: ANY_TLS13\n"
+	if err := os.WriteFile(file, []byte(yaml), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{
+		"MQSERVER":     "APP.SVRCONN/TCP/host1(1414)",
+		"MQ_APPL_NAME": "From env",
+	}
+	getenv := func(s string) string { return env[s] }
+
+	l, err := loadConnConfig(&ConnConfig{QMgrName: "QM2"}, file, "", getenv)
+	if err != nil {
+		t.Logf("Load failed: %v", err)
+		t.FailNow()
+	}
+
+	expected := map[string]string{
+		"qmgr":       "explicit",
+		"channel":    "env:MQSERVER",
+		"connName":   "env:MQSERVER",
+		"applName":   "env:MQ_APPL_NAME",
+		"userId":     "file:" + file,
+		"cipherSpec": "file:" + file,
+	}
+	for k, v := range expected {
+		if l.Sources[k] != v {
+			t.Logf("Source for %s. Expected: %s. Got: %s", k, v, l.Sources[k])
+			t.Fail()
+		}
+	}
+	if l.QMgrName != "QM2" || l.CNO.ApplName != "From env" || l.CNO.ClientConn == nil || l.CNO.ClientConn.SSLCipherSpec != "ANY_TLS13" ||
+		l.CNO.SecurityParms == nil || l.CNO.SecurityParms.UserId != "app" || l.CNO.Options&MQCNO_CLIENT_BINDING == 0 {
+		t.Logf("Loaded configuration wrong: %s", l)
+		t.Fail()
+	}
+
+	// An explicit local binding replaces the whole client definition from the environment
+	l, err = loadConnConfig(&ConnConfig{Binding: "local"}, "", "", getenv)
+	if err != nil || l.CNO.ClientConn != nil || l.CNO.Options&MQCNO_LOCAL_BINDING == 0 {
+		t.Logf("Local binding with MQSERVER. Got: %v %v", l, err)
+		t.Fail()
+	} else if _, ok := l.Sources["channel"]; ok {
+		t.Logf("Channel taken from MQSERVER with local binding")
+		t.Fail()
+	}
+
+	// So does an explicit CCDT
+	env["MQCCDTURL"] = "file:///env/ccdt.json"
+	l, err = loadConnConfig(&ConnConfig{CCDTUrl: "file:///app/ccdt.json"}, "", "", getenv)
+	if err != nil || l.CNO.ClientConn != nil || l.CNO.CCDTUrl != "file:///app/ccdt.json" || l.Sources["ccdtUrl"] != "explicit" {
+		t.Logf("Explicit CCDT with MQSERVER. Got: %v %v", l, err)
+		t.Fail()
+	}
+
+	// MQSERVER is used in preference to MQCCDTURL
+	l, err = loadConnConfig(nil, "", "", getenv)
+	if err != nil || l.CNO.CCDTUrl != "" || l.CNO.ClientConn == nil {
+		t.Logf("MQSERVER and MQCCDTURL. Got: %v %v", l, err)
+		t.Fail()
+	}
+
+	// The CCDT has its own CipherSpec, so one from elsewhere cannot be used
+	if _, err = loadConnConfig(&ConnConfig{CCDTUrl: "file:///app/ccdt.json"}, file, "", getenv); err == nil {
+		t.Logf("No error for cipherSpec with a CCDT")
+		t.Fail()
+	}
+
+	// JSON is recognised from the contents
+	jsonFile := filepath.Join(t.TempDir(), "mq.conf")
+	if err := os.WriteFile(jsonFile, []byte(` {"qmgr":"QM3","channel":"C1","connName":"h(1)"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	l, err = loadConnConfig(nil, jsonFile, "", func(string) string { return "" })
+	if err != nil || l.QMgrName != "QM3" || l.CNO.ClientConn == nil || l.CNO.ClientConn.ChannelName != "C1" {
+		t.Logf("JSON file. Got: %v %v", l, err)
+		t.Fail()
+	}
+
+	// mqclient.ini comes last, and is not used for local connections
+	ini := filepath.Join(t.TempDir(), "mqclient.ini")
+	iniText := "# Client settings\nCHANNELS:\n  ChannelDefinitionDirectory=/var/ccdt\n  DefRecon=YES\nSSL:\n  SSLKeyRepository=/var/keys/key\n"
+	if err := os.WriteFile(ini, []byte(iniText), 0600); err != nil {
+		t.Fatal(err)
+	}
+	l, err = loadConnConfig(&ConnConfig{QMgrName: "QM4"}, "", ini, func(string) string { return "" })
+	if err != nil || l.CNO.CCDTUrl != "file:///var/ccdt/AMQCLCHL.TAB" || l.Config.Reconnect != "yes" ||
+		l.Config.KeyRepository != "/var/keys/key" || l.Sources["ccdtUrl"] != "mqclient.ini:"+ini {
+		t.Logf("mqclient.ini. Got: %v %v", l, err)
+		t.Fail()
+	}
+	l, err = loadConnConfig(nil, "", ini, getenv)
+	if err != nil || l.Sources["channel"] != "env:MQSERVER" || l.CNO.CCDTUrl != "" || l.Sources["keyRepository"] != "mqclient.ini:"+ini {
+		t.Logf("mqclient.ini with MQSERVER. Got: %v %v", l, err)
+		t.Fail()
+	}
+	l, err = loadConnConfig(&ConnConfig{Binding: "local"}, "", ini, func(string) string { return "" })
+	if err != nil || len(l.Sources) != 1 {
+		t.Logf("mqclient.ini with local binding. Got: %v %v", l, err)
+		t.Fail()
+	}
+	if c := findClientIni(func(s string) string { return map[string]string{"MQCLNTCF": ini}[s] }); c != ini {
+		t.Logf("MQCLNTCF not used. Got: %s", c)
+		t.Fail()
+	}
+}
+
+// Tests for mqiccdt.go
+func TestCCDT(t *testing.T) {
+	js := `{"channel":[
+	  {"name":"APP.SVRCONN","type":"clientConnection",
+	   "clientConnection":{"connection":[{"host":"host1","port":1414},{"host":"host2","port":1415}],"queueManager":"QM1"},
+	   "connectionManagement":{"clientReconnect":"yes","sharingConversations":0},
+	   "transmissionSecurity":{"cipherSpecification":"ANY_TLS13"}},
+	  {"name":"APP.SVRCONN",
+	   "clientConnection":{"connection":[{"host":"host3"}],"queueManager":"QM1"},
+	   "transmissionSecurity":{"cipherSpecification":"NOT_A_CIPHER"}}
+	]}`
+
+	ccdt, err := ParseCCDT([]byte(js))
+	if err != nil {
+		t.Logf("Parse failed: %v", err)
+		t.FailNow()
+	}
+
+	var verr *CCDTValidationError
+	if err = ccdt.Validate(); !errors.As(err, &verr) || len(verr.Problems) != 3 {
+		t.Logf("Expected 3 problems (duplicate, port, cipher). Got: %v", err)
+		t.Fail()
+	}
+
+	cd := ccdt.Channel[0].ToMQCD()
+	if cd.ConnectionName != "host1(1414),host2(1415)" || cd.DefReconnect != MQRCN_YES || cd.SharingConversations != 0 ||
+		cd.SSLCipherSpec != "ANY_TLS13" {
+		t.Logf("MQCD wrong. Got: %+v", cd)
+		t.Fail()
+	}
+
+	ch, err := NewCCDTChannel(cd, "QM1")
+	if err != nil {
+		t.Logf("Conversion from MQCD failed: %v", err)
+		t.FailNow()
+	}
+	one := &CCDT{Channel: []CCDTChannel{ch}}
+	if err = one.Validate(); err != nil {
+		t.Logf("Converted channel is not valid: %v", err)
+		t.Fail()
+	}
+	if cd2 := ch.ToMQCD(); *cd2 != *cd {
+		t.Logf("MQCD round trip failed.\nExpected: %+v\nGot:      %+v", cd, cd2)
+		t.Fail()
+	}
+
+	// The duplicate in the first CCDT is replaced by the second definition, and then by
+	// the converted channel
+	other := &CCDT{Channel: []CCDTChannel{{Name: "OTHER.SVRCONN"}, ch}}
+	merged := MergeCCDT(ccdt, other)
+	if len(merged.Channel) != 2 || merged.Channel[0].ClientConnection.Connection[0].Host != "host1" || merged.Channel[1].Name != "OTHER.SVRCONN" {
+		t.Logf("Merge wrong. Got: %+v", merged.Channel)
+		t.Fail()
+	}
+}
+
+func TestCCDTCipherSpecs(t *testing.T) {
+	// Deprecated CipherSpecs are still accepted, as MQ can be configured to allow them
+	for _, cs := range []string{"TLS_RSA_WITH_AES_128_CBC_SHA", "TLS_RSA_WITH_AES_256_CBC_SHA", "ECDHE_RSA_3DES_EDE_CBC_SHA256", "ANY_TLS13_OR_HIGHER"} {
+		ccdt := &CCDT{Channel: []CCDTChannel{{Name: "APP.SVRCONN", TransmissionSecurity: &CCDTTransmissionSecurity{CipherSpecification: cs}}}}
+		ccdt.Channel[0].ClientConnection.Connection = []CCDTConnection{{Host: "host1", Port: 1414}}
+		if err := ccdt.Validate(); err != nil {
+			t.Logf("CipherSpec %s rejected: %v", cs, err)
+			t.Fail()
+		}
+	}
+}
+
+func TestCCDTUnknownMembers(t *testing.T) {
+	js := `{"channel":[
+	  {"name":"APP.SVRCONN","type":"clientConnection",
+	   "clientConnection":{"connection":[{"host":"host1","port":1414,"localAddress":"10.0.0.1"}],"queueManager":"QM1","extra":true},
+	   "channelExits":{"sendExit":["exit1(Send)"]},
+	   "general":{"description":"Test","futureAttr":[1,2]},
+	   "transmissionSecurity":{"cipherSpecification":"ANY_TLS13","spn":"x"},
+	   "connectionManagement":{"clientWeight":5,"localAddress":"a"}}
+	],"version":2}`
+
+	file := filepath.Join(t.TempDir(), "ccdt.json")
+	if err := os.WriteFile(file, []byte(js), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ccdt, err := LoadCCDT(file)
+	if err != nil {
+		t.Logf("Load failed: %v", err)
+		t.FailNow()
+	}
+
+	ch := ccdt.Channel[0]
+	if string(ccdt.Unknown["version"]) != "2" || ch.Unknown["channelExits"] == nil || ch.Unknown["name"] != nil ||
+		ch.General.Unknown["futureAttr"] == nil || ch.ClientConnection.Unknown["extra"] == nil ||
+		ch.ClientConnection.Connection[0].Unknown["localAddress"] == nil ||
+		ch.TransmissionSecurity.Unknown["spn"] == nil || ch.ConnectionManagement.Unknown["localAddress"] == nil {
+		t.Logf("Unknown members not kept. Got: %+v", ccdt)
+		t.Fail()
+	}
+	if ch.General.Description != "Test" || ch.ConnectionManagement.ClientWeight != 5 {
+		t.Logf("Known members wrong. Got: %+v", ch)
+		t.Fail()
+	}
+
+	// Merging and writing out again must give the same content as the original file
+	merged := MergeCCDT(ccdt, &CCDT{})
+	out := filepath.Join(t.TempDir(), "out.json")
+	if err = merged.WriteFile(out); err != nil {
+		t.Logf("Write failed: %v", err)
+		t.FailNow()
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var before, after interface{}
+	json.Unmarshal([]byte(js), &before)
+	json.Unmarshal(b, &after)
+	if !reflect.DeepEqual(before, after) {
+		t.Logf("Round trip changed the CCDT.\nBefore: %s\nAfter:  %s", js, b)
+		t.Fail()
+	}
+}
+
+// Tests for mqicredentials.go
+func TestTokenEndpointProvider(t *testing.T) {
+	requests := 0
+	expiresIn := 3600
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.FormValue("grant_type") != "client_credentials" || r.FormValue("client_id") != "cid" || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, `{"access_token":"token%d","expires_in":%d}`, requests, expiresIn)
+	}))
+	defer server.Close()
+
+	p := NewTokenEndpointProvider(server.URL, "cid", "secret")
+	for i := 0; i < 2; i++ {
+		creds, err := p.Credentials(context.Background())
+		if err != nil || creds.Token != "token1" {
+			t.Logf("Expected cached token1. Got: %v %v", creds, err)
+			t.Fail()
+		}
+	}
+
+	// A token that expires within RefreshBefore is replaced on the next call
+	p = NewTokenEndpointProvider(server.URL, "cid", "secret")
+	expiresIn = 10
+	p.Credentials(context.Background())
+	creds, err := p.Credentials(context.Background())
+	if err != nil || creds.Token != "token3" {
+		t.Logf("Expected refreshed token3. Got: %v %v", creds, err)
+		t.Fail()
+	}
+
+	p = NewTokenEndpointProvider(server.URL, "cid", "wrong")
+	if _, err = p.Credentials(context.Background()); err == nil {
+		t.Logf("No error for rejected request")
+		t.Fail()
+	}
+}
+
+func TestApplyCredentials(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(file, []byte("passw0rd\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cno := NewMQCNO()
+	cno.CredentialProvider = NewFileCredentialProvider("app", file)
+	withCreds, err := applyCredentials(cno)
+	if err != nil {
+		t.Logf("Apply failed: %v", err)
+		t.FailNow()
+	}
+	csp := withCreds.SecurityParms
+	if csp == nil || csp.AuthenticationType != MQCSP_AUTH_USER_ID_AND_PWD || csp.UserId != "app" || csp.Password != "passw0rd" {
+		t.Logf("MQCSP wrong. Got: %+v", csp)
+		t.Fail()
+	}
+	if cno.SecurityParms != nil {
+		t.Logf("Application CNO was modified")
+		t.Fail()
+	}
+
+	cno.CredentialProvider = &StaticCredentialProvider{Token: "abc"}
+	withCreds, _ = applyCredentials(cno)
+	if csp = withCreds.SecurityParms; csp.AuthenticationType != MQCSP_AUTH_ID_TOKEN || csp.Token != "abc" {
+		t.Logf("Token MQCSP wrong. Got: %+v", csp)
+		t.Fail()
+	}
+}
+
+// Tests for mqitls.go
+func writeTestCert(t *testing.T, dir string, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "app"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	return certFile, keyFile
+}
+
+func TestTLSOptions(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir, time.Now().Add(24*time.Hour))
+
+	var events []TLSEvent
+	o := NewTLSOptions(certFile, keyFile, "")
+	o.OnEvent = func(ev TLSEvent) { events = append(events, ev) }
+	defer o.Close()
+
+	cno := NewMQCNO()
+	cno.ClientConn = NewMQCD()
+	o.CipherSpec = "ANY_TLS13"
+	withTLS, err := o.apply(cno)
+	if err != nil {
+		t.Logf("Apply failed: %v", err)
+		t.FailNow()
+	}
+	keyRepo := withTLS.SSLConfig.KeyRepository
+	if !strings.HasSuffix(keyRepo, ".pem") || withTLS.ClientConn.SSLCipherSpec != "ANY_TLS13" || cno.ClientConn.SSLCipherSpec != "" {
+		t.Logf("TLS settings wrong. KeyRepository: %s CipherSpec: %s", keyRepo, withTLS.ClientConn.SSLCipherSpec)
+		t.Fail()
+	}
+	if len(events) != 1 || events[0].Type != TLSEventExpiring {
+		t.Logf("Expected an Expiring event. Got: %v", events)
+		t.Fail()
+	}
+
+	// The key repository holds the key and certificate, and is private
+	fi, err := os.Stat(keyRepo)
+	if err != nil || fi.Mode().Perm() != 0600 {
+		t.Logf("Key repository not private. Got: %v %v", fi, err)
+		t.Fail()
+	}
+	b, _ := os.ReadFile(keyRepo)
+	if !strings.Contains(string(b), "PRIVATE KEY") || !strings.Contains(string(b), "CERTIFICATE") {
+		t.Logf("Key repository incomplete: %s", b)
+		t.Fail()
+	}
+
+	// The same material gives the same key repository, so MQ does not see a change
+	if again, err := o.apply(cno); err != nil || again.SSLConfig.KeyRepository != keyRepo {
+		t.Logf("Key repository changed. Got: %v %v", again, err)
+		t.Fail()
+	}
+
+	// New material gives a new key repository and a Changed event
+	events = nil
+	time.Sleep(10 * time.Millisecond)
+	writeTestCert(t, dir, time.Now().Add(365*24*time.Hour))
+	withTLS, err = o.apply(cno)
+	if err != nil || withTLS.SSLConfig.KeyRepository == keyRepo {
+		t.Logf("Key repository not replaced. Got: %v %v", withTLS, err)
+		t.FailNow()
+	}
+	if len(events) != 1 || events[0].Type != TLSEventChanged {
+		t.Logf("Expected a Changed event. Got: %v", events)
+		t.Fail()
+	}
+
+	o.Close()
+	if _, err = os.Stat(withTLS.SSLConfig.KeyRepository); err == nil {
+		t.Logf("Key repository not removed by Close")
+		t.Fail()
+	}
+
+	// An expired certificate cannot be used
+	time.Sleep(10 * time.Millisecond)
+	writeTestCert(t, dir, time.Now().Add(-time.Minute))
+	if err = o.Validate(); err == nil {
+		t.Logf("No error for expired certificate")
+		t.Fail()
+	}
+}
+
+// Tests for mqiasync.go
+func TestAsyncPutReport(t *testing.T) {
+	opts := asyncPMOOptions(MQPMO_SYNC_RESPONSE | MQPMO_SYNCPOINT)
+	if opts != MQPMO_ASYNC_RESPONSE|MQPMO_SYNCPOINT {
+		t.Logf("PMO options wrong. Got: %x", opts)
+		t.Fail()
+	}
+
+	var totals AsyncPutReport
+	sts := NewMQSTS()
+	sts.PutSuccessCount = 10
+	r := reportFromSTS(10, sts)
+	totals.accumulate(&r)
+	if r.err() != nil {
+		t.Logf("Unexpected error for successful batch: %v", r.err())
+		t.Fail()
+	}
+
+	sts.PutSuccessCount = 7
+	sts.PutFailureCount = 3
+	sts.CompCode = MQCC_FAILED
+	sts.Reason = MQRC_Q_FULL
+	sts.ObjectName = "APP.Q"
+	r = reportFromSTS(10, sts)
+	totals.accumulate(&r)
+	if !errors.Is(r.err(), ErrQueueFull) {
+		t.Logf("Expected queue full error. Got: %v", r.err())
+		t.Fail()
+	}
+
+	if totals.Puts != 20 || totals.PutSuccessCount != 17 || totals.PutFailureCount != 3 || totals.ObjectName != "APP.Q" {
+		t.Logf("Totals wrong. Got: %+v", totals)
+		t.Fail()
+	}
+}
+
+func TestAsyncProducerClosed(t *testing.T) {
+	p := NewAsyncProducer(nil)
+	p.closed = true
+	if err := p.Put1(NewMQOD(), NewMQMD(), NewMQPMO(), nil); err != ErrProducerClosed {
+		t.Logf("Expected ErrProducerClosed. Got: %v", err)
+		t.Fail()
+	}
+}
+
+// Tests for mqibrowse.go
+func TestBrowserMatch(t *testing.T) {
+	opts := NewBrowseOptions()
+	opts.CorrelId = []byte("CORREL")
+	b := NewBrowser(MQObject{}, opts)
+
+	md := NewMQMD()
+	gmo := b.newGMO(md)
+	if gmo.MatchOptions != MQMO_MATCH_CORREL_ID || string(md.CorrelId) != "CORREL" {
+		t.Logf("Wrong match options %d for CorrelId %q", gmo.MatchOptions, md.CorrelId)
+		t.Fail()
+	}
+
+	b = NewBrowser(MQObject{}, nil)
+	gmo = b.newGMO(NewMQMD())
+	if gmo.MatchOptions != MQMO_NONE {
+		t.Logf("Expected no matching. Got %d", gmo.MatchOptions)
+		t.Fail()
+	}
+}
+
+// Tests for mqiselector.go
+func TestParseSelector(t *testing.T) {
+	good := []string{
+		"",
+		"colour = 'blue' AND size BETWEEN 3 AND 5",
+		"Root.MQMD.Priority > 4 OR name LIKE 'A\\_%' ESCAPE '\\'",
+		"Root.MQMD.CorrelId = \"0x414D5120\"",
+		"NOT (a IN ('x', 'y')) AND b IS NOT NULL AND c * 2 + 1.5E2 >= -d",
+		"JMSPriority <> 4 AND JMS_IBM_Format = 'MQSTR' AND flag = TRUE",
+	}
+	for _, s := range good {
+		if err := ValidateSelector(s); err != nil {
+			t.Logf("Selector %q should be valid. Got: %v", s, err)
+			t.Fail()
+		}
+	}
+
+	bad := map[string]int{
+		"colour = 'blue":        10,
+		"colour == 'blue'":      8,
+		"a AND":                 6,
+		"Root.MQMD.Priorty = 1": 1,
+		"JMSPriorty = 1":        1,
+		"size > 'big'":          6,
+		"a + 1":                 1,
+		"x = NULL":              5,
+		"a LIKE 3":              8,
+		"a IN ('x', 2)":         3,
+		"b = \"414D\"":          5,
+	}
+	for s, pos := range bad {
+		err := ValidateSelector(s)
+		var se *SelectorError
+		if !errors.As(err, &se) || se.Pos != pos {
+			t.Logf("Selector %q should fail at position %d. Got: %v", s, pos, err)
+			t.Fail()
+		}
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	props := map[string]interface{}{"colour": "blue", "size": int32(4), "weight": 2.5, "name": "A_1"}
+	tests := map[string]bool{
+		"colour = 'blue' AND size BETWEEN 3 AND 5": true,
+		"colour <> 'blue'":                         false,
+		"missing = 1":                              false,
+		"NOT (missing = 1)":                        false,
+		"missing = 1 OR size = 4":                  true,
+		"missing IS NULL":                          true,
+		"weight * 2 = size + 1":                    true,
+		"name LIKE 'A\\_%' ESCAPE '\\'":            true,
+		"name LIKE 'AB%'":                          false,
+		"colour NOT IN ('red', 'green')":           true,
+		"Root.MQMD.Priority = 7":                   true,
+	}
+	md := NewMQMD()
+	md.Priority = 7
+	for s, want := range tests {
+		sel, err := ParseSelector(s)
+		if err != nil {
+			t.Logf("Cannot parse %q: %v", s, err)
+			t.Fail()
+			continue
+		}
+		if got := sel.MatchesMessage(md, props); got != want {
+			t.Logf("Selector %q gave %v, expected %v", s, got, want)
+			t.Fail()
+		}
+	}
+}
+
+func TestSelectorBuilder(t *testing.T) {
+	s, err := Prop("colour").Eq("it's").And(MDField("Priority").Gt(4).Or(Prop("size").In(1, 2))).Not().Build()
+	if err != nil || s != "NOT (colour = 'it''s' AND (Root.MQMD.Priority > 4 OR size IN (1, 2)))" {
+		t.Logf("Unexpected selector %q: %v", s, err)
+		t.Fail()
+	}
+
+	if _, err = MDField("Priorty").Eq(1).Build(); err == nil {
+		t.Logf("Expected error for unknown MQMD field")
+		t.Fail()
+	}
+}
+
+// Tests for mqiconstexpr.go
+func TestParseMQIConstant(t *testing.T) {
+	tests := []struct {
+		class string
+		expr  string
+		want  int32
+	}{
+		{"MQOO", "MQOO_INPUT_SHARED | MQOO_FAIL_IF_QUIESCING", MQOO_INPUT_SHARED | MQOO_FAIL_IF_QUIESCING},
+		{"RC", "Q_FULL", MQRC_Q_FULL},
+		{"RC", "MQRCCF_NONE_FOUND", MQRCCF_NONE_FOUND},
+		{"", "mqgmo_wait|0x10", MQGMO_WAIT | 0x10},
+		{"MQGMO", "-1", -1},
+	}
+	for _, tc := range tests {
+		got, err := ParseMQIConstant(tc.class, tc.expr)
+		if err != nil || got != tc.want {
+			t.Logf("Parsing %q gave %d (%v), expected %d", tc.expr, got, err, tc.want)
+			t.Fail()
+		}
+	}
+
+	for _, expr := range []string{"MQGMO_WAIT", "NOT_A_NAME", "INPUT_SHARED |", ""} {
+		if _, err := ParseMQIConstant("OO", expr); err == nil {
+			t.Logf("Expected error parsing %q", expr)
+			t.Fail()
+		}
+	}
+}
+
+func TestFormatMQIFlags(t *testing.T) {
+	s := FormatMQIFlags("MQOO", MQOO_INPUT_SHARED|MQOO_FAIL_IF_QUIESCING|0x40000000)
+	if s != "MQOO_INPUT_SHARED | MQOO_FAIL_IF_QUIESCING | 0x40000000" {
+		t.Logf("Unexpected format %q", s)
+		t.Fail()
+	}
+	if s = FormatMQIFlags("GMO", 0); s != "MQGMO_NONE" {
+		t.Logf("Unexpected format of 0: %q", s)
+		t.Fail()
+	}
+}
+
+// Tests for mqijson.go
+func TestMQMDJSON(t *testing.T) {
+	md := NewMQMD()
+	md.MsgType = MQMT_REQUEST
+	md.Persistence = MQPER_PERSISTENT
+	md.Format = MQFMT_STRING
+	md.Report = MQRO_COA | MQRO_PASS_CORREL_ID
+	md.MsgId = []byte{1, 2, 3, 0xAB}
+	md.PutDateTime = time.Date(2026, 3, 4, 5, 6, 7, 80000000, time.UTC)
+
+	b, err := json.Marshal(md)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"msgType":"MQMT_REQUEST"`, `"persistence":"MQPER_PERSISTENT"`,
+		`"format":"MQFMT_STRING"`, `"msgId":"010203ab"`, `"putDateTime":"2026-03-04T05:06:07.08Z"`} {
+		if !strings.Contains(string(b), want) {
+			t.Logf("JSON %s does not contain %s", b, want)
+			t.Fail()
+		}
+	}
+
+	md2 := NewMQMD()
+	if err = json.Unmarshal(b, md2); err != nil || !reflect.DeepEqual(md, md2) {
+		t.Logf("MQMD changed after unmarshal: %+v %v", md2, err)
+		t.Fail()
+	}
+
+	if err = json.Unmarshal([]byte(`{"msgType":"MQMT_NOT_A_TYPE"}`), md2); err == nil {
+		t.Logf("Expected error for unknown msgType")
+		t.Fail()
+	}
+}
+
+func TestMQCNOJSON(t *testing.T) {
+	cno := NewMQCNO()
+	cno.Options = MQCNO_CLIENT_BINDING | MQCNO_RECONNECT
+	cno.SecurityParms = NewMQCSP()
+	cno.SecurityParms.AuthenticationType = MQCSP_AUTH_USER_ID_AND_PWD
+	cno.SecurityParms.UserId = "app"
+	cno.SecurityParms.Password = "secret"
+
+	b, err := json.Marshal(cno)
+	if err != nil || strings.Contains(string(b), "secret") {
+		t.Logf("Unexpected JSON %s: %v", b, err)
+		t.Fail()
+	}
+
+	// The password already in the structure is kept
+	cno.Options = 0
+	if err = json.Unmarshal(b, cno); err != nil || cno.Options != MQCNO_CLIENT_BINDING|MQCNO_RECONNECT || cno.SecurityParms.Password != "secret" {
+		t.Logf("Unexpected MQCNO after unmarshal: %+v %v", cno, err)
+		t.Fail()
+	}
+}
+
+// Tests for mqidump.go
+func TestMessageDump(t *testing.T) {
+	cp, err := LookupCCSID(500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	toEBCDIC := func(s string) []byte {
+		b, _ := cp.Encode(s)
+		return b
+	}
+
+	// An RFH2 from a big-endian EBCDIC system, followed by the body
+	folder := []byte("<usr/>  ")
+	buf := new(bytes.Buffer)
+	buf.Write(toEBCDIC("RFH "))
+	binary.Write(buf, binary.BigEndian, []int32{MQRFH_VERSION_2, 36 + 4 + int32(len(folder)), MQENC_INTEGER_NORMAL, 500})
+	buf.Write(toEBCDIC("MQSTR   "))
+	binary.Write(buf, binary.BigEndian, []int32{0, 1208, int32(len(folder))})
+	buf.Write(folder)
+	buf.Write(toEBCDIC("HELLO"))
+
+	md := NewMQMD()
+	md.Format = MQFMT_RF_HEADER_2
+	md.Encoding = MQENC_INTEGER_NORMAL
+	md.CodedCharSetId = 500
+	props := map[string]interface{}{"colour": "red"}
+
+	s := FormatMessage(md, props, buf.Bytes(), nil)
+	for _, want := range []string{"MQRFH2 at offset 0, length 48", "NameValueData.1 : '<usr/>'", "colour : 'red'", "'HELLO'", "format 'MQSTR'"} {
+		if !strings.Contains(s, want) {
+			t.Logf("Dump does not contain %q:\n%s", want, s)
+			t.Fail()
+		}
+	}
+
+	opts := NewDumpOptions()
+	opts.Format = DumpJSON
+	var j map[string]interface{}
+	if err := json.Unmarshal([]byte(FormatMessage(md, props, buf.Bytes(), opts)), &j); err != nil {
+		t.Logf("Invalid JSON dump: %v", err)
+		t.Fail()
+	}
+
+	// A header that claims to be longer than the message is not decoded
+	b := buf.Bytes()
+	binary.BigEndian.PutUint32(b[8:], 4000)
+	d := NewMessageDump(md, nil, b)
+	if len(d.Headers) != 0 || d.HeaderError == "" || d.BodyOffset != 0 {
+		t.Logf("Unexpected decode of bad header: %+v", d)
+		t.Fail()
+	}
+}
+
+// Tests for mqiccsid.go
+func TestDecodeBody(t *testing.T) {
+	md := NewMQMD()
+	md.Format = MQFMT_STRING
+	md.CodedCharSetId = 1047
+	cp, err := LookupCCSID(1047)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := "Grüße [from] z/OS"
+	buf, err := cp.Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := DecodeBody(md, buf); err != nil || s != msg {
+		t.Logf("EBCDIC body decoded as %q: %v", s, err)
+		t.Fail()
+	}
+
+	md.CodedCharSetId = 1200
+	md.Encoding = MQENC_INTEGER_REVERSED
+	if s, err := DecodeBody(md, []byte{'H', 0, 'i', 0}); err != nil || s != "Hi" {
+		t.Logf("UTF-16 body decoded as %q: %v", s, err)
+		t.Fail()
+	}
+
+	md.CodedCharSetId = 939
+	if _, err = DecodeBody(md, buf); !errors.Is(err, ErrUnknownCCSID) {
+		t.Logf("Expected ErrUnknownCCSID, got %v", err)
+		t.Fail()
+	}
+
+	if _, err = cp.Encode("中"); !errors.Is(err, ErrUnmappableCharacter) {
+		t.Logf("Expected ErrUnmappableCharacter, got %v", err)
+		t.Fail()
+	}
+}
+
+// Tests for mqiparse.go
+
+// Build a buffer from a list of integers in the native encoding
+func pcfWords(w ...int32) []byte {
+	b := make([]byte, len(w)*4)
+	for i, v := range w {
+		endian.PutUint32(b[i*4:], uint32(v))
+	}
+	return b
+}
+
+// Inputs found by fuzzing the original parsers, each of which made them panic or loop
+func malformedPCFInputs() map[string][]byte {
+	deep := []byte{}
+	for i := 0; i <= maxPCFGroupDepth; i++ {
+		deep = append(deep, pcfWords(MQCFT_GROUP, 16, MQGACF_Q_STATISTICS_DATA, 1)...)
+	}
+	deep = append(deep, pcfWords(MQCFT_INTEGER, 16, MQIA_CURRENT_Q_DEPTH, 1)...)
+
+	return map[string][]byte{
+		"empty":                 {},
+		"truncated":             pcfWords(MQCFT_STRING),
+		"StrucLength too long":  pcfWords(MQCFT_INTEGER, 1000, MQIA_CURRENT_Q_DEPTH, 1),
+		"StrucLength too short": pcfWords(999, 4),
+		"negative string":       pcfWords(MQCFT_STRING, 20, MQCA_Q_NAME, 1208, -1),
+		"string past end":       pcfWords(MQCFT_STRING, 24, MQCA_Q_NAME, 1208, 48, 0x41414141),
+		"huge list":             pcfWords(MQCFT_INTEGER_LIST, 20, MQIACF_Q_ATTRS, 0x7fffffff, 1),
+		"list overflow":         pcfWords(MQCFT_STRING_LIST, 28, MQCACF_Q_NAMES, 1208, 65536, 65536, 0),
+		"empty strings":         pcfWords(MQCFT_STRING_LIST, 24, MQCACF_Q_NAMES, 1208, 0x30303030, 0),
+		"bytes past end":        pcfWords(MQCFT_BYTE_STRING, 16, MQBACF_CONNECTION_ID, 1000),
+		"huge group":            pcfWords(MQCFT_GROUP, 16, MQGACF_Q_STATISTICS_DATA, 1000000),
+		"bad group member":      pcfWords(MQCFT_GROUP, 16, MQGACF_Q_STATISTICS_DATA, 1, MQCFT_INTEGER, 0, 0, 0),
+		"deep groups":           deep,
+	}
+}
+
+func TestParsePCFParameter(t *testing.T) {
+	for name, buf := range malformedPCFInputs() {
+		if _, _, err := ParsePCFParameter(buf); !errors.Is(err, ErrMalformedMessage) {
+			t.Logf("%s: expected ErrMalformedMessage, got %v", name, err)
+			t.Fail()
+		}
+		// The old interface returns nil so that callers stop at the bad data
+		if p, n := ReadPCFParameter(buf); p != nil || n != 0 {
+			t.Logf("%s: ReadPCFParameter returned %v, %d", name, p, n)
+			t.Fail()
+		}
+	}
+
+	buf := pcfWords(MQCFT_GROUP, 16, MQGACF_Q_STATISTICS_DATA, 2,
+		MQCFT_INTEGER, 16, MQIA_CURRENT_Q_DEPTH, 42,
+		MQCFT_STRING, 24, MQCA_Q_NAME, 1208, 4)
+	buf = append(buf, "Q1  "...)
+	buf = append(buf, pcfWords(MQCFT_INTEGER, 16, MQIA_MSG_DEQ_COUNT, 7)...)
+
+	p, n, err := ParsePCFParameter(buf)
+	if err != nil || n != len(buf)-16 || len(p.GroupList) != 2 {
+		t.Logf("Group parsed as %+v, %d: %v", p, n, err)
+		t.FailNow()
+	}
+	if p.GroupList[0].Int64Value[0] != 42 || p.GroupList[1].String[0] != "Q1" {
+		t.Logf("Group elements are %+v %+v", p.GroupList[0], p.GroupList[1])
+		t.Fail()
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	if _, _, err := ParsePCFHeader(pcfWords(MQCFT_RESPONSE, 36)); !errors.Is(err, ErrMalformedMessage) {
+		t.Logf("Short MQCFH gave %v", err)
+		t.Fail()
+	}
+	if cfh, _ := ReadPCFHeader(pcfWords(MQCFT_RESPONSE, 36, 1, 0, 1, 1, 0, 0, -1)); cfh != nil {
+		t.Logf("MQCFH with negative ParameterCount was accepted")
+		t.Fail()
+	}
+
+	// Only the newer interface checks StrucLength
+	odd := pcfWords(MQCFT_RESPONSE, 48, 1, 0, 1, 1, 0, 0, 0)
+	if _, _, err := ParsePCFHeader(odd); !errors.Is(err, ErrMalformedMessage) {
+		t.Logf("MQCFH with StrucLength 48 gave %v", err)
+		t.Fail()
+	}
+	if cfh, n := ReadPCFHeader(odd); cfh == nil || n != int(MQCFH_STRUC_LENGTH) {
+		t.Logf("MQCFH with StrucLength 48 was rejected by ReadPCFHeader")
+		t.Fail()
+	}
+
+	md := NewMQMD()
+	md.Format = MQFMT_DEAD_LETTER_HEADER
+	if hdr, _, err := GetHeader(md, []byte("DLH ")); hdr != nil || !errors.Is(err, ErrMalformedMessage) {
+		t.Logf("Short MQDLH gave %v, %v", hdr, err)
+		t.Fail()
+	}
+
+	// An RFH2 whose only name/value string claims to be longer than the header
+	md.Format = MQFMT_RF_HEADER_2
+	buf := append([]byte("RFH "), pcfWords(MQRFH_VERSION_2, 44, MQENC_NATIVE, 1208)...)
+	buf = append(buf, (MQFMT_STRING + space8)[:8]...)
+	buf = append(buf, pcfWords(0, 1208, 100, 0)...)
+	hdr, l, err := GetHeader(md, buf)
+	rfh2, ok := hdr.(*MQRFH2)
+	if err != nil || !ok || l != 44 {
+		t.Logf("RFH2 parsed as %v, %d: %v", hdr, l, err)
+		t.FailNow()
+	}
+	if _, err = rfh2.ParseNameValues(md, buf); !errors.Is(err, ErrMalformedMessage) {
+		t.Logf("Bad NameValueLength gave %v", err)
+		t.Fail()
+	}
+	if props := rfh2.Get(buf); len(props) != 0 {
+		t.Logf("Get returned %v", props)
+		t.Fail()
+	}
+}
+
+func FuzzParsePCFParameter(f *testing.F) {
+	for _, buf := range malformedPCFInputs() {
+		f.Add(buf)
+	}
+	f.Add(pcfWords(MQCFT_INTEGER, 16, MQIA_CURRENT_Q_DEPTH, 1))
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		p, n, err := ParsePCFParameter(buf)
+		if err == nil && (p == nil || n <= 0 || n > len(buf)) {
+			t.Errorf("ParsePCFParameter returned %v, %d for %d bytes", p, n, len(buf))
+		}
+	})
+}
+
+// Tests for mqilog.go
+func TestStructuredLogger(t *testing.T) {
+	var out bytes.Buffer
+	l := NewJSONLogger(&out, true)
+	SetLogger(l)
+	defer SetLogger(nil)
+	if GetLogger() != l {
+		t.Logf("GetLogger did not return the Logger that was set")
+		t.Fail()
+	}
+
+	md := NewMQMD()
+	md.MsgId = []byte{1, 2, 3}
+	md.Format = MQFMT_STRING
+	mqreturn := &MQReturn{MQCC: MQCC_FAILED, MQRC: MQRC_Q_FULL, verb: "MQPUT"}
+	logVerb("MQPUT", verbStart(), &MQQueueManager{Name: "QM1"}, "APP.Q", mqreturn, md, "dataLength", 5)
+	logError("Bad <%s>\n", "thing")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 records, got %q", out.String())
+	}
+
+	var rec map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &rec); err != nil {
+		t.Fatalf("Record %s: %v", lines[0], err)
+	}
+	expected := map[string]interface{}{
+		"level": "DEBUG", "verb": "MQPUT", "qmgr": "QM1", "object": "APP.Q",
+		"compCode": float64(MQCC_FAILED), "reason": float64(MQRC_Q_FULL),
+		"msgId": "010203", "format": "MQSTR", "dataLength": float64(5),
+	}
+	for k, v := range expected {
+		if rec[k] != v {
+			t.Logf("Attribute %s is %v, expected %v", k, rec[k], v)
+			t.Fail()
+		}
+	}
+	if _, ok := rec["duration"]; !ok {
+		t.Logf("No duration in %s", lines[0])
+		t.Fail()
+	}
+
+	if !strings.Contains(lines[1], `"level":"ERROR","msg":"Bad <thing>"`) {
+		t.Logf("Error record is %s", lines[1])
+		t.Fail()
+	}
+}
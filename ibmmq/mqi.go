@@ -248,6 +248,7 @@ func Connx(goQMgrName string, gocno *MQCNO) (MQQueueManager, error) {
 	var mqcno C.MQCNO
 
 	traceEntry("Connx")
+	start := verbStart()
 
 	// MQ normally sets signal handlers that turn out to
 	// get in the way of Go programs. In particular SEGV.
@@ -284,7 +285,26 @@ func Connx(goQMgrName string, gocno *MQCNO) (MQQueueManager, error) {
 			gocno.Options |= MQCNO_HANDLE_SHARE_NO_BLOCK
 		}
 	}
-	copyCNOtoC(&mqcno, gocno)
+	// The credentials and TLS material go into a copy of the CNO so the secrets
+	// are not left in the application's structure
+	cnoToC := gocno
+	if gocno.CredentialProvider != nil {
+		cnoWithCreds, err := applyCredentials(gocno)
+		if err != nil {
+			traceExitErr("Connx", 2, err)
+			return qMgr, err
+		}
+		cnoToC = cnoWithCreds
+	}
+	if gocno.TLSOptions != nil {
+		cnoWithTLS, err := gocno.TLSOptions.apply(cnoToC)
+		if err != nil {
+			traceExitErr("Connx", 3, err)
+			return qMgr, err
+		}
+		cnoToC = cnoWithTLS
+	}
+	copyCNOtoC(&mqcno, cnoToC)
 
 	C.MQCONNX((*C.MQCHAR)(mqQMgrName), &mqcno, &qMgr.hConn, &mqcc, &mqrc)
 
@@ -296,6 +316,7 @@ func Connx(goQMgrName string, gocno *MQCNO) (MQQueueManager, error) {
 		MQRC: int32(mqrc),
 		verb: "MQCONNX",
 	}
+	logVerb("MQCONNX", start, &qMgr, "", mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Connx", 1, mqreturn)
@@ -314,6 +335,7 @@ func (x *MQQueueManager) Disc() error {
 	var mqcc C.MQLONG
 
 	traceEntry("Disc")
+	start := verbStart()
 
 	// Cleanup any allocated message Handles. If the
 	// MQDISC fails (unusual), that's still OK because we would reallocate
@@ -329,9 +351,11 @@ func (x *MQQueueManager) Disc() error {
 		MQRC: int32(mqrc),
 		verb: "MQDISC",
 	}
+	logVerb("MQDISC", start, x, "", &mqreturn, nil)
 
 	if int32(mqrc) != C.MQRC_HCONN_ERROR {
 		cbRemoveConnection(savedConn)
+		closeConnEvents(int32(savedConn))
 	}
 
 	if mqcc != C.MQCC_OK {
@@ -353,6 +377,7 @@ func (x *MQQueueManager) Open(good *MQOD, goOpenOptions int32) (MQObject, error)
 	var mqOpenOptions C.MQLONG
 
 	traceEntry("Open")
+	start := verbStart()
 
 	object := MQObject{
 		Name: good.ObjectName,
@@ -377,6 +402,7 @@ func (x *MQQueueManager) Open(good *MQOD, goOpenOptions int32) (MQObject, error)
 		MQRC: int32(mqrc),
 		verb: "MQOPEN",
 	}
+	logVerb("MQOPEN", start, x, good.ObjectName, &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Open", 1, &mqreturn)
@@ -408,6 +434,7 @@ func (object *MQObject) Close(goCloseOptions int32) error {
 	var mqCloseOptions C.MQLONG
 
 	traceEntry("Close")
+	start := verbStart()
 
 	mqCloseOptions = C.MQLONG(goCloseOptions)
 
@@ -422,6 +449,7 @@ func (object *MQObject) Close(goCloseOptions int32) error {
 
 	savedHConn := object.qMgr.hConn
 	savedHObj := object.hObj
+	cacheKey := objectCacheKey(*object)
 
 	C.MQCLOSE(object.qMgr.hConn, &object.hObj, mqCloseOptions, &mqcc, &mqrc)
 
@@ -429,6 +457,7 @@ func (object *MQObject) Close(goCloseOptions int32) error {
 		MQRC: int32(mqrc),
 		verb: "MQCLOSE",
 	}
+	logVerb("MQCLOSE", start, object.qMgr, object.Name, &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Close", 1, &mqreturn)
@@ -440,6 +469,7 @@ func (object *MQObject) Close(goCloseOptions int32) error {
 		f(object)
 	}
 	cbRemoveHandle(savedHConn, savedHObj)
+	maxMsgLengthCache.Delete(cacheKey)
 	traceExit("Close")
 	return nil
 
@@ -454,6 +484,7 @@ func (x *MQQueueManager) Sub(gosd *MQSD, qObject *MQObject) (MQObject, error) {
 	var mqsd C.MQSD
 
 	traceEntry("Sub")
+	start := verbStart()
 
 	subObject := MQObject{
 		Name: gosd.ObjectName + "[" + gosd.ObjectString + "]",
@@ -482,6 +513,7 @@ func (x *MQQueueManager) Sub(gosd *MQSD, qObject *MQObject) (MQObject, error) {
 		MQRC: int32(mqrc),
 		verb: "MQSUB",
 	}
+	logVerb("MQSUB", start, x, subObject.Name, &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Sub", 1, &mqreturn)
@@ -504,6 +536,7 @@ func (subObject *MQObject) Subrq(gosro *MQSRO, action int32) error {
 	var mqsro C.MQSRO
 
 	traceEntry("Subrq")
+	start := verbStart()
 
 	if !IsUsableHObj(*subObject) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -529,6 +562,7 @@ func (subObject *MQObject) Subrq(gosro *MQSRO, action int32) error {
 		MQRC: int32(mqrc),
 		verb: "MQSUBRQ",
 	}
+	logVerb("MQSUBRQ", start, subObject.qMgr, subObject.Name, &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Subrq", 1, &mqreturn)
@@ -548,6 +582,7 @@ func (x *MQQueueManager) Begin(gobo *MQBO) error {
 	var mqbo C.MQBO
 
 	traceEntry("Begin")
+	start := verbStart()
 
 	copyBOtoC(&mqbo, gobo)
 
@@ -559,6 +594,7 @@ func (x *MQQueueManager) Begin(gobo *MQBO) error {
 		MQRC: int32(mqrc),
 		verb: "MQBEGIN",
 	}
+	logVerb("MQBEGIN", start, x, "", &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Beqin", 1, &mqreturn)
@@ -578,6 +614,7 @@ func (x *MQQueueManager) Cmit() error {
 	var mqcc C.MQLONG
 
 	traceEntry("Cmit")
+	start := verbStart()
 
 	C.MQCMIT(x.hConn, &mqcc, &mqrc)
 
@@ -585,6 +622,7 @@ func (x *MQQueueManager) Cmit() error {
 		MQRC: int32(mqrc),
 		verb: "MQCMIT",
 	}
+	logVerb("MQCMIT", start, x, "", &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Cmit", 1, &mqreturn)
@@ -604,6 +642,7 @@ func (x *MQQueueManager) Back() error {
 	var mqcc C.MQLONG
 
 	traceEntry("Back")
+	start := verbStart()
 
 	C.MQBACK(x.hConn, &mqcc, &mqrc)
 
@@ -611,6 +650,7 @@ func (x *MQQueueManager) Back() error {
 		MQRC: int32(mqrc),
 		verb: "MQBACK",
 	}
+	logVerb("MQBACK", start, x, "", &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Back", 1, &mqreturn)
@@ -633,6 +673,7 @@ func (x *MQQueueManager) Stat(statusType int32, gosts *MQSTS) error {
 	var mqsts C.MQSTS
 
 	traceEntry("Stat")
+	start := verbStart()
 
 	copySTStoC(&mqsts, gosts)
 
@@ -642,6 +683,7 @@ func (x *MQQueueManager) Stat(statusType int32, gosts *MQSTS) error {
 		MQRC: int32(mqrc),
 		verb: "MQSTAT",
 	}
+	logVerb("MQSTAT", start, x, "", &mqreturn, nil)
 
 	copySTSfromC(&mqsts, gosts)
 
@@ -668,6 +710,7 @@ func (object MQObject) Put(gomd *MQMD,
 	var ptr C.PMQVOID
 
 	traceEntry("Put")
+	start := verbStart()
 
 	if !IsUsableHObj(object) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -720,6 +763,7 @@ func (object MQObject) Put(gomd *MQMD,
 		MQRC: int32(mqrc),
 		verb: "MQPUT",
 	}
+	logVerb("MQPUT", start, object.qMgr, object.Name, &mqreturn, gomd, "dataLength", bufflen)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Put", 2, &mqreturn)
@@ -746,6 +790,7 @@ func (x *MQQueueManager) Put1(good *MQOD, gomd *MQMD,
 	var ptr C.PMQVOID
 
 	traceEntry("Put1")
+	start := verbStart()
 
 	err := checkMD(gomd, "MQPUT1")
 	if err != nil {
@@ -792,6 +837,7 @@ func (x *MQQueueManager) Put1(good *MQOD, gomd *MQMD,
 		MQRC: int32(mqrc),
 		verb: "MQPUT1",
 	}
+	logVerb("MQPUT1", start, x, good.ObjectName, &mqreturn, gomd, "dataLength", bufflen)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Put1", 2, &mqreturn)
@@ -811,7 +857,7 @@ func (object MQObject) Get(gomd *MQMD,
 	gogmo *MQGMO, buffer []byte) (int, error) {
 
 	traceEntry("Get")
-	datalen, removed, err := object.getInternal(gomd, gogmo, buffer, false)
+	datalen, removed, err := object.getPoisonChecked(gomd, gogmo, buffer, false)
 	if removed > 0 {
 		copy(buffer, buffer[removed:])
 		datalen -= removed
@@ -829,7 +875,7 @@ func (object MQObject) GetSlice(gomd *MQMD,
 	gogmo *MQGMO, buffer []byte) ([]byte, int, error) {
 
 	traceEntry("GetSlice")
-	realDatalen, removed, err := object.getInternal(gomd, gogmo, buffer, true)
+	realDatalen, removed, err := object.getPoisonChecked(gomd, gogmo, buffer, true)
 
 	// The datalen will be set even if the buffer is too small - there
 	// will be one of MQRC_TRUNCATED_MSG_ACCEPTED or _FAILED depending on the
@@ -862,6 +908,7 @@ func (object MQObject) getInternal(gomd *MQMD,
 
 	removed := 0
 	traceEntry("getInternal")
+	start := verbStart()
 
 	err := checkMD(gomd, "MQGET")
 	if err != nil {
@@ -929,6 +976,7 @@ func (object MQObject) getInternal(gomd *MQMD,
 		MQRC: int32(mqrc),
 		verb: "MQGET",
 	}
+	logVerb("MQGET", start, object.qMgr, object.Name, &mqreturn, gomd, "dataLength", godatalen)
 
 	// Only process OTEL tracing if we actually got a message
 	if mqcc == C.MQCC_OK || mqrc == C.MQRC_TRUNCATED_MSG_ACCEPTED {
@@ -969,6 +1017,7 @@ func (object MQObject) Inq(goSelectors []int32) (map[int32]interface{}, error) {
 	var charLength int
 
 	traceEntry("Inq")
+	start := verbStart()
 
 	if !IsUsableHObj(object) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -1008,6 +1057,7 @@ func (object MQObject) Inq(goSelectors []int32) (map[int32]interface{}, error) {
 		MQRC: int32(mqrc),
 		verb: "MQINQ",
 	}
+	logVerb("MQINQ", start, object.qMgr, object.Name, &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Inq", 1, &mqreturn)
@@ -1092,6 +1142,29 @@ func (object MQObject) InqMap(goSelectors []int32) (map[int32]interface{}, error
 	return object.Inq(goSelectors)
 }
 
+/*
+inqWithOpen is used by the helper functions that need queue attributes even when
+the application opened the object without MQOO_INQUIRE. If the direct Inq fails, the
+object is opened again just for the inquiry.
+*/
+func (object MQObject) inqWithOpen(goSelectors []int32) (map[int32]interface{}, error) {
+	values, err := object.Inq(goSelectors)
+	if err == nil {
+		return values, nil
+	}
+
+	od := NewMQOD()
+	od.ObjectType = C.MQOT_Q
+	od.ObjectName = object.Name
+	inqObject, openErr := object.qMgr.Open(od, C.MQOO_INQUIRE)
+	if openErr != nil {
+		return nil, openErr
+	}
+	values, err = inqObject.Inq(goSelectors)
+	inqObject.Close(0)
+	return values, err
+}
+
 /*
 Set is the function that wraps MQSET. The single parameter is a map whose
 elements contain an MQIA/MQCA selector with either a string or an int32 for
@@ -1109,6 +1182,7 @@ func (object MQObject) Set(goSelectors map[int32]interface{}) error {
 	var charLength int
 
 	traceEntry("Set")
+	start := verbStart()
 
 	if !IsUsableHObj(object) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -1197,6 +1271,7 @@ func (object MQObject) Set(goSelectors map[int32]interface{}) error {
 		MQRC: int32(mqrc),
 		verb: "MQSET",
 	}
+	logVerb("MQSET", start, object.qMgr, object.Name, &mqreturn, nil)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Set", 1, &mqreturn)
@@ -1220,6 +1295,7 @@ func (x *MQQueueManager) CrtMH(gocmho *MQCMHO) (MQMessageHandle, error) {
 	var mqhmsg C.MQHMSG
 
 	traceEntry("CrtMH")
+	start := verbStart()
 
 	copyCMHOtoC(&mqcmho, gocmho)
 
@@ -1233,6 +1309,7 @@ func (x *MQQueueManager) CrtMH(gocmho *MQCMHO) (MQMessageHandle, error) {
 		MQRC: int32(mqrc),
 		verb: "MQCRTMH",
 	}
+	logVerb("MQCRTMH", start, x, "", &mqreturn, nil)
 
 	copyCMHOfromC(&mqcmho, gocmho)
 	msgHandle := MQMessageHandle{hMsg: mqhmsg, qMgr: x}
@@ -1257,6 +1334,7 @@ func (handle *MQMessageHandle) DltMH(godmho *MQDMHO) error {
 	var mqdmho C.MQDMHO
 
 	traceEntry("DltMH")
+	start := verbStart()
 
 	if !IsUsableHandle(*handle) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -1278,6 +1356,7 @@ func (handle *MQMessageHandle) DltMH(godmho *MQDMHO) error {
 		MQRC: int32(mqrc),
 		verb: "MQDLTMH",
 	}
+	logVerb("MQDLTMH", start, handle.qMgr, "", &mqreturn, nil)
 
 	copyDMHOfromC(&mqdmho, godmho)
 
@@ -1317,6 +1396,7 @@ func (handle *MQMessageHandle) SetMP(gosmpo *MQSMPO, name string, gopd *MQPD, va
 	var propertyFloat64 C.MQFLOAT64
 
 	traceEntry("SetMP")
+	start := verbStart()
 
 	if !IsUsableHandle(*handle) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -1433,6 +1513,7 @@ func (handle *MQMessageHandle) SetMP(gosmpo *MQSMPO, name string, gopd *MQPD, va
 		MQRC: int32(mqrc),
 		verb: "MQSETMP",
 	}
+	logVerb("MQSETMP", start, handle.qMgr, "", &mqreturn, nil, "property", name)
 
 	copySMPOfromC(&mqsmpo, gosmpo)
 	copyPDfromC(&mqpd, gopd)
@@ -1457,6 +1538,7 @@ func (handle *MQMessageHandle) DltMP(godmpo *MQDMPO, name string) error {
 	var mqName C.MQCHARV
 
 	traceEntry("DltMP")
+	start := verbStart()
 
 	if !IsUsableHandle(*handle) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -1491,6 +1573,7 @@ func (handle *MQMessageHandle) DltMP(godmpo *MQDMPO, name string) error {
 		MQRC: int32(mqrc),
 		verb: "MQDLTMP",
 	}
+	logVerb("MQDLTMP", start, handle.qMgr, "", &mqreturn, nil, "property", name)
 
 	copyDMPOfromC(&mqdmpo, godmpo)
 
@@ -1523,6 +1606,7 @@ func (handle *MQMessageHandle) InqMP(goimpo *MQIMPO, gopd *MQPD, name string) (s
 	const propbufsize = 10240
 
 	traceEntry("InqMP")
+	start := verbStart()
 
 	if !IsUsableHandle(*handle) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -1572,6 +1656,7 @@ func (handle *MQMessageHandle) InqMP(goimpo *MQIMPO, gopd *MQPD, name string) (s
 		MQRC: int32(mqrc),
 		verb: "MQINQMP",
 	}
+	logVerb("MQINQMP", start, handle.qMgr, "", &mqreturn, nil, "property", name)
 
 	copyIMPOfromC(&mqimpo, goimpo)
 	copyPDfromC(&mqpd, gopd)
@@ -1625,18 +1710,27 @@ func (handle *MQMessageHandle) InqMP(goimpo *MQIMPO, gopd *MQPD, name string) (s
 
 /*
 GetHeader returns a structure containing a parsed-out version of an MQI
-message header such as the MQDLH (which is currently the only structure
-supported). Other structures like the RFH2 could follow.
+message header. The MQDLH and MQRFH2 are supported. An error wrapping
+ErrMalformedMessage is returned if the header does not fit in the buffer.
 
 The caller of this function needs to cast the returned structure to the
 specific type in order to reference the fields.
 */
 func GetHeader(md *MQMD, buf []byte) (interface{}, int, error) {
+	// Return an untyped nil on error, so a type assertion by the caller fails
 	switch md.Format {
 	case MQFMT_DEAD_LETTER_HEADER:
-		return getHeaderDLH(md, buf)
+		dlh, l, err := ParseHeaderDLH(md, buf)
+		if err != nil {
+			return nil, 0, err
+		}
+		return dlh, l, nil
 	case MQFMT_RF_HEADER_2:
-		return getHeaderRFH2(md, buf)
+		rfh2, l, err := ParseHeaderRFH2(md, buf)
+		if err != nil {
+			return nil, 0, err
+		}
+		return rfh2, l, nil
 	}
 
 	mqreturn := &MQReturn{MQCC: int32(MQCC_FAILED),
@@ -27,7 +27,6 @@ package ibmmq
 import "C"
 
 import (
-	"bytes"
 	"encoding/binary"
 	"time"
 )
@@ -120,37 +119,3 @@ func (dlh *MQDLH) Bytes() []byte {
 
 	return buf
 }
-
-/*
-We have a byte array for the message contents. The start of that buffer
-is the MQDLH structure. We read the bytes from that fixed header to match
-the C structure definition for each field. The DLH does not have multiple
-versions defined so we don't need to check that as we go through.
-*/
-func getHeaderDLH(md *MQMD, buf []byte) (*MQDLH, int, error) {
-
-	var version int32
-
-	dlh := NewMQDLH(nil)
-
-	r := bytes.NewBuffer(buf)
-	_ = readStringFromFixedBuffer(r, 4) // StrucId
-	binary.Read(r, endian, &version)
-	binary.Read(r, endian, &dlh.Reason)
-	dlh.DestQName = readStringFromFixedBuffer(r, MQ_OBJECT_NAME_LENGTH)
-	dlh.DestQMgrName = readStringFromFixedBuffer(r, MQ_Q_MGR_NAME_LENGTH)
-
-	binary.Read(r, endian, &dlh.Encoding)
-	binary.Read(r, endian, &dlh.CodedCharSetId)
-
-	dlh.Format = readStringFromFixedBuffer(r, MQ_FORMAT_LENGTH)
-
-	binary.Read(r, endian, &dlh.PutApplType)
-
-	dlh.PutApplName = readStringFromFixedBuffer(r, MQ_PUT_APPL_NAME_LENGTH)
-	dlh.PutDate = readStringFromFixedBuffer(r, MQ_PUT_DATE_LENGTH)
-	dlh.PutTime = readStringFromFixedBuffer(r, MQ_PUT_TIME_LENGTH)
-	dlh.PutDateTime = createGoDateTime(dlh.PutDate, dlh.PutTime)
-
-	return dlh, dlh.strucLength, nil
-}
@@ -220,6 +220,13 @@ type MQCNO struct {
 	SSLConfig     *MQSCO
 	ApplName      string
 	BalanceParms  *MQBNO
+
+	// Not part of the MQI structure. If set, Connx asks it for the
+	// userid and password or token to put in the MQCSP.
+	CredentialProvider CredentialProvider
+	// Not part of the MQI structure. If set, Connx uses it to fill in the MQSCO
+	// and the TLS fields of the MQCD.
+	TLSOptions *TLSOptions
 }
 
 /*
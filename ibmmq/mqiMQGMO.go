@@ -51,6 +51,7 @@ type MQGMO struct {
 	Reserved2      int32
 	MsgHandle      MQMessageHandle
 	OtelOpts       OtelOpts
+	PoisonHandler  *PoisonHandler // Not part of the C structure. Used by Get/GetSlice but not by CB
 }
 
 /*
@@ -77,6 +78,7 @@ func NewMQGMO() *MQGMO {
 
 	gmo.OtelOpts.Context = nil
 	gmo.OtelOpts.RemoveRFH2 = false
+	gmo.PoisonHandler = nil
 	return gmo
 }
 
@@ -105,6 +105,18 @@ func NewMQMD() *MQMD {
 	return md
 }
 
+// Make an independent copy of an MQMD, including the byte arrays, so that
+// a saved version is not changed by a later MQI call.
+func cloneMD(gomd *MQMD) *MQMD {
+	md := new(MQMD)
+	*md = *gomd
+	md.MsgId = append([]byte(nil), gomd.MsgId...)
+	md.CorrelId = append([]byte(nil), gomd.CorrelId...)
+	md.AccountingToken = append([]byte(nil), gomd.AccountingToken...)
+	md.GroupId = append([]byte(nil), gomd.GroupId...)
+	return md
+}
+
 func checkMD(gomd *MQMD, verb string) error {
 	mqrc := C.MQRC_NONE
 
@@ -27,11 +27,7 @@ package ibmmq
 import "C"
 
 import (
-	"bytes"
-	"encoding/binary"
 	"encoding/hex"
-	"fmt"
-	"runtime/debug"
 	"strings"
 )
 
@@ -329,212 +325,44 @@ func (p *PCFParameter) Bytes() []byte {
 }
 
 /*
-ReadPCFHeader extracts the MQCFH from an MQ message
+ReadPCFHeader extracts the MQCFH from an MQ message. It returns nil if the
+message does not start with a valid MQCFH. Use ParsePCFHeader to get the reason.
+Unlike ParsePCFHeader, the StrucLength field is not checked, as this function
+has always accepted any value there.
 */
 func ReadPCFHeader(buf []byte) (*MQCFH, int) {
-
-	fullLen := len(buf)
-
-	if fullLen < C.MQCFH_STRUC_LENGTH {
+	cfh, bytesRead, err := parsePCFHeader(buf, false)
+	if err != nil {
+		logTrace("ReadPCFHeader: %v", err)
 		return nil, 0
 	}
-
-	cfh := new(MQCFH)
-	p := bytes.NewBuffer(buf)
-
-	binary.Read(p, endian, &cfh.Type)
-	binary.Read(p, endian, &cfh.StrucLength)
-	binary.Read(p, endian, &cfh.Version)
-	binary.Read(p, endian, &cfh.Command)
-	binary.Read(p, endian, &cfh.MsgSeqNumber)
-	binary.Read(p, endian, &cfh.Control)
-	binary.Read(p, endian, &cfh.CompCode)
-	binary.Read(p, endian, &cfh.Reason)
-	binary.Read(p, endian, &cfh.ParameterCount)
-
-	bytesRead := fullLen - p.Len()
 	return cfh, bytesRead
 }
 
 /*
-ReadPCFEmbeddedHeader extracts the MQCFH from an MQ message
+ReadPCFEmbeddedHeader extracts the MQEPH from an MQ message. It returns nil if the
+message does not start with a valid MQEPH. Use ParsePCFEmbeddedHeader to get the reason.
 */
 func ReadPCFEmbeddedHeader(buf []byte) (*MQEPH, int) {
-	var dummy int32
-
-	fullLen := len(buf)
-	if fullLen < C.MQEPH_STRUC_LENGTH_FIXED {
+	eph, bytesRead, err := ParsePCFEmbeddedHeader(buf)
+	if err != nil {
+		logTrace("ReadPCFEmbeddedHeader: %v", err)
 		return nil, 0
 	}
-
-	eph := new(MQEPH)
-	p := bytes.NewBuffer(buf)
-
-	offset := 0
-	binary.Read(p, endian, &dummy)
-	offset += 4
-	binary.Read(p, endian, &eph.Version)
-	offset += 4
-
-	binary.Read(p, endian, &eph.StrucLength)
-	offset += 4
-
-	binary.Read(p, endian, &eph.Encoding)
-	offset += 4
-
-	binary.Read(p, endian, &eph.CodedCharSetId)
-	offset += 4
-
-	// We don't actually use this next field
-	// s := string(buf[offset : offset+8])
-	// s = trimToNull(s)
-	offset += 8
-	p.Next(8)
-
-	binary.Read(p, endian, &eph.Flags)
-	bytesRead := fullLen - p.Len()
-
 	return eph, bytesRead
 }
 
 /*
 ReadPCFParameter extracts the next PCF parameter element from an
-MQ message.
+MQ message. It returns nil if the element is not valid, and callers stepping
+through the message must stop there. Use ParsePCFParameter to get the reason.
 */
 func ReadPCFParameter(buf []byte) (*PCFParameter, int) {
-	var i32 int32
-	var i64 int64
-	var mqlong int32
-	var count int32
-
-	pcfParm := new(PCFParameter)
-	fullLen := len(buf)
-	p := bytes.NewBuffer(buf)
-
-	binary.Read(p, endian, &pcfParm.Type)
-	binary.Read(p, endian, &pcfParm.strucLength)
-
-	switch pcfParm.Type {
-	// There are more PCF element types but the monitoring packages only
-	// needed a subset. We can add the others later if necessary.
-	case C.MQCFT_INTEGER:
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &i32)
-		pcfParm.Int64Value = append(pcfParm.Int64Value, int64(i32))
-
-	case C.MQCFT_INTEGER_LIST:
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &count)
-		for i := 0; i < int(count); i++ {
-			binary.Read(p, endian, &i32)
-			pcfParm.Int64Value = append(pcfParm.Int64Value, int64(i32))
-		}
-
-	case C.MQCFT_INTEGER64:
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &mqlong) // Used for alignment
-		binary.Read(p, endian, &i64)
-		pcfParm.Int64Value = append(pcfParm.Int64Value, i64)
-
-	case C.MQCFT_INTEGER64_LIST:
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &count)
-		for i := 0; i < int(count); i++ {
-			binary.Read(p, endian, &i64)
-			pcfParm.Int64Value = append(pcfParm.Int64Value, i64)
-		}
-
-	case C.MQCFT_STRING:
-		offset := int32(C.MQCFST_STRUC_LENGTH_FIXED)
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &pcfParm.CodedCharSetId)
-		binary.Read(p, endian, &pcfParm.stringLength)
-		s := string(buf[offset : pcfParm.stringLength+offset])
-		s = trimToNull(s)
-		pcfParm.String = append(pcfParm.String, s)
-		p.Next(int(pcfParm.strucLength - offset))
-
-	case C.MQCFT_STRING_LIST:
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &pcfParm.CodedCharSetId)
-		binary.Read(p, endian, &count)
-		binary.Read(p, endian, &pcfParm.stringLength)
-		for i := 0; i < int(count); i++ {
-			offset := C.MQCFSL_STRUC_LENGTH_FIXED + i*int(pcfParm.stringLength)
-			s := string(buf[offset : int(pcfParm.stringLength)+offset])
-			s = trimToNull(s)
-			pcfParm.String = append(pcfParm.String, s)
-		}
-		p.Next(int(pcfParm.strucLength - C.MQCFSL_STRUC_LENGTH_FIXED))
-
-	case C.MQCFT_GROUP:
-		// This reads the entire group, including the group elements.
-		// Which might in turn be nested groups
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &pcfParm.ParameterCount)
-		offset := 16 // Include the Type/StrucLength words in the already-read count
-		bytesRead := 0
-		pcfParm.GroupList = make([]*PCFParameter, pcfParm.ParameterCount)
-		for i := 0; i < int(pcfParm.ParameterCount); i++ {
-			pcfParm.GroupList[i], bytesRead = ReadPCFParameter(buf[offset:])
-			offset += bytesRead
-		}
-		return pcfParm, offset
-
-	case C.MQCFT_BYTE_STRING:
-		// The byte string is converted to a hex string as that's how
-		// we expect to use it in reporting
-		offset := int32(C.MQCFBS_STRUC_LENGTH_FIXED)
-		binary.Read(p, endian, &pcfParm.Parameter)
-		binary.Read(p, endian, &pcfParm.stringLength)
-		s := hex.EncodeToString(buf[offset : pcfParm.stringLength+offset])
-		pcfParm.String = append(pcfParm.String, s)
-		p.Next(int(pcfParm.strucLength - offset))
-
-	case C.MQCFT_INTEGER_FILTER:
-		binary.Read(p, endian, &pcfParm.Filter.Parameter)
-		binary.Read(p, endian, &pcfParm.Filter.Operator)
-		binary.Read(p, endian, &i32)
-		pcfParm.Filter.FilterValue = int64(i32)
-
-	case C.MQCFT_STRING_FILTER:
-		offset := int32(C.MQCFSF_STRUC_LENGTH_FIXED)
-
-		binary.Read(p, endian, &pcfParm.Filter.Parameter)
-		binary.Read(p, endian, &pcfParm.Filter.Operator)
-		binary.Read(p, endian, &pcfParm.CodedCharSetId)
-		binary.Read(p, endian, &pcfParm.stringLength)
-		s := string(buf[offset : pcfParm.stringLength+offset])
-		s = trimToNull(s)
-		pcfParm.Filter.FilterValue = s
-		p.Next(int(pcfParm.strucLength - offset))
-
-	case C.MQCFT_BYTE_STRING_FILTER:
-		offset := int32(C.MQCFBF_STRUC_LENGTH_FIXED)
-		binary.Read(p, endian, &pcfParm.Filter.Parameter)
-		binary.Read(p, endian, &pcfParm.Filter.Operator)
-		binary.Read(p, endian, &pcfParm.stringLength)
-		s := hex.EncodeToString(buf[offset : pcfParm.stringLength+offset])
-		pcfParm.Filter.FilterValue = s
-		p.Next(int(pcfParm.strucLength - offset))
-
-	default:
-		// This should not happen, but if it does then dump various pieces of
-		// debug information that might help solve the problem.
-		// TODO: Put this in something like an environment variable control option
-		localerr := fmt.Errorf("mqiPCF.go: Unknown PCF type %d", pcfParm.Type)
-		fmt.Println("Error: ", localerr)
-		fmt.Println("Buffer Len: ", len(buf))
-		fmt.Println("Buffer: ", buf)
-
-		debug.PrintStack()
-		// After dumping the stack, we will try to carry on regardless.
-		// Skip the remains of this structure, assuming it really is
-		// PCF and we just don't know how to process the element type
-		p.Next(int(pcfParm.strucLength - 8))
+	pcfParm, bytesRead, err := ParsePCFParameter(buf)
+	if err != nil {
+		logTrace("ReadPCFParameter: %v", err)
+		return nil, 0
 	}
-
-	bytesRead := fullLen - p.Len()
 	return pcfParm, bytesRead
 }
 
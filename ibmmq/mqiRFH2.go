@@ -112,55 +112,17 @@ func (rfh2 *MQRFH2) bytes() []byte {
 	return buf
 }
 
-/*
-We have a byte array for the message contents. The start of that buffer
-is the MQRFH2 structure. We read the bytes from that fixed header to match
-the C structure definition for each field. We will assume use of RFH v2
-
-This function is not called directly by applications. They can use the GetHeader
-function instead, which covers both RFH2 and DLH structures.
-*/
-func getHeaderRFH2(md *MQMD, buf []byte) (*MQRFH2, int, error) {
-
-	var version int32
-
-	rfh2 := NewMQRFH2(nil)
-
-	r := bytes.NewBuffer(buf)
-	_ = readStringFromFixedBuffer(r, 4) // StrucId
-	binary.Read(r, endian, &version)
-	binary.Read(r, endian, &rfh2.StrucLength)
-
-	binary.Read(r, endian, &rfh2.Encoding)
-	binary.Read(r, endian, &rfh2.CodedCharSetId)
-
-	rfh2.Format = readStringFromFixedBuffer(r, MQ_FORMAT_LENGTH)
-	binary.Read(r, endian, &rfh2.Flags)
-	binary.Read(r, endian, &rfh2.NameValueCCSID)
-
-	return rfh2, int(rfh2.StrucLength), nil
-}
-
-// Split the name/value strings in the RFH2 into a string array
-// In the message body , each consists of a length/string duple, so we read the length
-// and then the string. And repeat until the buffer is exhausted.
-// Each returned string will be a complete XML-like element which requires
-// further parsing to extract individual properties.
+// Split the name/value strings in the RFH2 into a string array.
+// The buffer starts with the RFH2 itself, and the lengths are in the native encoding.
+// Parsing stops at the first string that does not fit; use ParseNameValues
+// to find out if that happened.
+//
+// Deprecated: a message from another platform has its lengths in the encoding
+// given by its MQMD, which this function ignores. Use ParseNameValues instead.
 func (hdr *MQRFH2) Get(buf []byte) []string {
-	var l int32
-	props := make([]string, 0)
-
-	r := bytes.NewBuffer(buf[MQRFH_STRUC_LENGTH_FIXED_2:hdr.StrucLength])
-	// logTrace("RFH2 Get: Input buffer length %d Variable length %d", len(buf), r.Len())
-
-	propsLen := r.Len() // binary.Read modifies the buffer length so get it at the start of the loop
-	for offset := 0; offset < propsLen; {
-		binary.Read(r, endian, &l)
-		offset += 4
-		s := readStringFromFixedBuffer(r, l)
-		props = append(props, s)
-		offset += int(l)
-		// logTrace("RFH2 Get:  propsLen=%d stringLength=%d offset=%d ", propsLen, l, offset)
+	props, err := hdr.parseNameValues(buf, endian)
+	if err != nil {
+		logError("RFH2 Get: %v", err)
 	}
 	return props
 }
@@ -0,0 +1,322 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file helps with putting messages using MQPMO_ASYNC_RESPONSE. A client
+application does not wait for the queue manager to confirm each put, so any failures
+are only reported by a later MQSTAT call. The AsyncProducer makes that call
+regularly, and reports the results for each batch of puts on a channel.
+
+The AsyncProducer serialises its own MQI calls, but the connection must not be
+used by other goroutines at the same time unless it was made with MQCNO_HANDLE_SHARE_BLOCK.
+*/
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+/*
+ErrProducerClosed is returned by Put and Put1 after the AsyncProducer has been closed
+*/
+var ErrProducerClosed = errors.New("async producer is closed")
+
+/*
+AsyncPutReport gives the results of the puts since the previous MQSTAT. The
+failing object fields, and the CompCode and Reason, describe the
+error that MQSTAT reported.
+*/
+type AsyncPutReport struct {
+	Time            time.Time
+	Puts            int // The number of puts made by the AsyncProducer in this batch
+	PutSuccessCount int32
+	PutWarningCount int32
+	PutFailureCount int32
+
+	CompCode           int32
+	Reason             int32
+	ObjectType         int32
+	ObjectName         string
+	ObjectQMgrName     string
+	ResolvedObjectName string
+	ResolvedQMgrName   string
+	ObjectString       string
+
+	Err error // Set if the MQSTAT call itself failed
+}
+
+// Number of reports that can be waiting before the oldest are discarded
+const asyncReportQueueLength = 32
+
+/*
+AsyncProducer puts messages with MQPMO_ASYNC_RESPONSE and collects their status.
+StatEvery and StatInterval can be changed after NewAsyncProducer but
+before the first Put.
+*/
+type AsyncProducer struct {
+	StatEvery    int           // Call MQSTAT after this many puts. 0 means only use the timer
+	StatInterval time.Duration // Call MQSTAT this often if there have been puts. 0 means no timer
+
+	qMgr    *MQQueueManager
+	mutex   sync.Mutex
+	puts    int
+	totals  AsyncPutReport
+	reports chan AsyncPutReport
+	stop    chan struct{}
+	started bool
+	closed  bool
+}
+
+/*
+NewAsyncProducer creates an AsyncProducer for puts on the connection
+*/
+func NewAsyncProducer(qMgr *MQQueueManager) *AsyncProducer {
+	p := new(AsyncProducer)
+	p.StatEvery = 1000
+	p.StatInterval = 1 * time.Second
+	p.qMgr = qMgr
+	p.reports = make(chan AsyncPutReport, asyncReportQueueLength)
+	p.stop = make(chan struct{})
+	return p
+}
+
+/*
+Reports returns the channel on which a report is sent after each MQSTAT. It is
+closed by Close. Applications do not have to read from it: when the channel is full,
+the oldest report is discarded to make room. Totals always has the overall counts.
+*/
+func (p *AsyncProducer) Reports() <-chan AsyncPutReport {
+	return p.reports
+}
+
+// Force the asynchronous response options, leaving the others alone
+func asyncPMOOptions(options int32) int32 {
+	options &^= MQPMO_SYNC_RESPONSE | MQPMO_RESPONSE_AS_Q_DEF | MQPMO_RESPONSE_AS_TOPIC_DEF
+	return options | MQPMO_ASYNC_RESPONSE
+}
+
+/*
+Put a message to an object, which must have been opened with this producer's connection.
+The PMO options are temporarily changed to include MQPMO_ASYNC_RESPONSE. An error
+is only returned for failures that the client can detect immediately.
+*/
+func (p *AsyncProducer) Put(object MQObject, gomd *MQMD, gopmo *MQPMO, buffer []byte) error {
+	return p.put(gopmo, func() error {
+		return object.Put(gomd, gopmo, buffer)
+	})
+}
+
+/*
+Put1 opens, puts to, and closes an object in a single call, with MQPMO_ASYNC_RESPONSE
+*/
+func (p *AsyncProducer) Put1(good *MQOD, gomd *MQMD, gopmo *MQPMO, buffer []byte) error {
+	return p.put(gopmo, func() error {
+		return p.qMgr.Put1(good, gomd, gopmo, buffer)
+	})
+}
+
+func (p *AsyncProducer) put(gopmo *MQPMO, fn func() error) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.closed {
+		return ErrProducerClosed
+	}
+	if !p.started {
+		p.start()
+	}
+
+	savedOptions := gopmo.Options
+	gopmo.Options = asyncPMOOptions(savedOptions)
+	err := fn()
+	gopmo.Options = savedOptions
+
+	if err != nil {
+		return err
+	}
+	p.puts++
+	if p.StatEvery > 0 && p.puts >= p.StatEvery {
+		p.stat()
+	}
+	return nil
+}
+
+// Start the timer. Called with the mutex held.
+func (p *AsyncProducer) start() {
+	p.started = true
+	if p.StatInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.StatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				p.mutex.Lock()
+				if p.puts > 0 && !p.closed {
+					p.stat()
+				}
+				p.mutex.Unlock()
+			}
+		}
+	}()
+}
+
+// Call MQSTAT and report the results. Called with the mutex held.
+func (p *AsyncProducer) stat() AsyncPutReport {
+	sts := NewMQSTS()
+	err := p.qMgr.Stat(MQSTAT_TYPE_ASYNC_ERROR, sts)
+
+	r := reportFromSTS(p.puts, sts)
+	r.Err = err
+	// If MQSTAT failed, the puts have not been reported on, so they stay
+	// in the count for the next call
+	if err == nil {
+		p.puts = 0
+		p.totals.accumulate(&r)
+	}
+
+	if r.PutFailureCount > 0 || r.PutWarningCount > 0 {
+		logTrace("AsyncProducer: %d failures, %d warnings. First reason %d for %s", r.PutFailureCount, r.PutWarningCount, r.Reason, r.ObjectName)
+	}
+
+	// Only this function sends to the channel, and the mutex is held, so there
+	// is always room after taking out the oldest report
+	select {
+	case p.reports <- r:
+	default:
+		select {
+		case old := <-p.reports:
+			logTrace("AsyncProducer: Report channel is full. Discarding report from %v", old.Time)
+		default:
+		}
+		p.reports <- r
+	}
+	return r
+}
+
+func reportFromSTS(puts int, sts *MQSTS) AsyncPutReport {
+	return AsyncPutReport{
+		Time:               time.Now(),
+		Puts:               puts,
+		PutSuccessCount:    sts.PutSuccessCount,
+		PutWarningCount:    sts.PutWarningCount,
+		PutFailureCount:    sts.PutFailureCount,
+		CompCode:           sts.CompCode,
+		Reason:             sts.Reason,
+		ObjectType:         sts.ObjectType,
+		ObjectName:         sts.ObjectName,
+		ObjectQMgrName:     sts.ObjectQMgrName,
+		ResolvedObjectName: sts.ResolvedObjectName,
+		ResolvedQMgrName:   sts.ResolvedQMgrName,
+		ObjectString:       sts.ObjectString,
+	}
+}
+
+// Add a batch to the running totals. The error details are those of the most
+// recent batch that had an error.
+func (t *AsyncPutReport) accumulate(r *AsyncPutReport) {
+	t.Time = r.Time
+	t.Puts += r.Puts
+	t.PutSuccessCount += r.PutSuccessCount
+	t.PutWarningCount += r.PutWarningCount
+	t.PutFailureCount += r.PutFailureCount
+	if r.CompCode != MQCC_OK {
+		t.CompCode = r.CompCode
+		t.Reason = r.Reason
+		t.ObjectType = r.ObjectType
+		t.ObjectName = r.ObjectName
+		t.ObjectQMgrName = r.ObjectQMgrName
+		t.ResolvedObjectName = r.ResolvedObjectName
+		t.ResolvedQMgrName = r.ResolvedQMgrName
+		t.ObjectString = r.ObjectString
+	}
+	if r.Err != nil {
+		t.Err = r.Err
+	}
+}
+
+// The error that a report represents. Warnings are not treated as errors.
+func (r *AsyncPutReport) err() error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if r.PutFailureCount > 0 {
+		return &MQReturn{MQCC: MQCC_FAILED, MQRC: r.Reason, verb: "MQPUT"}
+	}
+	return nil
+}
+
+/*
+Flush calls MQSTAT, so that the status of every put made so far is known. It should be
+called before committing or disconnecting. The error is the first failure
+reported by MQSTAT, if there was one; the application might then back out the unit of work.
+*/
+func (p *AsyncProducer) Flush() (AsyncPutReport, error) {
+	traceEntry("AsyncFlush")
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Everything was flushed by Close
+	if p.closed {
+		traceExitF("AsyncFlush", 1, "Closed")
+		return AsyncPutReport{}, nil
+	}
+
+	r := p.stat()
+	err := r.err()
+	traceExitErr("AsyncFlush", 0, err)
+	return r, err
+}
+
+/*
+Totals returns the counts for all the batches reported so far
+*/
+func (p *AsyncProducer) Totals() AsyncPutReport {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.totals
+}
+
+/*
+Close stops the timer, flushes any outstanding puts and closes the
+Reports channel. The connection is not disconnected.
+*/
+func (p *AsyncProducer) Close() (AsyncPutReport, error) {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return AsyncPutReport{}, nil
+	}
+	r := p.stat()
+	p.closed = true
+	close(p.stop)
+	close(p.reports)
+	p.mutex.Unlock()
+
+	return r, r.err()
+}
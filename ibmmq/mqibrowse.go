@@ -0,0 +1,275 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file provides an iterator for browsing the messages on a queue, without
+the application having to manage the MQGMO_BROWSE_FIRST and MQGMO_BROWSE_NEXT
+options itself.
+
+With co-operative browsing, several applications - perhaps in different
+processes - can browse the same queue and each message is returned to only one
+of them. The browsed message is marked; the application then gets it under the
+cursor to process it, or unmarks it so that someone else can have it. A mark is
+also removed by the queue manager if the message is not got within the MARKINT
+time of the queue manager. This is the usual pattern for a dispatcher that
+hands out messages to workers.
+
+	b, err := ibmmq.OpenBrowser(qMgr, "APP.Q", opts)
+	for b.Next() {
+	    m := b.Message()
+	    ...
+	}
+	if b.Err() != nil {
+	    ...
+	}
+*/
+
+import (
+	"errors"
+)
+
+/*
+BrowseOptions control which messages a Browser returns
+*/
+type BrowseOptions struct {
+	Selector     string // Message selector. Only used by OpenBrowser as it has to be given on MQOPEN
+	MsgId        []byte // Only return messages with this MsgId
+	CorrelId     []byte // Only return messages with this CorrelId
+	CoOp         bool   // Use co-operative browse marking
+	Input        bool   // OpenBrowser also opens the queue for input, so GetUnderCursor can be used
+	WaitInterval int32  // Milliseconds that Next waits for a message. 0 means no wait
+	MaxMsgLength int    // Largest message that can be returned. 0 means no limit
+}
+
+/*
+NewBrowseOptions fills in default values for the BrowseOptions structure
+*/
+func NewBrowseOptions() *BrowseOptions {
+	o := new(BrowseOptions)
+	o.Selector = ""
+	o.MsgId = nil
+	o.CorrelId = nil
+	o.CoOp = false
+	o.Input = false
+	o.WaitInterval = 0
+	o.MaxMsgLength = 0
+	return o
+}
+
+/*
+Browser steps through the messages on a queue
+*/
+type Browser struct {
+	object  MQObject
+	owned   bool // The queue was opened by OpenBrowser, so is closed by Close
+	opts    BrowseOptions
+	started bool
+	current *Message
+	err     error
+}
+
+/*
+NewBrowser creates a Browser for a queue that has already been opened with
+MQOO_BROWSE, and with MQOO_CO_OP if co-operative browsing is used. The Selector option is ignored.
+*/
+func NewBrowser(object MQObject, opts *BrowseOptions) *Browser {
+	if opts == nil {
+		opts = NewBrowseOptions()
+	}
+	b := new(Browser)
+	b.object = object
+	b.opts = *opts
+	return b
+}
+
+/*
+OpenBrowser opens a queue for browsing, with the open options and selector
+needed for the BrowseOptions, and creates a Browser for it
+*/
+func OpenBrowser(qMgr *MQQueueManager, qName string, opts *BrowseOptions) (*Browser, error) {
+	traceEntry("OpenBrowser")
+
+	if opts == nil {
+		opts = NewBrowseOptions()
+	}
+
+	od := NewMQOD()
+	od.ObjectType = MQOT_Q
+	od.ObjectName = qName
+	od.SelectionString = opts.Selector
+
+	openOptions := MQOO_BROWSE | MQOO_FAIL_IF_QUIESCING
+	if opts.CoOp {
+		openOptions |= MQOO_CO_OP
+	}
+	if opts.Input {
+		openOptions |= MQOO_INPUT_AS_Q_DEF
+	}
+
+	object, err := qMgr.Open(od, openOptions)
+	if err != nil {
+		traceExitErr("OpenBrowser", 1, err)
+		return nil, err
+	}
+
+	b := NewBrowser(object, opts)
+	b.owned = true
+
+	traceExit("OpenBrowser")
+	return b, nil
+}
+
+/*
+Next moves the cursor to the next message, returning false if there are no more
+or there was an error. Next can be called again after it has returned false at the end
+of the queue, to see whether any new messages have arrived.
+
+In co-operative mode, each call starts again from the beginning of the queue
+looking for the first unmarked message, so that messages whose marks have been removed
+are found again.
+*/
+func (b *Browser) Next() bool {
+	b.current = nil
+	b.err = nil
+
+	md := NewMQMD()
+	gmo := b.newGMO(md)
+
+	if b.opts.CoOp {
+		gmo.Options |= MQGMO_BROWSE_FIRST | MQGMO_MARK_BROWSE_CO_OP | MQGMO_UNMARKED_BROWSE_MSG
+	} else if b.started {
+		gmo.Options |= MQGMO_BROWSE_NEXT
+	} else {
+		gmo.Options |= MQGMO_BROWSE_FIRST
+	}
+	if b.opts.WaitInterval > 0 {
+		gmo.Options |= MQGMO_WAIT
+		gmo.WaitInterval = b.opts.WaitInterval
+	}
+
+	data, err := b.object.GetAutoSize(md, gmo, 0, b.opts.MaxMsgLength)
+	if isGetFailure(err) {
+		if !errors.Is(err, ErrNoMsgAvailable) {
+			logTrace("Browser: Browse failed: %v", err)
+			b.err = err
+		}
+		return false
+	}
+
+	b.started = true
+	b.current = &Message{MD: md, Data: data}
+	return true
+}
+
+// A GMO with the matching options set from the BrowseOptions, and the MD fields to match
+func (b *Browser) newGMO(md *MQMD) *MQGMO {
+	gmo := NewMQGMO()
+	gmo.Version = MQGMO_VERSION_2
+	gmo.Options = MQGMO_FAIL_IF_QUIESCING
+	gmo.MatchOptions = MQMO_NONE
+	if len(b.opts.MsgId) > 0 {
+		gmo.MatchOptions |= MQMO_MATCH_MSG_ID
+		md.MsgId = append([]byte{}, b.opts.MsgId...)
+	}
+	if len(b.opts.CorrelId) > 0 {
+		gmo.MatchOptions |= MQMO_MATCH_CORREL_ID
+		md.CorrelId = append([]byte{}, b.opts.CorrelId...)
+	}
+	return gmo
+}
+
+/*
+Message returns the message that Next moved to
+*/
+func (b *Browser) Message() *Message {
+	return b.current
+}
+
+/*
+Err returns the error that stopped Next, if it was not just reaching the end of the queue
+*/
+func (b *Browser) Err() error {
+	return b.err
+}
+
+/*
+GetUnderCursor removes the message under the cursor from the queue, returning it.
+A nil GMO uses the defaults; the application can set options such as MQGMO_SYNCPOINT
+in it. The queue must have been opened for input.
+*/
+func (b *Browser) GetUnderCursor(gogmo *MQGMO) (*Message, error) {
+	traceEntry("GetUnderCursor")
+
+	if gogmo == nil {
+		gogmo = NewMQGMO()
+	}
+	savedOptions := gogmo.Options
+	gogmo.Options &^= (MQGMO_BROWSE_FIRST | MQGMO_BROWSE_NEXT | MQGMO_BROWSE_MSG_UNDER_CURSOR | MQGMO_WAIT)
+	gogmo.Options |= MQGMO_MSG_UNDER_CURSOR
+
+	md := NewMQMD()
+	data, err := b.object.GetAutoSize(md, gogmo, 0, b.opts.MaxMsgLength)
+	gogmo.Options = savedOptions
+
+	if isGetFailure(err) {
+		traceExitErr("GetUnderCursor", 1, err)
+		return nil, err
+	}
+
+	b.current = nil
+	traceExit("GetUnderCursor")
+	return &Message{MD: md, Data: data}, err
+}
+
+/*
+Unmark removes the co-operative mark from the message under the cursor, so that it can be
+given to another browser
+*/
+func (b *Browser) Unmark() error {
+	traceEntry("Unmark")
+
+	md := NewMQMD()
+	gmo := NewMQGMO()
+	gmo.Options = MQGMO_BROWSE_MSG_UNDER_CURSOR | MQGMO_UNMARK_BROWSE_CO_OP | MQGMO_ACCEPT_TRUNCATED_MSG
+
+	// We do not need the message data again
+	_, _, err := b.object.GetSlice(md, gmo, make([]byte, 0))
+	if isGetFailure(err) {
+		traceExitErr("Unmark", 1, err)
+		return err
+	}
+
+	b.current = nil
+	traceExit("Unmark")
+	return nil
+}
+
+/*
+Close the Browser. The queue is closed if it was opened by OpenBrowser.
+*/
+func (b *Browser) Close() error {
+	b.current = nil
+	if b.owned {
+		b.owned = false
+		return b.object.Close(0)
+	}
+	return nil
+}
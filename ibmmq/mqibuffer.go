@@ -0,0 +1,183 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file deals with getting messages when the application does not know how
+big they might be. The MQGET is done into a buffer taken from a pool; if the message
+does not fit, a large enough buffer is taken and the same message is retrieved again.
+
+The pool is split into size classes that are powers of two, so that a request for a
+buffer does not keep finding one that is too small.
+*/
+
+import (
+	"bytes"
+	"sync"
+)
+
+const (
+	defaultGetBufferSize = 32 * 1024
+	minPooledBufferSize  = 4 * 1024
+	// Larger buffers are not returned to the pool, so that one unusually
+	// big message does not hold on to a lot of memory
+	maxPooledBufferSize = 4 * 1024 * 1024
+)
+
+// One pool for each size class from minPooledBufferSize to maxPooledBufferSize
+var getBufferPools [11]sync.Pool
+
+// Which pool holds buffers big enough for this size
+func bufferClass(size int) int {
+	c := 0
+	for s := minPooledBufferSize; s < size; s <<= 1 {
+		c++
+	}
+	return c
+}
+
+func getPooledBuffer(size int) *[]byte {
+	if size > maxPooledBufferSize {
+		b := make([]byte, 0, size)
+		return &b
+	}
+	c := bufferClass(size)
+	if p, ok := getBufferPools[c].Get().(*[]byte); ok {
+		return p
+	}
+	b := make([]byte, 0, minPooledBufferSize<<c)
+	return &b
+}
+
+// Only buffers that were created for a size class go back into the pool
+func putPooledBuffer(p *[]byte) {
+	size := cap(*p)
+	if size < minPooledBufferSize || size > maxPooledBufferSize || size&(size-1) != 0 {
+		return
+	}
+	*p = (*p)[:0]
+	getBufferPools[bufferClass(size)].Put(p)
+}
+
+/*
+GetAutoSize gets a message of any length, returning a slice that is exactly the
+size of the message data. The buffers used for the MQGET come from a pool, and are
+reused by later calls; the returned slice is a copy that belongs to the caller. The first MQGET uses a buffer of initialSize bytes (or a default
+value if that is 0). If the message is truncated, the buffer is enlarged to the real
+message length and the same message is retrieved again. The maxSize parameter gives
+an upper limit for the buffer; 0 means no limit other than the queue manager's own.
+
+When the failed MQGET returns a MsgToken, the retry of a destructive get matches on it,
+so it cannot return a different message even if other applications are reading the queue.
+If that message has already been taken, the retry fails with MQRC_NO_MSG_AVAILABLE. If there
+is no MsgToken, for example from a z/OS queue manager, the retry uses the original selection
+criteria, and another reader of the queue might have taken the message in between; the retry
+then returns the next matching message instead. For
+browse operations, the retry uses MQGMO_BROWSE_MSG_UNDER_CURSOR so that the cursor does
+not move on to a different message.
+
+If the GMO includes MQGMO_ACCEPT_TRUNCATED_MSG, that option is only used once
+the message is known to be bigger than maxSize, so a message is never
+removed from the queue while it might still fit.
+
+As with GetSlice, an error with MQCC_WARNING can still come with message data.
+*/
+func (object MQObject) GetAutoSize(gomd *MQMD, gogmo *MQGMO, initialSize int, maxSize int) ([]byte, error) {
+	traceEntry("GetAutoSize")
+
+	if initialSize <= 0 {
+		initialSize = defaultGetBufferSize
+	}
+	if maxSize > 0 && initialSize > maxSize {
+		initialSize = maxSize
+	}
+
+	savedMD := cloneMD(gomd)
+	savedOptions := gogmo.Options
+	savedVersion := gogmo.Version
+	savedMatchOptions := gogmo.MatchOptions
+	acceptTruncated := savedOptions&MQGMO_ACCEPT_TRUNCATED_MSG != 0
+	defer func() {
+		gogmo.Options = savedOptions
+		gogmo.Version = savedVersion
+		gogmo.MatchOptions = savedMatchOptions
+	}()
+
+	opts := savedOptions &^ MQGMO_ACCEPT_TRUNCATED_MSG
+	size := initialSize
+	for {
+		gogmo.Options = opts
+		if acceptTruncated && maxSize > 0 && size >= maxSize {
+			gogmo.Options |= MQGMO_ACCEPT_TRUNCATED_MSG
+		}
+
+		// The pooled buffer might be bigger than asked for, but MQGET is only given size bytes
+		bp := getPooledBuffer(size)
+		data, datalen, err := object.GetSlice(gomd, gogmo, (*bp)[0:0:size])
+		mqreturn, ok := err.(*MQReturn)
+		retry := ok && mqreturn.MQRC == MQRC_TRUNCATED_MSG_FAILED && datalen > size &&
+			(maxSize == 0 || size < maxSize)
+		if retry && maxSize > 0 && datalen > maxSize && !acceptTruncated {
+			// Another attempt cannot succeed
+			retry = false
+		}
+
+		if !retry {
+			var result []byte
+			if err == nil || (ok && mqreturn.MQCC == MQCC_WARNING) {
+				result = make([]byte, len(data))
+				copy(result, data)
+			}
+			putPooledBuffer(bp)
+			traceExitErr("GetAutoSize", 0, err)
+			return result, err
+		}
+
+		putPooledBuffer(bp)
+		size = datalen
+		if maxSize > 0 && size > maxSize {
+			size = maxSize
+		}
+		logTrace("GetAutoSize: Retrying with buffer size %d for message length %d", size, datalen)
+
+		if opts&(MQGMO_BROWSE_FIRST|MQGMO_BROWSE_NEXT) != 0 {
+			// A truncated browse has already moved the cursor to the message we want
+			opts &^= (MQGMO_BROWSE_FIRST | MQGMO_BROWSE_NEXT)
+			opts |= MQGMO_BROWSE_MSG_UNDER_CURSOR
+		} else if opts&MQGMO_BROWSE_MSG_UNDER_CURSOR == 0 {
+			pinToMsgToken(gogmo)
+		}
+		*gomd = *cloneMD(savedMD)
+	}
+}
+
+// Set the GMO so that the next MQGET can only return the message identified by the
+// MsgToken from the previous one. Returns false if there is no token to match,
+// in which case the original selection criteria are used again.
+func pinToMsgToken(gogmo *MQGMO) bool {
+	if len(gogmo.MsgToken) != int(MQ_MSG_TOKEN_LENGTH) || bytes.Equal(gogmo.MsgToken, make([]byte, MQ_MSG_TOKEN_LENGTH)) {
+		return false
+	}
+	if gogmo.Version < MQGMO_VERSION_3 {
+		gogmo.Version = MQGMO_VERSION_3
+	}
+	gogmo.MatchOptions = MQMO_MATCH_MSG_TOKEN
+	return true
+}
@@ -223,6 +223,7 @@ func (object *MQObject) CB(goOperation int32, gocbd *MQCBD, gomd *MQMD, gogmo *M
 	var mqgmo C.MQGMO
 
 	traceEntry("CB(Q)")
+	start := verbStart()
 
 	if !IsUsableHObj(*object) {
 		err := &MQReturn{MQCC: MQCC_FAILED,
@@ -277,6 +278,7 @@ func (object *MQObject) CB(goOperation int32, gocbd *MQCBD, gomd *MQMD, gogmo *M
 		MQRC: int32(mqrc),
 		verb: "MQCB",
 	}
+	logVerb("MQCB", start, object.qMgr, object.Name, &mqreturn, nil, "operation", goOperation)
 
 	if mqcc != C.MQCC_OK {
 		// Don't leak these 4 bytes when the register failed
@@ -327,6 +329,7 @@ func (object *MQQueueManager) CB(goOperation int32, gocbd *MQCBD) error {
 	var mqcbd C.MQCBD
 
 	traceEntry("CB(QM)")
+	start := verbStart()
 
 	mqOperation = C.MQLONG(goOperation)
 	copyCBDtoC(&mqcbd, gocbd)
@@ -346,6 +349,7 @@ func (object *MQQueueManager) CB(goOperation int32, gocbd *MQCBD) error {
 		MQRC: int32(mqrc),
 		verb: "MQCB",
 	}
+	logVerb("MQCB", start, object, "", &mqreturn, nil, "operation", goOperation)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("CB(QM)", 1, &mqreturn)
@@ -385,6 +389,7 @@ func (x *MQQueueManager) Ctl(goOperation int32, goctlo *MQCTLO) error {
 	var mqctlo C.MQCTLO
 
 	traceEntry("Ctl")
+	start := verbStart()
 
 	mqOperation = C.MQLONG(goOperation)
 	copyCTLOtoC(&mqctlo, goctlo)
@@ -406,6 +411,7 @@ func (x *MQQueueManager) Ctl(goOperation int32, goctlo *MQCTLO) error {
 		MQRC: int32(mqrc),
 		verb: "MQCTL",
 	}
+	logVerb("MQCTL", start, x, "", &mqreturn, nil, "operation", goOperation)
 
 	if mqcc != C.MQCC_OK {
 		traceExitErr("Ctl", 1, &mqreturn)
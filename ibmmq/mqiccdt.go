@@ -0,0 +1,632 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file is a model of the JSON format of the Client Channel Definition Table
+so that applications can create, check and combine CCDTs without
+needing runmqsc or the MQ Explorer. Only the client-connection attributes that
+have an equivalent in the Go MQCD structure are modelled. Other attributes, such as
+channel exits, are kept in the Unknown field of each structure when a file is read, so
+that they are written back out unchanged.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The port used by MQ when a connection name does not include one
+const defaultListenerPort = 1414
+
+/*
+CCDT is the top level of a JSON CCDT file
+*/
+type CCDT struct {
+	Channel []CCDTChannel `json:"channel"`
+
+	Unknown map[string]json.RawMessage `json:"-"` // Members that are not modelled
+}
+
+/*
+CCDTChannel is a single client-connection channel definition
+*/
+type CCDTChannel struct {
+	Name                 string                    `json:"name"`
+	Type                 string                    `json:"type,omitempty"`
+	General              *CCDTGeneral              `json:"general,omitempty"`
+	ClientConnection     CCDTClientConnection      `json:"clientConnection"`
+	ConnectionManagement *CCDTConnectionManagement `json:"connectionManagement,omitempty"`
+	TransmissionSecurity *CCDTTransmissionSecurity `json:"transmissionSecurity,omitempty"`
+
+	Unknown map[string]json.RawMessage `json:"-"` // Members that are not modelled
+}
+
+/*
+CCDTGeneral holds the general channel attributes
+*/
+type CCDTGeneral struct {
+	Description          string `json:"description,omitempty"`
+	MaximumMessageLength *int32 `json:"maximumMessageLength,omitempty"`
+
+	Unknown map[string]json.RawMessage `json:"-"` // Members that are not modelled
+}
+
+/*
+CCDTClientConnection says where the channel goes
+*/
+type CCDTClientConnection struct {
+	Connection   []CCDTConnection `json:"connection"`
+	QueueManager string           `json:"queueManager,omitempty"`
+
+	Unknown map[string]json.RawMessage `json:"-"` // Members that are not modelled
+}
+
+/*
+CCDTConnection is one of the addresses for the channel
+*/
+type CCDTConnection struct {
+	Host string `json:"host"`
+	Port int    `json:"port,omitempty"`
+
+	Unknown map[string]json.RawMessage `json:"-"` // Members that are not modelled
+}
+
+/*
+CCDTConnectionManagement holds the attributes that control how the channel is chosen
+and how the connection behaves. Pointer fields are not set if they are nil.
+*/
+type CCDTConnectionManagement struct {
+	Affinity             string `json:"affinity,omitempty"`        // "preferred" or "none"
+	ClientWeight         int32  `json:"clientWeight,omitempty"`    // 0 to 99
+	ClientReconnect      string `json:"clientReconnect,omitempty"` // "no", "yes", "queueManager" or "disabled"
+	SharingConversations *int32 `json:"sharingConversations,omitempty"`
+	HeartbeatInterval    *int32 `json:"heartbeatInterval,omitempty"`
+	KeepAliveInterval    *int32 `json:"keepAliveInterval,omitempty"` // -1 means AUTO
+
+	Unknown map[string]json.RawMessage `json:"-"` // Members that are not modelled
+}
+
+/*
+CCDTTransmissionSecurity holds the TLS attributes
+*/
+type CCDTTransmissionSecurity struct {
+	CipherSpecification string `json:"cipherSpecification,omitempty"`
+	CertificateLabel    string `json:"certificateLabel,omitempty"`
+	CertificatePeerName string `json:"certificatePeerName,omitempty"`
+
+	Unknown map[string]json.RawMessage `json:"-"` // Members that are not modelled
+}
+
+/*
+CCDTValidationError lists all of the problems found by Validate
+*/
+type CCDTValidationError struct {
+	Problems []string
+}
+
+func (e *CCDTValidationError) Error() string {
+	return "invalid CCDT: " + strings.Join(e.Problems, "; ")
+}
+
+// Each structure keeps the JSON members that it does not know about. The
+// methods convert to a type without the methods, to avoid recursion.
+
+func (ccdt *CCDT) UnmarshalJSON(b []byte) error {
+	type plain CCDT
+	return unmarshalKeepUnknown(b, (*plain)(ccdt), &ccdt.Unknown)
+}
+
+func (ccdt CCDT) MarshalJSON() ([]byte, error) {
+	type plain CCDT
+	return marshalKeepUnknown(plain(ccdt), ccdt.Unknown)
+}
+
+func (ch *CCDTChannel) UnmarshalJSON(b []byte) error {
+	type plain CCDTChannel
+	return unmarshalKeepUnknown(b, (*plain)(ch), &ch.Unknown)
+}
+
+func (ch CCDTChannel) MarshalJSON() ([]byte, error) {
+	type plain CCDTChannel
+	return marshalKeepUnknown(plain(ch), ch.Unknown)
+}
+
+func (g *CCDTGeneral) UnmarshalJSON(b []byte) error {
+	type plain CCDTGeneral
+	return unmarshalKeepUnknown(b, (*plain)(g), &g.Unknown)
+}
+
+func (g CCDTGeneral) MarshalJSON() ([]byte, error) {
+	type plain CCDTGeneral
+	return marshalKeepUnknown(plain(g), g.Unknown)
+}
+
+func (cc *CCDTClientConnection) UnmarshalJSON(b []byte) error {
+	type plain CCDTClientConnection
+	return unmarshalKeepUnknown(b, (*plain)(cc), &cc.Unknown)
+}
+
+func (cc CCDTClientConnection) MarshalJSON() ([]byte, error) {
+	type plain CCDTClientConnection
+	return marshalKeepUnknown(plain(cc), cc.Unknown)
+}
+
+func (c *CCDTConnection) UnmarshalJSON(b []byte) error {
+	type plain CCDTConnection
+	return unmarshalKeepUnknown(b, (*plain)(c), &c.Unknown)
+}
+
+func (c CCDTConnection) MarshalJSON() ([]byte, error) {
+	type plain CCDTConnection
+	return marshalKeepUnknown(plain(c), c.Unknown)
+}
+
+func (cm *CCDTConnectionManagement) UnmarshalJSON(b []byte) error {
+	type plain CCDTConnectionManagement
+	return unmarshalKeepUnknown(b, (*plain)(cm), &cm.Unknown)
+}
+
+func (cm CCDTConnectionManagement) MarshalJSON() ([]byte, error) {
+	type plain CCDTConnectionManagement
+	return marshalKeepUnknown(plain(cm), cm.Unknown)
+}
+
+func (ts *CCDTTransmissionSecurity) UnmarshalJSON(b []byte) error {
+	type plain CCDTTransmissionSecurity
+	return unmarshalKeepUnknown(b, (*plain)(ts), &ts.Unknown)
+}
+
+func (ts CCDTTransmissionSecurity) MarshalJSON() ([]byte, error) {
+	type plain CCDTTransmissionSecurity
+	return marshalKeepUnknown(plain(ts), ts.Unknown)
+}
+
+// Decode into v, which points at a struct, and put any members that it does not
+// have a field for into unknown
+func unmarshalKeepUnknown(b []byte, v interface{}, unknown *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(b, &members); err != nil {
+		return err
+	}
+
+	t := reflect.TypeOf(v).Elem()
+	for name := range members {
+		for i := 0; i < t.NumField(); i++ {
+			tag := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+			// The decoder matches names without regard to case, so we do the same
+			if tag != "-" && strings.EqualFold(tag, name) {
+				delete(members, name)
+				break
+			}
+		}
+	}
+
+	*unknown = nil
+	if len(members) > 0 {
+		*unknown = members
+	}
+	return nil
+}
+
+// Encode v, and add the unknown members after the known ones. They are sorted
+// so that the output is always the same.
+func marshalKeepUnknown(v interface{}, unknown map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(unknown) == 0 {
+		return b, err
+	}
+
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bytes.NewBuffer(bytes.TrimSuffix(b, []byte("}")))
+	for i, name := range names {
+		if i > 0 || len(b) > 2 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(unknown[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+var ccdtAffinity = map[string]int32{
+	"preferred": MQCAFTY_PREFERRED,
+	"none":      MQCAFTY_NONE,
+}
+
+var ccdtReconnect = map[string]int32{
+	"no":           MQRCN_NO,
+	"yes":          MQRCN_YES,
+	"queueManager": MQRCN_Q_MGR,
+	"disabled":     MQRCN_DISABLED,
+}
+
+// The CipherSpecs that a client channel can use, from the MQ CipherSpec tables. Many
+// of the older ones are deprecated and are disabled unless the queue manager and client
+// are configured to allow them, but they are still valid names in a CCDT.
+var ccdtCipherSpecs = map[string]bool{
+	"ANY":                                  true,
+	"ANY_TLS12":                            true,
+	"ANY_TLS12_OR_HIGHER":                  true,
+	"ANY_TLS13":                            true,
+	"ANY_TLS13_OR_HIGHER":                  true,
+	"TLS_AES_128_GCM_SHA256":               true,
+	"TLS_AES_256_GCM_SHA384":               true,
+	"TLS_CHACHA20_POLY1305_SHA256":         true,
+	"TLS_AES_128_CCM_SHA256":               true,
+	"TLS_AES_128_CCM_8_SHA256":             true,
+	"ECDHE_ECDSA_AES_128_CBC_SHA256":       true,
+	"ECDHE_ECDSA_AES_256_CBC_SHA384":       true,
+	"ECDHE_ECDSA_AES_128_GCM_SHA256":       true,
+	"ECDHE_ECDSA_AES_256_GCM_SHA384":       true,
+	"ECDHE_RSA_AES_128_CBC_SHA256":         true,
+	"ECDHE_RSA_AES_256_CBC_SHA384":         true,
+	"ECDHE_RSA_AES_128_GCM_SHA256":         true,
+	"ECDHE_RSA_AES_256_GCM_SHA384":         true,
+	"ECDHE_ECDSA_CHACHA20_POLY1305_SHA256": true,
+	"ECDHE_RSA_CHACHA20_POLY1305_SHA256":   true,
+	"TLS_RSA_WITH_AES_128_CBC_SHA256":      true,
+	"TLS_RSA_WITH_AES_256_CBC_SHA256":      true,
+	"TLS_RSA_WITH_AES_128_GCM_SHA256":      true,
+	"TLS_RSA_WITH_AES_256_GCM_SHA384":      true,
+	"ECDHE_ECDSA_NULL_SHA256":              true,
+	"ECDHE_RSA_NULL_SHA256":                true,
+	"TLS_RSA_WITH_NULL_SHA256":             true,
+	"ECDHE_ECDSA_3DES_EDE_CBC_SHA256":      true,
+	"ECDHE_RSA_3DES_EDE_CBC_SHA256":        true,
+	"ECDHE_ECDSA_RC4_128_SHA256":           true,
+	"ECDHE_RSA_RC4_128_SHA256":             true,
+	"TLS_RSA_WITH_AES_128_CBC_SHA":         true,
+	"TLS_RSA_WITH_AES_256_CBC_SHA":         true,
+	"TLS_RSA_WITH_DES_CBC_SHA":             true,
+	"TLS_RSA_WITH_3DES_EDE_CBC_SHA":        true,
+	"FIPS_WITH_3DES_EDE_CBC_SHA":           true,
+	"FIPS_WITH_DES_CBC_SHA":                true,
+	"TRIPLE_DES_SHA_US":                    true,
+	"DES_SHA_EXPORT":                       true,
+	"DES_SHA_EXPORT1024":                   true,
+	"RC4_SHA_US":                           true,
+	"RC4_MD5_US":                           true,
+	"RC4_MD5_EXPORT":                       true,
+	"RC4_56_SHA_EXPORT1024":                true,
+	"RC2_MD5_EXPORT":                       true,
+	"NULL_SHA":                             true,
+	"NULL_MD5":                             true,
+}
+
+/*
+LoadCCDT reads a JSON CCDT file
+*/
+func LoadCCDT(file string) (*CCDT, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	ccdt, err := ParseCCDT(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	return ccdt, nil
+}
+
+/*
+ParseCCDT converts the JSON form of a CCDT into the model
+*/
+func ParseCCDT(b []byte) (*CCDT, error) {
+	ccdt := new(CCDT)
+	if err := json.Unmarshal(b, ccdt); err != nil {
+		return nil, err
+	}
+	return ccdt, nil
+}
+
+/*
+Write generates the JSON form of the CCDT
+*/
+func (ccdt *CCDT) Write(w io.Writer) error {
+	b, err := json.MarshalIndent(ccdt, "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	_, err = w.Write(b)
+	return err
+}
+
+/*
+WriteFile generates the JSON form of the CCDT into a file. The CCDT is
+validated first, so that an unusable file is not created.
+*/
+func (ccdt *CCDT) WriteFile(file string) error {
+	if err := ccdt.Validate(); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := ccdt.Write(&buf); err != nil {
+		return err
+	}
+	return os.WriteFile(file, buf.Bytes(), 0644)
+}
+
+/*
+Validate checks the definitions, returning a CCDTValidationError that lists every problem.
+The JSON format permits several channels with the same name, to give alternative
+routes to different queue managers, but the same name cannot be used twice for one queue manager.
+*/
+func (ccdt *CCDT) Validate() error {
+	var problems []string
+	add := func(ch *CCDTChannel, format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf("channel %q: ", ch.Name)+fmt.Sprintf(format, args...))
+	}
+
+	seen := make(map[string]bool)
+	for i := range ccdt.Channel {
+		ch := &ccdt.Channel[i]
+
+		if ch.Name == "" {
+			add(ch, "no name")
+		} else if len(ch.Name) > int(MQ_CHANNEL_NAME_LENGTH) {
+			add(ch, "name is longer than %d characters", MQ_CHANNEL_NAME_LENGTH)
+		}
+		if ch.Type != "" && ch.Type != "clientConnection" {
+			add(ch, "type %q is not clientConnection", ch.Type)
+		}
+
+		key := ccdtKey(ch)
+		if seen[key] {
+			add(ch, "duplicate definition for queue manager %q", ch.ClientConnection.QueueManager)
+		}
+		seen[key] = true
+
+		if len(ch.ClientConnection.Connection) == 0 {
+			add(ch, "no connections")
+		}
+		for _, c := range ch.ClientConnection.Connection {
+			if c.Host == "" {
+				add(ch, "connection with no host")
+			}
+			if c.Port == 0 {
+				add(ch, "connection to %q has no port", c.Host)
+			} else if c.Port < 0 || c.Port > 65535 {
+				add(ch, "connection to %q has invalid port %d", c.Host, c.Port)
+			}
+		}
+
+		if cm := ch.ConnectionManagement; cm != nil {
+			if _, ok := ccdtAffinity[cm.Affinity]; cm.Affinity != "" && !ok {
+				add(ch, "invalid affinity %q", cm.Affinity)
+			}
+			if _, ok := ccdtReconnect[cm.ClientReconnect]; cm.ClientReconnect != "" && !ok {
+				add(ch, "invalid clientReconnect %q", cm.ClientReconnect)
+			}
+			if cm.ClientWeight < 0 || cm.ClientWeight > 99 {
+				add(ch, "clientWeight %d is not between 0 and 99", cm.ClientWeight)
+			}
+			if cm.SharingConversations != nil && *cm.SharingConversations < 0 {
+				add(ch, "negative sharingConversations")
+			}
+			if cm.HeartbeatInterval != nil && *cm.HeartbeatInterval < 0 {
+				add(ch, "negative heartbeatInterval")
+			}
+			if cm.KeepAliveInterval != nil && *cm.KeepAliveInterval < -1 {
+				add(ch, "invalid keepAliveInterval")
+			}
+		}
+
+		if ts := ch.TransmissionSecurity; ts != nil {
+			if ts.CipherSpecification != "" && !ccdtCipherSpecs[ts.CipherSpecification] {
+				add(ch, "unknown cipherSpecification %q", ts.CipherSpecification)
+			}
+			if ts.CipherSpecification == "" && (ts.CertificateLabel != "" || ts.CertificatePeerName != "") {
+				add(ch, "TLS attributes are set without a cipherSpecification")
+			}
+		}
+
+		if g := ch.General; g != nil && g.MaximumMessageLength != nil && *g.MaximumMessageLength < 0 {
+			add(ch, "negative maximumMessageLength")
+		}
+	}
+
+	if len(problems) > 0 {
+		return &CCDTValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// Channels are identified by both the name and the queue manager
+func ccdtKey(ch *CCDTChannel) string {
+	return ch.Name + "/" + ch.ClientConnection.QueueManager
+}
+
+/*
+MergeCCDT combines several CCDTs. A channel in a later CCDT replaces one with the same
+name and queue manager in an earlier one; other channels are added in order. Unknown
+members at the top level are combined in the same way.
+*/
+func MergeCCDT(ccdts ...*CCDT) *CCDT {
+	merged := new(CCDT)
+	index := make(map[string]int)
+
+	for _, ccdt := range ccdts {
+		if ccdt == nil {
+			continue
+		}
+		for name, v := range ccdt.Unknown {
+			if merged.Unknown == nil {
+				merged.Unknown = make(map[string]json.RawMessage)
+			}
+			merged.Unknown[name] = v
+		}
+		for _, ch := range ccdt.Channel {
+			key := ccdtKey(&ch)
+			if i, ok := index[key]; ok {
+				merged.Channel[i] = ch
+			} else {
+				index[key] = len(merged.Channel)
+				merged.Channel = append(merged.Channel, ch)
+			}
+		}
+	}
+	return merged
+}
+
+/*
+ToMQCD converts a channel definition to an MQCD, starting from the default values given
+by NewMQCD. The CCDT should have been validated; invalid values are ignored.
+*/
+func (ch *CCDTChannel) ToMQCD() *MQCD {
+	cd := NewMQCD()
+	cd.ChannelName = ch.Name
+
+	var conns []string
+	for _, c := range ch.ClientConnection.Connection {
+		port := c.Port
+		if port == 0 {
+			port = defaultListenerPort
+		}
+		conns = append(conns, fmt.Sprintf("%s(%d)", c.Host, port))
+	}
+	cd.ConnectionName = strings.Join(conns, ",")
+
+	if g := ch.General; g != nil && g.MaximumMessageLength != nil {
+		cd.MaxMsgLength = *g.MaximumMessageLength
+	}
+
+	if cm := ch.ConnectionManagement; cm != nil {
+		if v, ok := ccdtAffinity[cm.Affinity]; ok {
+			cd.ConnectionAffinity = v
+		}
+		if v, ok := ccdtReconnect[cm.ClientReconnect]; ok {
+			cd.DefReconnect = v
+		}
+		cd.ClientChannelWeight = cm.ClientWeight
+		if cm.SharingConversations != nil {
+			cd.SharingConversations = *cm.SharingConversations
+		}
+		if cm.HeartbeatInterval != nil {
+			cd.HeartbeatInterval = *cm.HeartbeatInterval
+		}
+		if cm.KeepAliveInterval != nil {
+			cd.KeepAliveInterval = *cm.KeepAliveInterval
+		}
+	}
+
+	if ts := ch.TransmissionSecurity; ts != nil {
+		cd.SSLCipherSpec = ts.CipherSpecification
+		cd.CertificateLabel = ts.CertificateLabel
+		cd.SSLPeerName = ts.CertificatePeerName
+	}
+
+	return cd
+}
+
+/*
+NewCCDTChannel converts an MQCD to a channel definition for the named queue manager,
+which can be empty. Only values that differ from the NewMQCD defaults are set, to
+keep the generated CCDT short.
+*/
+func NewCCDTChannel(cd *MQCD, qMgrName string) (CCDTChannel, error) {
+	ch := CCDTChannel{Name: cd.ChannelName, Type: "clientConnection"}
+	ch.ClientConnection.QueueManager = qMgrName
+
+	for _, c := range strings.Split(cd.ConnectionName, ",") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		conn := CCDTConnection{Host: c, Port: defaultListenerPort}
+		if i := strings.Index(c, "("); i >= 0 {
+			if !strings.HasSuffix(c, ")") {
+				return ch, fmt.Errorf("invalid connection name %q", c)
+			}
+			port, err := strconv.Atoi(c[i+1 : len(c)-1])
+			if err != nil {
+				return ch, fmt.Errorf("invalid port in connection name %q", c)
+			}
+			conn.Host = c[:i]
+			conn.Port = port
+		}
+		ch.ClientConnection.Connection = append(ch.ClientConnection.Connection, conn)
+	}
+
+	def := NewMQCD()
+
+	if cd.MaxMsgLength != def.MaxMsgLength {
+		v := cd.MaxMsgLength
+		ch.General = &CCDTGeneral{MaximumMessageLength: &v}
+	}
+
+	cm := new(CCDTConnectionManagement)
+	for name, v := range ccdtAffinity {
+		if v == cd.ConnectionAffinity && v != def.ConnectionAffinity {
+			cm.Affinity = name
+		}
+	}
+	for name, v := range ccdtReconnect {
+		if v == cd.DefReconnect && v != def.DefReconnect {
+			cm.ClientReconnect = name
+		}
+	}
+	cm.ClientWeight = cd.ClientChannelWeight
+	if cd.SharingConversations != def.SharingConversations {
+		v := cd.SharingConversations
+		cm.SharingConversations = &v
+	}
+	if cd.HeartbeatInterval != def.HeartbeatInterval {
+		v := cd.HeartbeatInterval
+		cm.HeartbeatInterval = &v
+	}
+	if cd.KeepAliveInterval != def.KeepAliveInterval {
+		v := cd.KeepAliveInterval
+		cm.KeepAliveInterval = &v
+	}
+	if !reflect.DeepEqual(*cm, CCDTConnectionManagement{}) {
+		ch.ConnectionManagement = cm
+	}
+
+	if cd.SSLCipherSpec != "" || cd.CertificateLabel != "" || cd.SSLPeerName != "" {
+		ch.TransmissionSecurity = &CCDTTransmissionSecurity{
+			CipherSpecification: cd.SSLCipherSpec,
+			CertificateLabel:    cd.CertificateLabel,
+			CertificatePeerName: cd.SSLPeerName,
+		}
+	}
+
+	return ch, nil
+}
@@ -0,0 +1,255 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file converts text between MQ CCSIDs and Go strings without calling the
+queue manager. Normally the best way to get a message in a different codepage is to use
+MQGMO_CONVERT, but that is not always possible: the message might have been
+browsed from a file or a dead-letter queue, or it might be in a header that the queue
+manager does not convert.
+
+The registry has converters for UTF-8, UTF-16, the common ASCII-based codepages
+and the EBCDIC codepages used on z/OS and IBM i for Western European languages. Other
+codepages, including the DBCS ones, can be added with RegisterCCSID. For example
+an application could wrap one of the encodings from golang.org/x/text.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+/*
+Errors from the conversion functions. They are wrapped with the details, so
+use errors.Is to check for them.
+*/
+var (
+	ErrUnknownCCSID        = errors.New("no converter for CCSID")
+	ErrUnmappableCharacter = errors.New("character cannot be converted")
+)
+
+/*
+Codepage converts between the bytes of one CCSID and Go strings
+*/
+type Codepage interface {
+	Decode(b []byte) (string, error)
+	Encode(s string) ([]byte, error)
+}
+
+var ccsidRegistry = struct {
+	sync.RWMutex
+	codepages map[int32]Codepage
+}{codepages: builtinCodepages()}
+
+/*
+RegisterCCSID adds a converter to the registry, replacing any that is already there for the CCSID
+*/
+func RegisterCCSID(ccsid int32, cp Codepage) {
+	ccsidRegistry.Lock()
+	ccsidRegistry.codepages[ccsid] = cp
+	ccsidRegistry.Unlock()
+}
+
+/*
+LookupCCSID returns the converter for a CCSID. The error wraps ErrUnknownCCSID
+if there is none.
+*/
+func LookupCCSID(ccsid int32) (Codepage, error) {
+	ccsidRegistry.RLock()
+	cp, ok := ccsidRegistry.codepages[ccsid]
+	ccsidRegistry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %d", ErrUnknownCCSID, ccsid)
+	}
+	return cp, nil
+}
+
+/*
+DecodeBody converts the text of a message to a Go string. Any MQ headers at
+the start of the message, such as an MQDLH or MQRFH2, are skipped and the CCSID and
+encoding come from the last of them. It should only be used for messages whose body
+is text, normally with the MQSTR format.
+*/
+func DecodeBody(md *MQMD, buf []byte) (string, error) {
+	d := NewMessageDump(md, nil, buf)
+	if d.HeaderError != "" {
+		return "", errors.New(d.HeaderError)
+	}
+
+	cp, err := LookupCCSID(d.BodyCodedCharSetId)
+	if err != nil {
+		return "", err
+	}
+
+	// The byte order of UTF-16 follows the integer encoding
+	if u, ok := cp.(*utf16Codepage); ok {
+		cp = &utf16Codepage{order: dumpByteOrder(d.BodyEncoding), ucs2: u.ucs2}
+	}
+	return cp.Decode(buf[d.BodyOffset:])
+}
+
+// Strings in fixed-length fields end at the first null
+func decodeFixedString(b []byte, ccsid int32) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	if cp, err := LookupCCSID(ccsid); err == nil {
+		if s, err := cp.Decode(b); err == nil {
+			return s
+		}
+	}
+	return string(b)
+}
+
+// Returns the table for a single-byte codepage, or nil for any other kind
+func sbcsTable(ccsid int32) *[256]rune {
+	cp, err := LookupCCSID(ccsid)
+	if err != nil {
+		return nil
+	}
+	if s, ok := cp.(*sbcsCodepage); ok {
+		return s.table
+	}
+	return nil
+}
+
+func builtinCodepages() map[int32]Codepage {
+	var ascii, latin1 [256]rune
+	for i := range latin1 {
+		latin1[i] = rune(i)
+		ascii[i] = rune(i)
+		if i >= 0x80 {
+			ascii[i] = utf8.RuneError
+		}
+	}
+
+	m := map[int32]Codepage{
+		367:   newSBCS(&ascii),
+		819:   newSBCS(&latin1),
+		1208:  utf8Codepage{},
+		1200:  &utf16Codepage{order: binary.BigEndian},
+		13488: &utf16Codepage{order: binary.BigEndian, ucs2: true},
+		17584: &utf16Codepage{order: binary.BigEndian, ucs2: true},
+	}
+	tables := map[int32]*[256]rune{
+		37: &ccsidTable37, 273: &ccsidTable273, 277: &ccsidTable277, 278: &ccsidTable278,
+		280: &ccsidTable280, 284: &ccsidTable284, 285: &ccsidTable285, 297: &ccsidTable297,
+		500: &ccsidTable500, 871: &ccsidTable871, 1047: &ccsidTable1047,
+		1140: &ccsidTable1140, 1141: &ccsidTable1141, 1142: &ccsidTable1142, 1143: &ccsidTable1143,
+		1144: &ccsidTable1144, 1145: &ccsidTable1145, 1146: &ccsidTable1146, 1147: &ccsidTable1147,
+		1148: &ccsidTable1148, 1149: &ccsidTable1149,
+		437: &ccsidTable437, 850: &ccsidTable850, 923: &ccsidTable923, 1252: &ccsidTable1252,
+		5348: &ccsidTable1252, // 1252 with the euro, which the table already has
+	}
+	for ccsid, t := range tables {
+		m[ccsid] = newSBCS(t)
+	}
+	return m
+}
+
+// A single-byte codepage is a table of characters. The reverse map for
+// encoding is only built if it is needed.
+type sbcsCodepage struct {
+	table   *[256]rune
+	once    sync.Once
+	reverse map[rune]byte
+}
+
+func newSBCS(t *[256]rune) *sbcsCodepage {
+	return &sbcsCodepage{table: t}
+}
+
+func (c *sbcsCodepage) Decode(b []byte) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for _, x := range b {
+		sb.WriteRune(c.table[x])
+	}
+	return sb.String(), nil
+}
+
+func (c *sbcsCodepage) Encode(s string) ([]byte, error) {
+	c.once.Do(func() {
+		c.reverse = make(map[rune]byte, 256)
+		for i := len(c.table) - 1; i >= 0; i-- {
+			if c.table[i] != utf8.RuneError {
+				c.reverse[c.table[i]] = byte(i)
+			}
+		}
+	})
+
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		x, ok := c.reverse[r]
+		if !ok {
+			return b, fmt.Errorf("%w: %q", ErrUnmappableCharacter, r)
+		}
+		b = append(b, x)
+	}
+	return b, nil
+}
+
+type utf8Codepage struct{}
+
+// Invalid sequences are replaced rather than treated as an error, in the
+// same way as the single-byte codepages
+func (utf8Codepage) Decode(b []byte) (string, error) {
+	return strings.ToValidUTF8(string(b), string(utf8.RuneError)), nil
+}
+
+func (utf8Codepage) Encode(s string) ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UCS-2 is the subset of UTF-16 without surrogate pairs
+type utf16Codepage struct {
+	order binary.ByteOrder
+	ucs2  bool
+}
+
+func (c *utf16Codepage) Decode(b []byte) (string, error) {
+	if len(b)%2 != 0 {
+		return "", fmt.Errorf("%w: odd number of bytes for UTF-16", ErrUnmappableCharacter)
+	}
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = c.order.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(u)), nil
+}
+
+func (c *utf16Codepage) Encode(s string) ([]byte, error) {
+	u := utf16.Encode([]rune(s))
+	b := make([]byte, len(u)*2)
+	for i, x := range u {
+		if c.ucs2 && utf16.IsSurrogate(rune(x)) {
+			return b[:i*2], fmt.Errorf("%w: %s is outside UCS-2", ErrUnmappableCharacter, s)
+		}
+		c.order.PutUint16(b[i*2:], x)
+	}
+	return b, nil
+}
@@ -0,0 +1,534 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file builds an MQCNO from several sources of configuration, so that
+every application does not need its own rules for reading them. Each
+setting is taken from the first of these that provides it:
+
+  - the ConnConfig passed by the application
+  - environment variables: MQSERVER, MQCCDTURL, MQSSLKEYR and MQ_APPL_NAME
+  - a configuration file
+  - the MQ client configuration file, mqclient.ini
+
+The settings that say how to reach the queue manager - binding, connName, channel
+and ccdtUrl - are treated as a group. They all come from the first source that sets
+any of them, so an application that gives a CCDT does not have it mixed with a channel
+from MQSERVER. As with the MQ client, MQSERVER is used in preference to MQCCDTURL.
+
+The names of the settings in a configuration file are the json tags of the ConnConfig
+fields. A file that starts with "{" is read as JSON. Anything else is read as lines of
+"key: value", with "#" comments; a YAML file without nesting, lists or multi-line
+values is in that form.
+
+The mqclient.ini file is found in the same way as the MQ client does: the MQCLNTCF
+environment variable, then the current directory, the MQ data directory and the
+home directory. Only these settings are read from it, and only for client connections:
+
+  - CHANNELS: ServerConnectionParms, ChannelDefinitionDirectory, ChannelDefinitionFile,
+    CCDTURL and DefRecon
+  - SSL: SSLKeyRepository and CertificateLabel
+
+Everything else in the file, such as the TCP and exit settings, is still used by the MQ
+client library when it connects, but is not reported in Sources.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+/*
+ConnConfig holds the settings that can be used to build an MQCNO. Empty fields are not set.
+*/
+type ConnConfig struct {
+	QMgrName         string `json:"qmgr,omitempty"`
+	Binding          string `json:"binding,omitempty"`  // "client" or "local"
+	ConnName         string `json:"connName,omitempty"` // For example "host1(1414),host2(1414)"
+	Channel          string `json:"channel,omitempty"`
+	CCDTUrl          string `json:"ccdtUrl,omitempty"`
+	CipherSpec       string `json:"cipherSpec,omitempty"`
+	PeerName         string `json:"peerName,omitempty"`
+	KeyRepository    string `json:"keyRepository,omitempty"`
+	CertificateLabel string `json:"certificateLabel,omitempty"`
+	ApplName         string `json:"applName,omitempty"`
+	UserId           string `json:"userId,omitempty"`
+	Password         string `json:"password,omitempty"`
+	Reconnect        string `json:"reconnect,omitempty"` // One of "yes", "qmgr", "no", "default"
+}
+
+/*
+LoadedConnConfig is the result of LoadConnConfig. Sources says where each setting
+came from, using the same names as the configuration file. The values are "explicit",
+"env:" followed by the variable name, "file:" followed by the file name, or "mqclient.ini:"
+followed by the name of the MQ client configuration file.
+*/
+type LoadedConnConfig struct {
+	QMgrName string
+	CNO      *MQCNO
+	Config   ConnConfig
+	Sources  map[string]string
+}
+
+// The fields of a ConnConfig, in the order they are reported
+var connConfigFields = []struct {
+	name  string
+	field func(*ConnConfig) *string
+}{
+	{"qmgr", func(c *ConnConfig) *string { return &c.QMgrName }},
+	{"binding", func(c *ConnConfig) *string { return &c.Binding }},
+	{"connName", func(c *ConnConfig) *string { return &c.ConnName }},
+	{"channel", func(c *ConnConfig) *string { return &c.Channel }},
+	{"ccdtUrl", func(c *ConnConfig) *string { return &c.CCDTUrl }},
+	{"cipherSpec", func(c *ConnConfig) *string { return &c.CipherSpec }},
+	{"peerName", func(c *ConnConfig) *string { return &c.PeerName }},
+	{"keyRepository", func(c *ConnConfig) *string { return &c.KeyRepository }},
+	{"certificateLabel", func(c *ConnConfig) *string { return &c.CertificateLabel }},
+	{"applName", func(c *ConnConfig) *string { return &c.ApplName }},
+	{"userId", func(c *ConnConfig) *string { return &c.UserId }},
+	{"password", func(c *ConnConfig) *string { return &c.Password }},
+	{"reconnect", func(c *ConnConfig) *string { return &c.Reconnect }},
+}
+
+/*
+LoadConnConfig merges the explicit configuration, the environment, the configuration
+file and mqclient.ini, and builds an MQCNO from the result. Either explicit or configFile can be empty.
+An error is returned if the file cannot be read, or if the merged settings conflict.
+*/
+func LoadConnConfig(explicit *ConnConfig, configFile string) (*LoadedConnConfig, error) {
+	traceEntry("LoadConnConfig")
+
+	l, err := loadConnConfig(explicit, configFile, findClientIni(os.Getenv), os.Getenv)
+	if err != nil {
+		traceExitErr("LoadConnConfig", 1, err)
+		return nil, err
+	}
+
+	traceExit("LoadConnConfig")
+	return l, nil
+}
+
+func loadConnConfig(explicit *ConnConfig, configFile string, clientIni string, getenv func(string) string) (*LoadedConnConfig, error) {
+	l := &LoadedConnConfig{Sources: make(map[string]string)}
+
+	if explicit != nil {
+		l.merge(explicit, func(string) string { return "explicit" })
+	}
+
+	envConfig, envSources, err := connConfigFromEnv(getenv)
+	if err != nil {
+		return nil, err
+	}
+	l.merge(envConfig, func(name string) string { return envSources[name] })
+
+	if configFile != "" {
+		fileConfig, err := readConnConfigFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		l.merge(fileConfig, func(string) string { return "file:" + configFile })
+	}
+
+	// The MQ client's own configuration does not apply to local connections
+	if clientIni != "" && l.Config.Binding != "local" {
+		iniConfig, err := readClientIni(clientIni)
+		if err != nil {
+			return nil, err
+		}
+		l.merge(iniConfig, func(string) string { return "mqclient.ini:" + clientIni })
+	}
+
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+
+	l.QMgrName = l.Config.QMgrName
+	l.CNO = l.Config.toCNO()
+	return l, nil
+}
+
+// The settings that describe the connection, which are taken from a single source
+var connDefinitionGroup = map[string]bool{
+	"binding":  true,
+	"connName": true,
+	"channel":  true,
+	"ccdtUrl":  true,
+}
+
+// Fill in any settings that are not already set. The connection definition group is
+// only used if no earlier source has set any of it.
+func (l *LoadedConnConfig) merge(c *ConnConfig, source func(string) string) {
+	takeGroup := true
+	for name := range connDefinitionGroup {
+		if _, ok := l.Sources[name]; ok {
+			takeGroup = false
+		}
+	}
+
+	for _, f := range connConfigFields {
+		v := *f.field(c)
+		if v == "" || *f.field(&l.Config) != "" {
+			continue
+		}
+		if connDefinitionGroup[f.name] && !takeGroup {
+			continue
+		}
+		*f.field(&l.Config) = v
+		l.Sources[f.name] = source(f.name)
+	}
+}
+
+// Read the environment variables, remembering which one provided each setting
+func connConfigFromEnv(getenv func(string) string) (*ConnConfig, map[string]string, error) {
+	c := new(ConnConfig)
+	sources := make(map[string]string)
+
+	if s := getenv("MQSERVER"); s != "" {
+		// The format is CHANNEL/TRANSPORT/CONNAME
+		parts := strings.SplitN(s, "/", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, nil, fmt.Errorf("MQSERVER must be in the form CHANNEL/TCP/CONNAME")
+		}
+		if !strings.EqualFold(parts[1], "TCP") {
+			return nil, nil, fmt.Errorf("MQSERVER transport type %s is not supported", parts[1])
+		}
+		c.Channel = parts[0]
+		c.ConnName = parts[2]
+		sources["channel"] = "env:MQSERVER"
+		sources["connName"] = "env:MQSERVER"
+	}
+
+	env := []struct {
+		variable string
+		name     string
+		field    *string
+	}{
+		{"MQSSLKEYR", "keyRepository", &c.KeyRepository},
+		{"MQ_APPL_NAME", "applName", &c.ApplName},
+	}
+	for _, e := range env {
+		if s := getenv(e.variable); s != "" {
+			*e.field = s
+			sources[e.name] = "env:" + e.variable
+		}
+	}
+
+	// MQSERVER takes precedence over a CCDT, as it does for the MQ client
+	if s := getenv("MQCCDTURL"); s != "" && c.ConnName == "" {
+		c.CCDTUrl = s
+		sources["ccdtUrl"] = "env:MQCCDTURL"
+	}
+
+	return c, sources, nil
+}
+
+// The format is taken from the contents, not the file name
+func readConnConfigFile(configFile string) (*ConnConfig, error) {
+	b, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		m, err := parseKeyValueLines(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", configFile, err)
+		}
+		// Go through JSON so both file formats are checked in the same way
+		if b, err = json.Marshal(m); err != nil {
+			return nil, err
+		}
+	}
+
+	c := new(ConnConfig)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(c); err != nil {
+		return nil, fmt.Errorf("%s: %v", configFile, err)
+	}
+	return c, nil
+}
+
+// Parse "key: value" lines, ignoring blank lines and comments. Values can be quoted.
+func parseKeyValueLines(b []byte) (map[string]string, error) {
+	m := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			return nil, fmt.Errorf("line %d: nested values are not supported", lineNum)
+		}
+
+		i := strings.Index(trimmed, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("line %d: expected key: value", lineNum)
+		}
+		key := strings.TrimSpace(trimmed[:i])
+		value := strings.TrimSpace(trimmed[i+1:])
+
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') {
+			end := strings.IndexByte(value[1:], value[0])
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated string", lineNum)
+			}
+			value = value[1 : end+1]
+		} else if j := strings.Index(value, " #"); j >= 0 {
+			value = strings.TrimSpace(value[:j])
+		}
+
+		if _, ok := m[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key %s", lineNum, key)
+		}
+		m[key] = value
+	}
+	return m, scanner.Err()
+}
+
+// Find mqclient.ini in the places that the MQ client looks. An empty string
+// is returned if there isn't one.
+func findClientIni(getenv func(string) string) string {
+	if f := getenv("MQCLNTCF"); f != "" {
+		return f
+	}
+
+	dirs := []string{"."}
+	if runtime.GOOS == "windows" {
+		if d := getenv("MQ_DATA_PATH"); d != "" {
+			dirs = append(dirs, d)
+		}
+	} else {
+		dirs = append(dirs, "/var/mqm")
+	}
+	if d, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, d)
+	}
+
+	for _, d := range dirs {
+		f := filepath.Join(d, "mqclient.ini")
+		if _, err := os.Stat(f); err == nil {
+			return f
+		}
+	}
+	return ""
+}
+
+// Values for the DefRecon attribute. NO leaves the choice to the application.
+var clientIniReconnect = map[string]string{
+	"YES":      "yes",
+	"QMGR":     "qmgr",
+	"DISABLED": "no",
+}
+
+// Read the settings that we understand from the CHANNELS and SSL stanzas
+func readClientIni(file string) (*ConnConfig, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	stanzas, err := parseClientIni(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+
+	c := new(ConnConfig)
+	channels := stanzas["CHANNELS"]
+	if s := channels["SERVERCONNECTIONPARMS"]; s != "" {
+		parts := strings.SplitN(s, "/", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" || !strings.EqualFold(parts[1], "TCP") {
+			return nil, fmt.Errorf("%s: ServerConnectionParms must be in the form CHANNEL/TCP/CONNAME", file)
+		}
+		c.Channel = parts[0]
+		c.ConnName = parts[2]
+	} else if s := channels["CCDTURL"]; s != "" {
+		c.CCDTUrl = s
+	} else if dir, tab := channels["CHANNELDEFINITIONDIRECTORY"], channels["CHANNELDEFINITIONFILE"]; dir != "" || tab != "" {
+		if tab == "" {
+			tab = "AMQCLCHL.TAB"
+		}
+		c.CCDTUrl = "file://" + filepath.ToSlash(filepath.Join(dir, tab))
+	}
+	if s := channels["DEFRECON"]; s != "" {
+		c.Reconnect = clientIniReconnect[strings.ToUpper(s)]
+	}
+
+	ssl := stanzas["SSL"]
+	c.KeyRepository = ssl["SSLKEYREPOSITORY"]
+	c.CertificateLabel = ssl["CERTIFICATELABEL"]
+	return c, nil
+}
+
+// Parse the stanzas of an MQ ini file. A stanza starts with "NAME:" and
+// is followed by indented "Key=Value" lines. Names and keys are not case-sensitive,
+// so they are returned in upper case.
+func parseClientIni(b []byte) (map[string]map[string]string, error) {
+	stanzas := make(map[string]map[string]string)
+	var current map[string]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		if strings.HasSuffix(line, ":") {
+			name := strings.ToUpper(strings.TrimSpace(strings.TrimSuffix(line, ":")))
+			if stanzas[name] == nil {
+				stanzas[name] = make(map[string]string)
+			}
+			current = stanzas[name]
+			continue
+		}
+
+		i := strings.Index(line, "=")
+		if i <= 0 || current == nil {
+			return nil, fmt.Errorf("line %d: expected Key=Value in a stanza", lineNum)
+		}
+		current[strings.ToUpper(strings.TrimSpace(line[:i]))] = strings.TrimSpace(line[i+1:])
+	}
+	return stanzas, scanner.Err()
+}
+
+// Check for combinations of settings that cannot work
+func (l *LoadedConnConfig) validate() error {
+	c := &l.Config
+	conflict := func(a, b string) error {
+		return fmt.Errorf("%s (from %s) cannot be used with %s (from %s)", a, l.Sources[a], b, l.Sources[b])
+	}
+
+	switch c.Binding {
+	case "", "client":
+	case "local":
+		for _, name := range []string{"connName", "channel", "ccdtUrl", "cipherSpec", "peerName", "keyRepository", "certificateLabel"} {
+			if _, ok := l.Sources[name]; ok {
+				return conflict("binding", name)
+			}
+		}
+		// A name starting with "*" selects a group of queue managers from a CCDT
+		if strings.HasPrefix(c.QMgrName, "*") {
+			return conflict("binding", "qmgr")
+		}
+	default:
+		return fmt.Errorf("binding (from %s) must be client or local", l.Sources["binding"])
+	}
+
+	if c.ConnName != "" && c.CCDTUrl != "" {
+		return conflict("connName", "ccdtUrl")
+	}
+	// The CCDT has its own TLS attributes for each channel, and the MQCD fields
+	// would not be used
+	if c.CCDTUrl != "" {
+		for _, name := range []string{"cipherSpec", "peerName"} {
+			if _, ok := l.Sources[name]; ok {
+				return conflict(name, "ccdtUrl")
+			}
+		}
+	}
+	if c.ConnName != "" && c.Channel == "" {
+		return fmt.Errorf("connName (from %s) needs a channel", l.Sources["connName"])
+	}
+	if c.Channel != "" && c.ConnName == "" {
+		return fmt.Errorf("channel (from %s) needs a connName", l.Sources["channel"])
+	}
+	if c.Password != "" && c.UserId == "" {
+		return fmt.Errorf("password (from %s) needs a userId", l.Sources["password"])
+	}
+	if c.Reconnect != "" {
+		if _, ok := connStringReconnect[c.Reconnect]; !ok {
+			return fmt.Errorf("reconnect (from %s) must be one of yes, qmgr, no, default", l.Sources["reconnect"])
+		}
+	}
+	return nil
+}
+
+func (c *ConnConfig) toCNO() *MQCNO {
+	cno := NewMQCNO()
+
+	if c.Binding == "local" {
+		cno.Options |= MQCNO_LOCAL_BINDING
+	} else if c.Binding == "client" || c.ConnName != "" || c.CCDTUrl != "" {
+		cno.Options |= MQCNO_CLIENT_BINDING
+	}
+	if c.Reconnect != "" {
+		cno.Options |= connStringReconnect[c.Reconnect]
+	}
+
+	if c.ConnName != "" {
+		cd := NewMQCD()
+		cd.ConnectionName = c.ConnName
+		cd.ChannelName = c.Channel
+		cd.SSLCipherSpec = c.CipherSpec
+		cd.SSLPeerName = c.PeerName
+		cno.ClientConn = cd
+	}
+	cno.CCDTUrl = c.CCDTUrl
+	cno.ApplName = c.ApplName
+
+	if c.KeyRepository != "" || c.CertificateLabel != "" {
+		sco := NewMQSCO()
+		sco.KeyRepository = c.KeyRepository
+		sco.CertificateLabel = c.CertificateLabel
+		cno.SSLConfig = sco
+	}
+
+	if c.UserId != "" {
+		csp := NewMQCSP()
+		csp.AuthenticationType = MQCSP_AUTH_USER_ID_AND_PWD
+		csp.UserId = c.UserId
+		csp.Password = c.Password
+		cno.SecurityParms = csp
+	}
+
+	return cno
+}
+
+/*
+String lists the settings and where they came from. Passwords are not shown.
+*/
+func (l *LoadedConnConfig) String() string {
+	var lines []string
+	for _, f := range connConfigFields {
+		source, ok := l.Sources[f.name]
+		if !ok {
+			continue
+		}
+		v := *f.field(&l.Config)
+		if f.name == "password" {
+			v = connStringSecret
+		}
+		lines = append(lines, fmt.Sprintf("%s=%s (%s)", f.name, v, source))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,435 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file converts between a URL-style connection string and the MQCNO structure
+with its MQCD, MQSCO and MQCSP substructures. The format is
+
+	mq://[user[:password]@]host(port)[,host(port)...][/qmgr][?param=value&...]
+
+A host can also be given as host:port, or as [address]:port for an IPv6 address. With
+no hosts, and no ccdt parameter, a local bindings connection is made. The parameters are:
+
+	channel          Channel name. Required if hosts are given without a ccdt
+	ccdt             URL or file name of a CCDT
+	cipher           TLS CipherSpec such as ANY_TLS13
+	peername         SSLPeerName for the channel
+	certlabel        Certificate label
+	keyrepo          Key repository
+	keyrepopassword  Key repository password
+	password         Password for the user, instead of putting it in the userinfo
+	token            Authentication token, instead of a user and password
+	applname         Application name
+	heartbeat        Heartbeat interval in seconds
+	sharecnv         Sharing conversations
+	reconnect        One of yes, qmgr, no, default
+
+Values are URL-decoded, so characters such as "&", "+" and "%" in a value must be
+escaped. A password in the userinfo section is also URL-decoded.
+
+When both hosts and a ccdt are given, the CCDT must be a local JSON file. It is read
+during parsing, and the channel definition for the queue manager (or the one named by the
+channel parameter) supplies the channel attributes. The hosts replace its connection
+names, and the cipher, peername, heartbeat and sharecnv parameters replace the
+corresponding attributes. The result is a complete MQCD, so MQ does not read the CCDT again.
+*/
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	connStringScheme = "mq://"
+	connStringSecret = "REDACTED"
+)
+
+/*
+ParseConnString converts a connection string into a queue manager name and an MQCNO
+that can be passed directly to Connx
+*/
+func ParseConnString(connString string) (string, *MQCNO, error) {
+	traceEntry("ParseConnString")
+
+	qMgrName, cno, err := parseConnString(connString)
+	if err != nil {
+		traceExitErr("ParseConnString", 1, err)
+		return "", nil, err
+	}
+
+	traceExit("ParseConnString")
+	return qMgrName, cno, nil
+}
+
+func parseConnString(connString string) (string, *MQCNO, error) {
+	if !strings.HasPrefix(strings.ToLower(connString), connStringScheme) {
+		return "", nil, fmt.Errorf("connection string must start with %s", connStringScheme)
+	}
+	rest := connString[len(connStringScheme):]
+
+	rawQuery := ""
+	if i := strings.Index(rest, "?"); i >= 0 {
+		rawQuery = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	qMgrName := ""
+	if i := strings.Index(rest, "/"); i >= 0 {
+		name, err := url.PathUnescape(rest[i+1:])
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid queue manager name: %v", err)
+		}
+		qMgrName = name
+		rest = rest[:i]
+	}
+
+	userInfo := ""
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		userInfo = rest[:i]
+		rest = rest[i+1:]
+	}
+
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid parameters: %v", err)
+	}
+
+	cno := NewMQCNO()
+	csp := NewMQCSP()
+	var sco *MQSCO
+	var cd *MQCD
+
+	if rest != "" {
+		var hosts []string
+		for _, h := range strings.Split(rest, ",") {
+			connName, err := connStringHost(h)
+			if err != nil {
+				return "", nil, err
+			}
+			hosts = append(hosts, connName)
+		}
+		cd = NewMQCD()
+		cd.ConnectionName = strings.Join(hosts, ",")
+	}
+
+	if userInfo != "" {
+		user := userInfo
+		password := ""
+		if i := strings.Index(userInfo, ":"); i >= 0 {
+			user = userInfo[:i]
+			password = userInfo[i+1:]
+		}
+		if csp.UserId, err = url.PathUnescape(user); err == nil {
+			csp.Password, err = url.PathUnescape(password)
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid user information: %v", err)
+		}
+		csp.AuthenticationType = MQCSP_AUTH_USER_ID_AND_PWD
+	}
+
+	getSCO := func() *MQSCO {
+		if sco == nil {
+			sco = NewMQSCO()
+		}
+		return sco
+	}
+
+	explicit := make(map[string]bool)
+	for key, values := range params {
+		if len(values) != 1 {
+			return "", nil, fmt.Errorf("parameter %s must be given exactly once", key)
+		}
+		value := values[0]
+		explicit[strings.ToLower(key)] = true
+
+		switch strings.ToLower(key) {
+		case "channel":
+			if cd == nil {
+				return "", nil, fmt.Errorf("channel parameter needs a host")
+			}
+			cd.ChannelName = value
+		case "ccdt":
+			cno.CCDTUrl = value
+		case "cipher":
+			if cd == nil {
+				return "", nil, fmt.Errorf("cipher parameter needs a host")
+			}
+			cd.SSLCipherSpec = value
+		case "peername":
+			if cd == nil {
+				return "", nil, fmt.Errorf("peername parameter needs a host")
+			}
+			cd.SSLPeerName = value
+		case "certlabel":
+			getSCO().CertificateLabel = value
+		case "keyrepo":
+			getSCO().KeyRepository = value
+		case "keyrepopassword":
+			getSCO().KeyRepoPassword = value
+		case "password":
+			csp.Password = value
+		case "token":
+			csp.Token = value
+			csp.AuthenticationType = MQCSP_AUTH_ID_TOKEN
+		case "applname":
+			cno.ApplName = value
+		case "heartbeat", "sharecnv":
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || n < 0 {
+				return "", nil, fmt.Errorf("parameter %s must be a non-negative number", key)
+			}
+			if cd == nil {
+				return "", nil, fmt.Errorf("%s parameter needs a host", key)
+			}
+			if strings.ToLower(key) == "heartbeat" {
+				cd.HeartbeatInterval = int32(n)
+			} else {
+				cd.SharingConversations = int32(n)
+			}
+		case "reconnect":
+			opt, ok := connStringReconnect[strings.ToLower(value)]
+			if !ok {
+				return "", nil, fmt.Errorf("invalid reconnect value %s", value)
+			}
+			cno.Options |= opt
+		default:
+			return "", nil, fmt.Errorf("unknown parameter %s", key)
+		}
+	}
+
+	if cd != nil && cno.CCDTUrl != "" {
+		cd, err = connStringCCDTChannel(cno.CCDTUrl, qMgrName, cd, explicit)
+		if err != nil {
+			return "", nil, err
+		}
+		cno.CCDTUrl = ""
+	}
+	if cd != nil && cd.ChannelName == "" {
+		return "", nil, fmt.Errorf("channel parameter is required when hosts are given")
+	}
+	if csp.Token != "" && csp.UserId != "" {
+		return "", nil, fmt.Errorf("a token cannot be used with a user")
+	}
+	if csp.Password != "" && csp.UserId == "" {
+		return "", nil, fmt.Errorf("a password needs a user")
+	}
+
+	if cd != nil || cno.CCDTUrl != "" {
+		cno.Options |= MQCNO_CLIENT_BINDING
+	}
+	cno.ClientConn = cd
+	cno.SSLConfig = sco
+	if csp.AuthenticationType != MQCSP_AUTH_NONE {
+		cno.SecurityParms = csp
+	}
+
+	return qMgrName, cno, nil
+}
+
+var connStringReconnect = map[string]int32{
+	"yes":     MQCNO_RECONNECT,
+	"qmgr":    MQCNO_RECONNECT_Q_MGR,
+	"no":      MQCNO_RECONNECT_DISABLED,
+	"default": MQCNO_RECONNECT_AS_DEF,
+}
+
+// Build the MQCD from the CCDT channel definition, with the hosts from the connection
+// string as its CONNAME. The cd has the values from the connection string, and
+// explicit says which parameters were given.
+func connStringCCDTChannel(ccdtUrl string, qMgrName string, cd *MQCD, explicit map[string]bool) (*MQCD, error) {
+	file := ccdtUrl
+	if strings.HasPrefix(strings.ToLower(file), "file://") {
+		file = file[len("file://"):]
+	} else if strings.Contains(file, "://") {
+		return nil, fmt.Errorf("ccdt parameter must be a local file when hosts are given")
+	}
+
+	ccdt, err := LoadCCDT(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read ccdt: %v", err)
+	}
+	ch, err := ccdtChannelFor(ccdt, cd.ChannelName, qMgrName)
+	if err != nil {
+		return nil, err
+	}
+
+	newcd := ch.ToMQCD()
+	newcd.ConnectionName = cd.ConnectionName
+	if explicit["cipher"] {
+		newcd.SSLCipherSpec = cd.SSLCipherSpec
+	}
+	if explicit["peername"] {
+		newcd.SSLPeerName = cd.SSLPeerName
+	}
+	if explicit["heartbeat"] {
+		newcd.HeartbeatInterval = cd.HeartbeatInterval
+	}
+	if explicit["sharecnv"] {
+		newcd.SharingConversations = cd.SharingConversations
+	}
+	return newcd, nil
+}
+
+// Find the channel in a CCDT. MQ's rules for choosing between several matching
+// channels, such as weighting and affinity, are not repeated here so the
+// choice must not be ambiguous. An empty channel name matches any channel.
+func ccdtChannelFor(ccdt *CCDT, channel string, qMgrName string) (*CCDTChannel, error) {
+	var found *CCDTChannel
+
+	for i := range ccdt.Channel {
+		ch := &ccdt.Channel[i]
+		if channel != "" && ch.Name != channel {
+			continue
+		}
+		if qMgrName != "" && ch.ClientConnection.QueueManager != "" && ch.ClientConnection.QueueManager != qMgrName {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("ccdt has more than one matching channel. Use the channel parameter to choose one")
+		}
+		found = ch
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("ccdt has no matching channel")
+	}
+	return found, nil
+}
+
+// Accept the MQ "host(port)" style, "host:port" or "[address]:port", returning the MQ style
+func connStringHost(h string) (string, error) {
+	h = strings.TrimSpace(h)
+	if h == "" {
+		return "", fmt.Errorf("empty host in connection string")
+	}
+	if strings.HasPrefix(h, "[") {
+		i := strings.Index(h, "]")
+		if i < 0 {
+			return "", fmt.Errorf("invalid host %s", h)
+		}
+		addr := h[1:i]
+		rest := h[i+1:]
+		if rest == "" {
+			return addr, nil
+		}
+		port := strings.TrimPrefix(rest, ":")
+		if _, err := strconv.Atoi(port); err != nil || port == rest {
+			return "", fmt.Errorf("invalid port in host %s", h)
+		}
+		return addr + "(" + port + ")", nil
+	}
+	if strings.Contains(h, "(") {
+		if !strings.HasSuffix(h, ")") {
+			return "", fmt.Errorf("invalid host %s", h)
+		}
+		return h, nil
+	}
+	// Only convert host:port when there is a single colon, so IPv6 addresses are left alone
+	if i := strings.LastIndex(h, ":"); i >= 0 && strings.Count(h, ":") == 1 {
+		port := h[i+1:]
+		if _, err := strconv.Atoi(port); err != nil {
+			return "", fmt.Errorf("invalid port in host %s", h)
+		}
+		return h[:i] + "(" + port + ")", nil
+	}
+	return h, nil
+}
+
+/*
+FormatConnString is the inverse of ParseConnString, for logging the configuration
+that a connection uses. Passwords and tokens are replaced by "REDACTED", so the
+result cannot always be parsed back into a usable MQCNO. Fields that cannot be
+expressed in a connection string are not shown.
+*/
+func FormatConnString(qMgrName string, cno *MQCNO) string {
+	var sb strings.Builder
+	params := url.Values{}
+
+	sb.WriteString(connStringScheme)
+
+	if cno != nil {
+		if csp := cno.SecurityParms; csp != nil {
+			if csp.UserId != "" {
+				sb.WriteString(url.PathEscape(csp.UserId))
+				if csp.Password != "" {
+					sb.WriteString(":" + connStringSecret)
+				}
+				sb.WriteString("@")
+			}
+			if csp.Token != "" {
+				params.Set("token", connStringSecret)
+			}
+		}
+
+		if cd := cno.ClientConn; cd != nil {
+			sb.WriteString(cd.ConnectionName)
+			params.Set("channel", cd.ChannelName)
+			if cd.SSLCipherSpec != "" {
+				params.Set("cipher", cd.SSLCipherSpec)
+			}
+			if cd.SSLPeerName != "" {
+				params.Set("peername", cd.SSLPeerName)
+			}
+			def := NewMQCD()
+			if cd.HeartbeatInterval != def.HeartbeatInterval {
+				params.Set("heartbeat", strconv.Itoa(int(cd.HeartbeatInterval)))
+			}
+			if cd.SharingConversations != def.SharingConversations {
+				params.Set("sharecnv", strconv.Itoa(int(cd.SharingConversations)))
+			}
+		}
+
+		if sco := cno.SSLConfig; sco != nil {
+			if sco.KeyRepository != "" {
+				params.Set("keyrepo", sco.KeyRepository)
+			}
+			if sco.KeyRepoPassword != "" {
+				params.Set("keyrepopassword", connStringSecret)
+			}
+			if sco.CertificateLabel != "" {
+				params.Set("certlabel", sco.CertificateLabel)
+			}
+		}
+
+		if cno.CCDTUrl != "" {
+			params.Set("ccdt", cno.CCDTUrl)
+		}
+		if cno.ApplName != "" {
+			params.Set("applname", cno.ApplName)
+		}
+		for name, opt := range connStringReconnect {
+			if opt != MQCNO_RECONNECT_AS_DEF && cno.Options&opt != 0 {
+				params.Set("reconnect", name)
+			}
+		}
+	}
+
+	if qMgrName != "" {
+		sb.WriteString("/" + url.PathEscape(qMgrName))
+	}
+	if len(params) > 0 {
+		sb.WriteString("?" + params.Encode())
+	}
+	return sb.String()
+}
@@ -0,0 +1,5260 @@
+// Code generated by mqiconstants_gen.go from the cmqc files. DO NOT EDIT.
+
+package ibmmq
+
+/*
+****************************************************************
+*
+*
+*                     IBM MQ for Go on all platforms
+* FILE NAME:      mqiconstants.go
+*
+* This file lists the names of the integer MQI definitions, so
+* that they can be looked up from strings. The values come from
+* the platform-specific cmqc_*.go files.
+****************************************************************
+* Copyright (c) IBM Corporation 2026
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+****************************************************************
+*
+ */
+
+//go:generate go run mqiconstants_gen.go
+
+type mqiConstant struct {
+	name  string
+	value int32
+}
+
+// In the same order as the cmqc definitions
+var mqiConstants = []mqiConstant{
+	{"MQACH_CURRENT_LENGTH", MQACH_CURRENT_LENGTH},
+	{"MQACH_CURRENT_VERSION", MQACH_CURRENT_VERSION},
+	{"MQACH_LENGTH_1", MQACH_LENGTH_1},
+	{"MQACH_VERSION_1", MQACH_VERSION_1},
+	{"MQACTIVE_NO", MQACTIVE_NO},
+	{"MQACTIVE_YES", MQACTIVE_YES},
+	{"MQACTP_FORWARD", MQACTP_FORWARD},
+	{"MQACTP_NEW", MQACTP_NEW},
+	{"MQACTP_REPLY", MQACTP_REPLY},
+	{"MQACTP_REPORT", MQACTP_REPORT},
+	{"MQACTV_DETAIL_HIGH", MQACTV_DETAIL_HIGH},
+	{"MQACTV_DETAIL_LOW", MQACTV_DETAIL_LOW},
+	{"MQACTV_DETAIL_MEDIUM", MQACTV_DETAIL_MEDIUM},
+	{"MQACT_ADD", MQACT_ADD},
+	{"MQACT_ADVANCE_LOG", MQACT_ADVANCE_LOG},
+	{"MQACT_ARCHIVE_LOG", MQACT_ARCHIVE_LOG},
+	{"MQACT_COLLECT_STATISTICS", MQACT_COLLECT_STATISTICS},
+	{"MQACT_FAIL", MQACT_FAIL},
+	{"MQACT_FORCE_REMOVE", MQACT_FORCE_REMOVE},
+	{"MQACT_PUBSUB", MQACT_PUBSUB},
+	{"MQACT_REDUCE_LOG", MQACT_REDUCE_LOG},
+	{"MQACT_REMOVE", MQACT_REMOVE},
+	{"MQACT_REMOVEALL", MQACT_REMOVEALL},
+	{"MQACT_REPLACE", MQACT_REPLACE},
+	{"MQADOPT_CHECK_ALL", MQADOPT_CHECK_ALL},
+	{"MQADOPT_CHECK_CHANNEL_NAME", MQADOPT_CHECK_CHANNEL_NAME},
+	{"MQADOPT_CHECK_NET_ADDR", MQADOPT_CHECK_NET_ADDR},
+	{"MQADOPT_CHECK_NONE", MQADOPT_CHECK_NONE},
+	{"MQADOPT_CHECK_Q_MGR_NAME", MQADOPT_CHECK_Q_MGR_NAME},
+	{"MQADOPT_TYPE_ALL", MQADOPT_TYPE_ALL},
+	{"MQADOPT_TYPE_CLUSRCVR", MQADOPT_TYPE_CLUSRCVR},
+	{"MQADOPT_TYPE_NO", MQADOPT_TYPE_NO},
+	{"MQADOPT_TYPE_RCVR", MQADOPT_TYPE_RCVR},
+	{"MQADOPT_TYPE_SDR", MQADOPT_TYPE_SDR},
+	{"MQADOPT_TYPE_SVR", MQADOPT_TYPE_SVR},
+	{"MQADPCTX_NO", MQADPCTX_NO},
+	{"MQADPCTX_YES", MQADPCTX_YES},
+	{"MQAIR_CURRENT_LENGTH", MQAIR_CURRENT_LENGTH},
+	{"MQAIR_CURRENT_VERSION", MQAIR_CURRENT_VERSION},
+	{"MQAIR_LENGTH_1", MQAIR_LENGTH_1},
+	{"MQAIR_LENGTH_2", MQAIR_LENGTH_2},
+	{"MQAIR_VERSION_1", MQAIR_VERSION_1},
+	{"MQAIR_VERSION_2", MQAIR_VERSION_2},
+	{"MQAIT_ALL", MQAIT_ALL},
+	{"MQAIT_CRL_LDAP", MQAIT_CRL_LDAP},
+	{"MQAIT_IDPW_LDAP", MQAIT_IDPW_LDAP},
+	{"MQAIT_IDPW_OS", MQAIT_IDPW_OS},
+	{"MQAIT_OCSP", MQAIT_OCSP},
+	{"MQAPPL_IMMOVABLE", MQAPPL_IMMOVABLE},
+	{"MQAPPL_MOVABLE", MQAPPL_MOVABLE},
+	{"MQAS_ACTIVE", MQAS_ACTIVE},
+	{"MQAS_INACTIVE", MQAS_INACTIVE},
+	{"MQAS_NONE", MQAS_NONE},
+	{"MQAS_STARTED", MQAS_STARTED},
+	{"MQAS_START_WAIT", MQAS_START_WAIT},
+	{"MQAS_STOPPED", MQAS_STOPPED},
+	{"MQAS_SUSPENDED", MQAS_SUSPENDED},
+	{"MQAS_SUSPENDED_TEMPORARY", MQAS_SUSPENDED_TEMPORARY},
+	{"MQAT_AIX", MQAT_AIX},
+	{"MQAT_AMQP", MQAT_AMQP},
+	{"MQAT_BATCH", MQAT_BATCH},
+	{"MQAT_BROKER", MQAT_BROKER},
+	{"MQAT_CHANNEL_INITIATOR", MQAT_CHANNEL_INITIATOR},
+	{"MQAT_CICS", MQAT_CICS},
+	{"MQAT_CICS_BRIDGE", MQAT_CICS_BRIDGE},
+	{"MQAT_CICS_VSE", MQAT_CICS_VSE},
+	{"MQAT_DEFAULT", MQAT_DEFAULT},
+	{"MQAT_DOS", MQAT_DOS},
+	{"MQAT_DQM", MQAT_DQM},
+	{"MQAT_GUARDIAN", MQAT_GUARDIAN},
+	{"MQAT_IMS", MQAT_IMS},
+	{"MQAT_IMS_BRIDGE", MQAT_IMS_BRIDGE},
+	{"MQAT_JAVA", MQAT_JAVA},
+	{"MQAT_MCAST_PUBLISH", MQAT_MCAST_PUBLISH},
+	{"MQAT_MVS", MQAT_MVS},
+	{"MQAT_NOTES_AGENT", MQAT_NOTES_AGENT},
+	{"MQAT_NO_CONTEXT", MQAT_NO_CONTEXT},
+	{"MQAT_NSK", MQAT_NSK},
+	{"MQAT_OPEN_TP1", MQAT_OPEN_TP1},
+	{"MQAT_OS2", MQAT_OS2},
+	{"MQAT_OS390", MQAT_OS390},
+	{"MQAT_OS400", MQAT_OS400},
+	{"MQAT_QMGR", MQAT_QMGR},
+	{"MQAT_QMGR_PUBLISH", MQAT_QMGR_PUBLISH},
+	{"MQAT_RRS_BATCH", MQAT_RRS_BATCH},
+	{"MQAT_SIB", MQAT_SIB},
+	{"MQAT_SYSTEM_EXTENSION", MQAT_SYSTEM_EXTENSION},
+	{"MQAT_TPF", MQAT_TPF},
+	{"MQAT_UNIX", MQAT_UNIX},
+	{"MQAT_UNKNOWN", MQAT_UNKNOWN},
+	{"MQAT_USER", MQAT_USER},
+	{"MQAT_USER_FIRST", MQAT_USER_FIRST},
+	{"MQAT_USER_LAST", MQAT_USER_LAST},
+	{"MQAT_VM", MQAT_VM},
+	{"MQAT_VMS", MQAT_VMS},
+	{"MQAT_VOS", MQAT_VOS},
+	{"MQAT_WINDOWS", MQAT_WINDOWS},
+	{"MQAT_WINDOWS_NT", MQAT_WINDOWS_NT},
+	{"MQAT_WLM", MQAT_WLM},
+	{"MQAT_XCF", MQAT_XCF},
+	{"MQAT_ZOS", MQAT_ZOS},
+	{"MQAUSC_ALLCHECKS", MQAUSC_ALLCHECKS},
+	{"MQAUSC_ALLCONNS", MQAUSC_ALLCONNS},
+	{"MQAUSC_FAILURES", MQAUSC_FAILURES},
+	{"MQAUTHENTICATE_OS", MQAUTHENTICATE_OS},
+	{"MQAUTHENTICATE_PAM", MQAUTHENTICATE_PAM},
+	{"MQAUTHOPT_CUMULATIVE", MQAUTHOPT_CUMULATIVE},
+	{"MQAUTHOPT_ENTITY_EXPLICIT", MQAUTHOPT_ENTITY_EXPLICIT},
+	{"MQAUTHOPT_ENTITY_SET", MQAUTHOPT_ENTITY_SET},
+	{"MQAUTHOPT_EXCLUDE_TEMP", MQAUTHOPT_EXCLUDE_TEMP},
+	{"MQAUTHOPT_NAME_ALL_MATCHING", MQAUTHOPT_NAME_ALL_MATCHING},
+	{"MQAUTHOPT_NAME_AS_WILDCARD", MQAUTHOPT_NAME_AS_WILDCARD},
+	{"MQAUTHOPT_NAME_EXPLICIT", MQAUTHOPT_NAME_EXPLICIT},
+	{"MQAUTH_ALL", MQAUTH_ALL},
+	{"MQAUTH_ALL_ADMIN", MQAUTH_ALL_ADMIN},
+	{"MQAUTH_ALL_MQI", MQAUTH_ALL_MQI},
+	{"MQAUTH_ALT_USER_AUTHORITY", MQAUTH_ALT_USER_AUTHORITY},
+	{"MQAUTH_BROWSE", MQAUTH_BROWSE},
+	{"MQAUTH_CHANGE", MQAUTH_CHANGE},
+	{"MQAUTH_CLEAR", MQAUTH_CLEAR},
+	{"MQAUTH_CONNECT", MQAUTH_CONNECT},
+	{"MQAUTH_CONTROL", MQAUTH_CONTROL},
+	{"MQAUTH_CONTROL_EXTENDED", MQAUTH_CONTROL_EXTENDED},
+	{"MQAUTH_CREATE", MQAUTH_CREATE},
+	{"MQAUTH_DELETE", MQAUTH_DELETE},
+	{"MQAUTH_DISPLAY", MQAUTH_DISPLAY},
+	{"MQAUTH_INPUT", MQAUTH_INPUT},
+	{"MQAUTH_INQUIRE", MQAUTH_INQUIRE},
+	{"MQAUTH_NONE", MQAUTH_NONE},
+	{"MQAUTH_OUTPUT", MQAUTH_OUTPUT},
+	{"MQAUTH_PASS_ALL_CONTEXT", MQAUTH_PASS_ALL_CONTEXT},
+	{"MQAUTH_PASS_IDENTITY_CONTEXT", MQAUTH_PASS_IDENTITY_CONTEXT},
+	{"MQAUTH_PUBLISH", MQAUTH_PUBLISH},
+	{"MQAUTH_RESUME", MQAUTH_RESUME},
+	{"MQAUTH_SET", MQAUTH_SET},
+	{"MQAUTH_SET_ALL_CONTEXT", MQAUTH_SET_ALL_CONTEXT},
+	{"MQAUTH_SET_IDENTITY_CONTEXT", MQAUTH_SET_IDENTITY_CONTEXT},
+	{"MQAUTH_SUBSCRIBE", MQAUTH_SUBSCRIBE},
+	{"MQAUTH_SYSTEM", MQAUTH_SYSTEM},
+	{"MQAUTOCLUS_TYPE_NONE", MQAUTOCLUS_TYPE_NONE},
+	{"MQAUTOCLUS_TYPE_UNIFORM", MQAUTOCLUS_TYPE_UNIFORM},
+	{"MQAUTO_START_NO", MQAUTO_START_NO},
+	{"MQAUTO_START_YES", MQAUTO_START_YES},
+	{"MQAXC_CURRENT_LENGTH", MQAXC_CURRENT_LENGTH},
+	{"MQAXC_CURRENT_VERSION", MQAXC_CURRENT_VERSION},
+	{"MQAXC_LENGTH_1", MQAXC_LENGTH_1},
+	{"MQAXC_LENGTH_2", MQAXC_LENGTH_2},
+	{"MQAXC_VERSION_1", MQAXC_VERSION_1},
+	{"MQAXC_VERSION_2", MQAXC_VERSION_2},
+	{"MQAXP_CURRENT_LENGTH", MQAXP_CURRENT_LENGTH},
+	{"MQAXP_CURRENT_VERSION", MQAXP_CURRENT_VERSION},
+	{"MQAXP_LENGTH_1", MQAXP_LENGTH_1},
+	{"MQAXP_VERSION_1", MQAXP_VERSION_1},
+	{"MQAXP_VERSION_2", MQAXP_VERSION_2},
+	{"MQBACF_ACCOUNTING_TOKEN", MQBACF_ACCOUNTING_TOKEN},
+	{"MQBACF_ALTERNATE_SECURITYID", MQBACF_ALTERNATE_SECURITYID},
+	{"MQBACF_CF_LEID", MQBACF_CF_LEID},
+	{"MQBACF_CONNECTION_ID", MQBACF_CONNECTION_ID},
+	{"MQBACF_CONN_TAG", MQBACF_CONN_TAG},
+	{"MQBACF_CORREL_ID", MQBACF_CORREL_ID},
+	{"MQBACF_DESTINATION_CORREL_ID", MQBACF_DESTINATION_CORREL_ID},
+	{"MQBACF_EVENT_ACCOUNTING_TOKEN", MQBACF_EVENT_ACCOUNTING_TOKEN},
+	{"MQBACF_EVENT_SECURITY_ID", MQBACF_EVENT_SECURITY_ID},
+	{"MQBACF_EXTERNAL_UOW_ID", MQBACF_EXTERNAL_UOW_ID},
+	{"MQBACF_FIRST", MQBACF_FIRST},
+	{"MQBACF_GENERIC_CONNECTION_ID", MQBACF_GENERIC_CONNECTION_ID},
+	{"MQBACF_GROUP_ID", MQBACF_GROUP_ID},
+	{"MQBACF_LAST_USED", MQBACF_LAST_USED},
+	{"MQBACF_MESSAGE_DATA", MQBACF_MESSAGE_DATA},
+	{"MQBACF_MQBNO_STRUCT", MQBACF_MQBNO_STRUCT},
+	{"MQBACF_MQBO_STRUCT", MQBACF_MQBO_STRUCT},
+	{"MQBACF_MQCBC_STRUCT", MQBACF_MQCBC_STRUCT},
+	{"MQBACF_MQCBD_STRUCT", MQBACF_MQCBD_STRUCT},
+	{"MQBACF_MQCB_FUNCTION", MQBACF_MQCB_FUNCTION},
+	{"MQBACF_MQCD_STRUCT", MQBACF_MQCD_STRUCT},
+	{"MQBACF_MQCNO_STRUCT", MQBACF_MQCNO_STRUCT},
+	{"MQBACF_MQGMO_STRUCT", MQBACF_MQGMO_STRUCT},
+	{"MQBACF_MQMD_STRUCT", MQBACF_MQMD_STRUCT},
+	{"MQBACF_MQPMO_STRUCT", MQBACF_MQPMO_STRUCT},
+	{"MQBACF_MQSD_STRUCT", MQBACF_MQSD_STRUCT},
+	{"MQBACF_MQSTS_STRUCT", MQBACF_MQSTS_STRUCT},
+	{"MQBACF_MSG_ID", MQBACF_MSG_ID},
+	{"MQBACF_ORIGIN_UOW_ID", MQBACF_ORIGIN_UOW_ID},
+	{"MQBACF_PROPERTIES_DATA", MQBACF_PROPERTIES_DATA},
+	{"MQBACF_Q_MGR_UOW_ID", MQBACF_Q_MGR_UOW_ID},
+	{"MQBACF_REQUEST_ID", MQBACF_REQUEST_ID},
+	{"MQBACF_RESPONSE_ID", MQBACF_RESPONSE_ID},
+	{"MQBACF_RESPONSE_SET", MQBACF_RESPONSE_SET},
+	{"MQBACF_SUB_CORREL_ID", MQBACF_SUB_CORREL_ID},
+	{"MQBACF_SUB_ID", MQBACF_SUB_ID},
+	{"MQBACF_XA_XID", MQBACF_XA_XID},
+	{"MQBACF_XQH_CORREL_ID", MQBACF_XQH_CORREL_ID},
+	{"MQBACF_XQH_MSG_ID", MQBACF_XQH_MSG_ID},
+	{"MQBALANCED_NO", MQBALANCED_NO},
+	{"MQBALANCED_NOT_APPLICABLE", MQBALANCED_NOT_APPLICABLE},
+	{"MQBALANCED_UNKNOWN", MQBALANCED_UNKNOWN},
+	{"MQBALANCED_YES", MQBALANCED_YES},
+	{"MQBALSTATE_HIGH", MQBALSTATE_HIGH},
+	{"MQBALSTATE_LOW", MQBALSTATE_LOW},
+	{"MQBALSTATE_NOT_APPLICABLE", MQBALSTATE_NOT_APPLICABLE},
+	{"MQBALSTATE_OK", MQBALSTATE_OK},
+	{"MQBALSTATE_UNKNOWN", MQBALSTATE_UNKNOWN},
+	{"MQBA_FIRST", MQBA_FIRST},
+	{"MQBA_LAST", MQBA_LAST},
+	{"MQBL_NULL_TERMINATED", MQBL_NULL_TERMINATED},
+	{"MQBMHO_CURRENT_LENGTH", MQBMHO_CURRENT_LENGTH},
+	{"MQBMHO_CURRENT_VERSION", MQBMHO_CURRENT_VERSION},
+	{"MQBMHO_DELETE_PROPERTIES", MQBMHO_DELETE_PROPERTIES},
+	{"MQBMHO_LENGTH_1", MQBMHO_LENGTH_1},
+	{"MQBMHO_NONE", MQBMHO_NONE},
+	{"MQBMHO_VERSION_1", MQBMHO_VERSION_1},
+	{"MQBND_BIND_NOT_FIXED", MQBND_BIND_NOT_FIXED},
+	{"MQBND_BIND_ON_GROUP", MQBND_BIND_ON_GROUP},
+	{"MQBND_BIND_ON_OPEN", MQBND_BIND_ON_OPEN},
+	{"MQBNO_BALTYPE_RA_MANAGED", MQBNO_BALTYPE_RA_MANAGED},
+	{"MQBNO_BALTYPE_REQREP", MQBNO_BALTYPE_REQREP},
+	{"MQBNO_BALTYPE_SIMPLE", MQBNO_BALTYPE_SIMPLE},
+	{"MQBNO_CURRENT_LENGTH", MQBNO_CURRENT_LENGTH},
+	{"MQBNO_CURRENT_VERSION", MQBNO_CURRENT_VERSION},
+	{"MQBNO_LENGTH_1", MQBNO_LENGTH_1},
+	{"MQBNO_OPTIONS_IGNORE_TRANS", MQBNO_OPTIONS_IGNORE_TRANS},
+	{"MQBNO_OPTIONS_NONE", MQBNO_OPTIONS_NONE},
+	{"MQBNO_TIMEOUT_AS_DEFAULT", MQBNO_TIMEOUT_AS_DEFAULT},
+	{"MQBNO_TIMEOUT_IMMEDIATE", MQBNO_TIMEOUT_IMMEDIATE},
+	{"MQBNO_TIMEOUT_NEVER", MQBNO_TIMEOUT_NEVER},
+	{"MQBNO_VERSION_1", MQBNO_VERSION_1},
+	{"MQBO_CURRENT_LENGTH", MQBO_CURRENT_LENGTH},
+	{"MQBO_CURRENT_VERSION", MQBO_CURRENT_VERSION},
+	{"MQBO_LENGTH_1", MQBO_LENGTH_1},
+	{"MQBO_NONE", MQBO_NONE},
+	{"MQBO_VERSION_1", MQBO_VERSION_1},
+	{"MQBPLOCATION_ABOVE", MQBPLOCATION_ABOVE},
+	{"MQBPLOCATION_BELOW", MQBPLOCATION_BELOW},
+	{"MQBPLOCATION_SWITCHING_ABOVE", MQBPLOCATION_SWITCHING_ABOVE},
+	{"MQBPLOCATION_SWITCHING_BELOW", MQBPLOCATION_SWITCHING_BELOW},
+	{"MQBT_OTMA", MQBT_OTMA},
+	{"MQCACF_ACTIVITY_DESC", MQCACF_ACTIVITY_DESC},
+	{"MQCACF_ADMIN_TOPIC_NAMES", MQCACF_ADMIN_TOPIC_NAMES},
+	{"MQCACF_ALIAS_Q_NAMES", MQCACF_ALIAS_Q_NAMES},
+	{"MQCACF_ALTERNATE_USERID", MQCACF_ALTERNATE_USERID},
+	{"MQCACF_AMQP_CLIENT_ID", MQCACF_AMQP_CLIENT_ID},
+	{"MQCACF_APPL_DESC", MQCACF_APPL_DESC},
+	{"MQCACF_APPL_FUNCTION", MQCACF_APPL_FUNCTION},
+	{"MQCACF_APPL_IDENTITY_DATA", MQCACF_APPL_IDENTITY_DATA},
+	{"MQCACF_APPL_IMMOVABLE_DATE", MQCACF_APPL_IMMOVABLE_DATE},
+	{"MQCACF_APPL_IMMOVABLE_TIME", MQCACF_APPL_IMMOVABLE_TIME},
+	{"MQCACF_APPL_NAME", MQCACF_APPL_NAME},
+	{"MQCACF_APPL_ORIGIN_DATA", MQCACF_APPL_ORIGIN_DATA},
+	{"MQCACF_APPL_TAG", MQCACF_APPL_TAG},
+	{"MQCACF_ARCHIVE_LOG_EXTENT_NAME", MQCACF_ARCHIVE_LOG_EXTENT_NAME},
+	{"MQCACF_ASID", MQCACF_ASID},
+	{"MQCACF_AUTH_INFO_NAMES", MQCACF_AUTH_INFO_NAMES},
+	{"MQCACF_AUTH_PROFILE_NAME", MQCACF_AUTH_PROFILE_NAME},
+	{"MQCACF_AUX_ERROR_DATA_STR_1", MQCACF_AUX_ERROR_DATA_STR_1},
+	{"MQCACF_AUX_ERROR_DATA_STR_2", MQCACF_AUX_ERROR_DATA_STR_2},
+	{"MQCACF_AUX_ERROR_DATA_STR_3", MQCACF_AUX_ERROR_DATA_STR_3},
+	{"MQCACF_BACKUP_DATE", MQCACF_BACKUP_DATE},
+	{"MQCACF_BACKUP_TIME", MQCACF_BACKUP_TIME},
+	{"MQCACF_BRIDGE_NAME", MQCACF_BRIDGE_NAME},
+	{"MQCACF_CF_OFFLOAD_SIZE1", MQCACF_CF_OFFLOAD_SIZE1},
+	{"MQCACF_CF_OFFLOAD_SIZE2", MQCACF_CF_OFFLOAD_SIZE2},
+	{"MQCACF_CF_OFFLOAD_SIZE3", MQCACF_CF_OFFLOAD_SIZE3},
+	{"MQCACF_CF_SMDS", MQCACF_CF_SMDS},
+	{"MQCACF_CF_SMDSCONN", MQCACF_CF_SMDSCONN},
+	{"MQCACF_CF_SMDS_GENERIC_NAME", MQCACF_CF_SMDS_GENERIC_NAME},
+	{"MQCACF_CF_STRUC_BACKUP_END", MQCACF_CF_STRUC_BACKUP_END},
+	{"MQCACF_CF_STRUC_BACKUP_START", MQCACF_CF_STRUC_BACKUP_START},
+	{"MQCACF_CF_STRUC_LOG_Q_MGRS", MQCACF_CF_STRUC_LOG_Q_MGRS},
+	{"MQCACF_CF_STRUC_NAME", MQCACF_CF_STRUC_NAME},
+	{"MQCACF_CF_STRUC_NAMES", MQCACF_CF_STRUC_NAMES},
+	{"MQCACF_CHAR_ATTRS", MQCACF_CHAR_ATTRS},
+	{"MQCACF_CHILD_Q_MGR_NAME", MQCACF_CHILD_Q_MGR_NAME},
+	{"MQCACF_COMMAND_MQSC", MQCACF_COMMAND_MQSC},
+	{"MQCACF_COMMAND_SCOPE", MQCACF_COMMAND_SCOPE},
+	{"MQCACF_CONFIGURATION_DATE", MQCACF_CONFIGURATION_DATE},
+	{"MQCACF_CONFIGURATION_TIME", MQCACF_CONFIGURATION_TIME},
+	{"MQCACF_CORREL_ID", MQCACF_CORREL_ID},
+	{"MQCACF_CSP_USER_IDENTIFIER", MQCACF_CSP_USER_IDENTIFIER},
+	{"MQCACF_CURRENT_LOG_EXTENT_NAME", MQCACF_CURRENT_LOG_EXTENT_NAME},
+	{"MQCACF_DATA_SET_NAME", MQCACF_DATA_SET_NAME},
+	{"MQCACF_DB2_NAME", MQCACF_DB2_NAME},
+	{"MQCACF_DESTINATION", MQCACF_DESTINATION},
+	{"MQCACF_DESTINATION_Q_MGR", MQCACF_DESTINATION_Q_MGR},
+	{"MQCACF_DISK_WRITTEN_LSN", MQCACF_DISK_WRITTEN_LSN},
+	{"MQCACF_DSG_NAME", MQCACF_DSG_NAME},
+	{"MQCACF_DYNAMIC_Q_NAME", MQCACF_DYNAMIC_Q_NAME},
+	{"MQCACF_ENTITY_NAME", MQCACF_ENTITY_NAME},
+	{"MQCACF_ENV_INFO", MQCACF_ENV_INFO},
+	{"MQCACF_ERROR_LOG_AFTER_ISOTIME", MQCACF_ERROR_LOG_AFTER_ISOTIME},
+	{"MQCACF_ERROR_LOG_ISOTIME", MQCACF_ERROR_LOG_ISOTIME},
+	{"MQCACF_ERROR_LOG_NAME", MQCACF_ERROR_LOG_NAME},
+	{"MQCACF_ERROR_LOG_RECORD", MQCACF_ERROR_LOG_RECORD},
+	{"MQCACF_ESCAPE_TEXT", MQCACF_ESCAPE_TEXT},
+	{"MQCACF_EVENT_APPL_IDENTITY", MQCACF_EVENT_APPL_IDENTITY},
+	{"MQCACF_EVENT_APPL_NAME", MQCACF_EVENT_APPL_NAME},
+	{"MQCACF_EVENT_APPL_ORIGIN", MQCACF_EVENT_APPL_ORIGIN},
+	{"MQCACF_EVENT_DUPLICATE_FROM", MQCACF_EVENT_DUPLICATE_FROM},
+	{"MQCACF_EVENT_Q_MGR", MQCACF_EVENT_Q_MGR},
+	{"MQCACF_EVENT_USER_ID", MQCACF_EVENT_USER_ID},
+	{"MQCACF_EXCL_OPERATOR_MESSAGES", MQCACF_EXCL_OPERATOR_MESSAGES},
+	{"MQCACF_FAIL_DATE", MQCACF_FAIL_DATE},
+	{"MQCACF_FAIL_TIME", MQCACF_FAIL_TIME},
+	{"MQCACF_FILTER", MQCACF_FILTER},
+	{"MQCACF_FIRST", MQCACF_FIRST},
+	{"MQCACF_FROM_AUTH_INFO_NAME", MQCACF_FROM_AUTH_INFO_NAME},
+	{"MQCACF_FROM_CF_STRUC_NAME", MQCACF_FROM_CF_STRUC_NAME},
+	{"MQCACF_FROM_CHANNEL_NAME", MQCACF_FROM_CHANNEL_NAME},
+	{"MQCACF_FROM_COMM_INFO_NAME", MQCACF_FROM_COMM_INFO_NAME},
+	{"MQCACF_FROM_LISTENER_NAME", MQCACF_FROM_LISTENER_NAME},
+	{"MQCACF_FROM_NAMELIST_NAME", MQCACF_FROM_NAMELIST_NAME},
+	{"MQCACF_FROM_PROCESS_NAME", MQCACF_FROM_PROCESS_NAME},
+	{"MQCACF_FROM_Q_NAME", MQCACF_FROM_Q_NAME},
+	{"MQCACF_FROM_SERVICE_NAME", MQCACF_FROM_SERVICE_NAME},
+	{"MQCACF_FROM_STORAGE_CLASS", MQCACF_FROM_STORAGE_CLASS},
+	{"MQCACF_FROM_SUB_NAME", MQCACF_FROM_SUB_NAME},
+	{"MQCACF_FROM_TOPIC_NAME", MQCACF_FROM_TOPIC_NAME},
+	{"MQCACF_GROUP_ENTITY_NAMES", MQCACF_GROUP_ENTITY_NAMES},
+	{"MQCACF_HOST_NAME", MQCACF_HOST_NAME},
+	{"MQCACF_LAST_GET_DATE", MQCACF_LAST_GET_DATE},
+	{"MQCACF_LAST_GET_TIME", MQCACF_LAST_GET_TIME},
+	{"MQCACF_LAST_MSG_DATE", MQCACF_LAST_MSG_DATE},
+	{"MQCACF_LAST_MSG_TIME", MQCACF_LAST_MSG_TIME},
+	{"MQCACF_LAST_PUB_DATE", MQCACF_LAST_PUB_DATE},
+	{"MQCACF_LAST_PUB_TIME", MQCACF_LAST_PUB_TIME},
+	{"MQCACF_LAST_PUT_DATE", MQCACF_LAST_PUT_DATE},
+	{"MQCACF_LAST_PUT_TIME", MQCACF_LAST_PUT_TIME},
+	{"MQCACF_LAST_USED", MQCACF_LAST_USED},
+	{"MQCACF_LOCAL_Q_NAMES", MQCACF_LOCAL_Q_NAMES},
+	{"MQCACF_LOG_PATH", MQCACF_LOG_PATH},
+	{"MQCACF_LOG_START_DATE", MQCACF_LOG_START_DATE},
+	{"MQCACF_LOG_START_LSN", MQCACF_LOG_START_LSN},
+	{"MQCACF_LOG_START_TIME", MQCACF_LOG_START_TIME},
+	{"MQCACF_MEDIA_LOG_EXTENT_NAME", MQCACF_MEDIA_LOG_EXTENT_NAME},
+	{"MQCACF_MODEL_Q_NAMES", MQCACF_MODEL_Q_NAMES},
+	{"MQCACF_MQCB_NAME", MQCACF_MQCB_NAME},
+	{"MQCACF_NAMELIST_NAMES", MQCACF_NAMELIST_NAMES},
+	{"MQCACF_NHA_ACKNOWLEDGED_LSN", MQCACF_NHA_ACKNOWLEDGED_LSN},
+	{"MQCACF_NHA_GROUP_ADDRESS", MQCACF_NHA_GROUP_ADDRESS},
+	{"MQCACF_NHA_GROUP_INITIAL_DATE", MQCACF_NHA_GROUP_INITIAL_DATE},
+	{"MQCACF_NHA_GROUP_INITIAL_LSN", MQCACF_NHA_GROUP_INITIAL_LSN},
+	{"MQCACF_NHA_GROUP_INITIAL_TIME", MQCACF_NHA_GROUP_INITIAL_TIME},
+	{"MQCACF_NHA_GROUP_INIT_ISOTIME", MQCACF_NHA_GROUP_INIT_ISOTIME},
+	{"MQCACF_NHA_GROUP_LIVE_ISOTIME", MQCACF_NHA_GROUP_LIVE_ISOTIME},
+	{"MQCACF_NHA_GROUP_LSN", MQCACF_NHA_GROUP_LSN},
+	{"MQCACF_NHA_GROUP_NAME", MQCACF_NHA_GROUP_NAME},
+	{"MQCACF_NHA_GROUP_RECOV_ISOTIME", MQCACF_NHA_GROUP_RECOV_ISOTIME},
+	{"MQCACF_NHA_GROUP_RECOV_LSN", MQCACF_NHA_GROUP_RECOV_LSN},
+	{"MQCACF_NHA_GROUP_SYNC_ISOTIME", MQCACF_NHA_GROUP_SYNC_ISOTIME},
+	{"MQCACF_NHA_INSTANCE_NAME", MQCACF_NHA_INSTANCE_NAME},
+	{"MQCACF_NHA_REPL_ADDRESS", MQCACF_NHA_REPL_ADDRESS},
+	{"MQCACF_NHA_SYNC_ISOTIME", MQCACF_NHA_SYNC_ISOTIME},
+	{"MQCACF_NONE", MQCACF_NONE},
+	{"MQCACF_OBJECT_NAME", MQCACF_OBJECT_NAME},
+	{"MQCACF_OBJECT_Q_MGR_NAME", MQCACF_OBJECT_Q_MGR_NAME},
+	{"MQCACF_OBJECT_STRING", MQCACF_OBJECT_STRING},
+	{"MQCACF_OPERATION_DATE", MQCACF_OPERATION_DATE},
+	{"MQCACF_OPERATION_TIME", MQCACF_OPERATION_TIME},
+	{"MQCACF_ORIGIN_NAME", MQCACF_ORIGIN_NAME},
+	{"MQCACF_PARENT_Q_MGR_NAME", MQCACF_PARENT_Q_MGR_NAME},
+	{"MQCACF_PRINCIPAL_ENTITY_NAMES", MQCACF_PRINCIPAL_ENTITY_NAMES},
+	{"MQCACF_PROCESS_NAMES", MQCACF_PROCESS_NAMES},
+	{"MQCACF_PSB_NAME", MQCACF_PSB_NAME},
+	{"MQCACF_PST_ID", MQCACF_PST_ID},
+	{"MQCACF_PUBLISH_TIMESTAMP", MQCACF_PUBLISH_TIMESTAMP},
+	{"MQCACF_PUT_DATE", MQCACF_PUT_DATE},
+	{"MQCACF_PUT_TIME", MQCACF_PUT_TIME},
+	{"MQCACF_Q_MGR_CPF", MQCACF_Q_MGR_CPF},
+	{"MQCACF_Q_MGR_DATA_PATH", MQCACF_Q_MGR_DATA_PATH},
+	{"MQCACF_Q_MGR_START_DATE", MQCACF_Q_MGR_START_DATE},
+	{"MQCACF_Q_MGR_START_TIME", MQCACF_Q_MGR_START_TIME},
+	{"MQCACF_Q_MGR_UOW_ID", MQCACF_Q_MGR_UOW_ID},
+	{"MQCACF_Q_NAMES", MQCACF_Q_NAMES},
+	{"MQCACF_RECEIVER_CHANNEL_NAMES", MQCACF_RECEIVER_CHANNEL_NAMES},
+	{"MQCACF_RECOVERY_DATE", MQCACF_RECOVERY_DATE},
+	{"MQCACF_RECOVERY_TIME", MQCACF_RECOVERY_TIME},
+	{"MQCACF_REG_CORREL_ID", MQCACF_REG_CORREL_ID},
+	{"MQCACF_REG_Q_MGR_NAME", MQCACF_REG_Q_MGR_NAME},
+	{"MQCACF_REG_Q_NAME", MQCACF_REG_Q_NAME},
+	{"MQCACF_REG_STREAM_NAME", MQCACF_REG_STREAM_NAME},
+	{"MQCACF_REG_SUB_IDENTITY", MQCACF_REG_SUB_IDENTITY},
+	{"MQCACF_REG_SUB_NAME", MQCACF_REG_SUB_NAME},
+	{"MQCACF_REG_SUB_USER_DATA", MQCACF_REG_SUB_USER_DATA},
+	{"MQCACF_REG_TIME", MQCACF_REG_TIME},
+	{"MQCACF_REG_TOPIC", MQCACF_REG_TOPIC},
+	{"MQCACF_REG_USER_ID", MQCACF_REG_USER_ID},
+	{"MQCACF_REMOTE_Q_NAMES", MQCACF_REMOTE_Q_NAMES},
+	{"MQCACF_REPLY_TO_Q", MQCACF_REPLY_TO_Q},
+	{"MQCACF_REPLY_TO_Q_MGR", MQCACF_REPLY_TO_Q_MGR},
+	{"MQCACF_REQUESTER_CHANNEL_NAMES", MQCACF_REQUESTER_CHANNEL_NAMES},
+	{"MQCACF_RESOLVED_LOCAL_Q_MGR", MQCACF_RESOLVED_LOCAL_Q_MGR},
+	{"MQCACF_RESOLVED_LOCAL_Q_NAME", MQCACF_RESOLVED_LOCAL_Q_NAME},
+	{"MQCACF_RESOLVED_OBJECT_STRING", MQCACF_RESOLVED_OBJECT_STRING},
+	{"MQCACF_RESOLVED_Q_MGR", MQCACF_RESOLVED_Q_MGR},
+	{"MQCACF_RESOLVED_Q_NAME", MQCACF_RESOLVED_Q_NAME},
+	{"MQCACF_RESPONSE_Q_MGR_NAME", MQCACF_RESPONSE_Q_MGR_NAME},
+	{"MQCACF_RESTART_LOG_EXTENT_NAME", MQCACF_RESTART_LOG_EXTENT_NAME},
+	{"MQCACF_ROUTING_FINGER_PRINT", MQCACF_ROUTING_FINGER_PRINT},
+	{"MQCACF_SECURITY_PROFILE", MQCACF_SECURITY_PROFILE},
+	{"MQCACF_SELECTION_STRING", MQCACF_SELECTION_STRING},
+	{"MQCACF_SENDER_CHANNEL_NAMES", MQCACF_SENDER_CHANNEL_NAMES},
+	{"MQCACF_SERVER_CHANNEL_NAMES", MQCACF_SERVER_CHANNEL_NAMES},
+	{"MQCACF_SERVICE_COMPONENT", MQCACF_SERVICE_COMPONENT},
+	{"MQCACF_SERVICE_START_DATE", MQCACF_SERVICE_START_DATE},
+	{"MQCACF_SERVICE_START_TIME", MQCACF_SERVICE_START_TIME},
+	{"MQCACF_STORAGE_CLASS_NAMES", MQCACF_STORAGE_CLASS_NAMES},
+	{"MQCACF_STREAM_NAME", MQCACF_STREAM_NAME},
+	{"MQCACF_STRING_DATA", MQCACF_STRING_DATA},
+	{"MQCACF_STRUC_ID", MQCACF_STRUC_ID},
+	{"MQCACF_SUBSCRIPTION_IDENTITY", MQCACF_SUBSCRIPTION_IDENTITY},
+	{"MQCACF_SUBSCRIPTION_NAME", MQCACF_SUBSCRIPTION_NAME},
+	{"MQCACF_SUBSCRIPTION_POINT", MQCACF_SUBSCRIPTION_POINT},
+	{"MQCACF_SUBSCRIPTION_USER_DATA", MQCACF_SUBSCRIPTION_USER_DATA},
+	{"MQCACF_SUB_NAME", MQCACF_SUB_NAME},
+	{"MQCACF_SUB_SELECTOR", MQCACF_SUB_SELECTOR},
+	{"MQCACF_SUB_USER_DATA", MQCACF_SUB_USER_DATA},
+	{"MQCACF_SUB_USER_ID", MQCACF_SUB_USER_ID},
+	{"MQCACF_SUPPORTED_STREAM_NAME", MQCACF_SUPPORTED_STREAM_NAME},
+	{"MQCACF_SYSP_ARCHIVE_PFX1", MQCACF_SYSP_ARCHIVE_PFX1},
+	{"MQCACF_SYSP_ARCHIVE_PFX2", MQCACF_SYSP_ARCHIVE_PFX2},
+	{"MQCACF_SYSP_ARCHIVE_UNIT1", MQCACF_SYSP_ARCHIVE_UNIT1},
+	{"MQCACF_SYSP_ARCHIVE_UNIT2", MQCACF_SYSP_ARCHIVE_UNIT2},
+	{"MQCACF_SYSP_CMD_USER_ID", MQCACF_SYSP_CMD_USER_ID},
+	{"MQCACF_SYSP_LOG_CORREL_ID", MQCACF_SYSP_LOG_CORREL_ID},
+	{"MQCACF_SYSP_LOG_RBA", MQCACF_SYSP_LOG_RBA},
+	{"MQCACF_SYSP_OFFLINE_RBA", MQCACF_SYSP_OFFLINE_RBA},
+	{"MQCACF_SYSP_OTMA_DRU_EXIT", MQCACF_SYSP_OTMA_DRU_EXIT},
+	{"MQCACF_SYSP_OTMA_GROUP", MQCACF_SYSP_OTMA_GROUP},
+	{"MQCACF_SYSP_OTMA_MEMBER", MQCACF_SYSP_OTMA_MEMBER},
+	{"MQCACF_SYSP_OTMA_TPIPE_PFX", MQCACF_SYSP_OTMA_TPIPE_PFX},
+	{"MQCACF_SYSP_Q_MGR_DATE", MQCACF_SYSP_Q_MGR_DATE},
+	{"MQCACF_SYSP_Q_MGR_RBA", MQCACF_SYSP_Q_MGR_RBA},
+	{"MQCACF_SYSP_Q_MGR_TIME", MQCACF_SYSP_Q_MGR_TIME},
+	{"MQCACF_SYSP_SERVICE", MQCACF_SYSP_SERVICE},
+	{"MQCACF_SYSP_UNIT_VOLSER", MQCACF_SYSP_UNIT_VOLSER},
+	{"MQCACF_SYSTEM_NAME", MQCACF_SYSTEM_NAME},
+	{"MQCACF_TASK_NUMBER", MQCACF_TASK_NUMBER},
+	{"MQCACF_TOPIC", MQCACF_TOPIC},
+	{"MQCACF_TOPIC_NAMES", MQCACF_TOPIC_NAMES},
+	{"MQCACF_TO_AUTH_INFO_NAME", MQCACF_TO_AUTH_INFO_NAME},
+	{"MQCACF_TO_CF_STRUC_NAME", MQCACF_TO_CF_STRUC_NAME},
+	{"MQCACF_TO_CHANNEL_NAME", MQCACF_TO_CHANNEL_NAME},
+	{"MQCACF_TO_COMM_INFO_NAME", MQCACF_TO_COMM_INFO_NAME},
+	{"MQCACF_TO_LISTENER_NAME", MQCACF_TO_LISTENER_NAME},
+	{"MQCACF_TO_NAMELIST_NAME", MQCACF_TO_NAMELIST_NAME},
+	{"MQCACF_TO_PROCESS_NAME", MQCACF_TO_PROCESS_NAME},
+	{"MQCACF_TO_Q_NAME", MQCACF_TO_Q_NAME},
+	{"MQCACF_TO_SERVICE_NAME", MQCACF_TO_SERVICE_NAME},
+	{"MQCACF_TO_STORAGE_CLASS", MQCACF_TO_STORAGE_CLASS},
+	{"MQCACF_TO_SUB_NAME", MQCACF_TO_SUB_NAME},
+	{"MQCACF_TO_TOPIC_NAME", MQCACF_TO_TOPIC_NAME},
+	{"MQCACF_TRANSACTION_ID", MQCACF_TRANSACTION_ID},
+	{"MQCACF_UNIFORM_CLUSTER_NAME", MQCACF_UNIFORM_CLUSTER_NAME},
+	{"MQCACF_UOW_LOG_EXTENT_NAME", MQCACF_UOW_LOG_EXTENT_NAME},
+	{"MQCACF_UOW_LOG_START_DATE", MQCACF_UOW_LOG_START_DATE},
+	{"MQCACF_UOW_LOG_START_TIME", MQCACF_UOW_LOG_START_TIME},
+	{"MQCACF_UOW_START_DATE", MQCACF_UOW_START_DATE},
+	{"MQCACF_UOW_START_TIME", MQCACF_UOW_START_TIME},
+	{"MQCACF_USAGE_LOG_LRSN", MQCACF_USAGE_LOG_LRSN},
+	{"MQCACF_USAGE_LOG_RBA", MQCACF_USAGE_LOG_RBA},
+	{"MQCACF_USER_IDENTIFIER", MQCACF_USER_IDENTIFIER},
+	{"MQCACF_VALUE_NAME", MQCACF_VALUE_NAME},
+	{"MQCACF_XA_INFO", MQCACF_XA_INFO},
+	{"MQCACF_XQH_PUT_DATE", MQCACF_XQH_PUT_DATE},
+	{"MQCACF_XQH_PUT_TIME", MQCACF_XQH_PUT_TIME},
+	{"MQCACF_XQH_REMOTE_Q_MGR", MQCACF_XQH_REMOTE_Q_MGR},
+	{"MQCACF_XQH_REMOTE_Q_NAME", MQCACF_XQH_REMOTE_Q_NAME},
+	{"MQCACH_CHANNEL_NAME", MQCACH_CHANNEL_NAME},
+	{"MQCACH_CHANNEL_NAMES", MQCACH_CHANNEL_NAMES},
+	{"MQCACH_CHANNEL_START_DATE", MQCACH_CHANNEL_START_DATE},
+	{"MQCACH_CHANNEL_START_TIME", MQCACH_CHANNEL_START_TIME},
+	{"MQCACH_CLIENT_ID", MQCACH_CLIENT_ID},
+	{"MQCACH_CLIENT_USER_ID", MQCACH_CLIENT_USER_ID},
+	{"MQCACH_CONNECTION_NAME", MQCACH_CONNECTION_NAME},
+	{"MQCACH_CONNECTION_NAME_LIST", MQCACH_CONNECTION_NAME_LIST},
+	{"MQCACH_CURRENT_LUWID", MQCACH_CURRENT_LUWID},
+	{"MQCACH_DESC", MQCACH_DESC},
+	{"MQCACH_FIRST", MQCACH_FIRST},
+	{"MQCACH_FORMAT_NAME", MQCACH_FORMAT_NAME},
+	{"MQCACH_GROUP_ADDRESS", MQCACH_GROUP_ADDRESS},
+	{"MQCACH_IP_ADDRESS", MQCACH_IP_ADDRESS},
+	{"MQCACH_JAAS_CONFIG", MQCACH_JAAS_CONFIG},
+	{"MQCACH_LAST_LUWID", MQCACH_LAST_LUWID},
+	{"MQCACH_LAST_MSG_DATE", MQCACH_LAST_MSG_DATE},
+	{"MQCACH_LAST_MSG_TIME", MQCACH_LAST_MSG_TIME},
+	{"MQCACH_LAST_USED", MQCACH_LAST_USED},
+	{"MQCACH_LISTENER_DESC", MQCACH_LISTENER_DESC},
+	{"MQCACH_LISTENER_NAME", MQCACH_LISTENER_NAME},
+	{"MQCACH_LISTENER_START_DATE", MQCACH_LISTENER_START_DATE},
+	{"MQCACH_LISTENER_START_TIME", MQCACH_LISTENER_START_TIME},
+	{"MQCACH_LOCAL_ADDRESS", MQCACH_LOCAL_ADDRESS},
+	{"MQCACH_LOCAL_NAME", MQCACH_LOCAL_NAME},
+	{"MQCACH_LU_NAME", MQCACH_LU_NAME},
+	{"MQCACH_MCA_JOB_NAME", MQCACH_MCA_JOB_NAME},
+	{"MQCACH_MCA_NAME", MQCACH_MCA_NAME},
+	{"MQCACH_MCA_USER_ID", MQCACH_MCA_USER_ID},
+	{"MQCACH_MCA_USER_ID_LIST", MQCACH_MCA_USER_ID_LIST},
+	{"MQCACH_MODE_NAME", MQCACH_MODE_NAME},
+	{"MQCACH_MR_EXIT_NAME", MQCACH_MR_EXIT_NAME},
+	{"MQCACH_MR_EXIT_USER_DATA", MQCACH_MR_EXIT_USER_DATA},
+	{"MQCACH_MSG_EXIT_NAME", MQCACH_MSG_EXIT_NAME},
+	{"MQCACH_MSG_EXIT_USER_DATA", MQCACH_MSG_EXIT_USER_DATA},
+	{"MQCACH_PASSWORD", MQCACH_PASSWORD},
+	{"MQCACH_RCV_EXIT_NAME", MQCACH_RCV_EXIT_NAME},
+	{"MQCACH_RCV_EXIT_USER_DATA", MQCACH_RCV_EXIT_USER_DATA},
+	{"MQCACH_REMOTE_APPL_TAG", MQCACH_REMOTE_APPL_TAG},
+	{"MQCACH_REMOTE_PRODUCT", MQCACH_REMOTE_PRODUCT},
+	{"MQCACH_REMOTE_VERSION", MQCACH_REMOTE_VERSION},
+	{"MQCACH_SEC_EXIT_NAME", MQCACH_SEC_EXIT_NAME},
+	{"MQCACH_SEC_EXIT_USER_DATA", MQCACH_SEC_EXIT_USER_DATA},
+	{"MQCACH_SEND_EXIT_NAME", MQCACH_SEND_EXIT_NAME},
+	{"MQCACH_SEND_EXIT_USER_DATA", MQCACH_SEND_EXIT_USER_DATA},
+	{"MQCACH_SSL_CERT_ISSUER_NAME", MQCACH_SSL_CERT_ISSUER_NAME},
+	{"MQCACH_SSL_CERT_USER_ID", MQCACH_SSL_CERT_USER_ID},
+	{"MQCACH_SSL_CIPHER_SPEC", MQCACH_SSL_CIPHER_SPEC},
+	{"MQCACH_SSL_CIPHER_SUITE", MQCACH_SSL_CIPHER_SUITE},
+	{"MQCACH_SSL_HANDSHAKE_STAGE", MQCACH_SSL_HANDSHAKE_STAGE},
+	{"MQCACH_SSL_KEY_PASSPHRASE", MQCACH_SSL_KEY_PASSPHRASE},
+	{"MQCACH_SSL_KEY_RESET_DATE", MQCACH_SSL_KEY_RESET_DATE},
+	{"MQCACH_SSL_KEY_RESET_TIME", MQCACH_SSL_KEY_RESET_TIME},
+	{"MQCACH_SSL_PEER_NAME", MQCACH_SSL_PEER_NAME},
+	{"MQCACH_SSL_SHORT_PEER_NAME", MQCACH_SSL_SHORT_PEER_NAME},
+	{"MQCACH_TCP_NAME", MQCACH_TCP_NAME},
+	{"MQCACH_TEMPORARY_MODEL_Q", MQCACH_TEMPORARY_MODEL_Q},
+	{"MQCACH_TEMPORARY_Q_PREFIX", MQCACH_TEMPORARY_Q_PREFIX},
+	{"MQCACH_TOPIC_ROOT", MQCACH_TOPIC_ROOT},
+	{"MQCACH_TP_NAME", MQCACH_TP_NAME},
+	{"MQCACH_USER_ID", MQCACH_USER_ID},
+	{"MQCACH_WEBCONTENT_PATH", MQCACH_WEBCONTENT_PATH},
+	{"MQCACH_XMIT_Q_NAME", MQCACH_XMIT_Q_NAME},
+	{"MQCADSD_MSGFORMAT", MQCADSD_MSGFORMAT},
+	{"MQCADSD_NONE", MQCADSD_NONE},
+	{"MQCADSD_RECV", MQCADSD_RECV},
+	{"MQCADSD_SEND", MQCADSD_SEND},
+	{"MQCAFTY_NONE", MQCAFTY_NONE},
+	{"MQCAFTY_PREFERRED", MQCAFTY_PREFERRED},
+	{"MQCAMO_CLOSE_DATE", MQCAMO_CLOSE_DATE},
+	{"MQCAMO_CLOSE_TIME", MQCAMO_CLOSE_TIME},
+	{"MQCAMO_CONN_DATE", MQCAMO_CONN_DATE},
+	{"MQCAMO_CONN_TIME", MQCAMO_CONN_TIME},
+	{"MQCAMO_DISC_DATE", MQCAMO_DISC_DATE},
+	{"MQCAMO_DISC_TIME", MQCAMO_DISC_TIME},
+	{"MQCAMO_END_DATE", MQCAMO_END_DATE},
+	{"MQCAMO_END_TIME", MQCAMO_END_TIME},
+	{"MQCAMO_FIRST", MQCAMO_FIRST},
+	{"MQCAMO_LAST_USED", MQCAMO_LAST_USED},
+	{"MQCAMO_MONITOR_CLASS", MQCAMO_MONITOR_CLASS},
+	{"MQCAMO_MONITOR_DESC", MQCAMO_MONITOR_DESC},
+	{"MQCAMO_MONITOR_TYPE", MQCAMO_MONITOR_TYPE},
+	{"MQCAMO_OPEN_DATE", MQCAMO_OPEN_DATE},
+	{"MQCAMO_OPEN_TIME", MQCAMO_OPEN_TIME},
+	{"MQCAMO_START_DATE", MQCAMO_START_DATE},
+	{"MQCAMO_START_TIME", MQCAMO_START_TIME},
+	{"MQCAP_EXPIRED", MQCAP_EXPIRED},
+	{"MQCAP_NOT_SUPPORTED", MQCAP_NOT_SUPPORTED},
+	{"MQCAP_SUPPORTED", MQCAP_SUPPORTED},
+	{"MQCAUT_ADDRESSMAP", MQCAUT_ADDRESSMAP},
+	{"MQCAUT_ALL", MQCAUT_ALL},
+	{"MQCAUT_BLOCKADDR", MQCAUT_BLOCKADDR},
+	{"MQCAUT_BLOCKUSER", MQCAUT_BLOCKUSER},
+	{"MQCAUT_QMGRMAP", MQCAUT_QMGRMAP},
+	{"MQCAUT_SSLPEERMAP", MQCAUT_SSLPEERMAP},
+	{"MQCAUT_USERMAP", MQCAUT_USERMAP},
+	{"MQCA_ADMIN_TOPIC_NAME", MQCA_ADMIN_TOPIC_NAME},
+	{"MQCA_ALTERATION_DATE", MQCA_ALTERATION_DATE},
+	{"MQCA_ALTERATION_TIME", MQCA_ALTERATION_TIME},
+	{"MQCA_AMQP_SSL_CIPHER_SUITES", MQCA_AMQP_SSL_CIPHER_SUITES},
+	{"MQCA_AMQP_VERSION", MQCA_AMQP_VERSION},
+	{"MQCA_APPL_ID", MQCA_APPL_ID},
+	{"MQCA_AUTH_INFO_CONN_NAME", MQCA_AUTH_INFO_CONN_NAME},
+	{"MQCA_AUTH_INFO_DESC", MQCA_AUTH_INFO_DESC},
+	{"MQCA_AUTH_INFO_NAME", MQCA_AUTH_INFO_NAME},
+	{"MQCA_AUTH_INFO_OCSP_URL", MQCA_AUTH_INFO_OCSP_URL},
+	{"MQCA_AUTO_REORG_CATALOG", MQCA_AUTO_REORG_CATALOG},
+	{"MQCA_AUTO_REORG_START_TIME", MQCA_AUTO_REORG_START_TIME},
+	{"MQCA_BACKOUT_REQ_Q_NAME", MQCA_BACKOUT_REQ_Q_NAME},
+	{"MQCA_BASE_OBJECT_NAME", MQCA_BASE_OBJECT_NAME},
+	{"MQCA_BASE_Q_NAME", MQCA_BASE_Q_NAME},
+	{"MQCA_BATCH_INTERFACE_ID", MQCA_BATCH_INTERFACE_ID},
+	{"MQCA_CERT_LABEL", MQCA_CERT_LABEL},
+	{"MQCA_CF_STRUC_DESC", MQCA_CF_STRUC_DESC},
+	{"MQCA_CF_STRUC_NAME", MQCA_CF_STRUC_NAME},
+	{"MQCA_CHANNEL_AUTO_DEF_EXIT", MQCA_CHANNEL_AUTO_DEF_EXIT},
+	{"MQCA_CHILD", MQCA_CHILD},
+	{"MQCA_CHINIT_SERVICE_PARM", MQCA_CHINIT_SERVICE_PARM},
+	{"MQCA_CHLAUTH_DESC", MQCA_CHLAUTH_DESC},
+	{"MQCA_CICS_FILE_NAME", MQCA_CICS_FILE_NAME},
+	{"MQCA_CLUSTER_DATE", MQCA_CLUSTER_DATE},
+	{"MQCA_CLUSTER_NAME", MQCA_CLUSTER_NAME},
+	{"MQCA_CLUSTER_NAMELIST", MQCA_CLUSTER_NAMELIST},
+	{"MQCA_CLUSTER_Q_MGR_NAME", MQCA_CLUSTER_Q_MGR_NAME},
+	{"MQCA_CLUSTER_TIME", MQCA_CLUSTER_TIME},
+	{"MQCA_CLUSTER_WORKLOAD_DATA", MQCA_CLUSTER_WORKLOAD_DATA},
+	{"MQCA_CLUSTER_WORKLOAD_EXIT", MQCA_CLUSTER_WORKLOAD_EXIT},
+	{"MQCA_CLUS_CHL_NAME", MQCA_CLUS_CHL_NAME},
+	{"MQCA_COMMAND_INPUT_Q_NAME", MQCA_COMMAND_INPUT_Q_NAME},
+	{"MQCA_COMMAND_REPLY_Q_NAME", MQCA_COMMAND_REPLY_Q_NAME},
+	{"MQCA_COMM_INFO_DESC", MQCA_COMM_INFO_DESC},
+	{"MQCA_COMM_INFO_NAME", MQCA_COMM_INFO_NAME},
+	{"MQCA_CONN_AUTH", MQCA_CONN_AUTH},
+	{"MQCA_CREATION_DATE", MQCA_CREATION_DATE},
+	{"MQCA_CREATION_TIME", MQCA_CREATION_TIME},
+	{"MQCA_CUSTOM", MQCA_CUSTOM},
+	{"MQCA_DEAD_LETTER_Q_NAME", MQCA_DEAD_LETTER_Q_NAME},
+	{"MQCA_DEF_XMIT_Q_NAME", MQCA_DEF_XMIT_Q_NAME},
+	{"MQCA_DNS_GROUP", MQCA_DNS_GROUP},
+	{"MQCA_ENV_DATA", MQCA_ENV_DATA},
+	{"MQCA_FIRST", MQCA_FIRST},
+	{"MQCA_IGQ_USER_ID", MQCA_IGQ_USER_ID},
+	{"MQCA_INITIAL_KEY", MQCA_INITIAL_KEY},
+	{"MQCA_INITIATION_Q_NAME", MQCA_INITIATION_Q_NAME},
+	{"MQCA_INSTALLATION_DESC", MQCA_INSTALLATION_DESC},
+	{"MQCA_INSTALLATION_NAME", MQCA_INSTALLATION_NAME},
+	{"MQCA_INSTALLATION_PATH", MQCA_INSTALLATION_PATH},
+	{"MQCA_LAST", MQCA_LAST},
+	{"MQCA_LAST_USED", MQCA_LAST_USED},
+	{"MQCA_LDAP_BASE_DN_GROUPS", MQCA_LDAP_BASE_DN_GROUPS},
+	{"MQCA_LDAP_BASE_DN_USERS", MQCA_LDAP_BASE_DN_USERS},
+	{"MQCA_LDAP_FIND_GROUP_FIELD", MQCA_LDAP_FIND_GROUP_FIELD},
+	{"MQCA_LDAP_GROUP_ATTR_FIELD", MQCA_LDAP_GROUP_ATTR_FIELD},
+	{"MQCA_LDAP_GROUP_OBJECT_CLASS", MQCA_LDAP_GROUP_OBJECT_CLASS},
+	{"MQCA_LDAP_PASSWORD", MQCA_LDAP_PASSWORD},
+	{"MQCA_LDAP_SHORT_USER_FIELD", MQCA_LDAP_SHORT_USER_FIELD},
+	{"MQCA_LDAP_USER_ATTR_FIELD", MQCA_LDAP_USER_ATTR_FIELD},
+	{"MQCA_LDAP_USER_NAME", MQCA_LDAP_USER_NAME},
+	{"MQCA_LDAP_USER_OBJECT_CLASS", MQCA_LDAP_USER_OBJECT_CLASS},
+	{"MQCA_LU62_ARM_SUFFIX", MQCA_LU62_ARM_SUFFIX},
+	{"MQCA_LU_GROUP_NAME", MQCA_LU_GROUP_NAME},
+	{"MQCA_LU_NAME", MQCA_LU_NAME},
+	{"MQCA_MODEL_DURABLE_Q", MQCA_MODEL_DURABLE_Q},
+	{"MQCA_MODEL_NON_DURABLE_Q", MQCA_MODEL_NON_DURABLE_Q},
+	{"MQCA_MONITOR_Q_NAME", MQCA_MONITOR_Q_NAME},
+	{"MQCA_NAMELIST_DESC", MQCA_NAMELIST_DESC},
+	{"MQCA_NAMELIST_NAME", MQCA_NAMELIST_NAME},
+	{"MQCA_NAMES", MQCA_NAMES},
+	{"MQCA_PARENT", MQCA_PARENT},
+	{"MQCA_PASS_TICKET_APPL", MQCA_PASS_TICKET_APPL},
+	{"MQCA_POLICY_NAME", MQCA_POLICY_NAME},
+	{"MQCA_PROCESS_DESC", MQCA_PROCESS_DESC},
+	{"MQCA_PROCESS_NAME", MQCA_PROCESS_NAME},
+	{"MQCA_QSG_CERT_LABEL", MQCA_QSG_CERT_LABEL},
+	{"MQCA_QSG_NAME", MQCA_QSG_NAME},
+	{"MQCA_Q_DESC", MQCA_Q_DESC},
+	{"MQCA_Q_MGR_DESC", MQCA_Q_MGR_DESC},
+	{"MQCA_Q_MGR_IDENTIFIER", MQCA_Q_MGR_IDENTIFIER},
+	{"MQCA_Q_MGR_NAME", MQCA_Q_MGR_NAME},
+	{"MQCA_Q_NAME", MQCA_Q_NAME},
+	{"MQCA_RECIPIENT_DN", MQCA_RECIPIENT_DN},
+	{"MQCA_REMOTE_Q_MGR_NAME", MQCA_REMOTE_Q_MGR_NAME},
+	{"MQCA_REMOTE_Q_NAME", MQCA_REMOTE_Q_NAME},
+	{"MQCA_REPOSITORY_NAME", MQCA_REPOSITORY_NAME},
+	{"MQCA_REPOSITORY_NAMELIST", MQCA_REPOSITORY_NAMELIST},
+	{"MQCA_RESUME_DATE", MQCA_RESUME_DATE},
+	{"MQCA_RESUME_TIME", MQCA_RESUME_TIME},
+	{"MQCA_SERVICE_DESC", MQCA_SERVICE_DESC},
+	{"MQCA_SERVICE_NAME", MQCA_SERVICE_NAME},
+	{"MQCA_SERVICE_START_ARGS", MQCA_SERVICE_START_ARGS},
+	{"MQCA_SERVICE_START_COMMAND", MQCA_SERVICE_START_COMMAND},
+	{"MQCA_SERVICE_STOP_ARGS", MQCA_SERVICE_STOP_ARGS},
+	{"MQCA_SERVICE_STOP_COMMAND", MQCA_SERVICE_STOP_COMMAND},
+	{"MQCA_SIGNER_DN", MQCA_SIGNER_DN},
+	{"MQCA_SSL_CERT_ISSUER_NAME", MQCA_SSL_CERT_ISSUER_NAME},
+	{"MQCA_SSL_CRL_NAMELIST", MQCA_SSL_CRL_NAMELIST},
+	{"MQCA_SSL_CRYPTO_HARDWARE", MQCA_SSL_CRYPTO_HARDWARE},
+	{"MQCA_SSL_KEY_LIBRARY", MQCA_SSL_KEY_LIBRARY},
+	{"MQCA_SSL_KEY_MEMBER", MQCA_SSL_KEY_MEMBER},
+	{"MQCA_SSL_KEY_REPOSITORY", MQCA_SSL_KEY_REPOSITORY},
+	{"MQCA_SSL_KEY_REPO_PASSWORD", MQCA_SSL_KEY_REPO_PASSWORD},
+	{"MQCA_STDERR_DESTINATION", MQCA_STDERR_DESTINATION},
+	{"MQCA_STDOUT_DESTINATION", MQCA_STDOUT_DESTINATION},
+	{"MQCA_STORAGE_CLASS", MQCA_STORAGE_CLASS},
+	{"MQCA_STORAGE_CLASS_DESC", MQCA_STORAGE_CLASS_DESC},
+	{"MQCA_STREAM_QUEUE_NAME", MQCA_STREAM_QUEUE_NAME},
+	{"MQCA_SYSTEM_LOG_Q_NAME", MQCA_SYSTEM_LOG_Q_NAME},
+	{"MQCA_TCP_NAME", MQCA_TCP_NAME},
+	{"MQCA_TOPIC_DESC", MQCA_TOPIC_DESC},
+	{"MQCA_TOPIC_NAME", MQCA_TOPIC_NAME},
+	{"MQCA_TOPIC_STRING", MQCA_TOPIC_STRING},
+	{"MQCA_TOPIC_STRING_FILTER", MQCA_TOPIC_STRING_FILTER},
+	{"MQCA_TPIPE_NAME", MQCA_TPIPE_NAME},
+	{"MQCA_TRIGGER_CHANNEL_NAME", MQCA_TRIGGER_CHANNEL_NAME},
+	{"MQCA_TRIGGER_DATA", MQCA_TRIGGER_DATA},
+	{"MQCA_TRIGGER_PROGRAM_NAME", MQCA_TRIGGER_PROGRAM_NAME},
+	{"MQCA_TRIGGER_TERM_ID", MQCA_TRIGGER_TERM_ID},
+	{"MQCA_TRIGGER_TRANS_ID", MQCA_TRIGGER_TRANS_ID},
+	{"MQCA_USER_DATA", MQCA_USER_DATA},
+	{"MQCA_USER_LIST", MQCA_USER_LIST},
+	{"MQCA_VERSION", MQCA_VERSION},
+	{"MQCA_XCF_GROUP_NAME", MQCA_XCF_GROUP_NAME},
+	{"MQCA_XCF_MEMBER_NAME", MQCA_XCF_MEMBER_NAME},
+	{"MQCA_XMIT_Q_NAME", MQCA_XMIT_Q_NAME},
+	{"MQCA_XR_SSL_CIPHER_SUITES", MQCA_XR_SSL_CIPHER_SUITES},
+	{"MQCA_XR_VERSION", MQCA_XR_VERSION},
+	{"MQCBCF_NONE", MQCBCF_NONE},
+	{"MQCBCF_READA_BUFFER_EMPTY", MQCBCF_READA_BUFFER_EMPTY},
+	{"MQCBCT_DEREGISTER_CALL", MQCBCT_DEREGISTER_CALL},
+	{"MQCBCT_EVENT_CALL", MQCBCT_EVENT_CALL},
+	{"MQCBCT_MC_EVENT_CALL", MQCBCT_MC_EVENT_CALL},
+	{"MQCBCT_MSG_NOT_REMOVED", MQCBCT_MSG_NOT_REMOVED},
+	{"MQCBCT_MSG_REMOVED", MQCBCT_MSG_REMOVED},
+	{"MQCBCT_REGISTER_CALL", MQCBCT_REGISTER_CALL},
+	{"MQCBCT_START_CALL", MQCBCT_START_CALL},
+	{"MQCBCT_STOP_CALL", MQCBCT_STOP_CALL},
+	{"MQCBC_CURRENT_LENGTH", MQCBC_CURRENT_LENGTH},
+	{"MQCBC_CURRENT_VERSION", MQCBC_CURRENT_VERSION},
+	{"MQCBC_LENGTH_1", MQCBC_LENGTH_1},
+	{"MQCBC_LENGTH_2", MQCBC_LENGTH_2},
+	{"MQCBC_VERSION_1", MQCBC_VERSION_1},
+	{"MQCBC_VERSION_2", MQCBC_VERSION_2},
+	{"MQCBDO_DEREGISTER_CALL", MQCBDO_DEREGISTER_CALL},
+	{"MQCBDO_EVENT_CALL", MQCBDO_EVENT_CALL},
+	{"MQCBDO_FAIL_IF_QUIESCING", MQCBDO_FAIL_IF_QUIESCING},
+	{"MQCBDO_MC_EVENT_CALL", MQCBDO_MC_EVENT_CALL},
+	{"MQCBDO_NONE", MQCBDO_NONE},
+	{"MQCBDO_REGISTER_CALL", MQCBDO_REGISTER_CALL},
+	{"MQCBDO_START_CALL", MQCBDO_START_CALL},
+	{"MQCBDO_STOP_CALL", MQCBDO_STOP_CALL},
+	{"MQCBD_CURRENT_LENGTH", MQCBD_CURRENT_LENGTH},
+	{"MQCBD_CURRENT_VERSION", MQCBD_CURRENT_VERSION},
+	{"MQCBD_FULL_MSG_LENGTH", MQCBD_FULL_MSG_LENGTH},
+	{"MQCBD_LENGTH_1", MQCBD_LENGTH_1},
+	{"MQCBD_VERSION_1", MQCBD_VERSION_1},
+	{"MQCBO_ADMIN_BAG", MQCBO_ADMIN_BAG},
+	{"MQCBO_CHECK_SELECTORS", MQCBO_CHECK_SELECTORS},
+	{"MQCBO_COMMAND_BAG", MQCBO_COMMAND_BAG},
+	{"MQCBO_DO_NOT_CHECK_SELECTORS", MQCBO_DO_NOT_CHECK_SELECTORS},
+	{"MQCBO_DO_NOT_REORDER", MQCBO_DO_NOT_REORDER},
+	{"MQCBO_GROUP_BAG", MQCBO_GROUP_BAG},
+	{"MQCBO_LIST_FORM_ALLOWED", MQCBO_LIST_FORM_ALLOWED},
+	{"MQCBO_LIST_FORM_INHIBITED", MQCBO_LIST_FORM_INHIBITED},
+	{"MQCBO_NONE", MQCBO_NONE},
+	{"MQCBO_REORDER_AS_REQUIRED", MQCBO_REORDER_AS_REQUIRED},
+	{"MQCBO_SYSTEM_BAG", MQCBO_SYSTEM_BAG},
+	{"MQCBO_USER_BAG", MQCBO_USER_BAG},
+	{"MQCBT_EVENT_HANDLER", MQCBT_EVENT_HANDLER},
+	{"MQCBT_MESSAGE_CONSUMER", MQCBT_MESSAGE_CONSUMER},
+	{"MQCCSI_APPL", MQCCSI_APPL},
+	{"MQCCSI_AS_PUBLISHED", MQCCSI_AS_PUBLISHED},
+	{"MQCCSI_DEFAULT", MQCCSI_DEFAULT},
+	{"MQCCSI_EMBEDDED", MQCCSI_EMBEDDED},
+	{"MQCCSI_INHERIT", MQCCSI_INHERIT},
+	{"MQCCSI_Q_MGR", MQCCSI_Q_MGR},
+	{"MQCCSI_UNDEFINED", MQCCSI_UNDEFINED},
+	{"MQCCT_NO", MQCCT_NO},
+	{"MQCCT_YES", MQCCT_YES},
+	{"MQCC_FAILED", MQCC_FAILED},
+	{"MQCC_OK", MQCC_OK},
+	{"MQCC_UNKNOWN", MQCC_UNKNOWN},
+	{"MQCC_WARNING", MQCC_WARNING},
+	{"MQCDC_CURRENT_LENGTH", MQCDC_CURRENT_LENGTH},
+	{"MQCDC_CURRENT_VERSION", MQCDC_CURRENT_VERSION},
+	{"MQCDC_LENGTH_1", MQCDC_LENGTH_1},
+	{"MQCDC_LENGTH_10", MQCDC_LENGTH_10},
+	{"MQCDC_LENGTH_11", MQCDC_LENGTH_11},
+	{"MQCDC_LENGTH_12", MQCDC_LENGTH_12},
+	{"MQCDC_LENGTH_2", MQCDC_LENGTH_2},
+	{"MQCDC_LENGTH_3", MQCDC_LENGTH_3},
+	{"MQCDC_LENGTH_4", MQCDC_LENGTH_4},
+	{"MQCDC_LENGTH_5", MQCDC_LENGTH_5},
+	{"MQCDC_LENGTH_6", MQCDC_LENGTH_6},
+	{"MQCDC_LENGTH_7", MQCDC_LENGTH_7},
+	{"MQCDC_LENGTH_8", MQCDC_LENGTH_8},
+	{"MQCDC_LENGTH_9", MQCDC_LENGTH_9},
+	{"MQCDC_NO_SENDER_CONVERSION", MQCDC_NO_SENDER_CONVERSION},
+	{"MQCDC_SENDER_CONVERSION", MQCDC_SENDER_CONVERSION},
+	{"MQCDC_VERSION_1", MQCDC_VERSION_1},
+	{"MQCDC_VERSION_10", MQCDC_VERSION_10},
+	{"MQCDC_VERSION_11", MQCDC_VERSION_11},
+	{"MQCDC_VERSION_12", MQCDC_VERSION_12},
+	{"MQCDC_VERSION_2", MQCDC_VERSION_2},
+	{"MQCDC_VERSION_3", MQCDC_VERSION_3},
+	{"MQCDC_VERSION_4", MQCDC_VERSION_4},
+	{"MQCDC_VERSION_5", MQCDC_VERSION_5},
+	{"MQCDC_VERSION_6", MQCDC_VERSION_6},
+	{"MQCDC_VERSION_7", MQCDC_VERSION_7},
+	{"MQCDC_VERSION_8", MQCDC_VERSION_8},
+	{"MQCDC_VERSION_9", MQCDC_VERSION_9},
+	{"MQCD_CURRENT_LENGTH", MQCD_CURRENT_LENGTH},
+	{"MQCD_CURRENT_VERSION", MQCD_CURRENT_VERSION},
+	{"MQCD_LENGTH_1", MQCD_LENGTH_1},
+	{"MQCD_LENGTH_10", MQCD_LENGTH_10},
+	{"MQCD_LENGTH_11", MQCD_LENGTH_11},
+	{"MQCD_LENGTH_12", MQCD_LENGTH_12},
+	{"MQCD_LENGTH_2", MQCD_LENGTH_2},
+	{"MQCD_LENGTH_3", MQCD_LENGTH_3},
+	{"MQCD_LENGTH_4", MQCD_LENGTH_4},
+	{"MQCD_LENGTH_5", MQCD_LENGTH_5},
+	{"MQCD_LENGTH_6", MQCD_LENGTH_6},
+	{"MQCD_LENGTH_7", MQCD_LENGTH_7},
+	{"MQCD_LENGTH_8", MQCD_LENGTH_8},
+	{"MQCD_LENGTH_9", MQCD_LENGTH_9},
+	{"MQCD_VERSION_1", MQCD_VERSION_1},
+	{"MQCD_VERSION_10", MQCD_VERSION_10},
+	{"MQCD_VERSION_11", MQCD_VERSION_11},
+	{"MQCD_VERSION_12", MQCD_VERSION_12},
+	{"MQCD_VERSION_2", MQCD_VERSION_2},
+	{"MQCD_VERSION_3", MQCD_VERSION_3},
+	{"MQCD_VERSION_4", MQCD_VERSION_4},
+	{"MQCD_VERSION_5", MQCD_VERSION_5},
+	{"MQCD_VERSION_6", MQCD_VERSION_6},
+	{"MQCD_VERSION_7", MQCD_VERSION_7},
+	{"MQCD_VERSION_8", MQCD_VERSION_8},
+	{"MQCD_VERSION_9", MQCD_VERSION_9},
+	{"MQCEX_AS_PARENT", MQCEX_AS_PARENT},
+	{"MQCEX_NOLIMIT", MQCEX_NOLIMIT},
+	{"MQCFACCESS_DISABLED", MQCFACCESS_DISABLED},
+	{"MQCFACCESS_ENABLED", MQCFACCESS_ENABLED},
+	{"MQCFACCESS_SUSPENDED", MQCFACCESS_SUSPENDED},
+	{"MQCFBF_STRUC_LENGTH_FIXED", MQCFBF_STRUC_LENGTH_FIXED},
+	{"MQCFBS_STRUC_LENGTH_FIXED", MQCFBS_STRUC_LENGTH_FIXED},
+	{"MQCFCONLOS_ASQMGR", MQCFCONLOS_ASQMGR},
+	{"MQCFCONLOS_TERMINATE", MQCFCONLOS_TERMINATE},
+	{"MQCFCONLOS_TOLERATE", MQCFCONLOS_TOLERATE},
+	{"MQCFC_LAST", MQCFC_LAST},
+	{"MQCFC_NOT_LAST", MQCFC_NOT_LAST},
+	{"MQCFGR_STRUC_LENGTH", MQCFGR_STRUC_LENGTH},
+	{"MQCFH_CURRENT_VERSION", MQCFH_CURRENT_VERSION},
+	{"MQCFH_STRUC_LENGTH", MQCFH_STRUC_LENGTH},
+	{"MQCFH_VERSION_1", MQCFH_VERSION_1},
+	{"MQCFH_VERSION_2", MQCFH_VERSION_2},
+	{"MQCFH_VERSION_3", MQCFH_VERSION_3},
+	{"MQCFIF_STRUC_LENGTH", MQCFIF_STRUC_LENGTH},
+	{"MQCFIL64_STRUC_LENGTH_FIXED", MQCFIL64_STRUC_LENGTH_FIXED},
+	{"MQCFIL_STRUC_LENGTH_FIXED", MQCFIL_STRUC_LENGTH_FIXED},
+	{"MQCFIN64_STRUC_LENGTH", MQCFIN64_STRUC_LENGTH},
+	{"MQCFIN_STRUC_LENGTH", MQCFIN_STRUC_LENGTH},
+	{"MQCFOFFLD_BOTH", MQCFOFFLD_BOTH},
+	{"MQCFOFFLD_DB2", MQCFOFFLD_DB2},
+	{"MQCFOFFLD_NONE", MQCFOFFLD_NONE},
+	{"MQCFOFFLD_SMDS", MQCFOFFLD_SMDS},
+	{"MQCFOP_CONTAINS", MQCFOP_CONTAINS},
+	{"MQCFOP_CONTAINS_GEN", MQCFOP_CONTAINS_GEN},
+	{"MQCFOP_EQUAL", MQCFOP_EQUAL},
+	{"MQCFOP_EXCLUDES", MQCFOP_EXCLUDES},
+	{"MQCFOP_EXCLUDES_GEN", MQCFOP_EXCLUDES_GEN},
+	{"MQCFOP_GREATER", MQCFOP_GREATER},
+	{"MQCFOP_LESS", MQCFOP_LESS},
+	{"MQCFOP_LIKE", MQCFOP_LIKE},
+	{"MQCFOP_NOT_EQUAL", MQCFOP_NOT_EQUAL},
+	{"MQCFOP_NOT_GREATER", MQCFOP_NOT_GREATER},
+	{"MQCFOP_NOT_LESS", MQCFOP_NOT_LESS},
+	{"MQCFOP_NOT_LIKE", MQCFOP_NOT_LIKE},
+	{"MQCFO_REFRESH_REPOSITORY_NO", MQCFO_REFRESH_REPOSITORY_NO},
+	{"MQCFO_REFRESH_REPOSITORY_YES", MQCFO_REFRESH_REPOSITORY_YES},
+	{"MQCFO_REMOVE_QUEUES_NO", MQCFO_REMOVE_QUEUES_NO},
+	{"MQCFO_REMOVE_QUEUES_YES", MQCFO_REMOVE_QUEUES_YES},
+	{"MQCFR_NO", MQCFR_NO},
+	{"MQCFR_YES", MQCFR_YES},
+	{"MQCFSF_STRUC_LENGTH_FIXED", MQCFSF_STRUC_LENGTH_FIXED},
+	{"MQCFSL_STRUC_LENGTH_FIXED", MQCFSL_STRUC_LENGTH_FIXED},
+	{"MQCFSTATUS_ACTIVE", MQCFSTATUS_ACTIVE},
+	{"MQCFSTATUS_ADMIN_INCOMPLETE", MQCFSTATUS_ADMIN_INCOMPLETE},
+	{"MQCFSTATUS_EMPTY", MQCFSTATUS_EMPTY},
+	{"MQCFSTATUS_FAILED", MQCFSTATUS_FAILED},
+	{"MQCFSTATUS_IN_BACKUP", MQCFSTATUS_IN_BACKUP},
+	{"MQCFSTATUS_IN_RECOVER", MQCFSTATUS_IN_RECOVER},
+	{"MQCFSTATUS_NEVER_USED", MQCFSTATUS_NEVER_USED},
+	{"MQCFSTATUS_NEW", MQCFSTATUS_NEW},
+	{"MQCFSTATUS_NONE", MQCFSTATUS_NONE},
+	{"MQCFSTATUS_NOT_FAILED", MQCFSTATUS_NOT_FAILED},
+	{"MQCFSTATUS_NOT_FOUND", MQCFSTATUS_NOT_FOUND},
+	{"MQCFSTATUS_NOT_RECOVERABLE", MQCFSTATUS_NOT_RECOVERABLE},
+	{"MQCFSTATUS_NO_BACKUP", MQCFSTATUS_NO_BACKUP},
+	{"MQCFSTATUS_RECOVERED", MQCFSTATUS_RECOVERED},
+	{"MQCFSTATUS_UNKNOWN", MQCFSTATUS_UNKNOWN},
+	{"MQCFSTATUS_XES_ERROR", MQCFSTATUS_XES_ERROR},
+	{"MQCFST_STRUC_LENGTH_FIXED", MQCFST_STRUC_LENGTH_FIXED},
+	{"MQCFTYPE_ADMIN", MQCFTYPE_ADMIN},
+	{"MQCFTYPE_APPL", MQCFTYPE_APPL},
+	{"MQCFT_ACCOUNTING", MQCFT_ACCOUNTING},
+	{"MQCFT_APP_ACTIVITY", MQCFT_APP_ACTIVITY},
+	{"MQCFT_BYTE_STRING", MQCFT_BYTE_STRING},
+	{"MQCFT_BYTE_STRING_FILTER", MQCFT_BYTE_STRING_FILTER},
+	{"MQCFT_COMMAND", MQCFT_COMMAND},
+	{"MQCFT_COMMAND_XR", MQCFT_COMMAND_XR},
+	{"MQCFT_EVENT", MQCFT_EVENT},
+	{"MQCFT_GROUP", MQCFT_GROUP},
+	{"MQCFT_INTEGER", MQCFT_INTEGER},
+	{"MQCFT_INTEGER64", MQCFT_INTEGER64},
+	{"MQCFT_INTEGER64_LIST", MQCFT_INTEGER64_LIST},
+	{"MQCFT_INTEGER_FILTER", MQCFT_INTEGER_FILTER},
+	{"MQCFT_INTEGER_LIST", MQCFT_INTEGER_LIST},
+	{"MQCFT_NONE", MQCFT_NONE},
+	{"MQCFT_REPORT", MQCFT_REPORT},
+	{"MQCFT_RESPONSE", MQCFT_RESPONSE},
+	{"MQCFT_STATISTICS", MQCFT_STATISTICS},
+	{"MQCFT_STATUS", MQCFT_STATUS},
+	{"MQCFT_STRING", MQCFT_STRING},
+	{"MQCFT_STRING_FILTER", MQCFT_STRING_FILTER},
+	{"MQCFT_STRING_LIST", MQCFT_STRING_LIST},
+	{"MQCFT_TRACE_ROUTE", MQCFT_TRACE_ROUTE},
+	{"MQCFT_USER", MQCFT_USER},
+	{"MQCFT_XR_ITEM", MQCFT_XR_ITEM},
+	{"MQCFT_XR_MSG", MQCFT_XR_MSG},
+	{"MQCFT_XR_SUMMARY", MQCFT_XR_SUMMARY},
+	{"MQCF_DIST_LISTS", MQCF_DIST_LISTS},
+	{"MQCF_NONE", MQCF_NONE},
+	{"MQCGWI_DEFAULT", MQCGWI_DEFAULT},
+	{"MQCHAD_DISABLED", MQCHAD_DISABLED},
+	{"MQCHAD_ENABLED", MQCHAD_ENABLED},
+	{"MQCHIDS_INDOUBT", MQCHIDS_INDOUBT},
+	{"MQCHIDS_NOT_INDOUBT", MQCHIDS_NOT_INDOUBT},
+	{"MQCHK_AS_Q_MGR", MQCHK_AS_Q_MGR},
+	{"MQCHK_NONE", MQCHK_NONE},
+	{"MQCHK_OPTIONAL", MQCHK_OPTIONAL},
+	{"MQCHK_REQUIRED", MQCHK_REQUIRED},
+	{"MQCHK_REQUIRED_ADMIN", MQCHK_REQUIRED_ADMIN},
+	{"MQCHLA_DISABLED", MQCHLA_DISABLED},
+	{"MQCHLA_ENABLED", MQCHLA_ENABLED},
+	{"MQCHLD_ALL", MQCHLD_ALL},
+	{"MQCHLD_DEFAULT", MQCHLD_DEFAULT},
+	{"MQCHLD_FIXSHARED", MQCHLD_FIXSHARED},
+	{"MQCHLD_PRIVATE", MQCHLD_PRIVATE},
+	{"MQCHLD_SHARED", MQCHLD_SHARED},
+	{"MQCHRR_RESET_NOT_REQUESTED", MQCHRR_RESET_NOT_REQUESTED},
+	{"MQCHSH_RESTART_NO", MQCHSH_RESTART_NO},
+	{"MQCHSH_RESTART_YES", MQCHSH_RESTART_YES},
+	{"MQCHSR_STOP_NOT_REQUESTED", MQCHSR_STOP_NOT_REQUESTED},
+	{"MQCHSR_STOP_REQUESTED", MQCHSR_STOP_REQUESTED},
+	{"MQCHSSTATE_COMPRESSING", MQCHSSTATE_COMPRESSING},
+	{"MQCHSSTATE_END_OF_BATCH", MQCHSSTATE_END_OF_BATCH},
+	{"MQCHSSTATE_HEARTBEATING", MQCHSSTATE_HEARTBEATING},
+	{"MQCHSSTATE_IN_CHADEXIT", MQCHSSTATE_IN_CHADEXIT},
+	{"MQCHSSTATE_IN_MQGET", MQCHSSTATE_IN_MQGET},
+	{"MQCHSSTATE_IN_MQI_CALL", MQCHSSTATE_IN_MQI_CALL},
+	{"MQCHSSTATE_IN_MQPUT", MQCHSSTATE_IN_MQPUT},
+	{"MQCHSSTATE_IN_MREXIT", MQCHSSTATE_IN_MREXIT},
+	{"MQCHSSTATE_IN_MSGEXIT", MQCHSSTATE_IN_MSGEXIT},
+	{"MQCHSSTATE_IN_RCVEXIT", MQCHSSTATE_IN_RCVEXIT},
+	{"MQCHSSTATE_IN_SCYEXIT", MQCHSSTATE_IN_SCYEXIT},
+	{"MQCHSSTATE_IN_SENDEXIT", MQCHSSTATE_IN_SENDEXIT},
+	{"MQCHSSTATE_NAME_SERVER", MQCHSSTATE_NAME_SERVER},
+	{"MQCHSSTATE_NET_CONNECTING", MQCHSSTATE_NET_CONNECTING},
+	{"MQCHSSTATE_OTHER", MQCHSSTATE_OTHER},
+	{"MQCHSSTATE_RECEIVING", MQCHSSTATE_RECEIVING},
+	{"MQCHSSTATE_RESYNCHING", MQCHSSTATE_RESYNCHING},
+	{"MQCHSSTATE_SENDING", MQCHSSTATE_SENDING},
+	{"MQCHSSTATE_SERIALIZING", MQCHSSTATE_SERIALIZING},
+	{"MQCHSSTATE_SSL_HANDSHAKING", MQCHSSTATE_SSL_HANDSHAKING},
+	{"MQCHS_BINDING", MQCHS_BINDING},
+	{"MQCHS_DISCONNECTED", MQCHS_DISCONNECTED},
+	{"MQCHS_INACTIVE", MQCHS_INACTIVE},
+	{"MQCHS_INITIALIZING", MQCHS_INITIALIZING},
+	{"MQCHS_PAUSED", MQCHS_PAUSED},
+	{"MQCHS_REQUESTING", MQCHS_REQUESTING},
+	{"MQCHS_RETRYING", MQCHS_RETRYING},
+	{"MQCHS_RUNNING", MQCHS_RUNNING},
+	{"MQCHS_STARTING", MQCHS_STARTING},
+	{"MQCHS_STOPPED", MQCHS_STOPPED},
+	{"MQCHS_STOPPING", MQCHS_STOPPING},
+	{"MQCHS_SWITCHING", MQCHS_SWITCHING},
+	{"MQCHTAB_CLNTCONN", MQCHTAB_CLNTCONN},
+	{"MQCHTAB_Q_MGR", MQCHTAB_Q_MGR},
+	{"MQCHT_ALL", MQCHT_ALL},
+	{"MQCHT_AMQP", MQCHT_AMQP},
+	{"MQCHT_CLNTCONN", MQCHT_CLNTCONN},
+	{"MQCHT_CLUSRCVR", MQCHT_CLUSRCVR},
+	{"MQCHT_CLUSSDR", MQCHT_CLUSSDR},
+	{"MQCHT_MQTT", MQCHT_MQTT},
+	{"MQCHT_RECEIVER", MQCHT_RECEIVER},
+	{"MQCHT_REQUESTER", MQCHT_REQUESTER},
+	{"MQCHT_SENDER", MQCHT_SENDER},
+	{"MQCHT_SERVER", MQCHT_SERVER},
+	{"MQCHT_SVRCONN", MQCHT_SVRCONN},
+	{"MQCIH_CURRENT_LENGTH", MQCIH_CURRENT_LENGTH},
+	{"MQCIH_CURRENT_VERSION", MQCIH_CURRENT_VERSION},
+	{"MQCIH_LENGTH_1", MQCIH_LENGTH_1},
+	{"MQCIH_LENGTH_2", MQCIH_LENGTH_2},
+	{"MQCIH_NONE", MQCIH_NONE},
+	{"MQCIH_NO_SYNC_ON_RETURN", MQCIH_NO_SYNC_ON_RETURN},
+	{"MQCIH_PASS_EXPIRATION", MQCIH_PASS_EXPIRATION},
+	{"MQCIH_REPLY_WITHOUT_NULLS", MQCIH_REPLY_WITHOUT_NULLS},
+	{"MQCIH_REPLY_WITH_NULLS", MQCIH_REPLY_WITH_NULLS},
+	{"MQCIH_SYNC_ON_RETURN", MQCIH_SYNC_ON_RETURN},
+	{"MQCIH_UNLIMITED_EXPIRATION", MQCIH_UNLIMITED_EXPIRATION},
+	{"MQCIH_VERSION_1", MQCIH_VERSION_1},
+	{"MQCIH_VERSION_2", MQCIH_VERSION_2},
+	{"MQCIT_MULTICAST", MQCIT_MULTICAST},
+	{"MQCLCT_DYNAMIC", MQCLCT_DYNAMIC},
+	{"MQCLCT_STATIC", MQCLCT_STATIC},
+	{"MQCLROUTE_DIRECT", MQCLROUTE_DIRECT},
+	{"MQCLROUTE_NONE", MQCLROUTE_NONE},
+	{"MQCLROUTE_TOPIC_HOST", MQCLROUTE_TOPIC_HOST},
+	{"MQCLRS_GLOBAL", MQCLRS_GLOBAL},
+	{"MQCLRS_LOCAL", MQCLRS_LOCAL},
+	{"MQCLRT_RETAINED", MQCLRT_RETAINED},
+	{"MQCLST_ACTIVE", MQCLST_ACTIVE},
+	{"MQCLST_ERROR", MQCLST_ERROR},
+	{"MQCLST_INVALID", MQCLST_INVALID},
+	{"MQCLST_PENDING", MQCLST_PENDING},
+	{"MQCLT_PROGRAM", MQCLT_PROGRAM},
+	{"MQCLT_TRANSACTION", MQCLT_TRANSACTION},
+	{"MQCLWL_USEQ_ANY", MQCLWL_USEQ_ANY},
+	{"MQCLWL_USEQ_AS_Q_MGR", MQCLWL_USEQ_AS_Q_MGR},
+	{"MQCLWL_USEQ_LOCAL", MQCLWL_USEQ_LOCAL},
+	{"MQCLXQ_CHANNEL", MQCLXQ_CHANNEL},
+	{"MQCLXQ_SCTQ", MQCLXQ_SCTQ},
+	{"MQCMDI_BACKUP_STARTED", MQCMDI_BACKUP_STARTED},
+	{"MQCMDI_CHANNEL_INIT_STARTED", MQCMDI_CHANNEL_INIT_STARTED},
+	{"MQCMDI_CLUSTER_REQUEST_QUEUED", MQCMDI_CLUSTER_REQUEST_QUEUED},
+	{"MQCMDI_CMDSCOPE_ACCEPTED", MQCMDI_CMDSCOPE_ACCEPTED},
+	{"MQCMDI_CMDSCOPE_COMPLETED", MQCMDI_CMDSCOPE_COMPLETED},
+	{"MQCMDI_CMDSCOPE_GENERATED", MQCMDI_CMDSCOPE_GENERATED},
+	{"MQCMDI_COMMAND_ACCEPTED", MQCMDI_COMMAND_ACCEPTED},
+	{"MQCMDI_DB2_OBSOLETE_MSGS", MQCMDI_DB2_OBSOLETE_MSGS},
+	{"MQCMDI_DB2_SUSPENDED", MQCMDI_DB2_SUSPENDED},
+	{"MQCMDI_IMS_BRIDGE_SUSPENDED", MQCMDI_IMS_BRIDGE_SUSPENDED},
+	{"MQCMDI_QSG_DISP_COMPLETED", MQCMDI_QSG_DISP_COMPLETED},
+	{"MQCMDI_RECOVER_COMPLETED", MQCMDI_RECOVER_COMPLETED},
+	{"MQCMDI_RECOVER_STARTED", MQCMDI_RECOVER_STARTED},
+	{"MQCMDI_REFRESH_CONFIGURATION", MQCMDI_REFRESH_CONFIGURATION},
+	{"MQCMDI_SEC_MIXEDCASE", MQCMDI_SEC_MIXEDCASE},
+	{"MQCMDI_SEC_SIGNOFF_ERROR", MQCMDI_SEC_SIGNOFF_ERROR},
+	{"MQCMDI_SEC_TIMER_ZERO", MQCMDI_SEC_TIMER_ZERO},
+	{"MQCMDI_SEC_UPPERCASE", MQCMDI_SEC_UPPERCASE},
+	{"MQCMDL_CURRENT_LEVEL", MQCMDL_CURRENT_LEVEL},
+	{"MQCMDL_LEVEL_1", MQCMDL_LEVEL_1},
+	{"MQCMDL_LEVEL_101", MQCMDL_LEVEL_101},
+	{"MQCMDL_LEVEL_110", MQCMDL_LEVEL_110},
+	{"MQCMDL_LEVEL_114", MQCMDL_LEVEL_114},
+	{"MQCMDL_LEVEL_120", MQCMDL_LEVEL_120},
+	{"MQCMDL_LEVEL_200", MQCMDL_LEVEL_200},
+	{"MQCMDL_LEVEL_201", MQCMDL_LEVEL_201},
+	{"MQCMDL_LEVEL_210", MQCMDL_LEVEL_210},
+	{"MQCMDL_LEVEL_211", MQCMDL_LEVEL_211},
+	{"MQCMDL_LEVEL_220", MQCMDL_LEVEL_220},
+	{"MQCMDL_LEVEL_221", MQCMDL_LEVEL_221},
+	{"MQCMDL_LEVEL_230", MQCMDL_LEVEL_230},
+	{"MQCMDL_LEVEL_320", MQCMDL_LEVEL_320},
+	{"MQCMDL_LEVEL_420", MQCMDL_LEVEL_420},
+	{"MQCMDL_LEVEL_500", MQCMDL_LEVEL_500},
+	{"MQCMDL_LEVEL_510", MQCMDL_LEVEL_510},
+	{"MQCMDL_LEVEL_520", MQCMDL_LEVEL_520},
+	{"MQCMDL_LEVEL_530", MQCMDL_LEVEL_530},
+	{"MQCMDL_LEVEL_531", MQCMDL_LEVEL_531},
+	{"MQCMDL_LEVEL_600", MQCMDL_LEVEL_600},
+	{"MQCMDL_LEVEL_700", MQCMDL_LEVEL_700},
+	{"MQCMDL_LEVEL_701", MQCMDL_LEVEL_701},
+	{"MQCMDL_LEVEL_710", MQCMDL_LEVEL_710},
+	{"MQCMDL_LEVEL_711", MQCMDL_LEVEL_711},
+	{"MQCMDL_LEVEL_750", MQCMDL_LEVEL_750},
+	{"MQCMDL_LEVEL_800", MQCMDL_LEVEL_800},
+	{"MQCMDL_LEVEL_801", MQCMDL_LEVEL_801},
+	{"MQCMDL_LEVEL_802", MQCMDL_LEVEL_802},
+	{"MQCMDL_LEVEL_900", MQCMDL_LEVEL_900},
+	{"MQCMDL_LEVEL_901", MQCMDL_LEVEL_901},
+	{"MQCMDL_LEVEL_902", MQCMDL_LEVEL_902},
+	{"MQCMDL_LEVEL_903", MQCMDL_LEVEL_903},
+	{"MQCMDL_LEVEL_904", MQCMDL_LEVEL_904},
+	{"MQCMDL_LEVEL_905", MQCMDL_LEVEL_905},
+	{"MQCMDL_LEVEL_910", MQCMDL_LEVEL_910},
+	{"MQCMDL_LEVEL_911", MQCMDL_LEVEL_911},
+	{"MQCMDL_LEVEL_912", MQCMDL_LEVEL_912},
+	{"MQCMDL_LEVEL_913", MQCMDL_LEVEL_913},
+	{"MQCMDL_LEVEL_914", MQCMDL_LEVEL_914},
+	{"MQCMDL_LEVEL_915", MQCMDL_LEVEL_915},
+	{"MQCMDL_LEVEL_920", MQCMDL_LEVEL_920},
+	{"MQCMDL_LEVEL_921", MQCMDL_LEVEL_921},
+	{"MQCMDL_LEVEL_922", MQCMDL_LEVEL_922},
+	{"MQCMDL_LEVEL_923", MQCMDL_LEVEL_923},
+	{"MQCMDL_LEVEL_924", MQCMDL_LEVEL_924},
+	{"MQCMDL_LEVEL_925", MQCMDL_LEVEL_925},
+	{"MQCMDL_LEVEL_930", MQCMDL_LEVEL_930},
+	{"MQCMDL_LEVEL_931", MQCMDL_LEVEL_931},
+	{"MQCMDL_LEVEL_932", MQCMDL_LEVEL_932},
+	{"MQCMDL_LEVEL_933", MQCMDL_LEVEL_933},
+	{"MQCMDL_LEVEL_934", MQCMDL_LEVEL_934},
+	{"MQCMDL_LEVEL_935", MQCMDL_LEVEL_935},
+	{"MQCMDL_LEVEL_940", MQCMDL_LEVEL_940},
+	{"MQCMDL_LEVEL_941", MQCMDL_LEVEL_941},
+	{"MQCMDL_LEVEL_942", MQCMDL_LEVEL_942},
+	{"MQCMDL_LEVEL_943", MQCMDL_LEVEL_943},
+	{"MQCMDL_LEVEL_944", MQCMDL_LEVEL_944},
+	{"MQCMDL_LEVEL_945", MQCMDL_LEVEL_945},
+	{"MQCMD_ACCOUNTING_MQI", MQCMD_ACCOUNTING_MQI},
+	{"MQCMD_ACCOUNTING_Q", MQCMD_ACCOUNTING_Q},
+	{"MQCMD_ACTIVITY_MSG", MQCMD_ACTIVITY_MSG},
+	{"MQCMD_ACTIVITY_TRACE", MQCMD_ACTIVITY_TRACE},
+	{"MQCMD_AMQP_DIAGNOSTICS", MQCMD_AMQP_DIAGNOSTICS},
+	{"MQCMD_ARCHIVE_LOG", MQCMD_ARCHIVE_LOG},
+	{"MQCMD_BACKUP_CF_STRUC", MQCMD_BACKUP_CF_STRUC},
+	{"MQCMD_BROKER_INTERNAL", MQCMD_BROKER_INTERNAL},
+	{"MQCMD_CHANGE_AUTH_INFO", MQCMD_CHANGE_AUTH_INFO},
+	{"MQCMD_CHANGE_BUFFER_POOL", MQCMD_CHANGE_BUFFER_POOL},
+	{"MQCMD_CHANGE_CF_STRUC", MQCMD_CHANGE_CF_STRUC},
+	{"MQCMD_CHANGE_CHANNEL", MQCMD_CHANGE_CHANNEL},
+	{"MQCMD_CHANGE_COMM_INFO", MQCMD_CHANGE_COMM_INFO},
+	{"MQCMD_CHANGE_LISTENER", MQCMD_CHANGE_LISTENER},
+	{"MQCMD_CHANGE_NAMELIST", MQCMD_CHANGE_NAMELIST},
+	{"MQCMD_CHANGE_PAGE_SET", MQCMD_CHANGE_PAGE_SET},
+	{"MQCMD_CHANGE_PROCESS", MQCMD_CHANGE_PROCESS},
+	{"MQCMD_CHANGE_PROT_POLICY", MQCMD_CHANGE_PROT_POLICY},
+	{"MQCMD_CHANGE_Q", MQCMD_CHANGE_Q},
+	{"MQCMD_CHANGE_Q_MGR", MQCMD_CHANGE_Q_MGR},
+	{"MQCMD_CHANGE_SECURITY", MQCMD_CHANGE_SECURITY},
+	{"MQCMD_CHANGE_SERVICE", MQCMD_CHANGE_SERVICE},
+	{"MQCMD_CHANGE_SMDS", MQCMD_CHANGE_SMDS},
+	{"MQCMD_CHANGE_STG_CLASS", MQCMD_CHANGE_STG_CLASS},
+	{"MQCMD_CHANGE_SUBSCRIPTION", MQCMD_CHANGE_SUBSCRIPTION},
+	{"MQCMD_CHANGE_TOPIC", MQCMD_CHANGE_TOPIC},
+	{"MQCMD_CHANGE_TRACE", MQCMD_CHANGE_TRACE},
+	{"MQCMD_CHANNEL_EVENT", MQCMD_CHANNEL_EVENT},
+	{"MQCMD_CLEAR_Q", MQCMD_CLEAR_Q},
+	{"MQCMD_CLEAR_TOPIC_STRING", MQCMD_CLEAR_TOPIC_STRING},
+	{"MQCMD_COMMAND_EVENT", MQCMD_COMMAND_EVENT},
+	{"MQCMD_CONFIG_EVENT", MQCMD_CONFIG_EVENT},
+	{"MQCMD_COPY_AUTH_INFO", MQCMD_COPY_AUTH_INFO},
+	{"MQCMD_COPY_CF_STRUC", MQCMD_COPY_CF_STRUC},
+	{"MQCMD_COPY_CHANNEL", MQCMD_COPY_CHANNEL},
+	{"MQCMD_COPY_COMM_INFO", MQCMD_COPY_COMM_INFO},
+	{"MQCMD_COPY_LISTENER", MQCMD_COPY_LISTENER},
+	{"MQCMD_COPY_NAMELIST", MQCMD_COPY_NAMELIST},
+	{"MQCMD_COPY_PROCESS", MQCMD_COPY_PROCESS},
+	{"MQCMD_COPY_Q", MQCMD_COPY_Q},
+	{"MQCMD_COPY_SERVICE", MQCMD_COPY_SERVICE},
+	{"MQCMD_COPY_STG_CLASS", MQCMD_COPY_STG_CLASS},
+	{"MQCMD_COPY_SUBSCRIPTION", MQCMD_COPY_SUBSCRIPTION},
+	{"MQCMD_COPY_TOPIC", MQCMD_COPY_TOPIC},
+	{"MQCMD_CREATE_AUTH_INFO", MQCMD_CREATE_AUTH_INFO},
+	{"MQCMD_CREATE_BUFFER_POOL", MQCMD_CREATE_BUFFER_POOL},
+	{"MQCMD_CREATE_CF_STRUC", MQCMD_CREATE_CF_STRUC},
+	{"MQCMD_CREATE_CHANNEL", MQCMD_CREATE_CHANNEL},
+	{"MQCMD_CREATE_COMM_INFO", MQCMD_CREATE_COMM_INFO},
+	{"MQCMD_CREATE_LISTENER", MQCMD_CREATE_LISTENER},
+	{"MQCMD_CREATE_LOG", MQCMD_CREATE_LOG},
+	{"MQCMD_CREATE_NAMELIST", MQCMD_CREATE_NAMELIST},
+	{"MQCMD_CREATE_PAGE_SET", MQCMD_CREATE_PAGE_SET},
+	{"MQCMD_CREATE_PROCESS", MQCMD_CREATE_PROCESS},
+	{"MQCMD_CREATE_PROT_POLICY", MQCMD_CREATE_PROT_POLICY},
+	{"MQCMD_CREATE_Q", MQCMD_CREATE_Q},
+	{"MQCMD_CREATE_SERVICE", MQCMD_CREATE_SERVICE},
+	{"MQCMD_CREATE_STG_CLASS", MQCMD_CREATE_STG_CLASS},
+	{"MQCMD_CREATE_SUBSCRIPTION", MQCMD_CREATE_SUBSCRIPTION},
+	{"MQCMD_CREATE_TOPIC", MQCMD_CREATE_TOPIC},
+	{"MQCMD_DELETE_AUTH_INFO", MQCMD_DELETE_AUTH_INFO},
+	{"MQCMD_DELETE_AUTH_REC", MQCMD_DELETE_AUTH_REC},
+	{"MQCMD_DELETE_BUFFER_POOL", MQCMD_DELETE_BUFFER_POOL},
+	{"MQCMD_DELETE_CF_STRUC", MQCMD_DELETE_CF_STRUC},
+	{"MQCMD_DELETE_CHANNEL", MQCMD_DELETE_CHANNEL},
+	{"MQCMD_DELETE_COMM_INFO", MQCMD_DELETE_COMM_INFO},
+	{"MQCMD_DELETE_LISTENER", MQCMD_DELETE_LISTENER},
+	{"MQCMD_DELETE_NAMELIST", MQCMD_DELETE_NAMELIST},
+	{"MQCMD_DELETE_PAGE_SET", MQCMD_DELETE_PAGE_SET},
+	{"MQCMD_DELETE_PROCESS", MQCMD_DELETE_PROCESS},
+	{"MQCMD_DELETE_PROT_POLICY", MQCMD_DELETE_PROT_POLICY},
+	{"MQCMD_DELETE_PUBLICATION", MQCMD_DELETE_PUBLICATION},
+	{"MQCMD_DELETE_Q", MQCMD_DELETE_Q},
+	{"MQCMD_DELETE_SERVICE", MQCMD_DELETE_SERVICE},
+	{"MQCMD_DELETE_STG_CLASS", MQCMD_DELETE_STG_CLASS},
+	{"MQCMD_DELETE_SUBSCRIPTION", MQCMD_DELETE_SUBSCRIPTION},
+	{"MQCMD_DELETE_TOPIC", MQCMD_DELETE_TOPIC},
+	{"MQCMD_DEREGISTER_PUBLISHER", MQCMD_DEREGISTER_PUBLISHER},
+	{"MQCMD_DEREGISTER_SUBSCRIBER", MQCMD_DEREGISTER_SUBSCRIBER},
+	{"MQCMD_ESCAPE", MQCMD_ESCAPE},
+	{"MQCMD_INQUIRE_AMQP_CAPABILITY", MQCMD_INQUIRE_AMQP_CAPABILITY},
+	{"MQCMD_INQUIRE_APPL_STATUS", MQCMD_INQUIRE_APPL_STATUS},
+	{"MQCMD_INQUIRE_ARCHIVE", MQCMD_INQUIRE_ARCHIVE},
+	{"MQCMD_INQUIRE_AUTH_INFO", MQCMD_INQUIRE_AUTH_INFO},
+	{"MQCMD_INQUIRE_AUTH_INFO_NAMES", MQCMD_INQUIRE_AUTH_INFO_NAMES},
+	{"MQCMD_INQUIRE_AUTH_RECS", MQCMD_INQUIRE_AUTH_RECS},
+	{"MQCMD_INQUIRE_AUTH_SERVICE", MQCMD_INQUIRE_AUTH_SERVICE},
+	{"MQCMD_INQUIRE_CF_STRUC", MQCMD_INQUIRE_CF_STRUC},
+	{"MQCMD_INQUIRE_CF_STRUC_NAMES", MQCMD_INQUIRE_CF_STRUC_NAMES},
+	{"MQCMD_INQUIRE_CF_STRUC_STATUS", MQCMD_INQUIRE_CF_STRUC_STATUS},
+	{"MQCMD_INQUIRE_CHANNEL", MQCMD_INQUIRE_CHANNEL},
+	{"MQCMD_INQUIRE_CHANNEL_INIT", MQCMD_INQUIRE_CHANNEL_INIT},
+	{"MQCMD_INQUIRE_CHANNEL_NAMES", MQCMD_INQUIRE_CHANNEL_NAMES},
+	{"MQCMD_INQUIRE_CHANNEL_STATUS", MQCMD_INQUIRE_CHANNEL_STATUS},
+	{"MQCMD_INQUIRE_CHLAUTH_RECS", MQCMD_INQUIRE_CHLAUTH_RECS},
+	{"MQCMD_INQUIRE_CLUSTER_Q_MGR", MQCMD_INQUIRE_CLUSTER_Q_MGR},
+	{"MQCMD_INQUIRE_CMD_SERVER", MQCMD_INQUIRE_CMD_SERVER},
+	{"MQCMD_INQUIRE_COMM_INFO", MQCMD_INQUIRE_COMM_INFO},
+	{"MQCMD_INQUIRE_CONNECTION", MQCMD_INQUIRE_CONNECTION},
+	{"MQCMD_INQUIRE_ENTITY_AUTH", MQCMD_INQUIRE_ENTITY_AUTH},
+	{"MQCMD_INQUIRE_ERROR_LOG", MQCMD_INQUIRE_ERROR_LOG},
+	{"MQCMD_INQUIRE_LISTENER", MQCMD_INQUIRE_LISTENER},
+	{"MQCMD_INQUIRE_LISTENER_STATUS", MQCMD_INQUIRE_LISTENER_STATUS},
+	{"MQCMD_INQUIRE_LOG", MQCMD_INQUIRE_LOG},
+	{"MQCMD_INQUIRE_MQXR_STATUS", MQCMD_INQUIRE_MQXR_STATUS},
+	{"MQCMD_INQUIRE_NAMELIST", MQCMD_INQUIRE_NAMELIST},
+	{"MQCMD_INQUIRE_NAMELIST_NAMES", MQCMD_INQUIRE_NAMELIST_NAMES},
+	{"MQCMD_INQUIRE_PROCESS", MQCMD_INQUIRE_PROCESS},
+	{"MQCMD_INQUIRE_PROCESS_NAMES", MQCMD_INQUIRE_PROCESS_NAMES},
+	{"MQCMD_INQUIRE_PROT_POLICY", MQCMD_INQUIRE_PROT_POLICY},
+	{"MQCMD_INQUIRE_PUBSUB_STATUS", MQCMD_INQUIRE_PUBSUB_STATUS},
+	{"MQCMD_INQUIRE_Q", MQCMD_INQUIRE_Q},
+	{"MQCMD_INQUIRE_QSG", MQCMD_INQUIRE_QSG},
+	{"MQCMD_INQUIRE_Q_MGR", MQCMD_INQUIRE_Q_MGR},
+	{"MQCMD_INQUIRE_Q_MGR_STATUS", MQCMD_INQUIRE_Q_MGR_STATUS},
+	{"MQCMD_INQUIRE_Q_NAMES", MQCMD_INQUIRE_Q_NAMES},
+	{"MQCMD_INQUIRE_Q_STATUS", MQCMD_INQUIRE_Q_STATUS},
+	{"MQCMD_INQUIRE_SECURITY", MQCMD_INQUIRE_SECURITY},
+	{"MQCMD_INQUIRE_SERVICE", MQCMD_INQUIRE_SERVICE},
+	{"MQCMD_INQUIRE_SERVICE_STATUS", MQCMD_INQUIRE_SERVICE_STATUS},
+	{"MQCMD_INQUIRE_SMDS", MQCMD_INQUIRE_SMDS},
+	{"MQCMD_INQUIRE_SMDSCONN", MQCMD_INQUIRE_SMDSCONN},
+	{"MQCMD_INQUIRE_STG_CLASS", MQCMD_INQUIRE_STG_CLASS},
+	{"MQCMD_INQUIRE_STG_CLASS_NAMES", MQCMD_INQUIRE_STG_CLASS_NAMES},
+	{"MQCMD_INQUIRE_SUBSCRIPTION", MQCMD_INQUIRE_SUBSCRIPTION},
+	{"MQCMD_INQUIRE_SUB_STATUS", MQCMD_INQUIRE_SUB_STATUS},
+	{"MQCMD_INQUIRE_SYSTEM", MQCMD_INQUIRE_SYSTEM},
+	{"MQCMD_INQUIRE_THREAD", MQCMD_INQUIRE_THREAD},
+	{"MQCMD_INQUIRE_TOPIC", MQCMD_INQUIRE_TOPIC},
+	{"MQCMD_INQUIRE_TOPIC_NAMES", MQCMD_INQUIRE_TOPIC_NAMES},
+	{"MQCMD_INQUIRE_TOPIC_STATUS", MQCMD_INQUIRE_TOPIC_STATUS},
+	{"MQCMD_INQUIRE_TRACE", MQCMD_INQUIRE_TRACE},
+	{"MQCMD_INQUIRE_USAGE", MQCMD_INQUIRE_USAGE},
+	{"MQCMD_INQUIRE_XR_CAPABILITY", MQCMD_INQUIRE_XR_CAPABILITY},
+	{"MQCMD_INTER_Q_MGR_BALANCE", MQCMD_INTER_Q_MGR_BALANCE},
+	{"MQCMD_INTER_Q_MGR_STATUS", MQCMD_INTER_Q_MGR_STATUS},
+	{"MQCMD_LOGGER_EVENT", MQCMD_LOGGER_EVENT},
+	{"MQCMD_MOVE_Q", MQCMD_MOVE_Q},
+	{"MQCMD_MQXR_DIAGNOSTICS", MQCMD_MQXR_DIAGNOSTICS},
+	{"MQCMD_NONE", MQCMD_NONE},
+	{"MQCMD_PERFM_EVENT", MQCMD_PERFM_EVENT},
+	{"MQCMD_PING_CHANNEL", MQCMD_PING_CHANNEL},
+	{"MQCMD_PING_Q_MGR", MQCMD_PING_Q_MGR},
+	{"MQCMD_PUBLISH", MQCMD_PUBLISH},
+	{"MQCMD_PURGE_CHANNEL", MQCMD_PURGE_CHANNEL},
+	{"MQCMD_Q_MGR_EVENT", MQCMD_Q_MGR_EVENT},
+	{"MQCMD_RECOVER_BSDS", MQCMD_RECOVER_BSDS},
+	{"MQCMD_RECOVER_CF_STRUC", MQCMD_RECOVER_CF_STRUC},
+	{"MQCMD_REFRESH_CLUSTER", MQCMD_REFRESH_CLUSTER},
+	{"MQCMD_REFRESH_Q_MGR", MQCMD_REFRESH_Q_MGR},
+	{"MQCMD_REFRESH_SECURITY", MQCMD_REFRESH_SECURITY},
+	{"MQCMD_REGISTER_PUBLISHER", MQCMD_REGISTER_PUBLISHER},
+	{"MQCMD_REGISTER_SUBSCRIBER", MQCMD_REGISTER_SUBSCRIBER},
+	{"MQCMD_REQUEST_UPDATE", MQCMD_REQUEST_UPDATE},
+	{"MQCMD_RESET_CF_STRUC", MQCMD_RESET_CF_STRUC},
+	{"MQCMD_RESET_CHANNEL", MQCMD_RESET_CHANNEL},
+	{"MQCMD_RESET_CLUSTER", MQCMD_RESET_CLUSTER},
+	{"MQCMD_RESET_Q_MGR", MQCMD_RESET_Q_MGR},
+	{"MQCMD_RESET_Q_STATS", MQCMD_RESET_Q_STATS},
+	{"MQCMD_RESET_SMDS", MQCMD_RESET_SMDS},
+	{"MQCMD_RESET_TPIPE", MQCMD_RESET_TPIPE},
+	{"MQCMD_RESOLVE_CHANNEL", MQCMD_RESOLVE_CHANNEL},
+	{"MQCMD_RESOLVE_INDOUBT", MQCMD_RESOLVE_INDOUBT},
+	{"MQCMD_RESUME_Q_MGR", MQCMD_RESUME_Q_MGR},
+	{"MQCMD_RESUME_Q_MGR_CLUSTER", MQCMD_RESUME_Q_MGR_CLUSTER},
+	{"MQCMD_REVERIFY_SECURITY", MQCMD_REVERIFY_SECURITY},
+	{"MQCMD_SET_ARCHIVE", MQCMD_SET_ARCHIVE},
+	{"MQCMD_SET_AUTH_REC", MQCMD_SET_AUTH_REC},
+	{"MQCMD_SET_CHLAUTH_REC", MQCMD_SET_CHLAUTH_REC},
+	{"MQCMD_SET_LOG", MQCMD_SET_LOG},
+	{"MQCMD_SET_PROT_POLICY", MQCMD_SET_PROT_POLICY},
+	{"MQCMD_SET_SYSTEM", MQCMD_SET_SYSTEM},
+	{"MQCMD_START_CHANNEL", MQCMD_START_CHANNEL},
+	{"MQCMD_START_CHANNEL_INIT", MQCMD_START_CHANNEL_INIT},
+	{"MQCMD_START_CHANNEL_LISTENER", MQCMD_START_CHANNEL_LISTENER},
+	{"MQCMD_START_CLIENT_TRACE", MQCMD_START_CLIENT_TRACE},
+	{"MQCMD_START_CMD_SERVER", MQCMD_START_CMD_SERVER},
+	{"MQCMD_START_Q_MGR", MQCMD_START_Q_MGR},
+	{"MQCMD_START_SERVICE", MQCMD_START_SERVICE},
+	{"MQCMD_START_SMDSCONN", MQCMD_START_SMDSCONN},
+	{"MQCMD_START_TRACE", MQCMD_START_TRACE},
+	{"MQCMD_STATISTICS_CHANNEL", MQCMD_STATISTICS_CHANNEL},
+	{"MQCMD_STATISTICS_MQI", MQCMD_STATISTICS_MQI},
+	{"MQCMD_STATISTICS_Q", MQCMD_STATISTICS_Q},
+	{"MQCMD_STOP_CHANNEL", MQCMD_STOP_CHANNEL},
+	{"MQCMD_STOP_CHANNEL_INIT", MQCMD_STOP_CHANNEL_INIT},
+	{"MQCMD_STOP_CHANNEL_LISTENER", MQCMD_STOP_CHANNEL_LISTENER},
+	{"MQCMD_STOP_CLIENT_TRACE", MQCMD_STOP_CLIENT_TRACE},
+	{"MQCMD_STOP_CMD_SERVER", MQCMD_STOP_CMD_SERVER},
+	{"MQCMD_STOP_CONNECTION", MQCMD_STOP_CONNECTION},
+	{"MQCMD_STOP_Q_MGR", MQCMD_STOP_Q_MGR},
+	{"MQCMD_STOP_SERVICE", MQCMD_STOP_SERVICE},
+	{"MQCMD_STOP_SMDSCONN", MQCMD_STOP_SMDSCONN},
+	{"MQCMD_STOP_TRACE", MQCMD_STOP_TRACE},
+	{"MQCMD_SUSPEND_Q_MGR", MQCMD_SUSPEND_Q_MGR},
+	{"MQCMD_SUSPEND_Q_MGR_CLUSTER", MQCMD_SUSPEND_Q_MGR_CLUSTER},
+	{"MQCMD_TRACE_ROUTE", MQCMD_TRACE_ROUTE},
+	{"MQCMHO_CURRENT_LENGTH", MQCMHO_CURRENT_LENGTH},
+	{"MQCMHO_CURRENT_VERSION", MQCMHO_CURRENT_VERSION},
+	{"MQCMHO_DEFAULT_VALIDATION", MQCMHO_DEFAULT_VALIDATION},
+	{"MQCMHO_LENGTH_1", MQCMHO_LENGTH_1},
+	{"MQCMHO_NONE", MQCMHO_NONE},
+	{"MQCMHO_NO_VALIDATION", MQCMHO_NO_VALIDATION},
+	{"MQCMHO_VALIDATE", MQCMHO_VALIDATE},
+	{"MQCMHO_VERSION_1", MQCMHO_VERSION_1},
+	{"MQCNO_ACCOUNTING_MQI_DISABLED", MQCNO_ACCOUNTING_MQI_DISABLED},
+	{"MQCNO_ACCOUNTING_MQI_ENABLED", MQCNO_ACCOUNTING_MQI_ENABLED},
+	{"MQCNO_ACCOUNTING_Q_DISABLED", MQCNO_ACCOUNTING_Q_DISABLED},
+	{"MQCNO_ACCOUNTING_Q_ENABLED", MQCNO_ACCOUNTING_Q_ENABLED},
+	{"MQCNO_ACTIVITY_TRACE_DISABLED", MQCNO_ACTIVITY_TRACE_DISABLED},
+	{"MQCNO_ACTIVITY_TRACE_ENABLED", MQCNO_ACTIVITY_TRACE_ENABLED},
+	{"MQCNO_ALL_CONVS_SHARE", MQCNO_ALL_CONVS_SHARE},
+	{"MQCNO_CD_FOR_OUTPUT_ONLY", MQCNO_CD_FOR_OUTPUT_ONLY},
+	{"MQCNO_CLIENT_BINDING", MQCNO_CLIENT_BINDING},
+	{"MQCNO_CURRENT_LENGTH", MQCNO_CURRENT_LENGTH},
+	{"MQCNO_CURRENT_VERSION", MQCNO_CURRENT_VERSION},
+	{"MQCNO_FASTPATH_BINDING", MQCNO_FASTPATH_BINDING},
+	{"MQCNO_GENERATE_CONN_TAG", MQCNO_GENERATE_CONN_TAG},
+	{"MQCNO_HANDLE_SHARE_BLOCK", MQCNO_HANDLE_SHARE_BLOCK},
+	{"MQCNO_HANDLE_SHARE_NONE", MQCNO_HANDLE_SHARE_NONE},
+	{"MQCNO_HANDLE_SHARE_NO_BLOCK", MQCNO_HANDLE_SHARE_NO_BLOCK},
+	{"MQCNO_ISOLATED_BINDING", MQCNO_ISOLATED_BINDING},
+	{"MQCNO_LENGTH_1", MQCNO_LENGTH_1},
+	{"MQCNO_LENGTH_2", MQCNO_LENGTH_2},
+	{"MQCNO_LENGTH_3", MQCNO_LENGTH_3},
+	{"MQCNO_LENGTH_4", MQCNO_LENGTH_4},
+	{"MQCNO_LENGTH_5", MQCNO_LENGTH_5},
+	{"MQCNO_LENGTH_6", MQCNO_LENGTH_6},
+	{"MQCNO_LENGTH_7", MQCNO_LENGTH_7},
+	{"MQCNO_LENGTH_8", MQCNO_LENGTH_8},
+	{"MQCNO_LOCAL_BINDING", MQCNO_LOCAL_BINDING},
+	{"MQCNO_NONE", MQCNO_NONE},
+	{"MQCNO_NO_CONV_SHARING", MQCNO_NO_CONV_SHARING},
+	{"MQCNO_RECONNECT", MQCNO_RECONNECT},
+	{"MQCNO_RECONNECT_AS_DEF", MQCNO_RECONNECT_AS_DEF},
+	{"MQCNO_RECONNECT_DISABLED", MQCNO_RECONNECT_DISABLED},
+	{"MQCNO_RECONNECT_Q_MGR", MQCNO_RECONNECT_Q_MGR},
+	{"MQCNO_RESTRICT_CONN_TAG_QSG", MQCNO_RESTRICT_CONN_TAG_QSG},
+	{"MQCNO_RESTRICT_CONN_TAG_Q_MGR", MQCNO_RESTRICT_CONN_TAG_Q_MGR},
+	{"MQCNO_SERIALIZE_CONN_TAG_QSG", MQCNO_SERIALIZE_CONN_TAG_QSG},
+	{"MQCNO_SERIALIZE_CONN_TAG_Q_MGR", MQCNO_SERIALIZE_CONN_TAG_Q_MGR},
+	{"MQCNO_SHARED_BINDING", MQCNO_SHARED_BINDING},
+	{"MQCNO_STANDARD_BINDING", MQCNO_STANDARD_BINDING},
+	{"MQCNO_USE_CD_SELECTION", MQCNO_USE_CD_SELECTION},
+	{"MQCNO_VERSION_1", MQCNO_VERSION_1},
+	{"MQCNO_VERSION_2", MQCNO_VERSION_2},
+	{"MQCNO_VERSION_3", MQCNO_VERSION_3},
+	{"MQCNO_VERSION_4", MQCNO_VERSION_4},
+	{"MQCNO_VERSION_5", MQCNO_VERSION_5},
+	{"MQCNO_VERSION_6", MQCNO_VERSION_6},
+	{"MQCNO_VERSION_7", MQCNO_VERSION_7},
+	{"MQCNO_VERSION_8", MQCNO_VERSION_8},
+	{"MQCODL_AS_INPUT", MQCODL_AS_INPUT},
+	{"MQCOMPRESS_ANY", MQCOMPRESS_ANY},
+	{"MQCOMPRESS_LZ4FAST", MQCOMPRESS_LZ4FAST},
+	{"MQCOMPRESS_LZ4HIGH", MQCOMPRESS_LZ4HIGH},
+	{"MQCOMPRESS_NONE", MQCOMPRESS_NONE},
+	{"MQCOMPRESS_NOT_AVAILABLE", MQCOMPRESS_NOT_AVAILABLE},
+	{"MQCOMPRESS_RLE", MQCOMPRESS_RLE},
+	{"MQCOMPRESS_SYSTEM", MQCOMPRESS_SYSTEM},
+	{"MQCOMPRESS_ZLIBFAST", MQCOMPRESS_ZLIBFAST},
+	{"MQCOMPRESS_ZLIBHIGH", MQCOMPRESS_ZLIBHIGH},
+	{"MQCOPY_ALL", MQCOPY_ALL},
+	{"MQCOPY_DEFAULT", MQCOPY_DEFAULT},
+	{"MQCOPY_FORWARD", MQCOPY_FORWARD},
+	{"MQCOPY_NONE", MQCOPY_NONE},
+	{"MQCOPY_PUBLISH", MQCOPY_PUBLISH},
+	{"MQCOPY_REPLY", MQCOPY_REPLY},
+	{"MQCOPY_REPORT", MQCOPY_REPORT},
+	{"MQCO_DELETE", MQCO_DELETE},
+	{"MQCO_DELETE_PURGE", MQCO_DELETE_PURGE},
+	{"MQCO_IMMEDIATE", MQCO_IMMEDIATE},
+	{"MQCO_KEEP_SUB", MQCO_KEEP_SUB},
+	{"MQCO_NONE", MQCO_NONE},
+	{"MQCO_QUIESCE", MQCO_QUIESCE},
+	{"MQCO_REMOVE_SUB", MQCO_REMOVE_SUB},
+	{"MQCQT_ALIAS_Q", MQCQT_ALIAS_Q},
+	{"MQCQT_LOCAL_Q", MQCQT_LOCAL_Q},
+	{"MQCQT_Q_MGR_ALIAS", MQCQT_Q_MGR_ALIAS},
+	{"MQCQT_REMOTE_Q", MQCQT_REMOTE_Q},
+	{"MQCRC_APPLICATION_ABEND", MQCRC_APPLICATION_ABEND},
+	{"MQCRC_BRIDGE_ABEND", MQCRC_BRIDGE_ABEND},
+	{"MQCRC_BRIDGE_ERROR", MQCRC_BRIDGE_ERROR},
+	{"MQCRC_BRIDGE_TIMEOUT", MQCRC_BRIDGE_TIMEOUT},
+	{"MQCRC_CICS_EXEC_ERROR", MQCRC_CICS_EXEC_ERROR},
+	{"MQCRC_MQ_API_ERROR", MQCRC_MQ_API_ERROR},
+	{"MQCRC_OK", MQCRC_OK},
+	{"MQCRC_PROGRAM_NOT_AVAILABLE", MQCRC_PROGRAM_NOT_AVAILABLE},
+	{"MQCRC_SECURITY_ERROR", MQCRC_SECURITY_ERROR},
+	{"MQCRC_TRANSID_NOT_AVAILABLE", MQCRC_TRANSID_NOT_AVAILABLE},
+	{"MQCSP_AUTH_ID_TOKEN", MQCSP_AUTH_ID_TOKEN},
+	{"MQCSP_AUTH_NONE", MQCSP_AUTH_NONE},
+	{"MQCSP_AUTH_USER_ID_AND_PWD", MQCSP_AUTH_USER_ID_AND_PWD},
+	{"MQCSP_CURRENT_LENGTH", MQCSP_CURRENT_LENGTH},
+	{"MQCSP_CURRENT_VERSION", MQCSP_CURRENT_VERSION},
+	{"MQCSP_LENGTH_1", MQCSP_LENGTH_1},
+	{"MQCSP_LENGTH_2", MQCSP_LENGTH_2},
+	{"MQCSP_LENGTH_3", MQCSP_LENGTH_3},
+	{"MQCSP_VERSION_1", MQCSP_VERSION_1},
+	{"MQCSP_VERSION_2", MQCSP_VERSION_2},
+	{"MQCSP_VERSION_3", MQCSP_VERSION_3},
+	{"MQCSRV_CONVERT_NO", MQCSRV_CONVERT_NO},
+	{"MQCSRV_CONVERT_YES", MQCSRV_CONVERT_YES},
+	{"MQCSRV_DLQ_NO", MQCSRV_DLQ_NO},
+	{"MQCSRV_DLQ_YES", MQCSRV_DLQ_YES},
+	{"MQCS_NONE", MQCS_NONE},
+	{"MQCS_STOPPED", MQCS_STOPPED},
+	{"MQCS_SUSPENDED", MQCS_SUSPENDED},
+	{"MQCS_SUSPENDED_TEMPORARY", MQCS_SUSPENDED_TEMPORARY},
+	{"MQCS_SUSPENDED_USER_ACTION", MQCS_SUSPENDED_USER_ACTION},
+	{"MQCTES_BACKOUT", MQCTES_BACKOUT},
+	{"MQCTES_COMMIT", MQCTES_COMMIT},
+	{"MQCTES_ENDTASK", MQCTES_ENDTASK},
+	{"MQCTES_NOSYNC", MQCTES_NOSYNC},
+	{"MQCTLO_CURRENT_LENGTH", MQCTLO_CURRENT_LENGTH},
+	{"MQCTLO_CURRENT_VERSION", MQCTLO_CURRENT_VERSION},
+	{"MQCTLO_FAIL_IF_QUIESCING", MQCTLO_FAIL_IF_QUIESCING},
+	{"MQCTLO_LENGTH_1", MQCTLO_LENGTH_1},
+	{"MQCTLO_NONE", MQCTLO_NONE},
+	{"MQCTLO_THREAD_AFFINITY", MQCTLO_THREAD_AFFINITY},
+	{"MQCTLO_VERSION_1", MQCTLO_VERSION_1},
+	{"MQCUOWC_BACKOUT", MQCUOWC_BACKOUT},
+	{"MQCUOWC_COMMIT", MQCUOWC_COMMIT},
+	{"MQCUOWC_CONTINUE", MQCUOWC_CONTINUE},
+	{"MQCUOWC_FIRST", MQCUOWC_FIRST},
+	{"MQCUOWC_LAST", MQCUOWC_LAST},
+	{"MQCUOWC_MIDDLE", MQCUOWC_MIDDLE},
+	{"MQCUOWC_ONLY", MQCUOWC_ONLY},
+	{"MQCXP_CURRENT_LENGTH", MQCXP_CURRENT_LENGTH},
+	{"MQCXP_CURRENT_VERSION", MQCXP_CURRENT_VERSION},
+	{"MQCXP_LENGTH_3", MQCXP_LENGTH_3},
+	{"MQCXP_LENGTH_4", MQCXP_LENGTH_4},
+	{"MQCXP_LENGTH_5", MQCXP_LENGTH_5},
+	{"MQCXP_LENGTH_6", MQCXP_LENGTH_6},
+	{"MQCXP_LENGTH_7", MQCXP_LENGTH_7},
+	{"MQCXP_LENGTH_8", MQCXP_LENGTH_8},
+	{"MQCXP_LENGTH_9", MQCXP_LENGTH_9},
+	{"MQCXP_VERSION_1", MQCXP_VERSION_1},
+	{"MQCXP_VERSION_2", MQCXP_VERSION_2},
+	{"MQCXP_VERSION_3", MQCXP_VERSION_3},
+	{"MQCXP_VERSION_4", MQCXP_VERSION_4},
+	{"MQCXP_VERSION_5", MQCXP_VERSION_5},
+	{"MQCXP_VERSION_6", MQCXP_VERSION_6},
+	{"MQCXP_VERSION_7", MQCXP_VERSION_7},
+	{"MQCXP_VERSION_8", MQCXP_VERSION_8},
+	{"MQCXP_VERSION_9", MQCXP_VERSION_9},
+	{"MQDCC_DEFAULT_CONVERSION", MQDCC_DEFAULT_CONVERSION},
+	{"MQDCC_FILL_TARGET_BUFFER", MQDCC_FILL_TARGET_BUFFER},
+	{"MQDCC_INT_DEFAULT_CONVERSION", MQDCC_INT_DEFAULT_CONVERSION},
+	{"MQDCC_NONE", MQDCC_NONE},
+	{"MQDCC_SOURCE_ENC_FACTOR", MQDCC_SOURCE_ENC_FACTOR},
+	{"MQDCC_SOURCE_ENC_MASK", MQDCC_SOURCE_ENC_MASK},
+	{"MQDCC_SOURCE_ENC_NATIVE", MQDCC_SOURCE_ENC_NATIVE},
+	{"MQDCC_SOURCE_ENC_NORMAL", MQDCC_SOURCE_ENC_NORMAL},
+	{"MQDCC_SOURCE_ENC_REVERSED", MQDCC_SOURCE_ENC_REVERSED},
+	{"MQDCC_SOURCE_ENC_UNDEFINED", MQDCC_SOURCE_ENC_UNDEFINED},
+	{"MQDCC_TARGET_ENC_FACTOR", MQDCC_TARGET_ENC_FACTOR},
+	{"MQDCC_TARGET_ENC_MASK", MQDCC_TARGET_ENC_MASK},
+	{"MQDCC_TARGET_ENC_NATIVE", MQDCC_TARGET_ENC_NATIVE},
+	{"MQDCC_TARGET_ENC_NORMAL", MQDCC_TARGET_ENC_NORMAL},
+	{"MQDCC_TARGET_ENC_REVERSED", MQDCC_TARGET_ENC_REVERSED},
+	{"MQDCC_TARGET_ENC_UNDEFINED", MQDCC_TARGET_ENC_UNDEFINED},
+	{"MQDC_MANAGED", MQDC_MANAGED},
+	{"MQDC_PROVIDED", MQDC_PROVIDED},
+	{"MQDELO_LOCAL", MQDELO_LOCAL},
+	{"MQDELO_NONE", MQDELO_NONE},
+	{"MQDHF_NEW_MSG_IDS", MQDHF_NEW_MSG_IDS},
+	{"MQDHF_NONE", MQDHF_NONE},
+	{"MQDH_CURRENT_LENGTH", MQDH_CURRENT_LENGTH},
+	{"MQDH_CURRENT_VERSION", MQDH_CURRENT_VERSION},
+	{"MQDH_LENGTH_1", MQDH_LENGTH_1},
+	{"MQDH_VERSION_1", MQDH_VERSION_1},
+	{"MQDISCONNECT_IMPLICIT", MQDISCONNECT_IMPLICIT},
+	{"MQDISCONNECT_NORMAL", MQDISCONNECT_NORMAL},
+	{"MQDISCONNECT_Q_MGR", MQDISCONNECT_Q_MGR},
+	{"MQDLH_CURRENT_LENGTH", MQDLH_CURRENT_LENGTH},
+	{"MQDLH_CURRENT_VERSION", MQDLH_CURRENT_VERSION},
+	{"MQDLH_LENGTH_1", MQDLH_LENGTH_1},
+	{"MQDLH_VERSION_1", MQDLH_VERSION_1},
+	{"MQDLV_ALL", MQDLV_ALL},
+	{"MQDLV_ALL_AVAIL", MQDLV_ALL_AVAIL},
+	{"MQDLV_ALL_DUR", MQDLV_ALL_DUR},
+	{"MQDLV_AS_PARENT", MQDLV_AS_PARENT},
+	{"MQDL_NOT_SUPPORTED", MQDL_NOT_SUPPORTED},
+	{"MQDL_SUPPORTED", MQDL_SUPPORTED},
+	{"MQDMHO_CURRENT_LENGTH", MQDMHO_CURRENT_LENGTH},
+	{"MQDMHO_CURRENT_VERSION", MQDMHO_CURRENT_VERSION},
+	{"MQDMHO_LENGTH_1", MQDMHO_LENGTH_1},
+	{"MQDMHO_NONE", MQDMHO_NONE},
+	{"MQDMHO_VERSION_1", MQDMHO_VERSION_1},
+	{"MQDMPO_CURRENT_LENGTH", MQDMPO_CURRENT_LENGTH},
+	{"MQDMPO_CURRENT_VERSION", MQDMPO_CURRENT_VERSION},
+	{"MQDMPO_DEL_FIRST", MQDMPO_DEL_FIRST},
+	{"MQDMPO_DEL_PROP_UNDER_CURSOR", MQDMPO_DEL_PROP_UNDER_CURSOR},
+	{"MQDMPO_LENGTH_1", MQDMPO_LENGTH_1},
+	{"MQDMPO_NONE", MQDMPO_NONE},
+	{"MQDMPO_VERSION_1", MQDMPO_VERSION_1},
+	{"MQDNSWLM_NO", MQDNSWLM_NO},
+	{"MQDNSWLM_YES", MQDNSWLM_YES},
+	{"MQDOPT_DEFINED", MQDOPT_DEFINED},
+	{"MQDOPT_RESOLVED", MQDOPT_RESOLVED},
+	{"MQDSB_1024K", MQDSB_1024K},
+	{"MQDSB_128K", MQDSB_128K},
+	{"MQDSB_16K", MQDSB_16K},
+	{"MQDSB_1M", MQDSB_1M},
+	{"MQDSB_256K", MQDSB_256K},
+	{"MQDSB_32K", MQDSB_32K},
+	{"MQDSB_512K", MQDSB_512K},
+	{"MQDSB_64K", MQDSB_64K},
+	{"MQDSB_8K", MQDSB_8K},
+	{"MQDSB_DEFAULT", MQDSB_DEFAULT},
+	{"MQDSE_DEFAULT", MQDSE_DEFAULT},
+	{"MQDSE_NO", MQDSE_NO},
+	{"MQDSE_YES", MQDSE_YES},
+	{"MQDXP_CURRENT_LENGTH", MQDXP_CURRENT_LENGTH},
+	{"MQDXP_CURRENT_VERSION", MQDXP_CURRENT_VERSION},
+	{"MQDXP_LENGTH_1", MQDXP_LENGTH_1},
+	{"MQDXP_LENGTH_2", MQDXP_LENGTH_2},
+	{"MQDXP_VERSION_1", MQDXP_VERSION_1},
+	{"MQDXP_VERSION_2", MQDXP_VERSION_2},
+	{"MQEC_CONNECTION_QUIESCING", MQEC_CONNECTION_QUIESCING},
+	{"MQEC_MSG_ARRIVED", MQEC_MSG_ARRIVED},
+	{"MQEC_Q_MGR_QUIESCING", MQEC_Q_MGR_QUIESCING},
+	{"MQEC_WAIT_CANCELED", MQEC_WAIT_CANCELED},
+	{"MQEC_WAIT_INTERVAL_EXPIRED", MQEC_WAIT_INTERVAL_EXPIRED},
+	{"MQEI_UNLIMITED", MQEI_UNLIMITED},
+	{"MQENC_AS_PUBLISHED", MQENC_AS_PUBLISHED},
+	{"MQENC_DECIMAL_MASK", MQENC_DECIMAL_MASK},
+	{"MQENC_DECIMAL_NORMAL", MQENC_DECIMAL_NORMAL},
+	{"MQENC_DECIMAL_REVERSED", MQENC_DECIMAL_REVERSED},
+	{"MQENC_DECIMAL_UNDEFINED", MQENC_DECIMAL_UNDEFINED},
+	{"MQENC_FLOAT_IEEE_NORMAL", MQENC_FLOAT_IEEE_NORMAL},
+	{"MQENC_FLOAT_IEEE_REVERSED", MQENC_FLOAT_IEEE_REVERSED},
+	{"MQENC_FLOAT_MASK", MQENC_FLOAT_MASK},
+	{"MQENC_FLOAT_S390", MQENC_FLOAT_S390},
+	{"MQENC_FLOAT_TNS", MQENC_FLOAT_TNS},
+	{"MQENC_FLOAT_UNDEFINED", MQENC_FLOAT_UNDEFINED},
+	{"MQENC_INTEGER_MASK", MQENC_INTEGER_MASK},
+	{"MQENC_INTEGER_NORMAL", MQENC_INTEGER_NORMAL},
+	{"MQENC_INTEGER_REVERSED", MQENC_INTEGER_REVERSED},
+	{"MQENC_INTEGER_UNDEFINED", MQENC_INTEGER_UNDEFINED},
+	{"MQENC_NATIVE", MQENC_NATIVE},
+	{"MQENC_NORMAL", MQENC_NORMAL},
+	{"MQENC_RESERVED_MASK", MQENC_RESERVED_MASK},
+	{"MQENC_REVERSED", MQENC_REVERSED},
+	{"MQENC_S390", MQENC_S390},
+	{"MQENC_TNS", MQENC_TNS},
+	{"MQEPH_CCSID_EMBEDDED", MQEPH_CCSID_EMBEDDED},
+	{"MQEPH_CURRENT_LENGTH", MQEPH_CURRENT_LENGTH},
+	{"MQEPH_CURRENT_VERSION", MQEPH_CURRENT_VERSION},
+	{"MQEPH_LENGTH_1", MQEPH_LENGTH_1},
+	{"MQEPH_NONE", MQEPH_NONE},
+	{"MQEPH_STRUC_LENGTH_FIXED", MQEPH_STRUC_LENGTH_FIXED},
+	{"MQEPH_VERSION_1", MQEPH_VERSION_1},
+	{"MQERO_NONE", MQERO_NONE},
+	{"MQET_MQSC", MQET_MQSC},
+	{"MQEVO_CONSOLE", MQEVO_CONSOLE},
+	{"MQEVO_CTLMSG", MQEVO_CTLMSG},
+	{"MQEVO_INIT", MQEVO_INIT},
+	{"MQEVO_INTERNAL", MQEVO_INTERNAL},
+	{"MQEVO_MQSET", MQEVO_MQSET},
+	{"MQEVO_MQSUB", MQEVO_MQSUB},
+	{"MQEVO_MSG", MQEVO_MSG},
+	{"MQEVO_OTHER", MQEVO_OTHER},
+	{"MQEVO_REST", MQEVO_REST},
+	{"MQEVR_ADMIN_ONLY", MQEVR_ADMIN_ONLY},
+	{"MQEVR_API_ONLY", MQEVR_API_ONLY},
+	{"MQEVR_DISABLED", MQEVR_DISABLED},
+	{"MQEVR_ENABLED", MQEVR_ENABLED},
+	{"MQEVR_EXCEPTION", MQEVR_EXCEPTION},
+	{"MQEVR_NO_DISPLAY", MQEVR_NO_DISPLAY},
+	{"MQEVR_USER_ONLY", MQEVR_USER_ONLY},
+	{"MQEXPI_OFF", MQEXPI_OFF},
+	{"MQEXTATTRS_ALL", MQEXTATTRS_ALL},
+	{"MQEXTATTRS_NONDEF", MQEXTATTRS_NONDEF},
+	{"MQEXT_ALL", MQEXT_ALL},
+	{"MQEXT_AUTHORITY", MQEXT_AUTHORITY},
+	{"MQEXT_OBJECT", MQEXT_OBJECT},
+	{"MQFB_ACTIVITY", MQFB_ACTIVITY},
+	{"MQFB_APPL_CANNOT_BE_STARTED", MQFB_APPL_CANNOT_BE_STARTED},
+	{"MQFB_APPL_FIRST", MQFB_APPL_FIRST},
+	{"MQFB_APPL_LAST", MQFB_APPL_LAST},
+	{"MQFB_APPL_TYPE_ERROR", MQFB_APPL_TYPE_ERROR},
+	{"MQFB_BIND_OPEN_CLUSRCVR_DEL", MQFB_BIND_OPEN_CLUSRCVR_DEL},
+	{"MQFB_BUFFER_OVERFLOW", MQFB_BUFFER_OVERFLOW},
+	{"MQFB_CHANNEL_COMPLETED", MQFB_CHANNEL_COMPLETED},
+	{"MQFB_CHANNEL_FAIL", MQFB_CHANNEL_FAIL},
+	{"MQFB_CHANNEL_FAIL_RETRY", MQFB_CHANNEL_FAIL_RETRY},
+	{"MQFB_CICS_APPL_ABENDED", MQFB_CICS_APPL_ABENDED},
+	{"MQFB_CICS_APPL_NOT_STARTED", MQFB_CICS_APPL_NOT_STARTED},
+	{"MQFB_CICS_BRIDGE_FAILURE", MQFB_CICS_BRIDGE_FAILURE},
+	{"MQFB_CICS_CCSID_ERROR", MQFB_CICS_CCSID_ERROR},
+	{"MQFB_CICS_CIH_ERROR", MQFB_CICS_CIH_ERROR},
+	{"MQFB_CICS_COMMAREA_ERROR", MQFB_CICS_COMMAREA_ERROR},
+	{"MQFB_CICS_CORREL_ID_ERROR", MQFB_CICS_CORREL_ID_ERROR},
+	{"MQFB_CICS_DLQ_ERROR", MQFB_CICS_DLQ_ERROR},
+	{"MQFB_CICS_ENCODING_ERROR", MQFB_CICS_ENCODING_ERROR},
+	{"MQFB_CICS_INTERNAL_ERROR", MQFB_CICS_INTERNAL_ERROR},
+	{"MQFB_CICS_NOT_AUTHORIZED", MQFB_CICS_NOT_AUTHORIZED},
+	{"MQFB_CICS_UOW_BACKED_OUT", MQFB_CICS_UOW_BACKED_OUT},
+	{"MQFB_CICS_UOW_ERROR", MQFB_CICS_UOW_ERROR},
+	{"MQFB_COA", MQFB_COA},
+	{"MQFB_COD", MQFB_COD},
+	{"MQFB_DATA_LENGTH_NEGATIVE", MQFB_DATA_LENGTH_NEGATIVE},
+	{"MQFB_DATA_LENGTH_TOO_BIG", MQFB_DATA_LENGTH_TOO_BIG},
+	{"MQFB_DATA_LENGTH_TOO_SHORT", MQFB_DATA_LENGTH_TOO_SHORT},
+	{"MQFB_DATA_LENGTH_ZERO", MQFB_DATA_LENGTH_ZERO},
+	{"MQFB_EXPIRATION", MQFB_EXPIRATION},
+	{"MQFB_IIH_ERROR", MQFB_IIH_ERROR},
+	{"MQFB_IMS_ERROR", MQFB_IMS_ERROR},
+	{"MQFB_IMS_FIRST", MQFB_IMS_FIRST},
+	{"MQFB_IMS_LAST", MQFB_IMS_LAST},
+	{"MQFB_IMS_NACK_1A_REASON_FIRST", MQFB_IMS_NACK_1A_REASON_FIRST},
+	{"MQFB_IMS_NACK_1A_REASON_LAST", MQFB_IMS_NACK_1A_REASON_LAST},
+	{"MQFB_LENGTH_OFF_BY_ONE", MQFB_LENGTH_OFF_BY_ONE},
+	{"MQFB_MAX_ACTIVITIES", MQFB_MAX_ACTIVITIES},
+	{"MQFB_MSG_SCOPE_MISMATCH", MQFB_MSG_SCOPE_MISMATCH},
+	{"MQFB_NAN", MQFB_NAN},
+	{"MQFB_NONE", MQFB_NONE},
+	{"MQFB_NOT_AUTHORIZED_FOR_IMS", MQFB_NOT_AUTHORIZED_FOR_IMS},
+	{"MQFB_NOT_A_GROUPUR_MSG", MQFB_NOT_A_GROUPUR_MSG},
+	{"MQFB_NOT_A_REPOSITORY_MSG", MQFB_NOT_A_REPOSITORY_MSG},
+	{"MQFB_NOT_DELIVERED", MQFB_NOT_DELIVERED},
+	{"MQFB_NOT_FORWARDED", MQFB_NOT_FORWARDED},
+	{"MQFB_PAN", MQFB_PAN},
+	{"MQFB_PUBLICATIONS_ON_REQUEST", MQFB_PUBLICATIONS_ON_REQUEST},
+	{"MQFB_QUIT", MQFB_QUIT},
+	{"MQFB_SELECTOR_MISMATCH", MQFB_SELECTOR_MISMATCH},
+	{"MQFB_STOPPED_BY_CHAD_EXIT", MQFB_STOPPED_BY_CHAD_EXIT},
+	{"MQFB_STOPPED_BY_MSG_EXIT", MQFB_STOPPED_BY_MSG_EXIT},
+	{"MQFB_STOPPED_BY_PUBSUB_EXIT", MQFB_STOPPED_BY_PUBSUB_EXIT},
+	{"MQFB_SUBSCRIBER_IS_PUBLISHER", MQFB_SUBSCRIBER_IS_PUBLISHER},
+	{"MQFB_SYSTEM_FIRST", MQFB_SYSTEM_FIRST},
+	{"MQFB_SYSTEM_LAST", MQFB_SYSTEM_LAST},
+	{"MQFB_TM_ERROR", MQFB_TM_ERROR},
+	{"MQFB_UNSUPPORTED_DELIVERY", MQFB_UNSUPPORTED_DELIVERY},
+	{"MQFB_UNSUPPORTED_FORWARDING", MQFB_UNSUPPORTED_FORWARDING},
+	{"MQFB_XMIT_Q_MSG_ERROR", MQFB_XMIT_Q_MSG_ERROR},
+	{"MQFC_NO", MQFC_NO},
+	{"MQFC_YES", MQFC_YES},
+	{"MQFIELD_WQR_CLWLQueuePriority", MQFIELD_WQR_CLWLQueuePriority},
+	{"MQFIELD_WQR_CLWLQueueRank", MQFIELD_WQR_CLWLQueueRank},
+	{"MQFIELD_WQR_CapExpiry", MQFIELD_WQR_CapExpiry},
+	{"MQFIELD_WQR_ClusterRecOffset", MQFIELD_WQR_ClusterRecOffset},
+	{"MQFIELD_WQR_DefBind", MQFIELD_WQR_DefBind},
+	{"MQFIELD_WQR_DefPersistence", MQFIELD_WQR_DefPersistence},
+	{"MQFIELD_WQR_DefPriority", MQFIELD_WQR_DefPriority},
+	{"MQFIELD_WQR_DefPutResponse", MQFIELD_WQR_DefPutResponse},
+	{"MQFIELD_WQR_InhibitPut", MQFIELD_WQR_InhibitPut},
+	{"MQFIELD_WQR_QDesc", MQFIELD_WQR_QDesc},
+	{"MQFIELD_WQR_QFlags", MQFIELD_WQR_QFlags},
+	{"MQFIELD_WQR_QMgrIdentifier", MQFIELD_WQR_QMgrIdentifier},
+	{"MQFIELD_WQR_QName", MQFIELD_WQR_QName},
+	{"MQFIELD_WQR_QType", MQFIELD_WQR_QType},
+	{"MQFIELD_WQR_StrucId", MQFIELD_WQR_StrucId},
+	{"MQFIELD_WQR_StrucLength", MQFIELD_WQR_StrucLength},
+	{"MQFIELD_WQR_Version", MQFIELD_WQR_Version},
+	{"MQFSENC_NO", MQFSENC_NO},
+	{"MQFSENC_UNKNOWN", MQFSENC_UNKNOWN},
+	{"MQFSENC_YES", MQFSENC_YES},
+	{"MQFS_SHARED", MQFS_SHARED},
+	{"MQFUN_TYPE_COMMAND", MQFUN_TYPE_COMMAND},
+	{"MQFUN_TYPE_JVM", MQFUN_TYPE_JVM},
+	{"MQFUN_TYPE_PROCEDURE", MQFUN_TYPE_PROCEDURE},
+	{"MQFUN_TYPE_PROGRAM", MQFUN_TYPE_PROGRAM},
+	{"MQFUN_TYPE_UNKNOWN", MQFUN_TYPE_UNKNOWN},
+	{"MQFUN_TYPE_USERDEF", MQFUN_TYPE_USERDEF},
+	{"MQGACF_ACTIVITY", MQGACF_ACTIVITY},
+	{"MQGACF_ACTIVITY_TRACE", MQGACF_ACTIVITY_TRACE},
+	{"MQGACF_ALL_APPLS", MQGACF_ALL_APPLS},
+	{"MQGACF_APPL_BALANCE", MQGACF_APPL_BALANCE},
+	{"MQGACF_APPL_STATUS", MQGACF_APPL_STATUS},
+	{"MQGACF_APP_DIST_LIST", MQGACF_APP_DIST_LIST},
+	{"MQGACF_CHANGED_APPLS", MQGACF_CHANGED_APPLS},
+	{"MQGACF_CHL_STATISTICS_DATA", MQGACF_CHL_STATISTICS_DATA},
+	{"MQGACF_COMMAND_CONTEXT", MQGACF_COMMAND_CONTEXT},
+	{"MQGACF_COMMAND_DATA", MQGACF_COMMAND_DATA},
+	{"MQGACF_EMBEDDED_MQMD", MQGACF_EMBEDDED_MQMD},
+	{"MQGACF_FIRST", MQGACF_FIRST},
+	{"MQGACF_LAST_USED", MQGACF_LAST_USED},
+	{"MQGACF_MESSAGE", MQGACF_MESSAGE},
+	{"MQGACF_MONITOR_CLASS", MQGACF_MONITOR_CLASS},
+	{"MQGACF_MONITOR_ELEMENT", MQGACF_MONITOR_ELEMENT},
+	{"MQGACF_MONITOR_TYPE", MQGACF_MONITOR_TYPE},
+	{"MQGACF_MQMD", MQGACF_MQMD},
+	{"MQGACF_OPERATION", MQGACF_OPERATION},
+	{"MQGACF_Q_ACCOUNTING_DATA", MQGACF_Q_ACCOUNTING_DATA},
+	{"MQGACF_Q_STATISTICS_DATA", MQGACF_Q_STATISTICS_DATA},
+	{"MQGACF_TRACE_ROUTE", MQGACF_TRACE_ROUTE},
+	{"MQGACF_VALUE_NAMING", MQGACF_VALUE_NAMING},
+	{"MQGA_FIRST", MQGA_FIRST},
+	{"MQGA_LAST", MQGA_LAST},
+	{"MQGMO_ACCEPT_TRUNCATED_MSG", MQGMO_ACCEPT_TRUNCATED_MSG},
+	{"MQGMO_ALL_MSGS_AVAILABLE", MQGMO_ALL_MSGS_AVAILABLE},
+	{"MQGMO_ALL_SEGMENTS_AVAILABLE", MQGMO_ALL_SEGMENTS_AVAILABLE},
+	{"MQGMO_BROWSE_CO_OP", MQGMO_BROWSE_CO_OP},
+	{"MQGMO_BROWSE_FIRST", MQGMO_BROWSE_FIRST},
+	{"MQGMO_BROWSE_HANDLE", MQGMO_BROWSE_HANDLE},
+	{"MQGMO_BROWSE_MSG_UNDER_CURSOR", MQGMO_BROWSE_MSG_UNDER_CURSOR},
+	{"MQGMO_BROWSE_NEXT", MQGMO_BROWSE_NEXT},
+	{"MQGMO_COMPLETE_MSG", MQGMO_COMPLETE_MSG},
+	{"MQGMO_CONVERT", MQGMO_CONVERT},
+	{"MQGMO_CURRENT_LENGTH", MQGMO_CURRENT_LENGTH},
+	{"MQGMO_CURRENT_VERSION", MQGMO_CURRENT_VERSION},
+	{"MQGMO_FAIL_IF_QUIESCING", MQGMO_FAIL_IF_QUIESCING},
+	{"MQGMO_LENGTH_1", MQGMO_LENGTH_1},
+	{"MQGMO_LENGTH_2", MQGMO_LENGTH_2},
+	{"MQGMO_LENGTH_3", MQGMO_LENGTH_3},
+	{"MQGMO_LENGTH_4", MQGMO_LENGTH_4},
+	{"MQGMO_LOCK", MQGMO_LOCK},
+	{"MQGMO_LOGICAL_ORDER", MQGMO_LOGICAL_ORDER},
+	{"MQGMO_MARK_BROWSE_CO_OP", MQGMO_MARK_BROWSE_CO_OP},
+	{"MQGMO_MARK_BROWSE_HANDLE", MQGMO_MARK_BROWSE_HANDLE},
+	{"MQGMO_MARK_SKIP_BACKOUT", MQGMO_MARK_SKIP_BACKOUT},
+	{"MQGMO_MSG_UNDER_CURSOR", MQGMO_MSG_UNDER_CURSOR},
+	{"MQGMO_NONE", MQGMO_NONE},
+	{"MQGMO_NO_PROPERTIES", MQGMO_NO_PROPERTIES},
+	{"MQGMO_NO_SYNCPOINT", MQGMO_NO_SYNCPOINT},
+	{"MQGMO_NO_WAIT", MQGMO_NO_WAIT},
+	{"MQGMO_PROPERTIES_AS_Q_DEF", MQGMO_PROPERTIES_AS_Q_DEF},
+	{"MQGMO_PROPERTIES_COMPATIBILITY", MQGMO_PROPERTIES_COMPATIBILITY},
+	{"MQGMO_PROPERTIES_FORCE_MQRFH2", MQGMO_PROPERTIES_FORCE_MQRFH2},
+	{"MQGMO_PROPERTIES_IN_HANDLE", MQGMO_PROPERTIES_IN_HANDLE},
+	{"MQGMO_SET_SIGNAL", MQGMO_SET_SIGNAL},
+	{"MQGMO_SYNCPOINT", MQGMO_SYNCPOINT},
+	{"MQGMO_SYNCPOINT_IF_PERSISTENT", MQGMO_SYNCPOINT_IF_PERSISTENT},
+	{"MQGMO_UNLOCK", MQGMO_UNLOCK},
+	{"MQGMO_UNMARKED_BROWSE_MSG", MQGMO_UNMARKED_BROWSE_MSG},
+	{"MQGMO_UNMARK_BROWSE_CO_OP", MQGMO_UNMARK_BROWSE_CO_OP},
+	{"MQGMO_UNMARK_BROWSE_HANDLE", MQGMO_UNMARK_BROWSE_HANDLE},
+	{"MQGMO_VERSION_1", MQGMO_VERSION_1},
+	{"MQGMO_VERSION_2", MQGMO_VERSION_2},
+	{"MQGMO_VERSION_3", MQGMO_VERSION_3},
+	{"MQGMO_VERSION_4", MQGMO_VERSION_4},
+	{"MQGMO_WAIT", MQGMO_WAIT},
+	{"MQGUR_DISABLED", MQGUR_DISABLED},
+	{"MQGUR_ENABLED", MQGUR_ENABLED},
+	{"MQHA_BAG_HANDLE", MQHA_BAG_HANDLE},
+	{"MQHA_FIRST", MQHA_FIRST},
+	{"MQHA_LAST", MQHA_LAST},
+	{"MQHA_LAST_USED", MQHA_LAST_USED},
+	{"MQHB_NONE", MQHB_NONE},
+	{"MQHB_UNUSABLE_HBAG", MQHB_UNUSABLE_HBAG},
+	{"MQHC_DEF_HCONN", MQHC_DEF_HCONN},
+	{"MQHC_UNASSOCIATED_HCONN", MQHC_UNASSOCIATED_HCONN},
+	{"MQHC_UNUSABLE_HCONN", MQHC_UNUSABLE_HCONN},
+	{"MQHM_NONE", MQHM_NONE},
+	{"MQHM_UNUSABLE_HMSG", MQHM_UNUSABLE_HMSG},
+	{"MQHO_NONE", MQHO_NONE},
+	{"MQHO_UNUSABLE_HOBJ", MQHO_UNUSABLE_HOBJ},
+	{"MQHSTATE_ACTIVE", MQHSTATE_ACTIVE},
+	{"MQHSTATE_INACTIVE", MQHSTATE_INACTIVE},
+	{"MQIACF_ACTION", MQIACF_ACTION},
+	{"MQIACF_ALL", MQIACF_ALL},
+	{"MQIACF_AMQP_ATTRS", MQIACF_AMQP_ATTRS},
+	{"MQIACF_AMQP_DIAGNOSTICS_TYPE", MQIACF_AMQP_DIAGNOSTICS_TYPE},
+	{"MQIACF_ANONYMOUS_COUNT", MQIACF_ANONYMOUS_COUNT},
+	{"MQIACF_API_CALLER_TYPE", MQIACF_API_CALLER_TYPE},
+	{"MQIACF_API_ENVIRONMENT", MQIACF_API_ENVIRONMENT},
+	{"MQIACF_APPL_COUNT", MQIACF_APPL_COUNT},
+	{"MQIACF_APPL_FUNCTION_TYPE", MQIACF_APPL_FUNCTION_TYPE},
+	{"MQIACF_APPL_IMMOVABLE_COUNT", MQIACF_APPL_IMMOVABLE_COUNT},
+	{"MQIACF_APPL_IMMOVABLE_REASON", MQIACF_APPL_IMMOVABLE_REASON},
+	{"MQIACF_APPL_INFO_APPL", MQIACF_APPL_INFO_APPL},
+	{"MQIACF_APPL_INFO_ATTRS", MQIACF_APPL_INFO_ATTRS},
+	{"MQIACF_APPL_INFO_LOCAL", MQIACF_APPL_INFO_LOCAL},
+	{"MQIACF_APPL_INFO_QMGR", MQIACF_APPL_INFO_QMGR},
+	{"MQIACF_APPL_INFO_TYPE", MQIACF_APPL_INFO_TYPE},
+	{"MQIACF_APPL_MOVABLE", MQIACF_APPL_MOVABLE},
+	{"MQIACF_ARCHIVE_LOG_SIZE", MQIACF_ARCHIVE_LOG_SIZE},
+	{"MQIACF_ASYNC_STATE", MQIACF_ASYNC_STATE},
+	{"MQIACF_AUTHORIZATION_LIST", MQIACF_AUTHORIZATION_LIST},
+	{"MQIACF_AUTH_ADD_AUTHS", MQIACF_AUTH_ADD_AUTHS},
+	{"MQIACF_AUTH_INFO_ATTRS", MQIACF_AUTH_INFO_ATTRS},
+	{"MQIACF_AUTH_OPTIONS", MQIACF_AUTH_OPTIONS},
+	{"MQIACF_AUTH_PROFILE_ATTRS", MQIACF_AUTH_PROFILE_ATTRS},
+	{"MQIACF_AUTH_REC_TYPE", MQIACF_AUTH_REC_TYPE},
+	{"MQIACF_AUTH_REMOVE_AUTHS", MQIACF_AUTH_REMOVE_AUTHS},
+	{"MQIACF_AUTH_SERVICE_ATTRS", MQIACF_AUTH_SERVICE_ATTRS},
+	{"MQIACF_AUTO_CLUSTER_TYPE", MQIACF_AUTO_CLUSTER_TYPE},
+	{"MQIACF_AUX_ERROR_DATA_INT_1", MQIACF_AUX_ERROR_DATA_INT_1},
+	{"MQIACF_AUX_ERROR_DATA_INT_2", MQIACF_AUX_ERROR_DATA_INT_2},
+	{"MQIACF_BACKOUT_COUNT", MQIACF_BACKOUT_COUNT},
+	{"MQIACF_BALANCED", MQIACF_BALANCED},
+	{"MQIACF_BALANCING_OPTIONS", MQIACF_BALANCING_OPTIONS},
+	{"MQIACF_BALANCING_TIMEOUT", MQIACF_BALANCING_TIMEOUT},
+	{"MQIACF_BALANCING_TYPE", MQIACF_BALANCING_TYPE},
+	{"MQIACF_BALSTATE", MQIACF_BALSTATE},
+	{"MQIACF_BRIDGE_TYPE", MQIACF_BRIDGE_TYPE},
+	{"MQIACF_BROKER_COUNT", MQIACF_BROKER_COUNT},
+	{"MQIACF_BROKER_OPTIONS", MQIACF_BROKER_OPTIONS},
+	{"MQIACF_BUFFER_LENGTH", MQIACF_BUFFER_LENGTH},
+	{"MQIACF_BUFFER_POOL_ID", MQIACF_BUFFER_POOL_ID},
+	{"MQIACF_BUFFER_POOL_LOCATION", MQIACF_BUFFER_POOL_LOCATION},
+	{"MQIACF_CALL_TYPE", MQIACF_CALL_TYPE},
+	{"MQIACF_CF_SMDS_BLOCK_SIZE", MQIACF_CF_SMDS_BLOCK_SIZE},
+	{"MQIACF_CF_SMDS_EXPAND", MQIACF_CF_SMDS_EXPAND},
+	{"MQIACF_CF_STATUS_BACKUP", MQIACF_CF_STATUS_BACKUP},
+	{"MQIACF_CF_STATUS_CONNECT", MQIACF_CF_STATUS_CONNECT},
+	{"MQIACF_CF_STATUS_SMDS", MQIACF_CF_STATUS_SMDS},
+	{"MQIACF_CF_STATUS_SUMMARY", MQIACF_CF_STATUS_SUMMARY},
+	{"MQIACF_CF_STATUS_TYPE", MQIACF_CF_STATUS_TYPE},
+	{"MQIACF_CF_STRUC_ACCESS", MQIACF_CF_STRUC_ACCESS},
+	{"MQIACF_CF_STRUC_ATTRS", MQIACF_CF_STRUC_ATTRS},
+	{"MQIACF_CF_STRUC_BACKUP_SIZE", MQIACF_CF_STRUC_BACKUP_SIZE},
+	{"MQIACF_CF_STRUC_ENTRIES_MAX", MQIACF_CF_STRUC_ENTRIES_MAX},
+	{"MQIACF_CF_STRUC_ENTRIES_USED", MQIACF_CF_STRUC_ENTRIES_USED},
+	{"MQIACF_CF_STRUC_SIZE_MAX", MQIACF_CF_STRUC_SIZE_MAX},
+	{"MQIACF_CF_STRUC_SIZE_USED", MQIACF_CF_STRUC_SIZE_USED},
+	{"MQIACF_CF_STRUC_STATUS", MQIACF_CF_STRUC_STATUS},
+	{"MQIACF_CF_STRUC_TYPE", MQIACF_CF_STRUC_TYPE},
+	{"MQIACF_CHANNEL_ATTRS", MQIACF_CHANNEL_ATTRS},
+	{"MQIACF_CHECKPOINT_COUNT", MQIACF_CHECKPOINT_COUNT},
+	{"MQIACF_CHECKPOINT_OPERATIONS", MQIACF_CHECKPOINT_OPERATIONS},
+	{"MQIACF_CHECKPOINT_SIZE", MQIACF_CHECKPOINT_SIZE},
+	{"MQIACF_CHINIT_STATUS", MQIACF_CHINIT_STATUS},
+	{"MQIACF_CHLAUTH_ATTRS", MQIACF_CHLAUTH_ATTRS},
+	{"MQIACF_CHLAUTH_TYPE", MQIACF_CHLAUTH_TYPE},
+	{"MQIACF_CLEAR_SCOPE", MQIACF_CLEAR_SCOPE},
+	{"MQIACF_CLEAR_TYPE", MQIACF_CLEAR_TYPE},
+	{"MQIACF_CLOSE_OPTIONS", MQIACF_CLOSE_OPTIONS},
+	{"MQIACF_CLUSTER_INFO", MQIACF_CLUSTER_INFO},
+	{"MQIACF_CLUSTER_Q_MGR_ATTRS", MQIACF_CLUSTER_Q_MGR_ATTRS},
+	{"MQIACF_CMDSCOPE_Q_MGR_COUNT", MQIACF_CMDSCOPE_Q_MGR_COUNT},
+	{"MQIACF_CMD_SERVER_STATUS", MQIACF_CMD_SERVER_STATUS},
+	{"MQIACF_COMMAND", MQIACF_COMMAND},
+	{"MQIACF_COMMAND_INFO", MQIACF_COMMAND_INFO},
+	{"MQIACF_COMM_INFO_ATTRS", MQIACF_COMM_INFO_ATTRS},
+	{"MQIACF_COMP_CODE", MQIACF_COMP_CODE},
+	{"MQIACF_CONFIGURATION_EVENTS", MQIACF_CONFIGURATION_EVENTS},
+	{"MQIACF_CONFIGURATION_OBJECTS", MQIACF_CONFIGURATION_OBJECTS},
+	{"MQIACF_CONNECTION_ATTRS", MQIACF_CONNECTION_ATTRS},
+	{"MQIACF_CONNECTION_COUNT", MQIACF_CONNECTION_COUNT},
+	{"MQIACF_CONNECTION_SWAP", MQIACF_CONNECTION_SWAP},
+	{"MQIACF_CONNECT_OPTIONS", MQIACF_CONNECT_OPTIONS},
+	{"MQIACF_CONNECT_TIME", MQIACF_CONNECT_TIME},
+	{"MQIACF_CONN_INFO_ALL", MQIACF_CONN_INFO_ALL},
+	{"MQIACF_CONN_INFO_CONN", MQIACF_CONN_INFO_CONN},
+	{"MQIACF_CONN_INFO_HANDLE", MQIACF_CONN_INFO_HANDLE},
+	{"MQIACF_CONN_INFO_TYPE", MQIACF_CONN_INFO_TYPE},
+	{"MQIACF_CONV_REASON_CODE", MQIACF_CONV_REASON_CODE},
+	{"MQIACF_CTL_OPERATION", MQIACF_CTL_OPERATION},
+	{"MQIACF_CUR_MAX_FILE_SIZE", MQIACF_CUR_MAX_FILE_SIZE},
+	{"MQIACF_CUR_Q_FILE_SIZE", MQIACF_CUR_Q_FILE_SIZE},
+	{"MQIACF_DATA_FS_IN_USE", MQIACF_DATA_FS_IN_USE},
+	{"MQIACF_DATA_FS_SIZE", MQIACF_DATA_FS_SIZE},
+	{"MQIACF_DB2_CONN_STATUS", MQIACF_DB2_CONN_STATUS},
+	{"MQIACF_DELETE_OPTIONS", MQIACF_DELETE_OPTIONS},
+	{"MQIACF_DESTINATION_CLASS", MQIACF_DESTINATION_CLASS},
+	{"MQIACF_DISCONNECT_TIME", MQIACF_DISCONNECT_TIME},
+	{"MQIACF_DISCONTINUITY_COUNT", MQIACF_DISCONTINUITY_COUNT},
+	{"MQIACF_DS_ENCRYPTED", MQIACF_DS_ENCRYPTED},
+	{"MQIACF_DURABLE_SUBSCRIPTION", MQIACF_DURABLE_SUBSCRIPTION},
+	{"MQIACF_ENCODING", MQIACF_ENCODING},
+	{"MQIACF_ENTITY_TYPE", MQIACF_ENTITY_TYPE},
+	{"MQIACF_ERROR_ID", MQIACF_ERROR_ID},
+	{"MQIACF_ERROR_IDENTIFIER", MQIACF_ERROR_IDENTIFIER},
+	{"MQIACF_ERROR_LOG_OPTIONS", MQIACF_ERROR_LOG_OPTIONS},
+	{"MQIACF_ERROR_OFFSET", MQIACF_ERROR_OFFSET},
+	{"MQIACF_ESCAPE_TYPE", MQIACF_ESCAPE_TYPE},
+	{"MQIACF_EVENT_APPL_TYPE", MQIACF_EVENT_APPL_TYPE},
+	{"MQIACF_EVENT_DUPLICATE_COUNT", MQIACF_EVENT_DUPLICATE_COUNT},
+	{"MQIACF_EVENT_ORIGIN", MQIACF_EVENT_ORIGIN},
+	{"MQIACF_EXCLUDE_INTERVAL", MQIACF_EXCLUDE_INTERVAL},
+	{"MQIACF_EXPIRY", MQIACF_EXPIRY},
+	{"MQIACF_EXPIRY_Q_COUNT", MQIACF_EXPIRY_Q_COUNT},
+	{"MQIACF_EXPIRY_TIME", MQIACF_EXPIRY_TIME},
+	{"MQIACF_EXPORT_ATTRS", MQIACF_EXPORT_ATTRS},
+	{"MQIACF_EXPORT_TYPE", MQIACF_EXPORT_TYPE},
+	{"MQIACF_FEEDBACK", MQIACF_FEEDBACK},
+	{"MQIACF_FIRST", MQIACF_FIRST},
+	{"MQIACF_FORCE", MQIACF_FORCE},
+	{"MQIACF_GET_OPTIONS", MQIACF_GET_OPTIONS},
+	{"MQIACF_GROUPUR_CHECK_ID", MQIACF_GROUPUR_CHECK_ID},
+	{"MQIACF_HANDLE_STATE", MQIACF_HANDLE_STATE},
+	{"MQIACF_HOBJ", MQIACF_HOBJ},
+	{"MQIACF_HSUB", MQIACF_HSUB},
+	{"MQIACF_IGNORE_STATE", MQIACF_IGNORE_STATE},
+	{"MQIACF_INQUIRY", MQIACF_INQUIRY},
+	{"MQIACF_INTATTR_COUNT", MQIACF_INTATTR_COUNT},
+	{"MQIACF_INTEGER_DATA", MQIACF_INTEGER_DATA},
+	{"MQIACF_INTERFACE_VERSION", MQIACF_INTERFACE_VERSION},
+	{"MQIACF_INT_ATTRS", MQIACF_INT_ATTRS},
+	{"MQIACF_INVALID_DEST_COUNT", MQIACF_INVALID_DEST_COUNT},
+	{"MQIACF_ITEM_COUNT", MQIACF_ITEM_COUNT},
+	{"MQIACF_KNOWN_DEST_COUNT", MQIACF_KNOWN_DEST_COUNT},
+	{"MQIACF_LAST_USED", MQIACF_LAST_USED},
+	{"MQIACF_LDAP_CONNECTION_STATUS", MQIACF_LDAP_CONNECTION_STATUS},
+	{"MQIACF_LISTENER_ATTRS", MQIACF_LISTENER_ATTRS},
+	{"MQIACF_LISTENER_STATUS_ATTRS", MQIACF_LISTENER_STATUS_ATTRS},
+	{"MQIACF_LOG_COMPRESSION", MQIACF_LOG_COMPRESSION},
+	{"MQIACF_LOG_EXTENT_SIZE", MQIACF_LOG_EXTENT_SIZE},
+	{"MQIACF_LOG_FS_IN_USE", MQIACF_LOG_FS_IN_USE},
+	{"MQIACF_LOG_FS_SIZE", MQIACF_LOG_FS_SIZE},
+	{"MQIACF_LOG_IN_USE", MQIACF_LOG_IN_USE},
+	{"MQIACF_LOG_PRIMARIES", MQIACF_LOG_PRIMARIES},
+	{"MQIACF_LOG_REDUCTION", MQIACF_LOG_REDUCTION},
+	{"MQIACF_LOG_SECONDARIES", MQIACF_LOG_SECONDARIES},
+	{"MQIACF_LOG_TYPE", MQIACF_LOG_TYPE},
+	{"MQIACF_LOG_UTILIZATION", MQIACF_LOG_UTILIZATION},
+	{"MQIACF_MAX_ACTIVITIES", MQIACF_MAX_ACTIVITIES},
+	{"MQIACF_MCAST_REL_INDICATOR", MQIACF_MCAST_REL_INDICATOR},
+	{"MQIACF_MEDIA_LOG_SIZE", MQIACF_MEDIA_LOG_SIZE},
+	{"MQIACF_MESSAGE_COUNT", MQIACF_MESSAGE_COUNT},
+	{"MQIACF_MODE", MQIACF_MODE},
+	{"MQIACF_MONITORING", MQIACF_MONITORING},
+	{"MQIACF_MOVABLE_APPL_COUNT", MQIACF_MOVABLE_APPL_COUNT},
+	{"MQIACF_MOVE_COUNT", MQIACF_MOVE_COUNT},
+	{"MQIACF_MOVE_TYPE", MQIACF_MOVE_TYPE},
+	{"MQIACF_MOVE_TYPE_ADD", MQIACF_MOVE_TYPE_ADD},
+	{"MQIACF_MOVE_TYPE_MOVE", MQIACF_MOVE_TYPE_MOVE},
+	{"MQIACF_MQCB_OPERATION", MQIACF_MQCB_OPERATION},
+	{"MQIACF_MQCB_OPTIONS", MQIACF_MQCB_OPTIONS},
+	{"MQIACF_MQCB_TYPE", MQIACF_MQCB_TYPE},
+	{"MQIACF_MQXR_DIAGNOSTICS_TYPE", MQIACF_MQXR_DIAGNOSTICS_TYPE},
+	{"MQIACF_MSG_FLAGS", MQIACF_MSG_FLAGS},
+	{"MQIACF_MSG_LENGTH", MQIACF_MSG_LENGTH},
+	{"MQIACF_MSG_TYPE", MQIACF_MSG_TYPE},
+	{"MQIACF_MULC_CAPTURE", MQIACF_MULC_CAPTURE},
+	{"MQIACF_NAMELIST_ATTRS", MQIACF_NAMELIST_ATTRS},
+	{"MQIACF_NHA_GROUP_BACKLOG", MQIACF_NHA_GROUP_BACKLOG},
+	{"MQIACF_NHA_GROUP_CONNECTED", MQIACF_NHA_GROUP_CONNECTED},
+	{"MQIACF_NHA_GROUP_IN_SYNC", MQIACF_NHA_GROUP_IN_SYNC},
+	{"MQIACF_NHA_GROUP_ROLE", MQIACF_NHA_GROUP_ROLE},
+	{"MQIACF_NHA_GROUP_STATUS", MQIACF_NHA_GROUP_STATUS},
+	{"MQIACF_NHA_INSTANCE_ACTV_CONNS", MQIACF_NHA_INSTANCE_ACTV_CONNS},
+	{"MQIACF_NHA_INSTANCE_BACKLOG", MQIACF_NHA_INSTANCE_BACKLOG},
+	{"MQIACF_NHA_INSTANCE_IN_SYNC", MQIACF_NHA_INSTANCE_IN_SYNC},
+	{"MQIACF_NHA_INSTANCE_ROLE", MQIACF_NHA_INSTANCE_ROLE},
+	{"MQIACF_NHA_INSTANCE_STATUS", MQIACF_NHA_INSTANCE_STATUS},
+	{"MQIACF_NHA_IN_SYNC_INSTANCES", MQIACF_NHA_IN_SYNC_INSTANCES},
+	{"MQIACF_NHA_TOTAL_INSTANCES", MQIACF_NHA_TOTAL_INSTANCES},
+	{"MQIACF_NHA_TYPE", MQIACF_NHA_TYPE},
+	{"MQIACF_NUM_PUBS", MQIACF_NUM_PUBS},
+	{"MQIACF_OBJECT_TYPE", MQIACF_OBJECT_TYPE},
+	{"MQIACF_OBSOLETE_MSGS", MQIACF_OBSOLETE_MSGS},
+	{"MQIACF_OFFSET", MQIACF_OFFSET},
+	{"MQIACF_OLDEST_MSG_AGE", MQIACF_OLDEST_MSG_AGE},
+	{"MQIACF_OPEN_BROWSE", MQIACF_OPEN_BROWSE},
+	{"MQIACF_OPEN_INPUT_TYPE", MQIACF_OPEN_INPUT_TYPE},
+	{"MQIACF_OPEN_INQUIRE", MQIACF_OPEN_INQUIRE},
+	{"MQIACF_OPEN_OPTIONS", MQIACF_OPEN_OPTIONS},
+	{"MQIACF_OPEN_OUTPUT", MQIACF_OPEN_OUTPUT},
+	{"MQIACF_OPEN_SET", MQIACF_OPEN_SET},
+	{"MQIACF_OPEN_TYPE", MQIACF_OPEN_TYPE},
+	{"MQIACF_OPERATION_ID", MQIACF_OPERATION_ID},
+	{"MQIACF_OPERATION_MODE", MQIACF_OPERATION_MODE},
+	{"MQIACF_OPERATION_TYPE", MQIACF_OPERATION_TYPE},
+	{"MQIACF_OPTIONS", MQIACF_OPTIONS},
+	{"MQIACF_ORIGINAL_LENGTH", MQIACF_ORIGINAL_LENGTH},
+	{"MQIACF_PAGECLAS", MQIACF_PAGECLAS},
+	{"MQIACF_PAGESET_STATUS", MQIACF_PAGESET_STATUS},
+	{"MQIACF_PARAMETER_ID", MQIACF_PARAMETER_ID},
+	{"MQIACF_PERMIT_STANDBY", MQIACF_PERMIT_STANDBY},
+	{"MQIACF_PERSISTENCE", MQIACF_PERSISTENCE},
+	{"MQIACF_POINTER_SIZE", MQIACF_POINTER_SIZE},
+	{"MQIACF_PRIORITY", MQIACF_PRIORITY},
+	{"MQIACF_PROCESS_ATTRS", MQIACF_PROCESS_ATTRS},
+	{"MQIACF_PROCESS_ID", MQIACF_PROCESS_ID},
+	{"MQIACF_PS_STATUS_TYPE", MQIACF_PS_STATUS_TYPE},
+	{"MQIACF_PUBLICATION_OPTIONS", MQIACF_PUBLICATION_OPTIONS},
+	{"MQIACF_PUBLISH_COUNT", MQIACF_PUBLISH_COUNT},
+	{"MQIACF_PUBSUB_PROPERTIES", MQIACF_PUBSUB_PROPERTIES},
+	{"MQIACF_PUBSUB_STATUS", MQIACF_PUBSUB_STATUS},
+	{"MQIACF_PUBSUB_STATUS_ATTRS", MQIACF_PUBSUB_STATUS_ATTRS},
+	{"MQIACF_PUB_PRIORITY", MQIACF_PUB_PRIORITY},
+	{"MQIACF_PURGE", MQIACF_PURGE},
+	{"MQIACF_PUT_OPTIONS", MQIACF_PUT_OPTIONS},
+	{"MQIACF_QSG_DISPS", MQIACF_QSG_DISPS},
+	{"MQIACF_QUIESCE", MQIACF_QUIESCE},
+	{"MQIACF_Q_ATTRS", MQIACF_Q_ATTRS},
+	{"MQIACF_Q_HANDLE", MQIACF_Q_HANDLE},
+	{"MQIACF_Q_MGR_ATTRS", MQIACF_Q_MGR_ATTRS},
+	{"MQIACF_Q_MGR_CLUSTER", MQIACF_Q_MGR_CLUSTER},
+	{"MQIACF_Q_MGR_DEFINITION_TYPE", MQIACF_Q_MGR_DEFINITION_TYPE},
+	{"MQIACF_Q_MGR_DQM", MQIACF_Q_MGR_DQM},
+	{"MQIACF_Q_MGR_EVENT", MQIACF_Q_MGR_EVENT},
+	{"MQIACF_Q_MGR_FACILITY", MQIACF_Q_MGR_FACILITY},
+	{"MQIACF_Q_MGR_FS_ENCRYPTED", MQIACF_Q_MGR_FS_ENCRYPTED},
+	{"MQIACF_Q_MGR_FS_IN_USE", MQIACF_Q_MGR_FS_IN_USE},
+	{"MQIACF_Q_MGR_FS_SIZE", MQIACF_Q_MGR_FS_SIZE},
+	{"MQIACF_Q_MGR_NUMBER", MQIACF_Q_MGR_NUMBER},
+	{"MQIACF_Q_MGR_PUBSUB", MQIACF_Q_MGR_PUBSUB},
+	{"MQIACF_Q_MGR_STATUS", MQIACF_Q_MGR_STATUS},
+	{"MQIACF_Q_MGR_STATUS_ATTRS", MQIACF_Q_MGR_STATUS_ATTRS},
+	{"MQIACF_Q_MGR_STATUS_INFO_NHA", MQIACF_Q_MGR_STATUS_INFO_NHA},
+	{"MQIACF_Q_MGR_STATUS_INFO_Q_MGR", MQIACF_Q_MGR_STATUS_INFO_Q_MGR},
+	{"MQIACF_Q_MGR_STATUS_INFO_TYPE", MQIACF_Q_MGR_STATUS_INFO_TYPE},
+	{"MQIACF_Q_MGR_STATUS_LOG", MQIACF_Q_MGR_STATUS_LOG},
+	{"MQIACF_Q_MGR_SYSTEM", MQIACF_Q_MGR_SYSTEM},
+	{"MQIACF_Q_MGR_TYPE", MQIACF_Q_MGR_TYPE},
+	{"MQIACF_Q_MGR_VERSION", MQIACF_Q_MGR_VERSION},
+	{"MQIACF_Q_STATUS", MQIACF_Q_STATUS},
+	{"MQIACF_Q_STATUS_ATTRS", MQIACF_Q_STATUS_ATTRS},
+	{"MQIACF_Q_STATUS_TYPE", MQIACF_Q_STATUS_TYPE},
+	{"MQIACF_Q_TIME_INDICATOR", MQIACF_Q_TIME_INDICATOR},
+	{"MQIACF_Q_TYPES", MQIACF_Q_TYPES},
+	{"MQIACF_REASON_CODE", MQIACF_REASON_CODE},
+	{"MQIACF_REASON_QUALIFIER", MQIACF_REASON_QUALIFIER},
+	{"MQIACF_RECORDED_ACTIVITIES", MQIACF_RECORDED_ACTIVITIES},
+	{"MQIACF_RECS_PRESENT", MQIACF_RECS_PRESENT},
+	{"MQIACF_REFRESH_INTERVAL", MQIACF_REFRESH_INTERVAL},
+	{"MQIACF_REFRESH_REPOSITORY", MQIACF_REFRESH_REPOSITORY},
+	{"MQIACF_REFRESH_TYPE", MQIACF_REFRESH_TYPE},
+	{"MQIACF_REGISTRATION_OPTIONS", MQIACF_REGISTRATION_OPTIONS},
+	{"MQIACF_REG_REG_OPTIONS", MQIACF_REG_REG_OPTIONS},
+	{"MQIACF_REMOTE_QMGR_ACTIVE", MQIACF_REMOTE_QMGR_ACTIVE},
+	{"MQIACF_REMOVE_AUTHREC", MQIACF_REMOVE_AUTHREC},
+	{"MQIACF_REMOVE_QUEUES", MQIACF_REMOVE_QUEUES},
+	{"MQIACF_REPLACE", MQIACF_REPLACE},
+	{"MQIACF_REPORT", MQIACF_REPORT},
+	{"MQIACF_REQUEST_ONLY", MQIACF_REQUEST_ONLY},
+	{"MQIACF_RESOLVED_TYPE", MQIACF_RESOLVED_TYPE},
+	{"MQIACF_RESTART_LOG_SIZE", MQIACF_RESTART_LOG_SIZE},
+	{"MQIACF_RETAINED_PUBLICATION", MQIACF_RETAINED_PUBLICATION},
+	{"MQIACF_REUSABLE_LOG_SIZE", MQIACF_REUSABLE_LOG_SIZE},
+	{"MQIACF_ROUTE_ACCUMULATION", MQIACF_ROUTE_ACCUMULATION},
+	{"MQIACF_ROUTE_DELIVERY", MQIACF_ROUTE_DELIVERY},
+	{"MQIACF_ROUTE_DETAIL", MQIACF_ROUTE_DETAIL},
+	{"MQIACF_ROUTE_FORWARDING", MQIACF_ROUTE_FORWARDING},
+	{"MQIACF_SECURITY_ATTRS", MQIACF_SECURITY_ATTRS},
+	{"MQIACF_SECURITY_INTERVAL", MQIACF_SECURITY_INTERVAL},
+	{"MQIACF_SECURITY_ITEM", MQIACF_SECURITY_ITEM},
+	{"MQIACF_SECURITY_SETTING", MQIACF_SECURITY_SETTING},
+	{"MQIACF_SECURITY_SWITCH", MQIACF_SECURITY_SWITCH},
+	{"MQIACF_SECURITY_TIMEOUT", MQIACF_SECURITY_TIMEOUT},
+	{"MQIACF_SECURITY_TYPE", MQIACF_SECURITY_TYPE},
+	{"MQIACF_SELECTOR", MQIACF_SELECTOR},
+	{"MQIACF_SELECTORS", MQIACF_SELECTORS},
+	{"MQIACF_SELECTOR_COUNT", MQIACF_SELECTOR_COUNT},
+	{"MQIACF_SELECTOR_TYPE", MQIACF_SELECTOR_TYPE},
+	{"MQIACF_SEQUENCE_NUMBER", MQIACF_SEQUENCE_NUMBER},
+	{"MQIACF_SERVICE_ATTRS", MQIACF_SERVICE_ATTRS},
+	{"MQIACF_SERVICE_STATUS", MQIACF_SERVICE_STATUS},
+	{"MQIACF_SERVICE_STATUS_ATTRS", MQIACF_SERVICE_STATUS_ATTRS},
+	{"MQIACF_SMDS_ATTRS", MQIACF_SMDS_ATTRS},
+	{"MQIACF_SMDS_AVAIL", MQIACF_SMDS_AVAIL},
+	{"MQIACF_SMDS_EXPANDST", MQIACF_SMDS_EXPANDST},
+	{"MQIACF_SMDS_OPENMODE", MQIACF_SMDS_OPENMODE},
+	{"MQIACF_SMDS_STATUS", MQIACF_SMDS_STATUS},
+	{"MQIACF_STATUS_TYPE", MQIACF_STATUS_TYPE},
+	{"MQIACF_STORAGE_CLASS_ATTRS", MQIACF_STORAGE_CLASS_ATTRS},
+	{"MQIACF_STRUC_LENGTH", MQIACF_STRUC_LENGTH},
+	{"MQIACF_SUBRQ_ACTION", MQIACF_SUBRQ_ACTION},
+	{"MQIACF_SUBRQ_OPTIONS", MQIACF_SUBRQ_OPTIONS},
+	{"MQIACF_SUBSCRIPTION_SCOPE", MQIACF_SUBSCRIPTION_SCOPE},
+	{"MQIACF_SUB_ATTRS", MQIACF_SUB_ATTRS},
+	{"MQIACF_SUB_LEVEL", MQIACF_SUB_LEVEL},
+	{"MQIACF_SUB_OPTIONS", MQIACF_SUB_OPTIONS},
+	{"MQIACF_SUB_STATUS_ATTRS", MQIACF_SUB_STATUS_ATTRS},
+	{"MQIACF_SUB_SUMMARY", MQIACF_SUB_SUMMARY},
+	{"MQIACF_SUB_TYPE", MQIACF_SUB_TYPE},
+	{"MQIACF_SUSPEND", MQIACF_SUSPEND},
+	{"MQIACF_SYSP_ALLOC_PRIMARY", MQIACF_SYSP_ALLOC_PRIMARY},
+	{"MQIACF_SYSP_ALLOC_SECONDARY", MQIACF_SYSP_ALLOC_SECONDARY},
+	{"MQIACF_SYSP_ALLOC_UNIT", MQIACF_SYSP_ALLOC_UNIT},
+	{"MQIACF_SYSP_ARCHIVE", MQIACF_SYSP_ARCHIVE},
+	{"MQIACF_SYSP_ARCHIVE_RETAIN", MQIACF_SYSP_ARCHIVE_RETAIN},
+	{"MQIACF_SYSP_ARCHIVE_WTOR", MQIACF_SYSP_ARCHIVE_WTOR},
+	{"MQIACF_SYSP_BLOCK_SIZE", MQIACF_SYSP_BLOCK_SIZE},
+	{"MQIACF_SYSP_CATALOG", MQIACF_SYSP_CATALOG},
+	{"MQIACF_SYSP_CHKPOINT_COUNT", MQIACF_SYSP_CHKPOINT_COUNT},
+	{"MQIACF_SYSP_CLUSTER_CACHE", MQIACF_SYSP_CLUSTER_CACHE},
+	{"MQIACF_SYSP_COMPACT", MQIACF_SYSP_COMPACT},
+	{"MQIACF_SYSP_DB2_BLOB_TASKS", MQIACF_SYSP_DB2_BLOB_TASKS},
+	{"MQIACF_SYSP_DB2_TASKS", MQIACF_SYSP_DB2_TASKS},
+	{"MQIACF_SYSP_DEALLOC_INTERVAL", MQIACF_SYSP_DEALLOC_INTERVAL},
+	{"MQIACF_SYSP_DUAL_ACTIVE", MQIACF_SYSP_DUAL_ACTIVE},
+	{"MQIACF_SYSP_DUAL_ARCHIVE", MQIACF_SYSP_DUAL_ARCHIVE},
+	{"MQIACF_SYSP_DUAL_BSDS", MQIACF_SYSP_DUAL_BSDS},
+	{"MQIACF_SYSP_EXIT_INTERVAL", MQIACF_SYSP_EXIT_INTERVAL},
+	{"MQIACF_SYSP_EXIT_TASKS", MQIACF_SYSP_EXIT_TASKS},
+	{"MQIACF_SYSP_FULL_LOGS", MQIACF_SYSP_FULL_LOGS},
+	{"MQIACF_SYSP_IN_BUFFER_SIZE", MQIACF_SYSP_IN_BUFFER_SIZE},
+	{"MQIACF_SYSP_LOG_COPY", MQIACF_SYSP_LOG_COPY},
+	{"MQIACF_SYSP_LOG_SUSPEND", MQIACF_SYSP_LOG_SUSPEND},
+	{"MQIACF_SYSP_LOG_USED", MQIACF_SYSP_LOG_USED},
+	{"MQIACF_SYSP_MAX_ACE_POOL", MQIACF_SYSP_MAX_ACE_POOL},
+	{"MQIACF_SYSP_MAX_ARCHIVE", MQIACF_SYSP_MAX_ARCHIVE},
+	{"MQIACF_SYSP_MAX_CONC_OFFLOADS", MQIACF_SYSP_MAX_CONC_OFFLOADS},
+	{"MQIACF_SYSP_MAX_CONNS", MQIACF_SYSP_MAX_CONNS},
+	{"MQIACF_SYSP_MAX_CONNS_BACK", MQIACF_SYSP_MAX_CONNS_BACK},
+	{"MQIACF_SYSP_MAX_CONNS_FORE", MQIACF_SYSP_MAX_CONNS_FORE},
+	{"MQIACF_SYSP_MAX_READ_TAPES", MQIACF_SYSP_MAX_READ_TAPES},
+	{"MQIACF_SYSP_OFFLOAD_STATUS", MQIACF_SYSP_OFFLOAD_STATUS},
+	{"MQIACF_SYSP_OTMA_INTERVAL", MQIACF_SYSP_OTMA_INTERVAL},
+	{"MQIACF_SYSP_OUT_BUFFER_COUNT", MQIACF_SYSP_OUT_BUFFER_COUNT},
+	{"MQIACF_SYSP_OUT_BUFFER_SIZE", MQIACF_SYSP_OUT_BUFFER_SIZE},
+	{"MQIACF_SYSP_PROTECT", MQIACF_SYSP_PROTECT},
+	{"MQIACF_SYSP_QUIESCE_INTERVAL", MQIACF_SYSP_QUIESCE_INTERVAL},
+	{"MQIACF_SYSP_Q_INDEX_DEFER", MQIACF_SYSP_Q_INDEX_DEFER},
+	{"MQIACF_SYSP_RESLEVEL_AUDIT", MQIACF_SYSP_RESLEVEL_AUDIT},
+	{"MQIACF_SYSP_ROUTING_CODE", MQIACF_SYSP_ROUTING_CODE},
+	{"MQIACF_SYSP_SMF_ACCOUNTING", MQIACF_SYSP_SMF_ACCOUNTING},
+	{"MQIACF_SYSP_SMF_ACCT_TIME_MINS", MQIACF_SYSP_SMF_ACCT_TIME_MINS},
+	{"MQIACF_SYSP_SMF_ACCT_TIME_SECS", MQIACF_SYSP_SMF_ACCT_TIME_SECS},
+	{"MQIACF_SYSP_SMF_INTERVAL", MQIACF_SYSP_SMF_INTERVAL},
+	{"MQIACF_SYSP_SMF_STATS", MQIACF_SYSP_SMF_STATS},
+	{"MQIACF_SYSP_SMF_STAT_TIME_MINS", MQIACF_SYSP_SMF_STAT_TIME_MINS},
+	{"MQIACF_SYSP_SMF_STAT_TIME_SECS", MQIACF_SYSP_SMF_STAT_TIME_SECS},
+	{"MQIACF_SYSP_TIMESTAMP", MQIACF_SYSP_TIMESTAMP},
+	{"MQIACF_SYSP_TOTAL_LOGS", MQIACF_SYSP_TOTAL_LOGS},
+	{"MQIACF_SYSP_TRACE_CLASS", MQIACF_SYSP_TRACE_CLASS},
+	{"MQIACF_SYSP_TRACE_SIZE", MQIACF_SYSP_TRACE_SIZE},
+	{"MQIACF_SYSP_TYPE", MQIACF_SYSP_TYPE},
+	{"MQIACF_SYSP_UNIT_ADDRESS", MQIACF_SYSP_UNIT_ADDRESS},
+	{"MQIACF_SYSP_UNIT_STATUS", MQIACF_SYSP_UNIT_STATUS},
+	{"MQIACF_SYSP_WLM_INTERVAL", MQIACF_SYSP_WLM_INTERVAL},
+	{"MQIACF_SYSP_WLM_INT_UNITS", MQIACF_SYSP_WLM_INT_UNITS},
+	{"MQIACF_SYSP_ZHYPERLINK", MQIACF_SYSP_ZHYPERLINK},
+	{"MQIACF_SYSP_ZHYPERWRITE", MQIACF_SYSP_ZHYPERWRITE},
+	{"MQIACF_SYSTEM_OBJECTS", MQIACF_SYSTEM_OBJECTS},
+	{"MQIACF_THREAD_ID", MQIACF_THREAD_ID},
+	{"MQIACF_TOPIC_ATTRS", MQIACF_TOPIC_ATTRS},
+	{"MQIACF_TOPIC_PUB", MQIACF_TOPIC_PUB},
+	{"MQIACF_TOPIC_STATUS", MQIACF_TOPIC_STATUS},
+	{"MQIACF_TOPIC_STATUS_ATTRS", MQIACF_TOPIC_STATUS_ATTRS},
+	{"MQIACF_TOPIC_STATUS_TYPE", MQIACF_TOPIC_STATUS_TYPE},
+	{"MQIACF_TOPIC_SUB", MQIACF_TOPIC_SUB},
+	{"MQIACF_TRACE_DATA_LENGTH", MQIACF_TRACE_DATA_LENGTH},
+	{"MQIACF_TRACE_DETAIL", MQIACF_TRACE_DETAIL},
+	{"MQIACF_UNCOMMITTED_MSGS", MQIACF_UNCOMMITTED_MSGS},
+	{"MQIACF_UNKNOWN_DEST_COUNT", MQIACF_UNKNOWN_DEST_COUNT},
+	{"MQIACF_UNRECORDED_ACTIVITIES", MQIACF_UNRECORDED_ACTIVITIES},
+	{"MQIACF_UOW_STATE", MQIACF_UOW_STATE},
+	{"MQIACF_UOW_TYPE", MQIACF_UOW_TYPE},
+	{"MQIACF_USAGE_BLOCK_SIZE", MQIACF_USAGE_BLOCK_SIZE},
+	{"MQIACF_USAGE_BUFFER_POOL", MQIACF_USAGE_BUFFER_POOL},
+	{"MQIACF_USAGE_DATA_BLOCKS", MQIACF_USAGE_DATA_BLOCKS},
+	{"MQIACF_USAGE_DATA_SET", MQIACF_USAGE_DATA_SET},
+	{"MQIACF_USAGE_DATA_SET_TYPE", MQIACF_USAGE_DATA_SET_TYPE},
+	{"MQIACF_USAGE_EMPTY_BUFFERS", MQIACF_USAGE_EMPTY_BUFFERS},
+	{"MQIACF_USAGE_EXPAND_COUNT", MQIACF_USAGE_EXPAND_COUNT},
+	{"MQIACF_USAGE_EXPAND_TYPE", MQIACF_USAGE_EXPAND_TYPE},
+	{"MQIACF_USAGE_FREE_BUFF", MQIACF_USAGE_FREE_BUFF},
+	{"MQIACF_USAGE_FREE_BUFF_PERC", MQIACF_USAGE_FREE_BUFF_PERC},
+	{"MQIACF_USAGE_INUSE_BUFFERS", MQIACF_USAGE_INUSE_BUFFERS},
+	{"MQIACF_USAGE_LOWEST_FREE", MQIACF_USAGE_LOWEST_FREE},
+	{"MQIACF_USAGE_NONPERSIST_PAGES", MQIACF_USAGE_NONPERSIST_PAGES},
+	{"MQIACF_USAGE_OFFLOAD_MSGS", MQIACF_USAGE_OFFLOAD_MSGS},
+	{"MQIACF_USAGE_PAGESET", MQIACF_USAGE_PAGESET},
+	{"MQIACF_USAGE_PERSIST_PAGES", MQIACF_USAGE_PERSIST_PAGES},
+	{"MQIACF_USAGE_READS_SAVED", MQIACF_USAGE_READS_SAVED},
+	{"MQIACF_USAGE_RESTART_EXTENTS", MQIACF_USAGE_RESTART_EXTENTS},
+	{"MQIACF_USAGE_SAVED_BUFFERS", MQIACF_USAGE_SAVED_BUFFERS},
+	{"MQIACF_USAGE_SMDS", MQIACF_USAGE_SMDS},
+	{"MQIACF_USAGE_TOTAL_BLOCKS", MQIACF_USAGE_TOTAL_BLOCKS},
+	{"MQIACF_USAGE_TOTAL_BUFFERS", MQIACF_USAGE_TOTAL_BUFFERS},
+	{"MQIACF_USAGE_TOTAL_PAGES", MQIACF_USAGE_TOTAL_PAGES},
+	{"MQIACF_USAGE_TYPE", MQIACF_USAGE_TYPE},
+	{"MQIACF_USAGE_UNUSED_PAGES", MQIACF_USAGE_UNUSED_PAGES},
+	{"MQIACF_USAGE_USED_BLOCKS", MQIACF_USAGE_USED_BLOCKS},
+	{"MQIACF_USAGE_USED_RATE", MQIACF_USAGE_USED_RATE},
+	{"MQIACF_USAGE_WAIT_RATE", MQIACF_USAGE_WAIT_RATE},
+	{"MQIACF_USER_ID_SUPPORT", MQIACF_USER_ID_SUPPORT},
+	{"MQIACF_VARIABLE_USER_ID", MQIACF_VARIABLE_USER_ID},
+	{"MQIACF_VERSION", MQIACF_VERSION},
+	{"MQIACF_WAIT_INTERVAL", MQIACF_WAIT_INTERVAL},
+	{"MQIACF_WILDCARD_SCHEMA", MQIACF_WILDCARD_SCHEMA},
+	{"MQIACF_XA_COUNT", MQIACF_XA_COUNT},
+	{"MQIACF_XA_FLAGS", MQIACF_XA_FLAGS},
+	{"MQIACF_XA_HANDLE", MQIACF_XA_HANDLE},
+	{"MQIACF_XA_RETCODE", MQIACF_XA_RETCODE},
+	{"MQIACF_XA_RETVAL", MQIACF_XA_RETVAL},
+	{"MQIACF_XA_RMID", MQIACF_XA_RMID},
+	{"MQIACF_XR_ATTRS", MQIACF_XR_ATTRS},
+	{"MQIACH_ACTIVE_CHL", MQIACH_ACTIVE_CHL},
+	{"MQIACH_ACTIVE_CHL_MAX", MQIACH_ACTIVE_CHL_MAX},
+	{"MQIACH_ACTIVE_CHL_PAUSED", MQIACH_ACTIVE_CHL_PAUSED},
+	{"MQIACH_ACTIVE_CHL_RETRY", MQIACH_ACTIVE_CHL_RETRY},
+	{"MQIACH_ACTIVE_CHL_STARTED", MQIACH_ACTIVE_CHL_STARTED},
+	{"MQIACH_ACTIVE_CHL_STOPPED", MQIACH_ACTIVE_CHL_STOPPED},
+	{"MQIACH_ADAPS_MAX", MQIACH_ADAPS_MAX},
+	{"MQIACH_ADAPS_STARTED", MQIACH_ADAPS_STARTED},
+	{"MQIACH_ADAPTER", MQIACH_ADAPTER},
+	{"MQIACH_ALLOC_FAST_TIMER", MQIACH_ALLOC_FAST_TIMER},
+	{"MQIACH_ALLOC_RETRY", MQIACH_ALLOC_RETRY},
+	{"MQIACH_ALLOC_SLOW_TIMER", MQIACH_ALLOC_SLOW_TIMER},
+	{"MQIACH_AMQP_KEEP_ALIVE", MQIACH_AMQP_KEEP_ALIVE},
+	{"MQIACH_AUTH_INFO_TYPES", MQIACH_AUTH_INFO_TYPES},
+	{"MQIACH_AVAILABLE_CIPHERSPECS", MQIACH_AVAILABLE_CIPHERSPECS},
+	{"MQIACH_BACKLOG", MQIACH_BACKLOG},
+	{"MQIACH_BATCHES", MQIACH_BATCHES},
+	{"MQIACH_BATCH_DATA_LIMIT", MQIACH_BATCH_DATA_LIMIT},
+	{"MQIACH_BATCH_HB", MQIACH_BATCH_HB},
+	{"MQIACH_BATCH_INTERVAL", MQIACH_BATCH_INTERVAL},
+	{"MQIACH_BATCH_SIZE", MQIACH_BATCH_SIZE},
+	{"MQIACH_BATCH_SIZE_INDICATOR", MQIACH_BATCH_SIZE_INDICATOR},
+	{"MQIACH_BUFFERS_RCVD", MQIACH_BUFFERS_RCVD},
+	{"MQIACH_BUFFERS_RECEIVED", MQIACH_BUFFERS_RECEIVED},
+	{"MQIACH_BUFFERS_SENT", MQIACH_BUFFERS_SENT},
+	{"MQIACH_BYTES_RCVD", MQIACH_BYTES_RCVD},
+	{"MQIACH_BYTES_RECEIVED", MQIACH_BYTES_RECEIVED},
+	{"MQIACH_BYTES_SENT", MQIACH_BYTES_SENT},
+	{"MQIACH_CHANNEL_DISP", MQIACH_CHANNEL_DISP},
+	{"MQIACH_CHANNEL_ERROR_DATA", MQIACH_CHANNEL_ERROR_DATA},
+	{"MQIACH_CHANNEL_INSTANCE_ATTRS", MQIACH_CHANNEL_INSTANCE_ATTRS},
+	{"MQIACH_CHANNEL_INSTANCE_TYPE", MQIACH_CHANNEL_INSTANCE_TYPE},
+	{"MQIACH_CHANNEL_STATUS", MQIACH_CHANNEL_STATUS},
+	{"MQIACH_CHANNEL_SUBSTATE", MQIACH_CHANNEL_SUBSTATE},
+	{"MQIACH_CHANNEL_SUMMARY_ATTRS", MQIACH_CHANNEL_SUMMARY_ATTRS},
+	{"MQIACH_CHANNEL_TABLE", MQIACH_CHANNEL_TABLE},
+	{"MQIACH_CHANNEL_TYPE", MQIACH_CHANNEL_TYPE},
+	{"MQIACH_CHANNEL_TYPES", MQIACH_CHANNEL_TYPES},
+	{"MQIACH_CLIENT_CHANNEL_WEIGHT", MQIACH_CLIENT_CHANNEL_WEIGHT},
+	{"MQIACH_CLWL_CHANNEL_PRIORITY", MQIACH_CLWL_CHANNEL_PRIORITY},
+	{"MQIACH_CLWL_CHANNEL_RANK", MQIACH_CLWL_CHANNEL_RANK},
+	{"MQIACH_CLWL_CHANNEL_WEIGHT", MQIACH_CLWL_CHANNEL_WEIGHT},
+	{"MQIACH_COMMAND_COUNT", MQIACH_COMMAND_COUNT},
+	{"MQIACH_COMPRESSION_RATE", MQIACH_COMPRESSION_RATE},
+	{"MQIACH_COMPRESSION_TIME", MQIACH_COMPRESSION_TIME},
+	{"MQIACH_CONNECTION_AFFINITY", MQIACH_CONNECTION_AFFINITY},
+	{"MQIACH_CURRENT_CHL", MQIACH_CURRENT_CHL},
+	{"MQIACH_CURRENT_CHL_LU62", MQIACH_CURRENT_CHL_LU62},
+	{"MQIACH_CURRENT_CHL_MAX", MQIACH_CURRENT_CHL_MAX},
+	{"MQIACH_CURRENT_CHL_TCP", MQIACH_CURRENT_CHL_TCP},
+	{"MQIACH_CURRENT_MSGS", MQIACH_CURRENT_MSGS},
+	{"MQIACH_CURRENT_SEQUENCE_NUMBER", MQIACH_CURRENT_SEQUENCE_NUMBER},
+	{"MQIACH_CURRENT_SEQ_NUMBER", MQIACH_CURRENT_SEQ_NUMBER},
+	{"MQIACH_CURRENT_SHARING_CONVS", MQIACH_CURRENT_SHARING_CONVS},
+	{"MQIACH_DATA_CONVERSION", MQIACH_DATA_CONVERSION},
+	{"MQIACH_DATA_COUNT", MQIACH_DATA_COUNT},
+	{"MQIACH_DEF_CHANNEL_DISP", MQIACH_DEF_CHANNEL_DISP},
+	{"MQIACH_DEF_RECONNECT", MQIACH_DEF_RECONNECT},
+	{"MQIACH_DISC_INTERVAL", MQIACH_DISC_INTERVAL},
+	{"MQIACH_DISC_RETRY", MQIACH_DISC_RETRY},
+	{"MQIACH_DISPS_MAX", MQIACH_DISPS_MAX},
+	{"MQIACH_DISPS_STARTED", MQIACH_DISPS_STARTED},
+	{"MQIACH_EXIT_TIME_INDICATOR", MQIACH_EXIT_TIME_INDICATOR},
+	{"MQIACH_FIRST", MQIACH_FIRST},
+	{"MQIACH_HB_INTERVAL", MQIACH_HB_INTERVAL},
+	{"MQIACH_HDR_COMPRESSION", MQIACH_HDR_COMPRESSION},
+	{"MQIACH_INBOUND_DISP", MQIACH_INBOUND_DISP},
+	{"MQIACH_INDOUBT_STATUS", MQIACH_INDOUBT_STATUS},
+	{"MQIACH_IN_DOUBT", MQIACH_IN_DOUBT},
+	{"MQIACH_IN_DOUBT_IN", MQIACH_IN_DOUBT_IN},
+	{"MQIACH_IN_DOUBT_OUT", MQIACH_IN_DOUBT_OUT},
+	{"MQIACH_KEEP_ALIVE_INTERVAL", MQIACH_KEEP_ALIVE_INTERVAL},
+	{"MQIACH_LAST_SEQUENCE_NUMBER", MQIACH_LAST_SEQUENCE_NUMBER},
+	{"MQIACH_LAST_SEQ_NUMBER", MQIACH_LAST_SEQ_NUMBER},
+	{"MQIACH_LAST_USED", MQIACH_LAST_USED},
+	{"MQIACH_LISTENER_CONTROL", MQIACH_LISTENER_CONTROL},
+	{"MQIACH_LISTENER_STATUS", MQIACH_LISTENER_STATUS},
+	{"MQIACH_LONG_RETRIES_LEFT", MQIACH_LONG_RETRIES_LEFT},
+	{"MQIACH_LONG_RETRY", MQIACH_LONG_RETRY},
+	{"MQIACH_LONG_TIMER", MQIACH_LONG_TIMER},
+	{"MQIACH_MATCH", MQIACH_MATCH},
+	{"MQIACH_MAX_INSTANCES", MQIACH_MAX_INSTANCES},
+	{"MQIACH_MAX_INSTS_PER_CLIENT", MQIACH_MAX_INSTS_PER_CLIENT},
+	{"MQIACH_MAX_MSG_LENGTH", MQIACH_MAX_MSG_LENGTH},
+	{"MQIACH_MAX_SHARING_CONVS", MQIACH_MAX_SHARING_CONVS},
+	{"MQIACH_MAX_XMIT_SIZE", MQIACH_MAX_XMIT_SIZE},
+	{"MQIACH_MCA_STATUS", MQIACH_MCA_STATUS},
+	{"MQIACH_MCA_TYPE", MQIACH_MCA_TYPE},
+	{"MQIACH_MC_HB_INTERVAL", MQIACH_MC_HB_INTERVAL},
+	{"MQIACH_MQTT_KEEP_ALIVE", MQIACH_MQTT_KEEP_ALIVE},
+	{"MQIACH_MR_COUNT", MQIACH_MR_COUNT},
+	{"MQIACH_MR_INTERVAL", MQIACH_MR_INTERVAL},
+	{"MQIACH_MSGS", MQIACH_MSGS},
+	{"MQIACH_MSGS_RCVD", MQIACH_MSGS_RCVD},
+	{"MQIACH_MSGS_RECEIVED", MQIACH_MSGS_RECEIVED},
+	{"MQIACH_MSGS_SENT", MQIACH_MSGS_SENT},
+	{"MQIACH_MSG_COMPRESSION", MQIACH_MSG_COMPRESSION},
+	{"MQIACH_MSG_HISTORY", MQIACH_MSG_HISTORY},
+	{"MQIACH_MSG_SEQUENCE_NUMBER", MQIACH_MSG_SEQUENCE_NUMBER},
+	{"MQIACH_MULTICAST_PROPERTIES", MQIACH_MULTICAST_PROPERTIES},
+	{"MQIACH_NAME_COUNT", MQIACH_NAME_COUNT},
+	{"MQIACH_NETWORK_PRIORITY", MQIACH_NETWORK_PRIORITY},
+	{"MQIACH_NETWORK_TIME_INDICATOR", MQIACH_NETWORK_TIME_INDICATOR},
+	{"MQIACH_NEW_SUBSCRIBER_HISTORY", MQIACH_NEW_SUBSCRIBER_HISTORY},
+	{"MQIACH_NPM_SPEED", MQIACH_NPM_SPEED},
+	{"MQIACH_PENDING_OUT", MQIACH_PENDING_OUT},
+	{"MQIACH_PORT", MQIACH_PORT},
+	{"MQIACH_PORT_NUMBER", MQIACH_PORT_NUMBER},
+	{"MQIACH_PROTOCOL", MQIACH_PROTOCOL},
+	{"MQIACH_PUT_AUTHORITY", MQIACH_PUT_AUTHORITY},
+	{"MQIACH_RESET_REQUESTED", MQIACH_RESET_REQUESTED},
+	{"MQIACH_SECURITY_PROTOCOL", MQIACH_SECURITY_PROTOCOL},
+	{"MQIACH_SEQUENCE_NUMBER_WRAP", MQIACH_SEQUENCE_NUMBER_WRAP},
+	{"MQIACH_SESSION_COUNT", MQIACH_SESSION_COUNT},
+	{"MQIACH_SHARED_CHL_RESTART", MQIACH_SHARED_CHL_RESTART},
+	{"MQIACH_SHARING_CONVERSATIONS", MQIACH_SHARING_CONVERSATIONS},
+	{"MQIACH_SHORT_RETRIES_LEFT", MQIACH_SHORT_RETRIES_LEFT},
+	{"MQIACH_SHORT_RETRY", MQIACH_SHORT_RETRY},
+	{"MQIACH_SHORT_TIMER", MQIACH_SHORT_TIMER},
+	{"MQIACH_SOCKET", MQIACH_SOCKET},
+	{"MQIACH_SPL_PROTECTION", MQIACH_SPL_PROTECTION},
+	{"MQIACH_SSLTASKS_MAX", MQIACH_SSLTASKS_MAX},
+	{"MQIACH_SSLTASKS_STARTED", MQIACH_SSLTASKS_STARTED},
+	{"MQIACH_SSL_CLIENT_AUTH", MQIACH_SSL_CLIENT_AUTH},
+	{"MQIACH_SSL_KEY_RESETS", MQIACH_SSL_KEY_RESETS},
+	{"MQIACH_SSL_RETURN_CODE", MQIACH_SSL_RETURN_CODE},
+	{"MQIACH_STOP_REQUESTED", MQIACH_STOP_REQUESTED},
+	{"MQIACH_USER_SOURCE", MQIACH_USER_SOURCE},
+	{"MQIACH_USE_CLIENT_ID", MQIACH_USE_CLIENT_ID},
+	{"MQIACH_WARNING", MQIACH_WARNING},
+	{"MQIACH_XMITQ_MSGS_AVAILABLE", MQIACH_XMITQ_MSGS_AVAILABLE},
+	{"MQIACH_XMITQ_TIME_INDICATOR", MQIACH_XMITQ_TIME_INDICATOR},
+	{"MQIACH_XMIT_PROTOCOL_TYPE", MQIACH_XMIT_PROTOCOL_TYPE},
+	{"MQIAMO64_AVG_Q_TIME", MQIAMO64_AVG_Q_TIME},
+	{"MQIAMO64_BROWSE_BYTES", MQIAMO64_BROWSE_BYTES},
+	{"MQIAMO64_BYTES", MQIAMO64_BYTES},
+	{"MQIAMO64_GET_BYTES", MQIAMO64_GET_BYTES},
+	{"MQIAMO64_HIGHRES_TIME", MQIAMO64_HIGHRES_TIME},
+	{"MQIAMO64_MONITOR_INTERVAL", MQIAMO64_MONITOR_INTERVAL},
+	{"MQIAMO64_PUBLISH_MSG_BYTES", MQIAMO64_PUBLISH_MSG_BYTES},
+	{"MQIAMO64_PUT_BYTES", MQIAMO64_PUT_BYTES},
+	{"MQIAMO64_QMGR_OP_DURATION", MQIAMO64_QMGR_OP_DURATION},
+	{"MQIAMO64_Q_TIME_AVG", MQIAMO64_Q_TIME_AVG},
+	{"MQIAMO64_Q_TIME_MAX", MQIAMO64_Q_TIME_MAX},
+	{"MQIAMO64_Q_TIME_MIN", MQIAMO64_Q_TIME_MIN},
+	{"MQIAMO64_TOPIC_PUT_BYTES", MQIAMO64_TOPIC_PUT_BYTES},
+	{"MQIAMO_ACKS_RCVD", MQIAMO_ACKS_RCVD},
+	{"MQIAMO_ACK_FEEDBACK", MQIAMO_ACK_FEEDBACK},
+	{"MQIAMO_ACTIVE_ACKERS", MQIAMO_ACTIVE_ACKERS},
+	{"MQIAMO_AVG_BATCH_SIZE", MQIAMO_AVG_BATCH_SIZE},
+	{"MQIAMO_AVG_Q_TIME", MQIAMO_AVG_Q_TIME},
+	{"MQIAMO_BACKOUTS", MQIAMO_BACKOUTS},
+	{"MQIAMO_BROWSES", MQIAMO_BROWSES},
+	{"MQIAMO_BROWSES_FAILED", MQIAMO_BROWSES_FAILED},
+	{"MQIAMO_BROWSE_MAX_BYTES", MQIAMO_BROWSE_MAX_BYTES},
+	{"MQIAMO_BROWSE_MIN_BYTES", MQIAMO_BROWSE_MIN_BYTES},
+	{"MQIAMO_BYTES_SENT", MQIAMO_BYTES_SENT},
+	{"MQIAMO_CBS", MQIAMO_CBS},
+	{"MQIAMO_CBS_FAILED", MQIAMO_CBS_FAILED},
+	{"MQIAMO_CLOSES", MQIAMO_CLOSES},
+	{"MQIAMO_CLOSES_FAILED", MQIAMO_CLOSES_FAILED},
+	{"MQIAMO_COMMITS", MQIAMO_COMMITS},
+	{"MQIAMO_COMMITS_FAILED", MQIAMO_COMMITS_FAILED},
+	{"MQIAMO_CONNS", MQIAMO_CONNS},
+	{"MQIAMO_CONNS_FAILED", MQIAMO_CONNS_FAILED},
+	{"MQIAMO_CONNS_MAX", MQIAMO_CONNS_MAX},
+	{"MQIAMO_CTLS", MQIAMO_CTLS},
+	{"MQIAMO_CTLS_FAILED", MQIAMO_CTLS_FAILED},
+	{"MQIAMO_DEST_DATA_PORT", MQIAMO_DEST_DATA_PORT},
+	{"MQIAMO_DEST_REPAIR_PORT", MQIAMO_DEST_REPAIR_PORT},
+	{"MQIAMO_DISCS", MQIAMO_DISCS},
+	{"MQIAMO_DISCS_IMPLICIT", MQIAMO_DISCS_IMPLICIT},
+	{"MQIAMO_DISC_TYPE", MQIAMO_DISC_TYPE},
+	{"MQIAMO_EXIT_TIME_AVG", MQIAMO_EXIT_TIME_AVG},
+	{"MQIAMO_EXIT_TIME_MAX", MQIAMO_EXIT_TIME_MAX},
+	{"MQIAMO_EXIT_TIME_MIN", MQIAMO_EXIT_TIME_MIN},
+	{"MQIAMO_FEEDBACK_MODE", MQIAMO_FEEDBACK_MODE},
+	{"MQIAMO_FIRST", MQIAMO_FIRST},
+	{"MQIAMO_FULL_BATCHES", MQIAMO_FULL_BATCHES},
+	{"MQIAMO_GENERATED_MSGS", MQIAMO_GENERATED_MSGS},
+	{"MQIAMO_GETS", MQIAMO_GETS},
+	{"MQIAMO_GETS_FAILED", MQIAMO_GETS_FAILED},
+	{"MQIAMO_GET_MAX_BYTES", MQIAMO_GET_MAX_BYTES},
+	{"MQIAMO_GET_MIN_BYTES", MQIAMO_GET_MIN_BYTES},
+	{"MQIAMO_HISTORY_PKTS", MQIAMO_HISTORY_PKTS},
+	{"MQIAMO_INCOMPLETE_BATCHES", MQIAMO_INCOMPLETE_BATCHES},
+	{"MQIAMO_INQS", MQIAMO_INQS},
+	{"MQIAMO_INQS_FAILED", MQIAMO_INQS_FAILED},
+	{"MQIAMO_INTERVAL", MQIAMO_INTERVAL},
+	{"MQIAMO_LAST_USED", MQIAMO_LAST_USED},
+	{"MQIAMO_LATE_JOIN_MARK", MQIAMO_LATE_JOIN_MARK},
+	{"MQIAMO_MCAST_BATCH_TIME", MQIAMO_MCAST_BATCH_TIME},
+	{"MQIAMO_MCAST_HEARTBEAT", MQIAMO_MCAST_HEARTBEAT},
+	{"MQIAMO_MCAST_XMIT_RATE", MQIAMO_MCAST_XMIT_RATE},
+	{"MQIAMO_MONITOR_CLASS", MQIAMO_MONITOR_CLASS},
+	{"MQIAMO_MONITOR_DATATYPE", MQIAMO_MONITOR_DATATYPE},
+	{"MQIAMO_MONITOR_DELTA", MQIAMO_MONITOR_DELTA},
+	{"MQIAMO_MONITOR_ELEMENT", MQIAMO_MONITOR_ELEMENT},
+	{"MQIAMO_MONITOR_FLAGS", MQIAMO_MONITOR_FLAGS},
+	{"MQIAMO_MONITOR_FLAGS_NONE", MQIAMO_MONITOR_FLAGS_NONE},
+	{"MQIAMO_MONITOR_FLAGS_OBJNAME", MQIAMO_MONITOR_FLAGS_OBJNAME},
+	{"MQIAMO_MONITOR_GB", MQIAMO_MONITOR_GB},
+	{"MQIAMO_MONITOR_HUNDREDTHS", MQIAMO_MONITOR_HUNDREDTHS},
+	{"MQIAMO_MONITOR_KB", MQIAMO_MONITOR_KB},
+	{"MQIAMO_MONITOR_LSN", MQIAMO_MONITOR_LSN},
+	{"MQIAMO_MONITOR_MB", MQIAMO_MONITOR_MB},
+	{"MQIAMO_MONITOR_MICROSEC", MQIAMO_MONITOR_MICROSEC},
+	{"MQIAMO_MONITOR_PERCENT", MQIAMO_MONITOR_PERCENT},
+	{"MQIAMO_MONITOR_TIMESTAMP", MQIAMO_MONITOR_TIMESTAMP},
+	{"MQIAMO_MONITOR_TYPE", MQIAMO_MONITOR_TYPE},
+	{"MQIAMO_MONITOR_UNIT", MQIAMO_MONITOR_UNIT},
+	{"MQIAMO_MSGS", MQIAMO_MSGS},
+	{"MQIAMO_MSGS_DELIVERED", MQIAMO_MSGS_DELIVERED},
+	{"MQIAMO_MSGS_EXPIRED", MQIAMO_MSGS_EXPIRED},
+	{"MQIAMO_MSGS_NOT_QUEUED", MQIAMO_MSGS_NOT_QUEUED},
+	{"MQIAMO_MSGS_PURGED", MQIAMO_MSGS_PURGED},
+	{"MQIAMO_MSGS_RCVD", MQIAMO_MSGS_RCVD},
+	{"MQIAMO_MSGS_SENT", MQIAMO_MSGS_SENT},
+	{"MQIAMO_MSG_BYTES_RCVD", MQIAMO_MSG_BYTES_RCVD},
+	{"MQIAMO_NACKS_CREATED", MQIAMO_NACKS_CREATED},
+	{"MQIAMO_NACKS_RCVD", MQIAMO_NACKS_RCVD},
+	{"MQIAMO_NACK_FEEDBACK", MQIAMO_NACK_FEEDBACK},
+	{"MQIAMO_NACK_PKTS_SENT", MQIAMO_NACK_PKTS_SENT},
+	{"MQIAMO_NET_TIME_AVG", MQIAMO_NET_TIME_AVG},
+	{"MQIAMO_NET_TIME_MAX", MQIAMO_NET_TIME_MAX},
+	{"MQIAMO_NET_TIME_MIN", MQIAMO_NET_TIME_MIN},
+	{"MQIAMO_NUM_STREAMS", MQIAMO_NUM_STREAMS},
+	{"MQIAMO_OBJECT_COUNT", MQIAMO_OBJECT_COUNT},
+	{"MQIAMO_OPENS", MQIAMO_OPENS},
+	{"MQIAMO_OPENS_FAILED", MQIAMO_OPENS_FAILED},
+	{"MQIAMO_PENDING_PKTS", MQIAMO_PENDING_PKTS},
+	{"MQIAMO_PKTS_DELIVERED", MQIAMO_PKTS_DELIVERED},
+	{"MQIAMO_PKTS_DROPPED", MQIAMO_PKTS_DROPPED},
+	{"MQIAMO_PKTS_DUPLICATED", MQIAMO_PKTS_DUPLICATED},
+	{"MQIAMO_PKTS_LOST", MQIAMO_PKTS_LOST},
+	{"MQIAMO_PKTS_PROCESSED", MQIAMO_PKTS_PROCESSED},
+	{"MQIAMO_PKTS_REPAIRED", MQIAMO_PKTS_REPAIRED},
+	{"MQIAMO_PKTS_SENT", MQIAMO_PKTS_SENT},
+	{"MQIAMO_PKT_RATE", MQIAMO_PKT_RATE},
+	{"MQIAMO_PUBLISH_MSG_COUNT", MQIAMO_PUBLISH_MSG_COUNT},
+	{"MQIAMO_PUT1S", MQIAMO_PUT1S},
+	{"MQIAMO_PUT1S_FAILED", MQIAMO_PUT1S_FAILED},
+	{"MQIAMO_PUTS", MQIAMO_PUTS},
+	{"MQIAMO_PUTS_FAILED", MQIAMO_PUTS_FAILED},
+	{"MQIAMO_PUT_MAX_BYTES", MQIAMO_PUT_MAX_BYTES},
+	{"MQIAMO_PUT_MIN_BYTES", MQIAMO_PUT_MIN_BYTES},
+	{"MQIAMO_PUT_RETRIES", MQIAMO_PUT_RETRIES},
+	{"MQIAMO_Q_MAX_DEPTH", MQIAMO_Q_MAX_DEPTH},
+	{"MQIAMO_Q_MIN_DEPTH", MQIAMO_Q_MIN_DEPTH},
+	{"MQIAMO_Q_TIME_AVG", MQIAMO_Q_TIME_AVG},
+	{"MQIAMO_Q_TIME_MAX", MQIAMO_Q_TIME_MAX},
+	{"MQIAMO_Q_TIME_MIN", MQIAMO_Q_TIME_MIN},
+	{"MQIAMO_RELIABILITY_TYPE", MQIAMO_RELIABILITY_TYPE},
+	{"MQIAMO_REPAIR_BYTES", MQIAMO_REPAIR_BYTES},
+	{"MQIAMO_REPAIR_PKTS", MQIAMO_REPAIR_PKTS},
+	{"MQIAMO_REPAIR_PKTS_RCVD", MQIAMO_REPAIR_PKTS_RCVD},
+	{"MQIAMO_REPAIR_PKTS_RQSTD", MQIAMO_REPAIR_PKTS_RQSTD},
+	{"MQIAMO_SETS", MQIAMO_SETS},
+	{"MQIAMO_SETS_FAILED", MQIAMO_SETS_FAILED},
+	{"MQIAMO_STATS", MQIAMO_STATS},
+	{"MQIAMO_STATS_FAILED", MQIAMO_STATS_FAILED},
+	{"MQIAMO_SUBRQS", MQIAMO_SUBRQS},
+	{"MQIAMO_SUBRQS_FAILED", MQIAMO_SUBRQS_FAILED},
+	{"MQIAMO_SUBS_DUR", MQIAMO_SUBS_DUR},
+	{"MQIAMO_SUBS_FAILED", MQIAMO_SUBS_FAILED},
+	{"MQIAMO_SUBS_NDUR", MQIAMO_SUBS_NDUR},
+	{"MQIAMO_SUB_DUR_HIGHWATER", MQIAMO_SUB_DUR_HIGHWATER},
+	{"MQIAMO_SUB_DUR_LOWWATER", MQIAMO_SUB_DUR_LOWWATER},
+	{"MQIAMO_SUB_NDUR_HIGHWATER", MQIAMO_SUB_NDUR_HIGHWATER},
+	{"MQIAMO_SUB_NDUR_LOWWATER", MQIAMO_SUB_NDUR_LOWWATER},
+	{"MQIAMO_TOPIC_PUT1S", MQIAMO_TOPIC_PUT1S},
+	{"MQIAMO_TOPIC_PUT1S_FAILED", MQIAMO_TOPIC_PUT1S_FAILED},
+	{"MQIAMO_TOPIC_PUTS", MQIAMO_TOPIC_PUTS},
+	{"MQIAMO_TOPIC_PUTS_FAILED", MQIAMO_TOPIC_PUTS_FAILED},
+	{"MQIAMO_TOTAL_BYTES_SENT", MQIAMO_TOTAL_BYTES_SENT},
+	{"MQIAMO_TOTAL_MSGS_DELIVERED", MQIAMO_TOTAL_MSGS_DELIVERED},
+	{"MQIAMO_TOTAL_MSGS_EXPIRED", MQIAMO_TOTAL_MSGS_EXPIRED},
+	{"MQIAMO_TOTAL_MSGS_PROCESSED", MQIAMO_TOTAL_MSGS_PROCESSED},
+	{"MQIAMO_TOTAL_MSGS_RCVD", MQIAMO_TOTAL_MSGS_RCVD},
+	{"MQIAMO_TOTAL_MSGS_RETURNED", MQIAMO_TOTAL_MSGS_RETURNED},
+	{"MQIAMO_TOTAL_MSGS_SELECTED", MQIAMO_TOTAL_MSGS_SELECTED},
+	{"MQIAMO_TOTAL_MSGS_SENT", MQIAMO_TOTAL_MSGS_SENT},
+	{"MQIAMO_TOTAL_MSG_BYTES_RCVD", MQIAMO_TOTAL_MSG_BYTES_RCVD},
+	{"MQIAMO_TOTAL_PKTS_SENT", MQIAMO_TOTAL_PKTS_SENT},
+	{"MQIAMO_TOTAL_REPAIR_PKTS", MQIAMO_TOTAL_REPAIR_PKTS},
+	{"MQIAMO_TOTAL_REPAIR_PKTS_RCVD", MQIAMO_TOTAL_REPAIR_PKTS_RCVD},
+	{"MQIAMO_TOTAL_REPAIR_PKTS_RQSTD", MQIAMO_TOTAL_REPAIR_PKTS_RQSTD},
+	{"MQIAMO_UNSUBS_DUR", MQIAMO_UNSUBS_DUR},
+	{"MQIAMO_UNSUBS_FAILED", MQIAMO_UNSUBS_FAILED},
+	{"MQIAMO_UNSUBS_NDUR", MQIAMO_UNSUBS_NDUR},
+	{"MQIASY_BAG_OPTIONS", MQIASY_BAG_OPTIONS},
+	{"MQIASY_CODED_CHAR_SET_ID", MQIASY_CODED_CHAR_SET_ID},
+	{"MQIASY_COMMAND", MQIASY_COMMAND},
+	{"MQIASY_COMP_CODE", MQIASY_COMP_CODE},
+	{"MQIASY_CONTROL", MQIASY_CONTROL},
+	{"MQIASY_FIRST", MQIASY_FIRST},
+	{"MQIASY_LAST", MQIASY_LAST},
+	{"MQIASY_LAST_USED", MQIASY_LAST_USED},
+	{"MQIASY_MSG_SEQ_NUMBER", MQIASY_MSG_SEQ_NUMBER},
+	{"MQIASY_REASON", MQIASY_REASON},
+	{"MQIASY_TYPE", MQIASY_TYPE},
+	{"MQIASY_VERSION", MQIASY_VERSION},
+	{"MQIAV_NOT_APPLICABLE", MQIAV_NOT_APPLICABLE},
+	{"MQIAV_UNDEFINED", MQIAV_UNDEFINED},
+	{"MQIA_ACCOUNTING_CONN_OVERRIDE", MQIA_ACCOUNTING_CONN_OVERRIDE},
+	{"MQIA_ACCOUNTING_INTERVAL", MQIA_ACCOUNTING_INTERVAL},
+	{"MQIA_ACCOUNTING_MQI", MQIA_ACCOUNTING_MQI},
+	{"MQIA_ACCOUNTING_Q", MQIA_ACCOUNTING_Q},
+	{"MQIA_ACTIVE_CHANNELS", MQIA_ACTIVE_CHANNELS},
+	{"MQIA_ACTIVITY_CONN_OVERRIDE", MQIA_ACTIVITY_CONN_OVERRIDE},
+	{"MQIA_ACTIVITY_RECORDING", MQIA_ACTIVITY_RECORDING},
+	{"MQIA_ACTIVITY_TRACE", MQIA_ACTIVITY_TRACE},
+	{"MQIA_ADOPTNEWMCA_CHECK", MQIA_ADOPTNEWMCA_CHECK},
+	{"MQIA_ADOPTNEWMCA_INTERVAL", MQIA_ADOPTNEWMCA_INTERVAL},
+	{"MQIA_ADOPTNEWMCA_TYPE", MQIA_ADOPTNEWMCA_TYPE},
+	{"MQIA_ADOPT_CONTEXT", MQIA_ADOPT_CONTEXT},
+	{"MQIA_ADVANCED_CAPABILITY", MQIA_ADVANCED_CAPABILITY},
+	{"MQIA_AMQP_CAPABILITY", MQIA_AMQP_CAPABILITY},
+	{"MQIA_APPL_TYPE", MQIA_APPL_TYPE},
+	{"MQIA_ARCHIVE", MQIA_ARCHIVE},
+	{"MQIA_AUTHENTICATION_FAIL_DELAY", MQIA_AUTHENTICATION_FAIL_DELAY},
+	{"MQIA_AUTHENTICATION_METHOD", MQIA_AUTHENTICATION_METHOD},
+	{"MQIA_AUTHOREV_SCOPE", MQIA_AUTHOREV_SCOPE},
+	{"MQIA_AUTHORITY_EVENT", MQIA_AUTHORITY_EVENT},
+	{"MQIA_AUTH_INFO_TYPE", MQIA_AUTH_INFO_TYPE},
+	{"MQIA_AUTO_REORGANIZATION", MQIA_AUTO_REORGANIZATION},
+	{"MQIA_AUTO_REORG_INTERVAL", MQIA_AUTO_REORG_INTERVAL},
+	{"MQIA_BACKOUT_THRESHOLD", MQIA_BACKOUT_THRESHOLD},
+	{"MQIA_BASE_TYPE", MQIA_BASE_TYPE},
+	{"MQIA_BATCH_INTERFACE_AUTO", MQIA_BATCH_INTERFACE_AUTO},
+	{"MQIA_BRIDGE_EVENT", MQIA_BRIDGE_EVENT},
+	{"MQIA_CAP_EXPIRY", MQIA_CAP_EXPIRY},
+	{"MQIA_CERT_VAL_POLICY", MQIA_CERT_VAL_POLICY},
+	{"MQIA_CF_CFCONLOS", MQIA_CF_CFCONLOS},
+	{"MQIA_CF_LEVEL", MQIA_CF_LEVEL},
+	{"MQIA_CF_OFFLDUSE", MQIA_CF_OFFLDUSE},
+	{"MQIA_CF_OFFLOAD", MQIA_CF_OFFLOAD},
+	{"MQIA_CF_OFFLOAD_THRESHOLD1", MQIA_CF_OFFLOAD_THRESHOLD1},
+	{"MQIA_CF_OFFLOAD_THRESHOLD2", MQIA_CF_OFFLOAD_THRESHOLD2},
+	{"MQIA_CF_OFFLOAD_THRESHOLD3", MQIA_CF_OFFLOAD_THRESHOLD3},
+	{"MQIA_CF_RECAUTO", MQIA_CF_RECAUTO},
+	{"MQIA_CF_RECOVER", MQIA_CF_RECOVER},
+	{"MQIA_CF_SMDS_BUFFERS", MQIA_CF_SMDS_BUFFERS},
+	{"MQIA_CHANNEL_AUTO_DEF", MQIA_CHANNEL_AUTO_DEF},
+	{"MQIA_CHANNEL_AUTO_DEF_EVENT", MQIA_CHANNEL_AUTO_DEF_EVENT},
+	{"MQIA_CHANNEL_EVENT", MQIA_CHANNEL_EVENT},
+	{"MQIA_CHECK_CLIENT_BINDING", MQIA_CHECK_CLIENT_BINDING},
+	{"MQIA_CHECK_LOCAL_BINDING", MQIA_CHECK_LOCAL_BINDING},
+	{"MQIA_CHINIT_ADAPTERS", MQIA_CHINIT_ADAPTERS},
+	{"MQIA_CHINIT_CONTROL", MQIA_CHINIT_CONTROL},
+	{"MQIA_CHINIT_DISPATCHERS", MQIA_CHINIT_DISPATCHERS},
+	{"MQIA_CHINIT_TRACE_AUTO_START", MQIA_CHINIT_TRACE_AUTO_START},
+	{"MQIA_CHINIT_TRACE_TABLE_SIZE", MQIA_CHINIT_TRACE_TABLE_SIZE},
+	{"MQIA_CHLAUTH_RECORDS", MQIA_CHLAUTH_RECORDS},
+	{"MQIA_CLUSTER_OBJECT_STATE", MQIA_CLUSTER_OBJECT_STATE},
+	{"MQIA_CLUSTER_PUB_ROUTE", MQIA_CLUSTER_PUB_ROUTE},
+	{"MQIA_CLUSTER_Q_TYPE", MQIA_CLUSTER_Q_TYPE},
+	{"MQIA_CLUSTER_WORKLOAD_LENGTH", MQIA_CLUSTER_WORKLOAD_LENGTH},
+	{"MQIA_CLWL_MRU_CHANNELS", MQIA_CLWL_MRU_CHANNELS},
+	{"MQIA_CLWL_Q_PRIORITY", MQIA_CLWL_Q_PRIORITY},
+	{"MQIA_CLWL_Q_RANK", MQIA_CLWL_Q_RANK},
+	{"MQIA_CLWL_USEQ", MQIA_CLWL_USEQ},
+	{"MQIA_CMD_SERVER_AUTO", MQIA_CMD_SERVER_AUTO},
+	{"MQIA_CMD_SERVER_CONTROL", MQIA_CMD_SERVER_CONTROL},
+	{"MQIA_CMD_SERVER_CONVERT_MSG", MQIA_CMD_SERVER_CONVERT_MSG},
+	{"MQIA_CMD_SERVER_DLQ_MSG", MQIA_CMD_SERVER_DLQ_MSG},
+	{"MQIA_CODED_CHAR_SET_ID", MQIA_CODED_CHAR_SET_ID},
+	{"MQIA_COMMAND_EVENT", MQIA_COMMAND_EVENT},
+	{"MQIA_COMMAND_LEVEL", MQIA_COMMAND_LEVEL},
+	{"MQIA_COMM_EVENT", MQIA_COMM_EVENT},
+	{"MQIA_COMM_INFO_TYPE", MQIA_COMM_INFO_TYPE},
+	{"MQIA_CONFIGURATION_EVENT", MQIA_CONFIGURATION_EVENT},
+	{"MQIA_CPI_LEVEL", MQIA_CPI_LEVEL},
+	{"MQIA_CURRENT_Q_DEPTH", MQIA_CURRENT_Q_DEPTH},
+	{"MQIA_DEFINITION_TYPE", MQIA_DEFINITION_TYPE},
+	{"MQIA_DEF_BIND", MQIA_DEF_BIND},
+	{"MQIA_DEF_CLUSTER_XMIT_Q_TYPE", MQIA_DEF_CLUSTER_XMIT_Q_TYPE},
+	{"MQIA_DEF_INPUT_OPEN_OPTION", MQIA_DEF_INPUT_OPEN_OPTION},
+	{"MQIA_DEF_PERSISTENCE", MQIA_DEF_PERSISTENCE},
+	{"MQIA_DEF_PRIORITY", MQIA_DEF_PRIORITY},
+	{"MQIA_DEF_PUT_RESPONSE_TYPE", MQIA_DEF_PUT_RESPONSE_TYPE},
+	{"MQIA_DEF_READ_AHEAD", MQIA_DEF_READ_AHEAD},
+	{"MQIA_DISPLAY_TYPE", MQIA_DISPLAY_TYPE},
+	{"MQIA_DIST_LISTS", MQIA_DIST_LISTS},
+	{"MQIA_DNS_WLM", MQIA_DNS_WLM},
+	{"MQIA_DURABLE_SUB", MQIA_DURABLE_SUB},
+	{"MQIA_ENCRYPTION_ALGORITHM", MQIA_ENCRYPTION_ALGORITHM},
+	{"MQIA_EXPIRY_INTERVAL", MQIA_EXPIRY_INTERVAL},
+	{"MQIA_FIRST", MQIA_FIRST},
+	{"MQIA_GROUP_UR", MQIA_GROUP_UR},
+	{"MQIA_HARDEN_GET_BACKOUT", MQIA_HARDEN_GET_BACKOUT},
+	{"MQIA_HIGH_Q_DEPTH", MQIA_HIGH_Q_DEPTH},
+	{"MQIA_IGQ_PUT_AUTHORITY", MQIA_IGQ_PUT_AUTHORITY},
+	{"MQIA_INDEX_TYPE", MQIA_INDEX_TYPE},
+	{"MQIA_INHIBIT_EVENT", MQIA_INHIBIT_EVENT},
+	{"MQIA_INHIBIT_GET", MQIA_INHIBIT_GET},
+	{"MQIA_INHIBIT_PUB", MQIA_INHIBIT_PUB},
+	{"MQIA_INHIBIT_PUT", MQIA_INHIBIT_PUT},
+	{"MQIA_INHIBIT_SUB", MQIA_INHIBIT_SUB},
+	{"MQIA_INTRA_GROUP_QUEUING", MQIA_INTRA_GROUP_QUEUING},
+	{"MQIA_IP_ADDRESS_VERSION", MQIA_IP_ADDRESS_VERSION},
+	{"MQIA_KEY_REUSE_COUNT", MQIA_KEY_REUSE_COUNT},
+	{"MQIA_LAST", MQIA_LAST},
+	{"MQIA_LAST_USED", MQIA_LAST_USED},
+	{"MQIA_LDAP_AUTHORMD", MQIA_LDAP_AUTHORMD},
+	{"MQIA_LDAP_NESTGRP", MQIA_LDAP_NESTGRP},
+	{"MQIA_LDAP_SECURE_COMM", MQIA_LDAP_SECURE_COMM},
+	{"MQIA_LISTENER_PORT_NUMBER", MQIA_LISTENER_PORT_NUMBER},
+	{"MQIA_LISTENER_TIMER", MQIA_LISTENER_TIMER},
+	{"MQIA_LOCAL_EVENT", MQIA_LOCAL_EVENT},
+	{"MQIA_LOGGER_EVENT", MQIA_LOGGER_EVENT},
+	{"MQIA_LU62_CHANNELS", MQIA_LU62_CHANNELS},
+	{"MQIA_MASTER_ADMIN", MQIA_MASTER_ADMIN},
+	{"MQIA_MAX_CHANNELS", MQIA_MAX_CHANNELS},
+	{"MQIA_MAX_CLIENTS", MQIA_MAX_CLIENTS},
+	{"MQIA_MAX_GLOBAL_LOCKS", MQIA_MAX_GLOBAL_LOCKS},
+	{"MQIA_MAX_HANDLES", MQIA_MAX_HANDLES},
+	{"MQIA_MAX_LOCAL_LOCKS", MQIA_MAX_LOCAL_LOCKS},
+	{"MQIA_MAX_MSG_LENGTH", MQIA_MAX_MSG_LENGTH},
+	{"MQIA_MAX_OPEN_Q", MQIA_MAX_OPEN_Q},
+	{"MQIA_MAX_PRIORITY", MQIA_MAX_PRIORITY},
+	{"MQIA_MAX_PROPERTIES_LENGTH", MQIA_MAX_PROPERTIES_LENGTH},
+	{"MQIA_MAX_Q_DEPTH", MQIA_MAX_Q_DEPTH},
+	{"MQIA_MAX_Q_FILE_SIZE", MQIA_MAX_Q_FILE_SIZE},
+	{"MQIA_MAX_Q_TRIGGERS", MQIA_MAX_Q_TRIGGERS},
+	{"MQIA_MAX_RECOVERY_TASKS", MQIA_MAX_RECOVERY_TASKS},
+	{"MQIA_MAX_RESPONSES", MQIA_MAX_RESPONSES},
+	{"MQIA_MAX_UNCOMMITTED_MSGS", MQIA_MAX_UNCOMMITTED_MSGS},
+	{"MQIA_MCAST_BRIDGE", MQIA_MCAST_BRIDGE},
+	{"MQIA_MEDIA_IMAGE_INTERVAL", MQIA_MEDIA_IMAGE_INTERVAL},
+	{"MQIA_MEDIA_IMAGE_LOG_LENGTH", MQIA_MEDIA_IMAGE_LOG_LENGTH},
+	{"MQIA_MEDIA_IMAGE_RECOVER_OBJ", MQIA_MEDIA_IMAGE_RECOVER_OBJ},
+	{"MQIA_MEDIA_IMAGE_RECOVER_Q", MQIA_MEDIA_IMAGE_RECOVER_Q},
+	{"MQIA_MEDIA_IMAGE_SCHEDULING", MQIA_MEDIA_IMAGE_SCHEDULING},
+	{"MQIA_MONITORING_AUTO_CLUSSDR", MQIA_MONITORING_AUTO_CLUSSDR},
+	{"MQIA_MONITORING_CHANNEL", MQIA_MONITORING_CHANNEL},
+	{"MQIA_MONITORING_Q", MQIA_MONITORING_Q},
+	{"MQIA_MONITOR_INTERVAL", MQIA_MONITOR_INTERVAL},
+	{"MQIA_MSG_DELIVERY_SEQUENCE", MQIA_MSG_DELIVERY_SEQUENCE},
+	{"MQIA_MSG_DEQ_COUNT", MQIA_MSG_DEQ_COUNT},
+	{"MQIA_MSG_ENQ_COUNT", MQIA_MSG_ENQ_COUNT},
+	{"MQIA_MSG_MARK_BROWSE_INTERVAL", MQIA_MSG_MARK_BROWSE_INTERVAL},
+	{"MQIA_MULTICAST", MQIA_MULTICAST},
+	{"MQIA_NAMELIST_TYPE", MQIA_NAMELIST_TYPE},
+	{"MQIA_NAME_COUNT", MQIA_NAME_COUNT},
+	{"MQIA_NPM_CLASS", MQIA_NPM_CLASS},
+	{"MQIA_NPM_DELIVERY", MQIA_NPM_DELIVERY},
+	{"MQIA_OPEN_INPUT_COUNT", MQIA_OPEN_INPUT_COUNT},
+	{"MQIA_OPEN_OUTPUT_COUNT", MQIA_OPEN_OUTPUT_COUNT},
+	{"MQIA_OTEL_PROPAGATION_CONTROL", MQIA_OTEL_PROPAGATION_CONTROL},
+	{"MQIA_OTEL_TRACE", MQIA_OTEL_TRACE},
+	{"MQIA_OUTBOUND_PORT_MAX", MQIA_OUTBOUND_PORT_MAX},
+	{"MQIA_OUTBOUND_PORT_MIN", MQIA_OUTBOUND_PORT_MIN},
+	{"MQIA_PAGESET_ID", MQIA_PAGESET_ID},
+	{"MQIA_PERFORMANCE_EVENT", MQIA_PERFORMANCE_EVENT},
+	{"MQIA_PLATFORM", MQIA_PLATFORM},
+	{"MQIA_PM_DELIVERY", MQIA_PM_DELIVERY},
+	{"MQIA_POLICY_VERSION", MQIA_POLICY_VERSION},
+	{"MQIA_PROPERTY_CONTROL", MQIA_PROPERTY_CONTROL},
+	{"MQIA_PROT_POLICY_CAPABILITY", MQIA_PROT_POLICY_CAPABILITY},
+	{"MQIA_PROXY_SUB", MQIA_PROXY_SUB},
+	{"MQIA_PUBSUB_CLUSTER", MQIA_PUBSUB_CLUSTER},
+	{"MQIA_PUBSUB_MAXMSG_RETRY_COUNT", MQIA_PUBSUB_MAXMSG_RETRY_COUNT},
+	{"MQIA_PUBSUB_MODE", MQIA_PUBSUB_MODE},
+	{"MQIA_PUBSUB_NP_MSG", MQIA_PUBSUB_NP_MSG},
+	{"MQIA_PUBSUB_NP_RESP", MQIA_PUBSUB_NP_RESP},
+	{"MQIA_PUBSUB_SYNC_PT", MQIA_PUBSUB_SYNC_PT},
+	{"MQIA_PUB_COUNT", MQIA_PUB_COUNT},
+	{"MQIA_PUB_SCOPE", MQIA_PUB_SCOPE},
+	{"MQIA_QMGR_CFCONLOS", MQIA_QMGR_CFCONLOS},
+	{"MQIA_QMOPT_CONS_COMMS_MSGS", MQIA_QMOPT_CONS_COMMS_MSGS},
+	{"MQIA_QMOPT_CONS_CRITICAL_MSGS", MQIA_QMOPT_CONS_CRITICAL_MSGS},
+	{"MQIA_QMOPT_CONS_ERROR_MSGS", MQIA_QMOPT_CONS_ERROR_MSGS},
+	{"MQIA_QMOPT_CONS_INFO_MSGS", MQIA_QMOPT_CONS_INFO_MSGS},
+	{"MQIA_QMOPT_CONS_REORG_MSGS", MQIA_QMOPT_CONS_REORG_MSGS},
+	{"MQIA_QMOPT_CONS_SYSTEM_MSGS", MQIA_QMOPT_CONS_SYSTEM_MSGS},
+	{"MQIA_QMOPT_CONS_WARNING_MSGS", MQIA_QMOPT_CONS_WARNING_MSGS},
+	{"MQIA_QMOPT_CSMT_ON_ERROR", MQIA_QMOPT_CSMT_ON_ERROR},
+	{"MQIA_QMOPT_INTERNAL_DUMP", MQIA_QMOPT_INTERNAL_DUMP},
+	{"MQIA_QMOPT_LOG_COMMS_MSGS", MQIA_QMOPT_LOG_COMMS_MSGS},
+	{"MQIA_QMOPT_LOG_CRITICAL_MSGS", MQIA_QMOPT_LOG_CRITICAL_MSGS},
+	{"MQIA_QMOPT_LOG_ERROR_MSGS", MQIA_QMOPT_LOG_ERROR_MSGS},
+	{"MQIA_QMOPT_LOG_INFO_MSGS", MQIA_QMOPT_LOG_INFO_MSGS},
+	{"MQIA_QMOPT_LOG_REORG_MSGS", MQIA_QMOPT_LOG_REORG_MSGS},
+	{"MQIA_QMOPT_LOG_SYSTEM_MSGS", MQIA_QMOPT_LOG_SYSTEM_MSGS},
+	{"MQIA_QMOPT_LOG_WARNING_MSGS", MQIA_QMOPT_LOG_WARNING_MSGS},
+	{"MQIA_QMOPT_TRACE_COMMS", MQIA_QMOPT_TRACE_COMMS},
+	{"MQIA_QMOPT_TRACE_CONVERSION", MQIA_QMOPT_TRACE_CONVERSION},
+	{"MQIA_QMOPT_TRACE_MQI_CALLS", MQIA_QMOPT_TRACE_MQI_CALLS},
+	{"MQIA_QMOPT_TRACE_REORG", MQIA_QMOPT_TRACE_REORG},
+	{"MQIA_QMOPT_TRACE_SYSTEM", MQIA_QMOPT_TRACE_SYSTEM},
+	{"MQIA_QSG_DISP", MQIA_QSG_DISP},
+	{"MQIA_Q_DEPTH_HIGH_EVENT", MQIA_Q_DEPTH_HIGH_EVENT},
+	{"MQIA_Q_DEPTH_HIGH_LIMIT", MQIA_Q_DEPTH_HIGH_LIMIT},
+	{"MQIA_Q_DEPTH_LOW_EVENT", MQIA_Q_DEPTH_LOW_EVENT},
+	{"MQIA_Q_DEPTH_LOW_LIMIT", MQIA_Q_DEPTH_LOW_LIMIT},
+	{"MQIA_Q_DEPTH_MAX_EVENT", MQIA_Q_DEPTH_MAX_EVENT},
+	{"MQIA_Q_SERVICE_INTERVAL", MQIA_Q_SERVICE_INTERVAL},
+	{"MQIA_Q_SERVICE_INTERVAL_EVENT", MQIA_Q_SERVICE_INTERVAL_EVENT},
+	{"MQIA_Q_TYPE", MQIA_Q_TYPE},
+	{"MQIA_Q_USERS", MQIA_Q_USERS},
+	{"MQIA_READ_AHEAD", MQIA_READ_AHEAD},
+	{"MQIA_RECEIVE_TIMEOUT", MQIA_RECEIVE_TIMEOUT},
+	{"MQIA_RECEIVE_TIMEOUT_MIN", MQIA_RECEIVE_TIMEOUT_MIN},
+	{"MQIA_RECEIVE_TIMEOUT_TYPE", MQIA_RECEIVE_TIMEOUT_TYPE},
+	{"MQIA_REMOTE_EVENT", MQIA_REMOTE_EVENT},
+	{"MQIA_RESPONSE_RESTART_POINT", MQIA_RESPONSE_RESTART_POINT},
+	{"MQIA_RETENTION_INTERVAL", MQIA_RETENTION_INTERVAL},
+	{"MQIA_REVERSE_DNS_LOOKUP", MQIA_REVERSE_DNS_LOOKUP},
+	{"MQIA_SCOPE", MQIA_SCOPE},
+	{"MQIA_SECURITY_CASE", MQIA_SECURITY_CASE},
+	{"MQIA_SERVICE_CONTROL", MQIA_SERVICE_CONTROL},
+	{"MQIA_SERVICE_TYPE", MQIA_SERVICE_TYPE},
+	{"MQIA_SHAREABILITY", MQIA_SHAREABILITY},
+	{"MQIA_SHARED_Q_Q_MGR_NAME", MQIA_SHARED_Q_Q_MGR_NAME},
+	{"MQIA_SIGNATURE_ALGORITHM", MQIA_SIGNATURE_ALGORITHM},
+	{"MQIA_SSL_EVENT", MQIA_SSL_EVENT},
+	{"MQIA_SSL_FIPS_REQUIRED", MQIA_SSL_FIPS_REQUIRED},
+	{"MQIA_SSL_RESET_COUNT", MQIA_SSL_RESET_COUNT},
+	{"MQIA_SSL_TASKS", MQIA_SSL_TASKS},
+	{"MQIA_START_STOP_EVENT", MQIA_START_STOP_EVENT},
+	{"MQIA_STATISTICS_AUTO_CLUSSDR", MQIA_STATISTICS_AUTO_CLUSSDR},
+	{"MQIA_STATISTICS_CHANNEL", MQIA_STATISTICS_CHANNEL},
+	{"MQIA_STATISTICS_INTERVAL", MQIA_STATISTICS_INTERVAL},
+	{"MQIA_STATISTICS_MQI", MQIA_STATISTICS_MQI},
+	{"MQIA_STATISTICS_Q", MQIA_STATISTICS_Q},
+	{"MQIA_STREAM_QUEUE_QOS", MQIA_STREAM_QUEUE_QOS},
+	{"MQIA_SUB_CONFIGURATION_EVENT", MQIA_SUB_CONFIGURATION_EVENT},
+	{"MQIA_SUB_COUNT", MQIA_SUB_COUNT},
+	{"MQIA_SUB_SCOPE", MQIA_SUB_SCOPE},
+	{"MQIA_SUITE_B_STRENGTH", MQIA_SUITE_B_STRENGTH},
+	{"MQIA_SYNCPOINT", MQIA_SYNCPOINT},
+	{"MQIA_TCP_CHANNELS", MQIA_TCP_CHANNELS},
+	{"MQIA_TCP_KEEP_ALIVE", MQIA_TCP_KEEP_ALIVE},
+	{"MQIA_TCP_STACK_TYPE", MQIA_TCP_STACK_TYPE},
+	{"MQIA_TIME_SINCE_RESET", MQIA_TIME_SINCE_RESET},
+	{"MQIA_TOLERATE_UNPROTECTED", MQIA_TOLERATE_UNPROTECTED},
+	{"MQIA_TOPIC_DEF_PERSISTENCE", MQIA_TOPIC_DEF_PERSISTENCE},
+	{"MQIA_TOPIC_NODE_COUNT", MQIA_TOPIC_NODE_COUNT},
+	{"MQIA_TOPIC_TYPE", MQIA_TOPIC_TYPE},
+	{"MQIA_TRACE_ROUTE_RECORDING", MQIA_TRACE_ROUTE_RECORDING},
+	{"MQIA_TREE_LIFE_TIME", MQIA_TREE_LIFE_TIME},
+	{"MQIA_TRIGGER_CONTROL", MQIA_TRIGGER_CONTROL},
+	{"MQIA_TRIGGER_DEPTH", MQIA_TRIGGER_DEPTH},
+	{"MQIA_TRIGGER_INTERVAL", MQIA_TRIGGER_INTERVAL},
+	{"MQIA_TRIGGER_MSG_PRIORITY", MQIA_TRIGGER_MSG_PRIORITY},
+	{"MQIA_TRIGGER_RESTART", MQIA_TRIGGER_RESTART},
+	{"MQIA_TRIGGER_TYPE", MQIA_TRIGGER_TYPE},
+	{"MQIA_UR_DISP", MQIA_UR_DISP},
+	{"MQIA_USAGE", MQIA_USAGE},
+	{"MQIA_USER_LIST", MQIA_USER_LIST},
+	{"MQIA_USE_DEAD_LETTER_Q", MQIA_USE_DEAD_LETTER_Q},
+	{"MQIA_WILDCARD_OPERATION", MQIA_WILDCARD_OPERATION},
+	{"MQIA_XR_CAPABILITY", MQIA_XR_CAPABILITY},
+	{"MQIDO_BACKOUT", MQIDO_BACKOUT},
+	{"MQIDO_COMMIT", MQIDO_COMMIT},
+	{"MQIEPF_CLIENT_LIBRARY", MQIEPF_CLIENT_LIBRARY},
+	{"MQIEPF_LOCAL_LIBRARY", MQIEPF_LOCAL_LIBRARY},
+	{"MQIEPF_NONE", MQIEPF_NONE},
+	{"MQIEPF_NON_THREADED_LIBRARY", MQIEPF_NON_THREADED_LIBRARY},
+	{"MQIEPF_THREADED_LIBRARY", MQIEPF_THREADED_LIBRARY},
+	{"MQIEP_CURRENT_LENGTH", MQIEP_CURRENT_LENGTH},
+	{"MQIEP_CURRENT_VERSION", MQIEP_CURRENT_VERSION},
+	{"MQIEP_LENGTH_1", MQIEP_LENGTH_1},
+	{"MQIEP_VERSION_1", MQIEP_VERSION_1},
+	{"MQIGQPA_ALTERNATE_OR_IGQ", MQIGQPA_ALTERNATE_OR_IGQ},
+	{"MQIGQPA_CONTEXT", MQIGQPA_CONTEXT},
+	{"MQIGQPA_DEFAULT", MQIGQPA_DEFAULT},
+	{"MQIGQPA_ONLY_IGQ", MQIGQPA_ONLY_IGQ},
+	{"MQIGQ_DISABLED", MQIGQ_DISABLED},
+	{"MQIGQ_ENABLED", MQIGQ_ENABLED},
+	{"MQIIH_CM0_REQUEST_RESPONSE", MQIIH_CM0_REQUEST_RESPONSE},
+	{"MQIIH_CURRENT_LENGTH", MQIIH_CURRENT_LENGTH},
+	{"MQIIH_CURRENT_VERSION", MQIIH_CURRENT_VERSION},
+	{"MQIIH_IGNORE_PURG", MQIIH_IGNORE_PURG},
+	{"MQIIH_LENGTH_1", MQIIH_LENGTH_1},
+	{"MQIIH_NONE", MQIIH_NONE},
+	{"MQIIH_PASS_EXPIRATION", MQIIH_PASS_EXPIRATION},
+	{"MQIIH_REPLY_FORMAT_NONE", MQIIH_REPLY_FORMAT_NONE},
+	{"MQIIH_UNLIMITED_EXPIRATION", MQIIH_UNLIMITED_EXPIRATION},
+	{"MQIIH_VERSION_1", MQIIH_VERSION_1},
+	{"MQIMGRCOV_AS_Q_MGR", MQIMGRCOV_AS_Q_MGR},
+	{"MQIMGRCOV_NO", MQIMGRCOV_NO},
+	{"MQIMGRCOV_YES", MQIMGRCOV_YES},
+	{"MQIMMREASON_APPLNAME_CHANGED", MQIMMREASON_APPLNAME_CHANGED},
+	{"MQIMMREASON_AWAITS_REPLY", MQIMMREASON_AWAITS_REPLY},
+	{"MQIMMREASON_IN_TRANSACTION", MQIMMREASON_IN_TRANSACTION},
+	{"MQIMMREASON_MOVING", MQIMMREASON_MOVING},
+	{"MQIMMREASON_NONE", MQIMMREASON_NONE},
+	{"MQIMMREASON_NOT_CLIENT", MQIMMREASON_NOT_CLIENT},
+	{"MQIMMREASON_NOT_RECONNECTABLE", MQIMMREASON_NOT_RECONNECTABLE},
+	{"MQIMMREASON_NO_REDIRECT", MQIMMREASON_NO_REDIRECT},
+	{"MQIMPO_CONVERT_TYPE", MQIMPO_CONVERT_TYPE},
+	{"MQIMPO_CONVERT_VALUE", MQIMPO_CONVERT_VALUE},
+	{"MQIMPO_CURRENT_LENGTH", MQIMPO_CURRENT_LENGTH},
+	{"MQIMPO_CURRENT_VERSION", MQIMPO_CURRENT_VERSION},
+	{"MQIMPO_INQ_FIRST", MQIMPO_INQ_FIRST},
+	{"MQIMPO_INQ_NEXT", MQIMPO_INQ_NEXT},
+	{"MQIMPO_INQ_PROP_UNDER_CURSOR", MQIMPO_INQ_PROP_UNDER_CURSOR},
+	{"MQIMPO_LENGTH_1", MQIMPO_LENGTH_1},
+	{"MQIMPO_NONE", MQIMPO_NONE},
+	{"MQIMPO_QUERY_LENGTH", MQIMPO_QUERY_LENGTH},
+	{"MQIMPO_VERSION_1", MQIMPO_VERSION_1},
+	{"MQINBD_GROUP", MQINBD_GROUP},
+	{"MQINBD_Q_MGR", MQINBD_Q_MGR},
+	{"MQIND_ALL", MQIND_ALL},
+	{"MQIND_NONE", MQIND_NONE},
+	{"MQIPADDR_IPV4", MQIPADDR_IPV4},
+	{"MQIPADDR_IPV6", MQIPADDR_IPV6},
+	{"MQIS_NO", MQIS_NO},
+	{"MQIS_YES", MQIS_YES},
+	{"MQITEM_BAG", MQITEM_BAG},
+	{"MQITEM_BYTE_STRING", MQITEM_BYTE_STRING},
+	{"MQITEM_BYTE_STRING_FILTER", MQITEM_BYTE_STRING_FILTER},
+	{"MQITEM_INTEGER", MQITEM_INTEGER},
+	{"MQITEM_INTEGER64", MQITEM_INTEGER64},
+	{"MQITEM_INTEGER_FILTER", MQITEM_INTEGER_FILTER},
+	{"MQITEM_STRING", MQITEM_STRING},
+	{"MQITEM_STRING_FILTER", MQITEM_STRING_FILTER},
+	{"MQIT_BAG", MQIT_BAG},
+	{"MQIT_CORREL_ID", MQIT_CORREL_ID},
+	{"MQIT_GROUP_ID", MQIT_GROUP_ID},
+	{"MQIT_INTEGER", MQIT_INTEGER},
+	{"MQIT_MSG_ID", MQIT_MSG_ID},
+	{"MQIT_MSG_TOKEN", MQIT_MSG_TOKEN},
+	{"MQIT_NONE", MQIT_NONE},
+	{"MQIT_STRING", MQIT_STRING},
+	{"MQKAI_AUTO", MQKAI_AUTO},
+	{"MQKEY_REUSE_DISABLED", MQKEY_REUSE_DISABLED},
+	{"MQKEY_REUSE_UNLIMITED", MQKEY_REUSE_UNLIMITED},
+	{"MQLDAPC_CONNECTED", MQLDAPC_CONNECTED},
+	{"MQLDAPC_ERROR", MQLDAPC_ERROR},
+	{"MQLDAPC_INACTIVE", MQLDAPC_INACTIVE},
+	{"MQLDAP_AUTHORMD_OS", MQLDAP_AUTHORMD_OS},
+	{"MQLDAP_AUTHORMD_SEARCHGRP", MQLDAP_AUTHORMD_SEARCHGRP},
+	{"MQLDAP_AUTHORMD_SEARCHUSR", MQLDAP_AUTHORMD_SEARCHUSR},
+	{"MQLDAP_AUTHORMD_SRCHGRPSN", MQLDAP_AUTHORMD_SRCHGRPSN},
+	{"MQLDAP_NESTGRP_NO", MQLDAP_NESTGRP_NO},
+	{"MQLDAP_NESTGRP_YES", MQLDAP_NESTGRP_YES},
+	{"MQLOGTYPE_CIRCULAR", MQLOGTYPE_CIRCULAR},
+	{"MQLOGTYPE_LINEAR", MQLOGTYPE_LINEAR},
+	{"MQLOGTYPE_REPLICATED", MQLOGTYPE_REPLICATED},
+	{"MQLR_AUTO", MQLR_AUTO},
+	{"MQLR_MAX", MQLR_MAX},
+	{"MQLR_ONE", MQLR_ONE},
+	{"MQMASTER_NO", MQMASTER_NO},
+	{"MQMASTER_YES", MQMASTER_YES},
+	{"MQMATCH_ALL", MQMATCH_ALL},
+	{"MQMATCH_EXACT", MQMATCH_EXACT},
+	{"MQMATCH_GENERIC", MQMATCH_GENERIC},
+	{"MQMATCH_RUNCHECK", MQMATCH_RUNCHECK},
+	{"MQMCAS_RUNNING", MQMCAS_RUNNING},
+	{"MQMCAS_STOPPED", MQMCAS_STOPPED},
+	{"MQMCAT_PROCESS", MQMCAT_PROCESS},
+	{"MQMCAT_THREAD", MQMCAT_THREAD},
+	{"MQMCB_DISABLED", MQMCB_DISABLED},
+	{"MQMCB_ENABLED", MQMCB_ENABLED},
+	{"MQMCEV_ACK_RETRIES_EXCEEDED", MQMCEV_ACK_RETRIES_EXCEEDED},
+	{"MQMCEV_CCT_GETTIME_FAILED", MQMCEV_CCT_GETTIME_FAILED},
+	{"MQMCEV_CLOSED_TRANS", MQMCEV_CLOSED_TRANS},
+	{"MQMCEV_DEST_INTERFACE_FAILOVER", MQMCEV_DEST_INTERFACE_FAILOVER},
+	{"MQMCEV_DEST_INTERFACE_FAILURE", MQMCEV_DEST_INTERFACE_FAILURE},
+	{"MQMCEV_FIRST_MESSAGE", MQMCEV_FIRST_MESSAGE},
+	{"MQMCEV_HEARTBEAT_TIMEOUT", MQMCEV_HEARTBEAT_TIMEOUT},
+	{"MQMCEV_LATE_JOIN_FAILURE", MQMCEV_LATE_JOIN_FAILURE},
+	{"MQMCEV_MEMORY_ALERT_OFF", MQMCEV_MEMORY_ALERT_OFF},
+	{"MQMCEV_MEMORY_ALERT_ON", MQMCEV_MEMORY_ALERT_ON},
+	{"MQMCEV_MESSAGE_LOSS", MQMCEV_MESSAGE_LOSS},
+	{"MQMCEV_NACK_ALERT_OFF", MQMCEV_NACK_ALERT_OFF},
+	{"MQMCEV_NACK_ALERT_ON", MQMCEV_NACK_ALERT_ON},
+	{"MQMCEV_NEW_SOURCE", MQMCEV_NEW_SOURCE},
+	{"MQMCEV_PACKET_LOSS", MQMCEV_PACKET_LOSS},
+	{"MQMCEV_PACKET_LOSS_NACK_EXPIRE", MQMCEV_PACKET_LOSS_NACK_EXPIRE},
+	{"MQMCEV_PORT_INTERFACE_FAILOVER", MQMCEV_PORT_INTERFACE_FAILOVER},
+	{"MQMCEV_PORT_INTERFACE_FAILURE", MQMCEV_PORT_INTERFACE_FAILURE},
+	{"MQMCEV_RECEIVE_QUEUE_TRIMMED", MQMCEV_RECEIVE_QUEUE_TRIMMED},
+	{"MQMCEV_RELIABILITY", MQMCEV_RELIABILITY},
+	{"MQMCEV_RELIABILITY_CHANGED", MQMCEV_RELIABILITY_CHANGED},
+	{"MQMCEV_REPAIR_ALERT_OFF", MQMCEV_REPAIR_ALERT_OFF},
+	{"MQMCEV_REPAIR_ALERT_ON", MQMCEV_REPAIR_ALERT_ON},
+	{"MQMCEV_REPAIR_DELAY", MQMCEV_REPAIR_DELAY},
+	{"MQMCEV_SEND_PACKET_FAILURE", MQMCEV_SEND_PACKET_FAILURE},
+	{"MQMCEV_SHM_DEST_UNUSABLE", MQMCEV_SHM_DEST_UNUSABLE},
+	{"MQMCEV_SHM_PORT_UNUSABLE", MQMCEV_SHM_PORT_UNUSABLE},
+	{"MQMCEV_STREAM_ERROR", MQMCEV_STREAM_ERROR},
+	{"MQMCEV_STREAM_EXPELLED", MQMCEV_STREAM_EXPELLED},
+	{"MQMCEV_STREAM_RESUME_NACK", MQMCEV_STREAM_RESUME_NACK},
+	{"MQMCEV_STREAM_SUSPEND_NACK", MQMCEV_STREAM_SUSPEND_NACK},
+	{"MQMCEV_VERSION_CONFLICT", MQMCEV_VERSION_CONFLICT},
+	{"MQMCP_ALL", MQMCP_ALL},
+	{"MQMCP_COMPAT", MQMCP_COMPAT},
+	{"MQMCP_NONE", MQMCP_NONE},
+	{"MQMCP_REPLY", MQMCP_REPLY},
+	{"MQMCP_USER", MQMCP_USER},
+	{"MQMC_AS_PARENT", MQMC_AS_PARENT},
+	{"MQMC_DISABLED", MQMC_DISABLED},
+	{"MQMC_ENABLED", MQMC_ENABLED},
+	{"MQMC_ONLY", MQMC_ONLY},
+	{"MQMD1_CURRENT_LENGTH", MQMD1_CURRENT_LENGTH},
+	{"MQMD1_LENGTH_1", MQMD1_LENGTH_1},
+	{"MQMD2_CURRENT_LENGTH", MQMD2_CURRENT_LENGTH},
+	{"MQMD2_LENGTH_1", MQMD2_LENGTH_1},
+	{"MQMD2_LENGTH_2", MQMD2_LENGTH_2},
+	{"MQMDEF_NONE", MQMDEF_NONE},
+	{"MQMDE_CURRENT_LENGTH", MQMDE_CURRENT_LENGTH},
+	{"MQMDE_CURRENT_VERSION", MQMDE_CURRENT_VERSION},
+	{"MQMDE_LENGTH_2", MQMDE_LENGTH_2},
+	{"MQMDE_VERSION_2", MQMDE_VERSION_2},
+	{"MQMDS_FIFO", MQMDS_FIFO},
+	{"MQMDS_PRIORITY", MQMDS_PRIORITY},
+	{"MQMD_CURRENT_LENGTH", MQMD_CURRENT_LENGTH},
+	{"MQMD_CURRENT_VERSION", MQMD_CURRENT_VERSION},
+	{"MQMD_LENGTH_1", MQMD_LENGTH_1},
+	{"MQMD_LENGTH_2", MQMD_LENGTH_2},
+	{"MQMD_VERSION_1", MQMD_VERSION_1},
+	{"MQMD_VERSION_2", MQMD_VERSION_2},
+	{"MQMEDIMGINTVL_OFF", MQMEDIMGINTVL_OFF},
+	{"MQMEDIMGLOGLN_OFF", MQMEDIMGLOGLN_OFF},
+	{"MQMEDIMGSCHED_AUTO", MQMEDIMGSCHED_AUTO},
+	{"MQMEDIMGSCHED_MANUAL", MQMEDIMGSCHED_MANUAL},
+	{"MQMF_ACCEPT_UNSUP_IF_XMIT_MASK", MQMF_ACCEPT_UNSUP_IF_XMIT_MASK},
+	{"MQMF_ACCEPT_UNSUP_MASK", MQMF_ACCEPT_UNSUP_MASK},
+	{"MQMF_LAST_MSG_IN_GROUP", MQMF_LAST_MSG_IN_GROUP},
+	{"MQMF_LAST_SEGMENT", MQMF_LAST_SEGMENT},
+	{"MQMF_MSG_IN_GROUP", MQMF_MSG_IN_GROUP},
+	{"MQMF_NONE", MQMF_NONE},
+	{"MQMF_REJECT_UNSUP_MASK", MQMF_REJECT_UNSUP_MASK},
+	{"MQMF_SEGMENT", MQMF_SEGMENT},
+	{"MQMF_SEGMENTATION_ALLOWED", MQMF_SEGMENTATION_ALLOWED},
+	{"MQMF_SEGMENTATION_INHIBITED", MQMF_SEGMENTATION_INHIBITED},
+	{"MQMHBO_CURRENT_LENGTH", MQMHBO_CURRENT_LENGTH},
+	{"MQMHBO_CURRENT_VERSION", MQMHBO_CURRENT_VERSION},
+	{"MQMHBO_DELETE_PROPERTIES", MQMHBO_DELETE_PROPERTIES},
+	{"MQMHBO_LENGTH_1", MQMHBO_LENGTH_1},
+	{"MQMHBO_NONE", MQMHBO_NONE},
+	{"MQMHBO_PROPERTIES_IN_MQRFH2", MQMHBO_PROPERTIES_IN_MQRFH2},
+	{"MQMHBO_VERSION_1", MQMHBO_VERSION_1},
+	{"MQMLP_ENCRYPTION_ALG_3DES", MQMLP_ENCRYPTION_ALG_3DES},
+	{"MQMLP_ENCRYPTION_ALG_AES128", MQMLP_ENCRYPTION_ALG_AES128},
+	{"MQMLP_ENCRYPTION_ALG_AES256", MQMLP_ENCRYPTION_ALG_AES256},
+	{"MQMLP_ENCRYPTION_ALG_DES", MQMLP_ENCRYPTION_ALG_DES},
+	{"MQMLP_ENCRYPTION_ALG_NONE", MQMLP_ENCRYPTION_ALG_NONE},
+	{"MQMLP_ENCRYPTION_ALG_RC2", MQMLP_ENCRYPTION_ALG_RC2},
+	{"MQMLP_SIGN_ALG_MD5", MQMLP_SIGN_ALG_MD5},
+	{"MQMLP_SIGN_ALG_NONE", MQMLP_SIGN_ALG_NONE},
+	{"MQMLP_SIGN_ALG_SHA1", MQMLP_SIGN_ALG_SHA1},
+	{"MQMLP_SIGN_ALG_SHA224", MQMLP_SIGN_ALG_SHA224},
+	{"MQMLP_SIGN_ALG_SHA256", MQMLP_SIGN_ALG_SHA256},
+	{"MQMLP_SIGN_ALG_SHA384", MQMLP_SIGN_ALG_SHA384},
+	{"MQMLP_SIGN_ALG_SHA512", MQMLP_SIGN_ALG_SHA512},
+	{"MQMLP_TOLERATE_UNPROTECTED_NO", MQMLP_TOLERATE_UNPROTECTED_NO},
+	{"MQMLP_TOLERATE_UNPROTECTED_YES", MQMLP_TOLERATE_UNPROTECTED_YES},
+	{"MQMMBI_UNLIMITED", MQMMBI_UNLIMITED},
+	{"MQMODE_FORCE", MQMODE_FORCE},
+	{"MQMODE_QUIESCE", MQMODE_QUIESCE},
+	{"MQMODE_TERMINATE", MQMODE_TERMINATE},
+	{"MQMON_DISABLED", MQMON_DISABLED},
+	{"MQMON_ENABLED", MQMON_ENABLED},
+	{"MQMON_HIGH", MQMON_HIGH},
+	{"MQMON_LOW", MQMON_LOW},
+	{"MQMON_MEDIUM", MQMON_MEDIUM},
+	{"MQMON_NONE", MQMON_NONE},
+	{"MQMON_NOT_AVAILABLE", MQMON_NOT_AVAILABLE},
+	{"MQMON_OFF", MQMON_OFF},
+	{"MQMON_ON", MQMON_ON},
+	{"MQMON_Q_MGR", MQMON_Q_MGR},
+	{"MQMO_MATCH_CORREL_ID", MQMO_MATCH_CORREL_ID},
+	{"MQMO_MATCH_GROUP_ID", MQMO_MATCH_GROUP_ID},
+	{"MQMO_MATCH_MSG_ID", MQMO_MATCH_MSG_ID},
+	{"MQMO_MATCH_MSG_SEQ_NUMBER", MQMO_MATCH_MSG_SEQ_NUMBER},
+	{"MQMO_MATCH_MSG_TOKEN", MQMO_MATCH_MSG_TOKEN},
+	{"MQMO_MATCH_OFFSET", MQMO_MATCH_OFFSET},
+	{"MQMO_NONE", MQMO_NONE},
+	{"MQMT_APPL_FIRST", MQMT_APPL_FIRST},
+	{"MQMT_APPL_LAST", MQMT_APPL_LAST},
+	{"MQMT_DATAGRAM", MQMT_DATAGRAM},
+	{"MQMT_MQE_FIELDS", MQMT_MQE_FIELDS},
+	{"MQMT_MQE_FIELDS_FROM_MQE", MQMT_MQE_FIELDS_FROM_MQE},
+	{"MQMT_REPLY", MQMT_REPLY},
+	{"MQMT_REPORT", MQMT_REPORT},
+	{"MQMT_REQUEST", MQMT_REQUEST},
+	{"MQMT_SYSTEM_FIRST", MQMT_SYSTEM_FIRST},
+	{"MQMT_SYSTEM_LAST", MQMT_SYSTEM_LAST},
+	{"MQMULC_REFINED", MQMULC_REFINED},
+	{"MQMULC_STANDARD", MQMULC_STANDARD},
+	{"MQNC_MAX_NAMELIST_NAME_COUNT", MQNC_MAX_NAMELIST_NAME_COUNT},
+	{"MQNHABACKLOG_UNKNOWN", MQNHABACKLOG_UNKNOWN},
+	{"MQNHACONNACTV_NO", MQNHACONNACTV_NO},
+	{"MQNHACONNACTV_YES", MQNHACONNACTV_YES},
+	{"MQNHACONNGRP_NO", MQNHACONNGRP_NO},
+	{"MQNHACONNGRP_SUSPENDED", MQNHACONNGRP_SUSPENDED},
+	{"MQNHACONNGRP_YES", MQNHACONNGRP_YES},
+	{"MQNHAGRPROLE_LIVE", MQNHAGRPROLE_LIVE},
+	{"MQNHAGRPROLE_NOT_CONFIGURED", MQNHAGRPROLE_NOT_CONFIGURED},
+	{"MQNHAGRPROLE_PENDING_LIVE", MQNHAGRPROLE_PENDING_LIVE},
+	{"MQNHAGRPROLE_PENDING_RECOVERY", MQNHAGRPROLE_PENDING_RECOVERY},
+	{"MQNHAGRPROLE_RECOVERY", MQNHAGRPROLE_RECOVERY},
+	{"MQNHAGRPROLE_UNKNOWN", MQNHAGRPROLE_UNKNOWN},
+	{"MQNHAINSYNC_NO", MQNHAINSYNC_NO},
+	{"MQNHAINSYNC_YES", MQNHAINSYNC_YES},
+	{"MQNHAROLE_ACTIVE", MQNHAROLE_ACTIVE},
+	{"MQNHAROLE_LEADER", MQNHAROLE_LEADER},
+	{"MQNHAROLE_REPLICA", MQNHAROLE_REPLICA},
+	{"MQNHAROLE_UNKNOWN", MQNHAROLE_UNKNOWN},
+	{"MQNHASTATUS_CHECKING", MQNHASTATUS_CHECKING},
+	{"MQNHASTATUS_DISCONNECTED", MQNHASTATUS_DISCONNECTED},
+	{"MQNHASTATUS_DISK_FULL", MQNHASTATUS_DISK_FULL},
+	{"MQNHASTATUS_NORMAL", MQNHASTATUS_NORMAL},
+	{"MQNHASTATUS_PARTITIONED", MQNHASTATUS_PARTITIONED},
+	{"MQNHASTATUS_REBASING", MQNHASTATUS_REBASING},
+	{"MQNHASTATUS_SYNCHRONIZING", MQNHASTATUS_SYNCHRONIZING},
+	{"MQNHASTATUS_UNKNOWN", MQNHASTATUS_UNKNOWN},
+	{"MQNHATYPE_ALL", MQNHATYPE_ALL},
+	{"MQNHATYPE_GROUP", MQNHATYPE_GROUP},
+	{"MQNHATYPE_INSTANCE", MQNHATYPE_INSTANCE},
+	{"MQNPMS_FAST", MQNPMS_FAST},
+	{"MQNPMS_NORMAL", MQNPMS_NORMAL},
+	{"MQNPM_CLASS_HIGH", MQNPM_CLASS_HIGH},
+	{"MQNPM_CLASS_NORMAL", MQNPM_CLASS_NORMAL},
+	{"MQNSH_ALL", MQNSH_ALL},
+	{"MQNSH_NONE", MQNSH_NONE},
+	{"MQNT_ALL", MQNT_ALL},
+	{"MQNT_AUTH_INFO", MQNT_AUTH_INFO},
+	{"MQNT_CLUSTER", MQNT_CLUSTER},
+	{"MQNT_NONE", MQNT_NONE},
+	{"MQNT_Q", MQNT_Q},
+	{"MQNXP_CURRENT_LENGTH", MQNXP_CURRENT_LENGTH},
+	{"MQNXP_CURRENT_VERSION", MQNXP_CURRENT_VERSION},
+	{"MQNXP_LENGTH_1", MQNXP_LENGTH_1},
+	{"MQNXP_LENGTH_2", MQNXP_LENGTH_2},
+	{"MQNXP_VERSION_1", MQNXP_VERSION_1},
+	{"MQNXP_VERSION_2", MQNXP_VERSION_2},
+	{"MQOA_FIRST", MQOA_FIRST},
+	{"MQOA_LAST", MQOA_LAST},
+	{"MQOD_CURRENT_LENGTH", MQOD_CURRENT_LENGTH},
+	{"MQOD_CURRENT_VERSION", MQOD_CURRENT_VERSION},
+	{"MQOD_LENGTH_1", MQOD_LENGTH_1},
+	{"MQOD_LENGTH_2", MQOD_LENGTH_2},
+	{"MQOD_LENGTH_3", MQOD_LENGTH_3},
+	{"MQOD_LENGTH_4", MQOD_LENGTH_4},
+	{"MQOD_VERSION_1", MQOD_VERSION_1},
+	{"MQOD_VERSION_2", MQOD_VERSION_2},
+	{"MQOD_VERSION_3", MQOD_VERSION_3},
+	{"MQOD_VERSION_4", MQOD_VERSION_4},
+	{"MQOL_UNDEFINED", MQOL_UNDEFINED},
+	{"MQOM_NO", MQOM_NO},
+	{"MQOM_YES", MQOM_YES},
+	{"MQOO_ALTERNATE_USER_AUTHORITY", MQOO_ALTERNATE_USER_AUTHORITY},
+	{"MQOO_BIND_AS_Q_DEF", MQOO_BIND_AS_Q_DEF},
+	{"MQOO_BIND_NOT_FIXED", MQOO_BIND_NOT_FIXED},
+	{"MQOO_BIND_ON_GROUP", MQOO_BIND_ON_GROUP},
+	{"MQOO_BIND_ON_OPEN", MQOO_BIND_ON_OPEN},
+	{"MQOO_BROWSE", MQOO_BROWSE},
+	{"MQOO_CO_OP", MQOO_CO_OP},
+	{"MQOO_FAIL_IF_QUIESCING", MQOO_FAIL_IF_QUIESCING},
+	{"MQOO_INPUT_AS_Q_DEF", MQOO_INPUT_AS_Q_DEF},
+	{"MQOO_INPUT_EXCLUSIVE", MQOO_INPUT_EXCLUSIVE},
+	{"MQOO_INPUT_SHARED", MQOO_INPUT_SHARED},
+	{"MQOO_INQUIRE", MQOO_INQUIRE},
+	{"MQOO_NO_MULTICAST", MQOO_NO_MULTICAST},
+	{"MQOO_NO_READ_AHEAD", MQOO_NO_READ_AHEAD},
+	{"MQOO_OUTPUT", MQOO_OUTPUT},
+	{"MQOO_PASS_ALL_CONTEXT", MQOO_PASS_ALL_CONTEXT},
+	{"MQOO_PASS_IDENTITY_CONTEXT", MQOO_PASS_IDENTITY_CONTEXT},
+	{"MQOO_READ_AHEAD", MQOO_READ_AHEAD},
+	{"MQOO_READ_AHEAD_AS_Q_DEF", MQOO_READ_AHEAD_AS_Q_DEF},
+	{"MQOO_RESOLVE_LOCAL_Q", MQOO_RESOLVE_LOCAL_Q},
+	{"MQOO_RESOLVE_LOCAL_TOPIC", MQOO_RESOLVE_LOCAL_TOPIC},
+	{"MQOO_RESOLVE_NAMES", MQOO_RESOLVE_NAMES},
+	{"MQOO_SAVE_ALL_CONTEXT", MQOO_SAVE_ALL_CONTEXT},
+	{"MQOO_SET", MQOO_SET},
+	{"MQOO_SET_ALL_CONTEXT", MQOO_SET_ALL_CONTEXT},
+	{"MQOO_SET_IDENTITY_CONTEXT", MQOO_SET_IDENTITY_CONTEXT},
+	{"MQOPER_APPL_FIRST", MQOPER_APPL_FIRST},
+	{"MQOPER_APPL_LAST", MQOPER_APPL_LAST},
+	{"MQOPER_BROWSE", MQOPER_BROWSE},
+	{"MQOPER_DISCARD", MQOPER_DISCARD},
+	{"MQOPER_DISCARDED_PUBLISH", MQOPER_DISCARDED_PUBLISH},
+	{"MQOPER_EXCLUDED_PUBLISH", MQOPER_EXCLUDED_PUBLISH},
+	{"MQOPER_GET", MQOPER_GET},
+	{"MQOPER_PUBLISH", MQOPER_PUBLISH},
+	{"MQOPER_PUT", MQOPER_PUT},
+	{"MQOPER_PUT_REPLY", MQOPER_PUT_REPLY},
+	{"MQOPER_PUT_REPORT", MQOPER_PUT_REPORT},
+	{"MQOPER_RECEIVE", MQOPER_RECEIVE},
+	{"MQOPER_SEND", MQOPER_SEND},
+	{"MQOPER_SYSTEM_FIRST", MQOPER_SYSTEM_FIRST},
+	{"MQOPER_SYSTEM_LAST", MQOPER_SYSTEM_LAST},
+	{"MQOPER_TRANSFORM", MQOPER_TRANSFORM},
+	{"MQOPER_UNKNOWN", MQOPER_UNKNOWN},
+	{"MQOPMODE_COMPAT", MQOPMODE_COMPAT},
+	{"MQOPMODE_NEW_FUNCTION", MQOPMODE_NEW_FUNCTION},
+	{"MQOP_DEREGISTER", MQOP_DEREGISTER},
+	{"MQOP_REGISTER", MQOP_REGISTER},
+	{"MQOP_RESUME", MQOP_RESUME},
+	{"MQOP_START", MQOP_START},
+	{"MQOP_START_WAIT", MQOP_START_WAIT},
+	{"MQOP_STOP", MQOP_STOP},
+	{"MQOP_SUSPEND", MQOP_SUSPEND},
+	{"MQOTEL_PCTL_AS_PARENT", MQOTEL_PCTL_AS_PARENT},
+	{"MQOTEL_PCTL_AUTO", MQOTEL_PCTL_AUTO},
+	{"MQOTEL_PCTL_MANUAL", MQOTEL_PCTL_MANUAL},
+	{"MQOTEL_PCTL_QMGR", MQOTEL_PCTL_QMGR},
+	{"MQOTEL_TRACE_AS_PARENT", MQOTEL_TRACE_AS_PARENT},
+	{"MQOTEL_TRACE_NONE", MQOTEL_TRACE_NONE},
+	{"MQOTEL_TRACE_OFF", MQOTEL_TRACE_OFF},
+	{"MQOTEL_TRACE_ON", MQOTEL_TRACE_ON},
+	{"MQOTEL_TRACE_QMGR", MQOTEL_TRACE_QMGR},
+	{"MQOT_ALIAS_Q", MQOT_ALIAS_Q},
+	{"MQOT_ALL", MQOT_ALL},
+	{"MQOT_AMQP_CHANNEL", MQOT_AMQP_CHANNEL},
+	{"MQOT_AUTH_INFO", MQOT_AUTH_INFO},
+	{"MQOT_AUTH_REC", MQOT_AUTH_REC},
+	{"MQOT_CF_STRUC", MQOT_CF_STRUC},
+	{"MQOT_CHANNEL", MQOT_CHANNEL},
+	{"MQOT_CHLAUTH", MQOT_CHLAUTH},
+	{"MQOT_CLNTCONN_CHANNEL", MQOT_CLNTCONN_CHANNEL},
+	{"MQOT_COMM_INFO", MQOT_COMM_INFO},
+	{"MQOT_CURRENT_CHANNEL", MQOT_CURRENT_CHANNEL},
+	{"MQOT_LISTENER", MQOT_LISTENER},
+	{"MQOT_LOCAL_Q", MQOT_LOCAL_Q},
+	{"MQOT_MODEL_Q", MQOT_MODEL_Q},
+	{"MQOT_NAMELIST", MQOT_NAMELIST},
+	{"MQOT_NONE", MQOT_NONE},
+	{"MQOT_PROCESS", MQOT_PROCESS},
+	{"MQOT_PROT_POLICY", MQOT_PROT_POLICY},
+	{"MQOT_Q", MQOT_Q},
+	{"MQOT_Q_MGR", MQOT_Q_MGR},
+	{"MQOT_RECEIVER_CHANNEL", MQOT_RECEIVER_CHANNEL},
+	{"MQOT_REMOTE_Q", MQOT_REMOTE_Q},
+	{"MQOT_REMOTE_Q_MGR_NAME", MQOT_REMOTE_Q_MGR_NAME},
+	{"MQOT_REQUESTER_CHANNEL", MQOT_REQUESTER_CHANNEL},
+	{"MQOT_RESERVED_1", MQOT_RESERVED_1},
+	{"MQOT_SAVED_CHANNEL", MQOT_SAVED_CHANNEL},
+	{"MQOT_SENDER_CHANNEL", MQOT_SENDER_CHANNEL},
+	{"MQOT_SERVER_CHANNEL", MQOT_SERVER_CHANNEL},
+	{"MQOT_SERVICE", MQOT_SERVICE},
+	{"MQOT_SHORT_CHANNEL", MQOT_SHORT_CHANNEL},
+	{"MQOT_STORAGE_CLASS", MQOT_STORAGE_CLASS},
+	{"MQOT_SVRCONN_CHANNEL", MQOT_SVRCONN_CHANNEL},
+	{"MQOT_TOPIC", MQOT_TOPIC},
+	{"MQOT_TT_CHANNEL", MQOT_TT_CHANNEL},
+	{"MQPAGECLAS_4KB", MQPAGECLAS_4KB},
+	{"MQPAGECLAS_FIXED4KB", MQPAGECLAS_FIXED4KB},
+	{"MQPA_ALTERNATE_OR_MCA", MQPA_ALTERNATE_OR_MCA},
+	{"MQPA_CONTEXT", MQPA_CONTEXT},
+	{"MQPA_DEFAULT", MQPA_DEFAULT},
+	{"MQPA_ONLY_MCA", MQPA_ONLY_MCA},
+	{"MQPBC_CURRENT_LENGTH", MQPBC_CURRENT_LENGTH},
+	{"MQPBC_CURRENT_VERSION", MQPBC_CURRENT_VERSION},
+	{"MQPBC_LENGTH_1", MQPBC_LENGTH_1},
+	{"MQPBC_LENGTH_2", MQPBC_LENGTH_2},
+	{"MQPBC_VERSION_1", MQPBC_VERSION_1},
+	{"MQPBC_VERSION_2", MQPBC_VERSION_2},
+	{"MQPD_ACCEPT_UNSUP_IF_XMIT_MASK", MQPD_ACCEPT_UNSUP_IF_XMIT_MASK},
+	{"MQPD_ACCEPT_UNSUP_MASK", MQPD_ACCEPT_UNSUP_MASK},
+	{"MQPD_CURRENT_LENGTH", MQPD_CURRENT_LENGTH},
+	{"MQPD_CURRENT_VERSION", MQPD_CURRENT_VERSION},
+	{"MQPD_LENGTH_1", MQPD_LENGTH_1},
+	{"MQPD_NONE", MQPD_NONE},
+	{"MQPD_NO_CONTEXT", MQPD_NO_CONTEXT},
+	{"MQPD_REJECT_UNSUP_MASK", MQPD_REJECT_UNSUP_MASK},
+	{"MQPD_SUPPORT_OPTIONAL", MQPD_SUPPORT_OPTIONAL},
+	{"MQPD_SUPPORT_REQUIRED", MQPD_SUPPORT_REQUIRED},
+	{"MQPD_SUPPORT_REQUIRED_IF_LOCAL", MQPD_SUPPORT_REQUIRED_IF_LOCAL},
+	{"MQPD_USER_CONTEXT", MQPD_USER_CONTEXT},
+	{"MQPD_VERSION_1", MQPD_VERSION_1},
+	{"MQPER_NOT_PERSISTENT", MQPER_NOT_PERSISTENT},
+	{"MQPER_PERSISTENCE_AS_PARENT", MQPER_PERSISTENCE_AS_PARENT},
+	{"MQPER_PERSISTENCE_AS_Q_DEF", MQPER_PERSISTENCE_AS_Q_DEF},
+	{"MQPER_PERSISTENCE_AS_TOPIC_DEF", MQPER_PERSISTENCE_AS_TOPIC_DEF},
+	{"MQPER_PERSISTENT", MQPER_PERSISTENT},
+	{"MQPL_AIX", MQPL_AIX},
+	{"MQPL_APPLIANCE", MQPL_APPLIANCE},
+	{"MQPL_MVS", MQPL_MVS},
+	{"MQPL_NSK", MQPL_NSK},
+	{"MQPL_NSS", MQPL_NSS},
+	{"MQPL_OPEN_TP1", MQPL_OPEN_TP1},
+	{"MQPL_OS2", MQPL_OS2},
+	{"MQPL_OS390", MQPL_OS390},
+	{"MQPL_OS400", MQPL_OS400},
+	{"MQPL_TPF", MQPL_TPF},
+	{"MQPL_UNIX", MQPL_UNIX},
+	{"MQPL_VM", MQPL_VM},
+	{"MQPL_VMS", MQPL_VMS},
+	{"MQPL_VSE", MQPL_VSE},
+	{"MQPL_WINDOWS", MQPL_WINDOWS},
+	{"MQPL_WINDOWS_NT", MQPL_WINDOWS_NT},
+	{"MQPL_ZOS", MQPL_ZOS},
+	{"MQPMO_ALTERNATE_USER_AUTHORITY", MQPMO_ALTERNATE_USER_AUTHORITY},
+	{"MQPMO_ASYNC_RESPONSE", MQPMO_ASYNC_RESPONSE},
+	{"MQPMO_CURRENT_LENGTH", MQPMO_CURRENT_LENGTH},
+	{"MQPMO_CURRENT_VERSION", MQPMO_CURRENT_VERSION},
+	{"MQPMO_DEFAULT_CONTEXT", MQPMO_DEFAULT_CONTEXT},
+	{"MQPMO_FAIL_IF_QUIESCING", MQPMO_FAIL_IF_QUIESCING},
+	{"MQPMO_LENGTH_1", MQPMO_LENGTH_1},
+	{"MQPMO_LENGTH_2", MQPMO_LENGTH_2},
+	{"MQPMO_LENGTH_3", MQPMO_LENGTH_3},
+	{"MQPMO_LOGICAL_ORDER", MQPMO_LOGICAL_ORDER},
+	{"MQPMO_MD_FOR_OUTPUT_ONLY", MQPMO_MD_FOR_OUTPUT_ONLY},
+	{"MQPMO_NEW_CORREL_ID", MQPMO_NEW_CORREL_ID},
+	{"MQPMO_NEW_MSG_ID", MQPMO_NEW_MSG_ID},
+	{"MQPMO_NONE", MQPMO_NONE},
+	{"MQPMO_NOT_OWN_SUBS", MQPMO_NOT_OWN_SUBS},
+	{"MQPMO_NO_CONTEXT", MQPMO_NO_CONTEXT},
+	{"MQPMO_NO_SYNCPOINT", MQPMO_NO_SYNCPOINT},
+	{"MQPMO_PASS_ALL_CONTEXT", MQPMO_PASS_ALL_CONTEXT},
+	{"MQPMO_PASS_IDENTITY_CONTEXT", MQPMO_PASS_IDENTITY_CONTEXT},
+	{"MQPMO_PUB_OPTIONS_MASK", MQPMO_PUB_OPTIONS_MASK},
+	{"MQPMO_RESOLVE_LOCAL_Q", MQPMO_RESOLVE_LOCAL_Q},
+	{"MQPMO_RESPONSE_AS_Q_DEF", MQPMO_RESPONSE_AS_Q_DEF},
+	{"MQPMO_RESPONSE_AS_TOPIC_DEF", MQPMO_RESPONSE_AS_TOPIC_DEF},
+	{"MQPMO_RETAIN", MQPMO_RETAIN},
+	{"MQPMO_SCOPE_QMGR", MQPMO_SCOPE_QMGR},
+	{"MQPMO_SET_ALL_CONTEXT", MQPMO_SET_ALL_CONTEXT},
+	{"MQPMO_SET_IDENTITY_CONTEXT", MQPMO_SET_IDENTITY_CONTEXT},
+	{"MQPMO_SUPPRESS_REPLYTO", MQPMO_SUPPRESS_REPLYTO},
+	{"MQPMO_SYNCPOINT", MQPMO_SYNCPOINT},
+	{"MQPMO_SYNC_RESPONSE", MQPMO_SYNC_RESPONSE},
+	{"MQPMO_VERSION_1", MQPMO_VERSION_1},
+	{"MQPMO_VERSION_2", MQPMO_VERSION_2},
+	{"MQPMO_VERSION_3", MQPMO_VERSION_3},
+	{"MQPMO_WARN_IF_NO_SUBS_MATCHED", MQPMO_WARN_IF_NO_SUBS_MATCHED},
+	{"MQPMRF_ACCOUNTING_TOKEN", MQPMRF_ACCOUNTING_TOKEN},
+	{"MQPMRF_CORREL_ID", MQPMRF_CORREL_ID},
+	{"MQPMRF_FEEDBACK", MQPMRF_FEEDBACK},
+	{"MQPMRF_GROUP_ID", MQPMRF_GROUP_ID},
+	{"MQPMRF_MSG_ID", MQPMRF_MSG_ID},
+	{"MQPMRF_NONE", MQPMRF_NONE},
+	{"MQPO_NO", MQPO_NO},
+	{"MQPO_YES", MQPO_YES},
+	{"MQPRI_PRIORITY_AS_PARENT", MQPRI_PRIORITY_AS_PARENT},
+	{"MQPRI_PRIORITY_AS_PUBLISHED", MQPRI_PRIORITY_AS_PUBLISHED},
+	{"MQPRI_PRIORITY_AS_Q_DEF", MQPRI_PRIORITY_AS_Q_DEF},
+	{"MQPRI_PRIORITY_AS_TOPIC_DEF", MQPRI_PRIORITY_AS_TOPIC_DEF},
+	{"MQPROP_ALL", MQPROP_ALL},
+	{"MQPROP_COMPATIBILITY", MQPROP_COMPATIBILITY},
+	{"MQPROP_FORCE_MQRFH2", MQPROP_FORCE_MQRFH2},
+	{"MQPROP_NONE", MQPROP_NONE},
+	{"MQPROP_UNRESTRICTED_LENGTH", MQPROP_UNRESTRICTED_LENGTH},
+	{"MQPROP_V6COMPAT", MQPROP_V6COMPAT},
+	{"MQPROTO_AMQP", MQPROTO_AMQP},
+	{"MQPROTO_HTTP", MQPROTO_HTTP},
+	{"MQPROTO_MQTTV3", MQPROTO_MQTTV3},
+	{"MQPROTO_MQTTV311", MQPROTO_MQTTV311},
+	{"MQPRT_ASYNC_RESPONSE", MQPRT_ASYNC_RESPONSE},
+	{"MQPRT_RESPONSE_AS_PARENT", MQPRT_RESPONSE_AS_PARENT},
+	{"MQPRT_SYNC_RESPONSE", MQPRT_SYNC_RESPONSE},
+	{"MQPSCLUS_DISABLED", MQPSCLUS_DISABLED},
+	{"MQPSCLUS_ENABLED", MQPSCLUS_ENABLED},
+	{"MQPSCT_NONE", MQPSCT_NONE},
+	{"MQPSM_COMPAT", MQPSM_COMPAT},
+	{"MQPSM_DISABLED", MQPSM_DISABLED},
+	{"MQPSM_ENABLED", MQPSM_ENABLED},
+	{"MQPSPROP_COMPAT", MQPSPROP_COMPAT},
+	{"MQPSPROP_MSGPROP", MQPSPROP_MSGPROP},
+	{"MQPSPROP_NONE", MQPSPROP_NONE},
+	{"MQPSPROP_RFH2", MQPSPROP_RFH2},
+	{"MQPSST_ALL", MQPSST_ALL},
+	{"MQPSST_CHILD", MQPSST_CHILD},
+	{"MQPSST_LOCAL", MQPSST_LOCAL},
+	{"MQPSST_PARENT", MQPSST_PARENT},
+	{"MQPSXP_CURRENT_LENGTH", MQPSXP_CURRENT_LENGTH},
+	{"MQPSXP_CURRENT_VERSION", MQPSXP_CURRENT_VERSION},
+	{"MQPSXP_LENGTH_1", MQPSXP_LENGTH_1},
+	{"MQPSXP_LENGTH_2", MQPSXP_LENGTH_2},
+	{"MQPSXP_VERSION_1", MQPSXP_VERSION_1},
+	{"MQPSXP_VERSION_2", MQPSXP_VERSION_2},
+	{"MQPS_STATUS_ACTIVE", MQPS_STATUS_ACTIVE},
+	{"MQPS_STATUS_COMPAT", MQPS_STATUS_COMPAT},
+	{"MQPS_STATUS_ERROR", MQPS_STATUS_ERROR},
+	{"MQPS_STATUS_INACTIVE", MQPS_STATUS_INACTIVE},
+	{"MQPS_STATUS_REFUSED", MQPS_STATUS_REFUSED},
+	{"MQPS_STATUS_STARTING", MQPS_STATUS_STARTING},
+	{"MQPS_STATUS_STOPPING", MQPS_STATUS_STOPPING},
+	{"MQPUBO_CORREL_ID_AS_IDENTITY", MQPUBO_CORREL_ID_AS_IDENTITY},
+	{"MQPUBO_IS_RETAINED_PUBLICATION", MQPUBO_IS_RETAINED_PUBLICATION},
+	{"MQPUBO_NONE", MQPUBO_NONE},
+	{"MQPUBO_NO_REGISTRATION", MQPUBO_NO_REGISTRATION},
+	{"MQPUBO_OTHER_SUBSCRIBERS_ONLY", MQPUBO_OTHER_SUBSCRIBERS_ONLY},
+	{"MQPUBO_RETAIN_PUBLICATION", MQPUBO_RETAIN_PUBLICATION},
+	{"MQQA_BACKOUT_HARDENED", MQQA_BACKOUT_HARDENED},
+	{"MQQA_BACKOUT_NOT_HARDENED", MQQA_BACKOUT_NOT_HARDENED},
+	{"MQQA_GET_ALLOWED", MQQA_GET_ALLOWED},
+	{"MQQA_GET_INHIBITED", MQQA_GET_INHIBITED},
+	{"MQQA_NOT_SHAREABLE", MQQA_NOT_SHAREABLE},
+	{"MQQA_PUT_ALLOWED", MQQA_PUT_ALLOWED},
+	{"MQQA_PUT_INHIBITED", MQQA_PUT_INHIBITED},
+	{"MQQA_SHAREABLE", MQQA_SHAREABLE},
+	{"MQQDT_PERMANENT_DYNAMIC", MQQDT_PERMANENT_DYNAMIC},
+	{"MQQDT_PREDEFINED", MQQDT_PREDEFINED},
+	{"MQQDT_SHARED_DYNAMIC", MQQDT_SHARED_DYNAMIC},
+	{"MQQDT_TEMPORARY_DYNAMIC", MQQDT_TEMPORARY_DYNAMIC},
+	{"MQQFS_DEFAULT", MQQFS_DEFAULT},
+	{"MQQF_CLWL_USEQ_ANY", MQQF_CLWL_USEQ_ANY},
+	{"MQQF_CLWL_USEQ_LOCAL", MQQF_CLWL_USEQ_LOCAL},
+	{"MQQF_LOCAL_Q", MQQF_LOCAL_Q},
+	{"MQQMDT_AUTO_CLUSTER_SENDER", MQQMDT_AUTO_CLUSTER_SENDER},
+	{"MQQMDT_AUTO_EXP_CLUSTER_SENDER", MQQMDT_AUTO_EXP_CLUSTER_SENDER},
+	{"MQQMDT_CLUSTER_RECEIVER", MQQMDT_CLUSTER_RECEIVER},
+	{"MQQMDT_EXPLICIT_CLUSTER_SENDER", MQQMDT_EXPLICIT_CLUSTER_SENDER},
+	{"MQQMFAC_DB2", MQQMFAC_DB2},
+	{"MQQMFAC_IMS_BRIDGE", MQQMFAC_IMS_BRIDGE},
+	{"MQQMF_AVAILABLE", MQQMF_AVAILABLE},
+	{"MQQMF_CLUSSDR_AUTO_DEFINED", MQQMF_CLUSSDR_AUTO_DEFINED},
+	{"MQQMF_CLUSSDR_USER_DEFINED", MQQMF_CLUSSDR_USER_DEFINED},
+	{"MQQMF_REPOSITORY_Q_MGR", MQQMF_REPOSITORY_Q_MGR},
+	{"MQQMOPT_DISABLED", MQQMOPT_DISABLED},
+	{"MQQMOPT_ENABLED", MQQMOPT_ENABLED},
+	{"MQQMOPT_REPLY", MQQMOPT_REPLY},
+	{"MQQMSTA_QUIESCING", MQQMSTA_QUIESCING},
+	{"MQQMSTA_RUNNING", MQQMSTA_RUNNING},
+	{"MQQMSTA_STANDBY", MQQMSTA_STANDBY},
+	{"MQQMSTA_STARTING", MQQMSTA_STARTING},
+	{"MQQMT_NORMAL", MQQMT_NORMAL},
+	{"MQQMT_REPOSITORY", MQQMT_REPOSITORY},
+	{"MQQO_NO", MQQO_NO},
+	{"MQQO_YES", MQQO_YES},
+	{"MQQSGD_ALL", MQQSGD_ALL},
+	{"MQQSGD_COPY", MQQSGD_COPY},
+	{"MQQSGD_GROUP", MQQSGD_GROUP},
+	{"MQQSGD_LIVE", MQQSGD_LIVE},
+	{"MQQSGD_PRIVATE", MQQSGD_PRIVATE},
+	{"MQQSGD_Q_MGR", MQQSGD_Q_MGR},
+	{"MQQSGD_SHARED", MQQSGD_SHARED},
+	{"MQQSGS_ACTIVE", MQQSGS_ACTIVE},
+	{"MQQSGS_CREATED", MQQSGS_CREATED},
+	{"MQQSGS_FAILED", MQQSGS_FAILED},
+	{"MQQSGS_INACTIVE", MQQSGS_INACTIVE},
+	{"MQQSGS_PENDING", MQQSGS_PENDING},
+	{"MQQSGS_UNKNOWN", MQQSGS_UNKNOWN},
+	{"MQQSIE_HIGH", MQQSIE_HIGH},
+	{"MQQSIE_NONE", MQQSIE_NONE},
+	{"MQQSIE_OK", MQQSIE_OK},
+	{"MQQSOT_ALL", MQQSOT_ALL},
+	{"MQQSOT_INPUT", MQQSOT_INPUT},
+	{"MQQSOT_OUTPUT", MQQSOT_OUTPUT},
+	{"MQQSO_EXCLUSIVE", MQQSO_EXCLUSIVE},
+	{"MQQSO_NO", MQQSO_NO},
+	{"MQQSO_SHARED", MQQSO_SHARED},
+	{"MQQSO_YES", MQQSO_YES},
+	{"MQQSUM_NO", MQQSUM_NO},
+	{"MQQSUM_YES", MQQSUM_YES},
+	{"MQQT_ALIAS", MQQT_ALIAS},
+	{"MQQT_ALL", MQQT_ALL},
+	{"MQQT_CLUSTER", MQQT_CLUSTER},
+	{"MQQT_LOCAL", MQQT_LOCAL},
+	{"MQQT_MODEL", MQQT_MODEL},
+	{"MQQT_REMOTE", MQQT_REMOTE},
+	{"MQRAR_NO", MQRAR_NO},
+	{"MQRAR_YES", MQRAR_YES},
+	{"MQRCCF_ACCESS_BLOCKED", MQRCCF_ACCESS_BLOCKED},
+	{"MQRCCF_ACTION_VALUE_ERROR", MQRCCF_ACTION_VALUE_ERROR},
+	{"MQRCCF_ADDRESS_ERROR", MQRCCF_ADDRESS_ERROR},
+	{"MQRCCF_ALLOCATE_FAILED", MQRCCF_ALLOCATE_FAILED},
+	{"MQRCCF_ALLOC_FAST_TIMER_ERROR", MQRCCF_ALLOC_FAST_TIMER_ERROR},
+	{"MQRCCF_ALLOC_RETRY_ERROR", MQRCCF_ALLOC_RETRY_ERROR},
+	{"MQRCCF_ALLOC_SLOW_TIMER_ERROR", MQRCCF_ALLOC_SLOW_TIMER_ERROR},
+	{"MQRCCF_ALREADY_JOINED", MQRCCF_ALREADY_JOINED},
+	{"MQRCCF_APPL_STATUS_NOT_FOUND", MQRCCF_APPL_STATUS_NOT_FOUND},
+	{"MQRCCF_ATTR_VALUE_ERROR", MQRCCF_ATTR_VALUE_ERROR},
+	{"MQRCCF_ATTR_VALUE_ERROR_QSG_QM", MQRCCF_ATTR_VALUE_ERROR_QSG_QM},
+	{"MQRCCF_ATTR_VALUE_FIXED", MQRCCF_ATTR_VALUE_FIXED},
+	{"MQRCCF_AUTHORIZED", MQRCCF_AUTHORIZED},
+	{"MQRCCF_AUTH_VALUE_ERROR", MQRCCF_AUTH_VALUE_ERROR},
+	{"MQRCCF_AUTH_VALUE_MISSING", MQRCCF_AUTH_VALUE_MISSING},
+	{"MQRCCF_BACKLOG_OUT_OF_RANGE", MQRCCF_BACKLOG_OUT_OF_RANGE},
+	{"MQRCCF_BATCH_INT_ERROR", MQRCCF_BATCH_INT_ERROR},
+	{"MQRCCF_BATCH_INT_WRONG_TYPE", MQRCCF_BATCH_INT_WRONG_TYPE},
+	{"MQRCCF_BATCH_SIZE_ERROR", MQRCCF_BATCH_SIZE_ERROR},
+	{"MQRCCF_BIND_FAILED", MQRCCF_BIND_FAILED},
+	{"MQRCCF_BROKER_COMMAND_FAILED", MQRCCF_BROKER_COMMAND_FAILED},
+	{"MQRCCF_BROKER_DELETED", MQRCCF_BROKER_DELETED},
+	{"MQRCCF_CCSID_ERROR", MQRCCF_CCSID_ERROR},
+	{"MQRCCF_CELL_DIR_NOT_AVAILABLE", MQRCCF_CELL_DIR_NOT_AVAILABLE},
+	{"MQRCCF_CERT_LABEL_NOT_ALLOWED", MQRCCF_CERT_LABEL_NOT_ALLOWED},
+	{"MQRCCF_CERT_VAL_POLICY_ERROR", MQRCCF_CERT_VAL_POLICY_ERROR},
+	{"MQRCCF_CFBF_FILTER_VAL_LEN_ERR", MQRCCF_CFBF_FILTER_VAL_LEN_ERR},
+	{"MQRCCF_CFBF_LENGTH_ERROR", MQRCCF_CFBF_LENGTH_ERROR},
+	{"MQRCCF_CFBF_OPERATOR_ERROR", MQRCCF_CFBF_OPERATOR_ERROR},
+	{"MQRCCF_CFBF_PARM_ID_ERROR", MQRCCF_CFBF_PARM_ID_ERROR},
+	{"MQRCCF_CFBS_DUPLICATE_PARM", MQRCCF_CFBS_DUPLICATE_PARM},
+	{"MQRCCF_CFBS_LENGTH_ERROR", MQRCCF_CFBS_LENGTH_ERROR},
+	{"MQRCCF_CFBS_PARM_ID_ERROR", MQRCCF_CFBS_PARM_ID_ERROR},
+	{"MQRCCF_CFBS_STRING_LENGTH_ERR", MQRCCF_CFBS_STRING_LENGTH_ERR},
+	{"MQRCCF_CFCONLOS_CHECKS_FAILED", MQRCCF_CFCONLOS_CHECKS_FAILED},
+	{"MQRCCF_CFGR_LENGTH_ERROR", MQRCCF_CFGR_LENGTH_ERROR},
+	{"MQRCCF_CFGR_PARM_COUNT_ERROR", MQRCCF_CFGR_PARM_COUNT_ERROR},
+	{"MQRCCF_CFGR_PARM_ID_ERROR", MQRCCF_CFGR_PARM_ID_ERROR},
+	{"MQRCCF_CFH_COMMAND_ERROR", MQRCCF_CFH_COMMAND_ERROR},
+	{"MQRCCF_CFH_CONTROL_ERROR", MQRCCF_CFH_CONTROL_ERROR},
+	{"MQRCCF_CFH_LENGTH_ERROR", MQRCCF_CFH_LENGTH_ERROR},
+	{"MQRCCF_CFH_MSG_SEQ_NUMBER_ERR", MQRCCF_CFH_MSG_SEQ_NUMBER_ERR},
+	{"MQRCCF_CFH_PARM_COUNT_ERROR", MQRCCF_CFH_PARM_COUNT_ERROR},
+	{"MQRCCF_CFH_TYPE_ERROR", MQRCCF_CFH_TYPE_ERROR},
+	{"MQRCCF_CFH_VERSION_ERROR", MQRCCF_CFH_VERSION_ERROR},
+	{"MQRCCF_CFIF_LENGTH_ERROR", MQRCCF_CFIF_LENGTH_ERROR},
+	{"MQRCCF_CFIF_OPERATOR_ERROR", MQRCCF_CFIF_OPERATOR_ERROR},
+	{"MQRCCF_CFIF_PARM_ID_ERROR", MQRCCF_CFIF_PARM_ID_ERROR},
+	{"MQRCCF_CFIL_COUNT_ERROR", MQRCCF_CFIL_COUNT_ERROR},
+	{"MQRCCF_CFIL_DUPLICATE_VALUE", MQRCCF_CFIL_DUPLICATE_VALUE},
+	{"MQRCCF_CFIL_LENGTH_ERROR", MQRCCF_CFIL_LENGTH_ERROR},
+	{"MQRCCF_CFIL_PARM_ID_ERROR", MQRCCF_CFIL_PARM_ID_ERROR},
+	{"MQRCCF_CFIN_DUPLICATE_PARM", MQRCCF_CFIN_DUPLICATE_PARM},
+	{"MQRCCF_CFIN_LENGTH_ERROR", MQRCCF_CFIN_LENGTH_ERROR},
+	{"MQRCCF_CFIN_PARM_ID_ERROR", MQRCCF_CFIN_PARM_ID_ERROR},
+	{"MQRCCF_CFSF_FILTER_VAL_LEN_ERR", MQRCCF_CFSF_FILTER_VAL_LEN_ERR},
+	{"MQRCCF_CFSF_LENGTH_ERROR", MQRCCF_CFSF_LENGTH_ERROR},
+	{"MQRCCF_CFSF_OPERATOR_ERROR", MQRCCF_CFSF_OPERATOR_ERROR},
+	{"MQRCCF_CFSF_PARM_ID_ERROR", MQRCCF_CFSF_PARM_ID_ERROR},
+	{"MQRCCF_CFSL_COUNT_ERROR", MQRCCF_CFSL_COUNT_ERROR},
+	{"MQRCCF_CFSL_DUPLICATE_PARM", MQRCCF_CFSL_DUPLICATE_PARM},
+	{"MQRCCF_CFSL_LENGTH_ERROR", MQRCCF_CFSL_LENGTH_ERROR},
+	{"MQRCCF_CFSL_PARM_ID_ERROR", MQRCCF_CFSL_PARM_ID_ERROR},
+	{"MQRCCF_CFSL_STRING_LENGTH_ERR", MQRCCF_CFSL_STRING_LENGTH_ERR},
+	{"MQRCCF_CFSL_TOTAL_LENGTH_ERROR", MQRCCF_CFSL_TOTAL_LENGTH_ERROR},
+	{"MQRCCF_CFST_CONFLICTING_PARM", MQRCCF_CFST_CONFLICTING_PARM},
+	{"MQRCCF_CFST_DUPLICATE_PARM", MQRCCF_CFST_DUPLICATE_PARM},
+	{"MQRCCF_CFST_LENGTH_ERROR", MQRCCF_CFST_LENGTH_ERROR},
+	{"MQRCCF_CFST_PARM_ID_ERROR", MQRCCF_CFST_PARM_ID_ERROR},
+	{"MQRCCF_CFST_STRING_LENGTH_ERR", MQRCCF_CFST_STRING_LENGTH_ERR},
+	{"MQRCCF_CF_STRUC_ALREADY_FAILED", MQRCCF_CF_STRUC_ALREADY_FAILED},
+	{"MQRCCF_CF_STRUC_ERROR", MQRCCF_CF_STRUC_ERROR},
+	{"MQRCCF_CHAD_ERROR", MQRCCF_CHAD_ERROR},
+	{"MQRCCF_CHAD_EVENT_ERROR", MQRCCF_CHAD_EVENT_ERROR},
+	{"MQRCCF_CHAD_EVENT_WRONG_TYPE", MQRCCF_CHAD_EVENT_WRONG_TYPE},
+	{"MQRCCF_CHAD_EXIT_ERROR", MQRCCF_CHAD_EXIT_ERROR},
+	{"MQRCCF_CHAD_EXIT_WRONG_TYPE", MQRCCF_CHAD_EXIT_WRONG_TYPE},
+	{"MQRCCF_CHAD_WRONG_TYPE", MQRCCF_CHAD_WRONG_TYPE},
+	{"MQRCCF_CHANNEL_ALREADY_EXISTS", MQRCCF_CHANNEL_ALREADY_EXISTS},
+	{"MQRCCF_CHANNEL_CLOSED", MQRCCF_CHANNEL_CLOSED},
+	{"MQRCCF_CHANNEL_DISABLED", MQRCCF_CHANNEL_DISABLED},
+	{"MQRCCF_CHANNEL_ERROR", MQRCCF_CHANNEL_ERROR},
+	{"MQRCCF_CHANNEL_INDOUBT", MQRCCF_CHANNEL_INDOUBT},
+	{"MQRCCF_CHANNEL_INITIATOR_ERROR", MQRCCF_CHANNEL_INITIATOR_ERROR},
+	{"MQRCCF_CHANNEL_IN_USE", MQRCCF_CHANNEL_IN_USE},
+	{"MQRCCF_CHANNEL_NAME_ERROR", MQRCCF_CHANNEL_NAME_ERROR},
+	{"MQRCCF_CHANNEL_NOT_ACTIVE", MQRCCF_CHANNEL_NOT_ACTIVE},
+	{"MQRCCF_CHANNEL_NOT_FOUND", MQRCCF_CHANNEL_NOT_FOUND},
+	{"MQRCCF_CHANNEL_NOT_STARTED", MQRCCF_CHANNEL_NOT_STARTED},
+	{"MQRCCF_CHANNEL_TABLE_ERROR", MQRCCF_CHANNEL_TABLE_ERROR},
+	{"MQRCCF_CHANNEL_TYPE_ERROR", MQRCCF_CHANNEL_TYPE_ERROR},
+	{"MQRCCF_CHLAUTH_ACTION_ERROR", MQRCCF_CHLAUTH_ACTION_ERROR},
+	{"MQRCCF_CHLAUTH_ALREADY_EXISTS", MQRCCF_CHLAUTH_ALREADY_EXISTS},
+	{"MQRCCF_CHLAUTH_CHKCLI_ERROR", MQRCCF_CHLAUTH_CHKCLI_ERROR},
+	{"MQRCCF_CHLAUTH_CLNTUSER_ERROR", MQRCCF_CHLAUTH_CLNTUSER_ERROR},
+	{"MQRCCF_CHLAUTH_DISABLED", MQRCCF_CHLAUTH_DISABLED},
+	{"MQRCCF_CHLAUTH_MAX_EXCEEDED", MQRCCF_CHLAUTH_MAX_EXCEEDED},
+	{"MQRCCF_CHLAUTH_NAME_ERROR", MQRCCF_CHLAUTH_NAME_ERROR},
+	{"MQRCCF_CHLAUTH_NOT_FOUND", MQRCCF_CHLAUTH_NOT_FOUND},
+	{"MQRCCF_CHLAUTH_RUNCHECK_ERROR", MQRCCF_CHLAUTH_RUNCHECK_ERROR},
+	{"MQRCCF_CHLAUTH_TYPE_ERROR", MQRCCF_CHLAUTH_TYPE_ERROR},
+	{"MQRCCF_CHLAUTH_USERSRC_ERROR", MQRCCF_CHLAUTH_USERSRC_ERROR},
+	{"MQRCCF_CHLAUTH_WARN_ERROR", MQRCCF_CHLAUTH_WARN_ERROR},
+	{"MQRCCF_CHL_INST_TYPE_ERROR", MQRCCF_CHL_INST_TYPE_ERROR},
+	{"MQRCCF_CHL_STATUS_NOT_FOUND", MQRCCF_CHL_STATUS_NOT_FOUND},
+	{"MQRCCF_CHL_SYSTEM_NOT_ACTIVE", MQRCCF_CHL_SYSTEM_NOT_ACTIVE},
+	{"MQRCCF_CLIENT_ID_ERROR", MQRCCF_CLIENT_ID_ERROR},
+	{"MQRCCF_CLIENT_ID_NOT_FOUND", MQRCCF_CLIENT_ID_NOT_FOUND},
+	{"MQRCCF_CLROUTE_NOT_ALTERABLE", MQRCCF_CLROUTE_NOT_ALTERABLE},
+	{"MQRCCF_CLUSTER_NAME_CONFLICT", MQRCCF_CLUSTER_NAME_CONFLICT},
+	{"MQRCCF_CLUSTER_Q_USAGE_ERROR", MQRCCF_CLUSTER_Q_USAGE_ERROR},
+	{"MQRCCF_CLUSTER_TOPIC_CONFLICT", MQRCCF_CLUSTER_TOPIC_CONFLICT},
+	{"MQRCCF_CLUS_XMIT_Q_USAGE_ERROR", MQRCCF_CLUS_XMIT_Q_USAGE_ERROR},
+	{"MQRCCF_CLWL_EXIT_NAME_ERROR", MQRCCF_CLWL_EXIT_NAME_ERROR},
+	{"MQRCCF_COMMAND_FAILED", MQRCCF_COMMAND_FAILED},
+	{"MQRCCF_COMMAND_INHIBITED", MQRCCF_COMMAND_INHIBITED},
+	{"MQRCCF_COMMAND_LENGTH_ERROR", MQRCCF_COMMAND_LENGTH_ERROR},
+	{"MQRCCF_COMMAND_LEVEL_CONFLICT", MQRCCF_COMMAND_LEVEL_CONFLICT},
+	{"MQRCCF_COMMAND_ORIGIN_ERROR", MQRCCF_COMMAND_ORIGIN_ERROR},
+	{"MQRCCF_COMMAND_REPLY_ERROR", MQRCCF_COMMAND_REPLY_ERROR},
+	{"MQRCCF_COMMAND_SCOPE_ERROR", MQRCCF_COMMAND_SCOPE_ERROR},
+	{"MQRCCF_COMMIT_FAILED", MQRCCF_COMMIT_FAILED},
+	{"MQRCCF_COMMS_LIBRARY_ERROR", MQRCCF_COMMS_LIBRARY_ERROR},
+	{"MQRCCF_COMM_INFO_TYPE_ERROR", MQRCCF_COMM_INFO_TYPE_ERROR},
+	{"MQRCCF_CONFIGURATION_ERROR", MQRCCF_CONFIGURATION_ERROR},
+	{"MQRCCF_CONNECTION_CLOSED", MQRCCF_CONNECTION_CLOSED},
+	{"MQRCCF_CONNECTION_ID_ERROR", MQRCCF_CONNECTION_ID_ERROR},
+	{"MQRCCF_CONNECTION_REFUSED", MQRCCF_CONNECTION_REFUSED},
+	{"MQRCCF_CONN_NAME_ERROR", MQRCCF_CONN_NAME_ERROR},
+	{"MQRCCF_CONN_NOT_STOPPED", MQRCCF_CONN_NOT_STOPPED},
+	{"MQRCCF_CORREL_ID_ERROR", MQRCCF_CORREL_ID_ERROR},
+	{"MQRCCF_CURRENT_LOG_EXTENT", MQRCCF_CURRENT_LOG_EXTENT},
+	{"MQRCCF_CUSTOM_ERROR", MQRCCF_CUSTOM_ERROR},
+	{"MQRCCF_DATA_CONV_VALUE_ERROR", MQRCCF_DATA_CONV_VALUE_ERROR},
+	{"MQRCCF_DATA_TOO_LARGE", MQRCCF_DATA_TOO_LARGE},
+	{"MQRCCF_DEFCLXQ_MODEL_Q_ERROR", MQRCCF_DEFCLXQ_MODEL_Q_ERROR},
+	{"MQRCCF_DEF_XMIT_Q_CLUS_ERROR", MQRCCF_DEF_XMIT_Q_CLUS_ERROR},
+	{"MQRCCF_DEL_OPTIONS_ERROR", MQRCCF_DEL_OPTIONS_ERROR},
+	{"MQRCCF_DEST_NAME_ERROR", MQRCCF_DEST_NAME_ERROR},
+	{"MQRCCF_DISC_INT_ERROR", MQRCCF_DISC_INT_ERROR},
+	{"MQRCCF_DISC_INT_WRONG_TYPE", MQRCCF_DISC_INT_WRONG_TYPE},
+	{"MQRCCF_DISC_RETRY_ERROR", MQRCCF_DISC_RETRY_ERROR},
+	{"MQRCCF_DISPOSITION_CONFLICT", MQRCCF_DISPOSITION_CONFLICT},
+	{"MQRCCF_DUPLICATE_IDENTITY", MQRCCF_DUPLICATE_IDENTITY},
+	{"MQRCCF_DUPLICATE_SUBSCRIPTION", MQRCCF_DUPLICATE_SUBSCRIPTION},
+	{"MQRCCF_DURABILITY_NOT_ALLOWED", MQRCCF_DURABILITY_NOT_ALLOWED},
+	{"MQRCCF_DYNAMIC_Q_SCOPE_ERROR", MQRCCF_DYNAMIC_Q_SCOPE_ERROR},
+	{"MQRCCF_ENCODING_ERROR", MQRCCF_ENCODING_ERROR},
+	{"MQRCCF_ENCRYPTION_ALG_ERROR", MQRCCF_ENCRYPTION_ALG_ERROR},
+	{"MQRCCF_ENTITY_NAME_MISSING", MQRCCF_ENTITY_NAME_MISSING},
+	{"MQRCCF_ENTITY_TYPE_MISSING", MQRCCF_ENTITY_TYPE_MISSING},
+	{"MQRCCF_ENTRY_ERROR", MQRCCF_ENTRY_ERROR},
+	{"MQRCCF_ESCAPE_TYPE_ERROR", MQRCCF_ESCAPE_TYPE_ERROR},
+	{"MQRCCF_EVENTS_DISABLED", MQRCCF_EVENTS_DISABLED},
+	{"MQRCCF_FILE_NOT_AVAILABLE", MQRCCF_FILE_NOT_AVAILABLE},
+	{"MQRCCF_FILTER_ERROR", MQRCCF_FILTER_ERROR},
+	{"MQRCCF_FORCE_VALUE_ERROR", MQRCCF_FORCE_VALUE_ERROR},
+	{"MQRCCF_FUNCTION_RESTRICTED", MQRCCF_FUNCTION_RESTRICTED},
+	{"MQRCCF_GROUPUR_CHECKS_FAILED", MQRCCF_GROUPUR_CHECKS_FAILED},
+	{"MQRCCF_HB_INTERVAL_ERROR", MQRCCF_HB_INTERVAL_ERROR},
+	{"MQRCCF_HB_INTERVAL_WRONG_TYPE", MQRCCF_HB_INTERVAL_WRONG_TYPE},
+	{"MQRCCF_HOBJ_ERROR", MQRCCF_HOBJ_ERROR},
+	{"MQRCCF_HOST_NOT_AVAILABLE", MQRCCF_HOST_NOT_AVAILABLE},
+	{"MQRCCF_INCOMPATIBLE_QM_IN_QSG", MQRCCF_INCOMPATIBLE_QM_IN_QSG},
+	{"MQRCCF_INCORRECT_Q", MQRCCF_INCORRECT_Q},
+	{"MQRCCF_INCORRECT_STREAM", MQRCCF_INCORRECT_STREAM},
+	{"MQRCCF_INDOUBT_VALUE_ERROR", MQRCCF_INDOUBT_VALUE_ERROR},
+	{"MQRCCF_INVALID_DESTINATION", MQRCCF_INVALID_DESTINATION},
+	{"MQRCCF_INVALID_PROTOCOL", MQRCCF_INVALID_PROTOCOL},
+	{"MQRCCF_IPADDR_ERROR", MQRCCF_IPADDR_ERROR},
+	{"MQRCCF_IPADDR_RANGE_CONFLICT", MQRCCF_IPADDR_RANGE_CONFLICT},
+	{"MQRCCF_IPADDR_RANGE_ERROR", MQRCCF_IPADDR_RANGE_ERROR},
+	{"MQRCCF_KEEP_ALIVE_INT_ERROR", MQRCCF_KEEP_ALIVE_INT_ERROR},
+	{"MQRCCF_KWD_VALUE_WRONG_TYPE", MQRCCF_KWD_VALUE_WRONG_TYPE},
+	{"MQRCCF_LIKE_OBJECT_WRONG_TYPE", MQRCCF_LIKE_OBJECT_WRONG_TYPE},
+	{"MQRCCF_LISTENER_CONFLICT", MQRCCF_LISTENER_CONFLICT},
+	{"MQRCCF_LISTENER_NOT_STARTED", MQRCCF_LISTENER_NOT_STARTED},
+	{"MQRCCF_LISTENER_RUNNING", MQRCCF_LISTENER_RUNNING},
+	{"MQRCCF_LISTENER_STARTED", MQRCCF_LISTENER_STARTED},
+	{"MQRCCF_LISTENER_STILL_ACTIVE", MQRCCF_LISTENER_STILL_ACTIVE},
+	{"MQRCCF_LISTENER_STOPPED", MQRCCF_LISTENER_STOPPED},
+	{"MQRCCF_LOG_EXTENT_ERROR", MQRCCF_LOG_EXTENT_ERROR},
+	{"MQRCCF_LOG_EXTENT_NOT_FOUND", MQRCCF_LOG_EXTENT_NOT_FOUND},
+	{"MQRCCF_LOG_NOT_REDUCED", MQRCCF_LOG_NOT_REDUCED},
+	{"MQRCCF_LOG_TYPE_ERROR", MQRCCF_LOG_TYPE_ERROR},
+	{"MQRCCF_LONG_RETRY_ERROR", MQRCCF_LONG_RETRY_ERROR},
+	{"MQRCCF_LONG_RETRY_WRONG_TYPE", MQRCCF_LONG_RETRY_WRONG_TYPE},
+	{"MQRCCF_LONG_TIMER_ERROR", MQRCCF_LONG_TIMER_ERROR},
+	{"MQRCCF_LONG_TIMER_WRONG_TYPE", MQRCCF_LONG_TIMER_WRONG_TYPE},
+	{"MQRCCF_LSTR_STATUS_NOT_FOUND", MQRCCF_LSTR_STATUS_NOT_FOUND},
+	{"MQRCCF_MAX_INSTANCES_ERROR", MQRCCF_MAX_INSTANCES_ERROR},
+	{"MQRCCF_MAX_INSTS_PER_CLNT_ERR", MQRCCF_MAX_INSTS_PER_CLNT_ERR},
+	{"MQRCCF_MAX_MSG_LENGTH_ERROR", MQRCCF_MAX_MSG_LENGTH_ERROR},
+	{"MQRCCF_MCA_NAME_ERROR", MQRCCF_MCA_NAME_ERROR},
+	{"MQRCCF_MCA_NAME_WRONG_TYPE", MQRCCF_MCA_NAME_WRONG_TYPE},
+	{"MQRCCF_MCA_TYPE_ERROR", MQRCCF_MCA_TYPE_ERROR},
+	{"MQRCCF_MD_FORMAT_ERROR", MQRCCF_MD_FORMAT_ERROR},
+	{"MQRCCF_MISSING_CONN_NAME", MQRCCF_MISSING_CONN_NAME},
+	{"MQRCCF_MODE_VALUE_ERROR", MQRCCF_MODE_VALUE_ERROR},
+	{"MQRCCF_MQCONN_FAILED", MQRCCF_MQCONN_FAILED},
+	{"MQRCCF_MQGET_FAILED", MQRCCF_MQGET_FAILED},
+	{"MQRCCF_MQINQ_FAILED", MQRCCF_MQINQ_FAILED},
+	{"MQRCCF_MQOPEN_FAILED", MQRCCF_MQOPEN_FAILED},
+	{"MQRCCF_MQPUT_FAILED", MQRCCF_MQPUT_FAILED},
+	{"MQRCCF_MQSET_FAILED", MQRCCF_MQSET_FAILED},
+	{"MQRCCF_MR_COUNT_ERROR", MQRCCF_MR_COUNT_ERROR},
+	{"MQRCCF_MR_COUNT_WRONG_TYPE", MQRCCF_MR_COUNT_WRONG_TYPE},
+	{"MQRCCF_MR_EXIT_NAME_ERROR", MQRCCF_MR_EXIT_NAME_ERROR},
+	{"MQRCCF_MR_EXIT_NAME_WRONG_TYPE", MQRCCF_MR_EXIT_NAME_WRONG_TYPE},
+	{"MQRCCF_MR_INTERVAL_ERROR", MQRCCF_MR_INTERVAL_ERROR},
+	{"MQRCCF_MR_INTERVAL_WRONG_TYPE", MQRCCF_MR_INTERVAL_WRONG_TYPE},
+	{"MQRCCF_MSG_EXIT_NAME_ERROR", MQRCCF_MSG_EXIT_NAME_ERROR},
+	{"MQRCCF_MSG_LENGTH_ERROR", MQRCCF_MSG_LENGTH_ERROR},
+	{"MQRCCF_MSG_SEQ_NUMBER_ERROR", MQRCCF_MSG_SEQ_NUMBER_ERROR},
+	{"MQRCCF_MSG_TRUNCATED", MQRCCF_MSG_TRUNCATED},
+	{"MQRCCF_NAMELIST_ERROR", MQRCCF_NAMELIST_ERROR},
+	{"MQRCCF_NETBIOS_NAME_ERROR", MQRCCF_NETBIOS_NAME_ERROR},
+	{"MQRCCF_NET_PRIORITY_ERROR", MQRCCF_NET_PRIORITY_ERROR},
+	{"MQRCCF_NET_PRIORITY_WRONG_TYPE", MQRCCF_NET_PRIORITY_WRONG_TYPE},
+	{"MQRCCF_NHA_NOT_AVAILABLE", MQRCCF_NHA_NOT_AVAILABLE},
+	{"MQRCCF_NONE_FOUND", MQRCCF_NONE_FOUND},
+	{"MQRCCF_NOT_AUTHORIZED", MQRCCF_NOT_AUTHORIZED},
+	{"MQRCCF_NOT_REGISTERED", MQRCCF_NOT_REGISTERED},
+	{"MQRCCF_NOT_XMIT_Q", MQRCCF_NOT_XMIT_Q},
+	{"MQRCCF_NO_CHANNEL_INITIATOR", MQRCCF_NO_CHANNEL_INITIATOR},
+	{"MQRCCF_NO_COMMS_MANAGER", MQRCCF_NO_COMMS_MANAGER},
+	{"MQRCCF_NO_RETAINED_MSG", MQRCCF_NO_RETAINED_MSG},
+	{"MQRCCF_NO_START_CMD", MQRCCF_NO_START_CMD},
+	{"MQRCCF_NO_STOP_CMD", MQRCCF_NO_STOP_CMD},
+	{"MQRCCF_NO_STORAGE", MQRCCF_NO_STORAGE},
+	{"MQRCCF_NO_XCF_PARTNER", MQRCCF_NO_XCF_PARTNER},
+	{"MQRCCF_NPM_SPEED_ERROR", MQRCCF_NPM_SPEED_ERROR},
+	{"MQRCCF_NPM_SPEED_WRONG_TYPE", MQRCCF_NPM_SPEED_WRONG_TYPE},
+	{"MQRCCF_OBJECT_ALREADY_EXISTS", MQRCCF_OBJECT_ALREADY_EXISTS},
+	{"MQRCCF_OBJECT_BEING_DELETED", MQRCCF_OBJECT_BEING_DELETED},
+	{"MQRCCF_OBJECT_IN_USE", MQRCCF_OBJECT_IN_USE},
+	{"MQRCCF_OBJECT_LIMIT_EXCEEDED", MQRCCF_OBJECT_LIMIT_EXCEEDED},
+	{"MQRCCF_OBJECT_NAME_ERROR", MQRCCF_OBJECT_NAME_ERROR},
+	{"MQRCCF_OBJECT_NAME_RESTRICTED", MQRCCF_OBJECT_NAME_RESTRICTED},
+	{"MQRCCF_OBJECT_OPEN", MQRCCF_OBJECT_OPEN},
+	{"MQRCCF_OBJECT_OPEN_FORCE", MQRCCF_OBJECT_OPEN_FORCE},
+	{"MQRCCF_OBJECT_TYPE_MISSING", MQRCCF_OBJECT_TYPE_MISSING},
+	{"MQRCCF_OBJECT_WRONG_TYPE", MQRCCF_OBJECT_WRONG_TYPE},
+	{"MQRCCF_PARM_CONFLICT", MQRCCF_PARM_CONFLICT},
+	{"MQRCCF_PARM_COUNT_TOO_BIG", MQRCCF_PARM_COUNT_TOO_BIG},
+	{"MQRCCF_PARM_COUNT_TOO_SMALL", MQRCCF_PARM_COUNT_TOO_SMALL},
+	{"MQRCCF_PARM_MISSING", MQRCCF_PARM_MISSING},
+	{"MQRCCF_PARM_SEQUENCE_ERROR", MQRCCF_PARM_SEQUENCE_ERROR},
+	{"MQRCCF_PARM_SYNTAX_ERROR", MQRCCF_PARM_SYNTAX_ERROR},
+	{"MQRCCF_PARM_VALUE_ERROR", MQRCCF_PARM_VALUE_ERROR},
+	{"MQRCCF_PATH_NOT_VALID", MQRCCF_PATH_NOT_VALID},
+	{"MQRCCF_PING_DATA_COMPARE_ERROR", MQRCCF_PING_DATA_COMPARE_ERROR},
+	{"MQRCCF_PING_DATA_COUNT_ERROR", MQRCCF_PING_DATA_COUNT_ERROR},
+	{"MQRCCF_PING_ERROR", MQRCCF_PING_ERROR},
+	{"MQRCCF_POLICY_NAME_MISSING", MQRCCF_POLICY_NAME_MISSING},
+	{"MQRCCF_POLICY_NOT_FOUND", MQRCCF_POLICY_NOT_FOUND},
+	{"MQRCCF_POLICY_VERSION_ERROR", MQRCCF_POLICY_VERSION_ERROR},
+	{"MQRCCF_PORT_IN_USE", MQRCCF_PORT_IN_USE},
+	{"MQRCCF_PORT_NUMBER_ERROR", MQRCCF_PORT_NUMBER_ERROR},
+	{"MQRCCF_PROFILE_NAME_ERROR", MQRCCF_PROFILE_NAME_ERROR},
+	{"MQRCCF_PROFILE_NAME_MISSING", MQRCCF_PROFILE_NAME_MISSING},
+	{"MQRCCF_PROGRAM_AUTH_FAILED", MQRCCF_PROGRAM_AUTH_FAILED},
+	{"MQRCCF_PROGRAM_NOT_AVAILABLE", MQRCCF_PROGRAM_NOT_AVAILABLE},
+	{"MQRCCF_PSCLUS_DISABLED_TOPDEF", MQRCCF_PSCLUS_DISABLED_TOPDEF},
+	{"MQRCCF_PSCLUS_TOPIC_EXISTS", MQRCCF_PSCLUS_TOPIC_EXISTS},
+	{"MQRCCF_PS_REQUIRED_MQUC", MQRCCF_PS_REQUIRED_MQUC},
+	{"MQRCCF_PUBSUB_INHIBITED", MQRCCF_PUBSUB_INHIBITED},
+	{"MQRCCF_PUB_OPTIONS_ERROR", MQRCCF_PUB_OPTIONS_ERROR},
+	{"MQRCCF_PURGE_VALUE_ERROR", MQRCCF_PURGE_VALUE_ERROR},
+	{"MQRCCF_PUT_AUTH_ERROR", MQRCCF_PUT_AUTH_ERROR},
+	{"MQRCCF_PUT_AUTH_WRONG_TYPE", MQRCCF_PUT_AUTH_WRONG_TYPE},
+	{"MQRCCF_PWD_LENGTH_ERROR", MQRCCF_PWD_LENGTH_ERROR},
+	{"MQRCCF_QUEUES_VALUE_ERROR", MQRCCF_QUEUES_VALUE_ERROR},
+	{"MQRCCF_QUIESCE_VALUE_ERROR", MQRCCF_QUIESCE_VALUE_ERROR},
+	{"MQRCCF_Q_ALREADY_IN_CELL", MQRCCF_Q_ALREADY_IN_CELL},
+	{"MQRCCF_Q_ATTR_CONFLICT", MQRCCF_Q_ATTR_CONFLICT},
+	{"MQRCCF_Q_MGR_ATTR_CONFLICT", MQRCCF_Q_MGR_ATTR_CONFLICT},
+	{"MQRCCF_Q_MGR_CCSID_ERROR", MQRCCF_Q_MGR_CCSID_ERROR},
+	{"MQRCCF_Q_MGR_NAME_ERROR", MQRCCF_Q_MGR_NAME_ERROR},
+	{"MQRCCF_Q_MGR_NOT_IN_QSG", MQRCCF_Q_MGR_NOT_IN_QSG},
+	{"MQRCCF_Q_MGR_STATUS_NOT_FOUND", MQRCCF_Q_MGR_STATUS_NOT_FOUND},
+	{"MQRCCF_Q_NAME_ERROR", MQRCCF_Q_NAME_ERROR},
+	{"MQRCCF_Q_STATUS_NOT_FOUND", MQRCCF_Q_STATUS_NOT_FOUND},
+	{"MQRCCF_Q_TYPE_ERROR", MQRCCF_Q_TYPE_ERROR},
+	{"MQRCCF_Q_WRONG_TYPE", MQRCCF_Q_WRONG_TYPE},
+	{"MQRCCF_RCV_EXIT_NAME_ERROR", MQRCCF_RCV_EXIT_NAME_ERROR},
+	{"MQRCCF_RECEIVED_DATA_ERROR", MQRCCF_RECEIVED_DATA_ERROR},
+	{"MQRCCF_RECEIVE_FAILED", MQRCCF_RECEIVE_FAILED},
+	{"MQRCCF_RECIPIENT_DN_MISSING", MQRCCF_RECIPIENT_DN_MISSING},
+	{"MQRCCF_REG_OPTIONS_ERROR", MQRCCF_REG_OPTIONS_ERROR},
+	{"MQRCCF_REMOTE_CHL_TYPE_ERROR", MQRCCF_REMOTE_CHL_TYPE_ERROR},
+	{"MQRCCF_REMOTE_QM_TERMINATING", MQRCCF_REMOTE_QM_TERMINATING},
+	{"MQRCCF_REMOTE_QM_UNAVAILABLE", MQRCCF_REMOTE_QM_UNAVAILABLE},
+	{"MQRCCF_REMOTE_Q_NAME_ERROR", MQRCCF_REMOTE_Q_NAME_ERROR},
+	{"MQRCCF_REPLACE_VALUE_ERROR", MQRCCF_REPLACE_VALUE_ERROR},
+	{"MQRCCF_REPOS_NAME_CONFLICT", MQRCCF_REPOS_NAME_CONFLICT},
+	{"MQRCCF_REPOS_VALUE_ERROR", MQRCCF_REPOS_VALUE_ERROR},
+	{"MQRCCF_RETAINED_NOT_SUPPORTED", MQRCCF_RETAINED_NOT_SUPPORTED},
+	{"MQRCCF_REVDNS_DISABLED", MQRCCF_REVDNS_DISABLED},
+	{"MQRCCF_SECURITY_CASE_CONFLICT", MQRCCF_SECURITY_CASE_CONFLICT},
+	{"MQRCCF_SECURITY_REFRESH_FAILED", MQRCCF_SECURITY_REFRESH_FAILED},
+	{"MQRCCF_SECURITY_SWITCH_OFF", MQRCCF_SECURITY_SWITCH_OFF},
+	{"MQRCCF_SEC_EXIT_NAME_ERROR", MQRCCF_SEC_EXIT_NAME_ERROR},
+	{"MQRCCF_SEND_EXIT_NAME_ERROR", MQRCCF_SEND_EXIT_NAME_ERROR},
+	{"MQRCCF_SEND_FAILED", MQRCCF_SEND_FAILED},
+	{"MQRCCF_SEQ_NUMBER_WRAP_ERROR", MQRCCF_SEQ_NUMBER_WRAP_ERROR},
+	{"MQRCCF_SERVICE_NAME_ERROR", MQRCCF_SERVICE_NAME_ERROR},
+	{"MQRCCF_SERVICE_REQUEST_PENDING", MQRCCF_SERVICE_REQUEST_PENDING},
+	{"MQRCCF_SERVICE_RUNNING", MQRCCF_SERVICE_RUNNING},
+	{"MQRCCF_SERVICE_STOPPED", MQRCCF_SERVICE_STOPPED},
+	{"MQRCCF_SERV_STATUS_NOT_FOUND", MQRCCF_SERV_STATUS_NOT_FOUND},
+	{"MQRCCF_SHARING_CONVS_ERROR", MQRCCF_SHARING_CONVS_ERROR},
+	{"MQRCCF_SHARING_CONVS_TYPE", MQRCCF_SHARING_CONVS_TYPE},
+	{"MQRCCF_SHORT_RETRY_ERROR", MQRCCF_SHORT_RETRY_ERROR},
+	{"MQRCCF_SHORT_RETRY_WRONG_TYPE", MQRCCF_SHORT_RETRY_WRONG_TYPE},
+	{"MQRCCF_SHORT_TIMER_ERROR", MQRCCF_SHORT_TIMER_ERROR},
+	{"MQRCCF_SHORT_TIMER_WRONG_TYPE", MQRCCF_SHORT_TIMER_WRONG_TYPE},
+	{"MQRCCF_SIGNATURE_ALG_ERROR", MQRCCF_SIGNATURE_ALG_ERROR},
+	{"MQRCCF_SMDS_REQUIRES_DSGROUP", MQRCCF_SMDS_REQUIRES_DSGROUP},
+	{"MQRCCF_SOCKET_ERROR", MQRCCF_SOCKET_ERROR},
+	{"MQRCCF_SSL_ALT_PROVIDER_REQD", MQRCCF_SSL_ALT_PROVIDER_REQD},
+	{"MQRCCF_SSL_CIPHER_SPEC_ERROR", MQRCCF_SSL_CIPHER_SPEC_ERROR},
+	{"MQRCCF_SSL_CIPHER_SUITE_ERROR", MQRCCF_SSL_CIPHER_SUITE_ERROR},
+	{"MQRCCF_SSL_CLIENT_AUTH_ERROR", MQRCCF_SSL_CLIENT_AUTH_ERROR},
+	{"MQRCCF_SSL_PEER_NAME_ERROR", MQRCCF_SSL_PEER_NAME_ERROR},
+	{"MQRCCF_STORAGE_CLASS_IN_USE", MQRCCF_STORAGE_CLASS_IN_USE},
+	{"MQRCCF_STREAMQ_CONFLICT", MQRCCF_STREAMQ_CONFLICT},
+	{"MQRCCF_STREAMQ_DEST_CONFLICT", MQRCCF_STREAMQ_DEST_CONFLICT},
+	{"MQRCCF_STREAMQ_DEST_NOT_SUPP", MQRCCF_STREAMQ_DEST_NOT_SUPP},
+	{"MQRCCF_STREAMQ_NOT_SUPPORTED", MQRCCF_STREAMQ_NOT_SUPPORTED},
+	{"MQRCCF_STREAM_ERROR", MQRCCF_STREAM_ERROR},
+	{"MQRCCF_STRUCTURE_TYPE_ERROR", MQRCCF_STRUCTURE_TYPE_ERROR},
+	{"MQRCCF_SUBSCRIPTION_IN_USE", MQRCCF_SUBSCRIPTION_IN_USE},
+	{"MQRCCF_SUBSCRIPTION_LOCKED", MQRCCF_SUBSCRIPTION_LOCKED},
+	{"MQRCCF_SUBSCRIPTION_POINT_ERR", MQRCCF_SUBSCRIPTION_POINT_ERR},
+	{"MQRCCF_SUB_ALREADY_EXISTS", MQRCCF_SUB_ALREADY_EXISTS},
+	{"MQRCCF_SUB_IDENTITY_ERROR", MQRCCF_SUB_IDENTITY_ERROR},
+	{"MQRCCF_SUB_NAME_ERROR", MQRCCF_SUB_NAME_ERROR},
+	{"MQRCCF_SUITE_B_ERROR", MQRCCF_SUITE_B_ERROR},
+	{"MQRCCF_SUPPRESSED_BY_EXIT", MQRCCF_SUPPRESSED_BY_EXIT},
+	{"MQRCCF_TERMINATED_BY_SEC_EXIT", MQRCCF_TERMINATED_BY_SEC_EXIT},
+	{"MQRCCF_TOLERATION_POL_ERROR", MQRCCF_TOLERATION_POL_ERROR},
+	{"MQRCCF_TOO_MANY_FILTERS", MQRCCF_TOO_MANY_FILTERS},
+	{"MQRCCF_TOPICSTR_ALREADY_EXISTS", MQRCCF_TOPICSTR_ALREADY_EXISTS},
+	{"MQRCCF_TOPIC_ERROR", MQRCCF_TOPIC_ERROR},
+	{"MQRCCF_TOPIC_RESTRICTED", MQRCCF_TOPIC_RESTRICTED},
+	{"MQRCCF_TOPIC_STRING_NOT_FOUND", MQRCCF_TOPIC_STRING_NOT_FOUND},
+	{"MQRCCF_TOPIC_TYPE_ERROR", MQRCCF_TOPIC_TYPE_ERROR},
+	{"MQRCCF_UNEXPECTED_ERROR", MQRCCF_UNEXPECTED_ERROR},
+	{"MQRCCF_UNKNOWN_BROKER", MQRCCF_UNKNOWN_BROKER},
+	{"MQRCCF_UNKNOWN_FILE_NAME", MQRCCF_UNKNOWN_FILE_NAME},
+	{"MQRCCF_UNKNOWN_OBJECT_NAME", MQRCCF_UNKNOWN_OBJECT_NAME},
+	{"MQRCCF_UNKNOWN_Q_MGR", MQRCCF_UNKNOWN_Q_MGR},
+	{"MQRCCF_UNKNOWN_REMOTE_CHANNEL", MQRCCF_UNKNOWN_REMOTE_CHANNEL},
+	{"MQRCCF_UNKNOWN_STREAM", MQRCCF_UNKNOWN_STREAM},
+	{"MQRCCF_UNKNOWN_USER_ID", MQRCCF_UNKNOWN_USER_ID},
+	{"MQRCCF_USER_EXIT_NOT_AVAILABLE", MQRCCF_USER_EXIT_NOT_AVAILABLE},
+	{"MQRCCF_USE_CLIENT_ID_ERROR", MQRCCF_USE_CLIENT_ID_ERROR},
+	{"MQRCCF_WRONG_CHANNEL_TYPE", MQRCCF_WRONG_CHANNEL_TYPE},
+	{"MQRCCF_WRONG_CHLAUTH_ACTION", MQRCCF_WRONG_CHLAUTH_ACTION},
+	{"MQRCCF_WRONG_CHLAUTH_MATCH", MQRCCF_WRONG_CHLAUTH_MATCH},
+	{"MQRCCF_WRONG_CHLAUTH_TYPE", MQRCCF_WRONG_CHLAUTH_TYPE},
+	{"MQRCCF_WRONG_CHLAUTH_USERSRC", MQRCCF_WRONG_CHLAUTH_USERSRC},
+	{"MQRCCF_WRONG_USER", MQRCCF_WRONG_USER},
+	{"MQRCCF_XMIT_PROTOCOL_TYPE_ERR", MQRCCF_XMIT_PROTOCOL_TYPE_ERR},
+	{"MQRCCF_XMIT_Q_NAME_ERROR", MQRCCF_XMIT_Q_NAME_ERROR},
+	{"MQRCCF_XMIT_Q_NAME_WRONG_TYPE", MQRCCF_XMIT_Q_NAME_WRONG_TYPE},
+	{"MQRCN_DISABLED", MQRCN_DISABLED},
+	{"MQRCN_NO", MQRCN_NO},
+	{"MQRCN_Q_MGR", MQRCN_Q_MGR},
+	{"MQRCN_YES", MQRCN_YES},
+	{"MQRCVTIME_ADD", MQRCVTIME_ADD},
+	{"MQRCVTIME_EQUAL", MQRCVTIME_EQUAL},
+	{"MQRCVTIME_MULTIPLY", MQRCVTIME_MULTIPLY},
+	{"MQRC_ACTION_ERROR", MQRC_ACTION_ERROR},
+	{"MQRC_ADAPTER_CONN_LOAD_ERROR", MQRC_ADAPTER_CONN_LOAD_ERROR},
+	{"MQRC_ADAPTER_CONV_LOAD_ERROR", MQRC_ADAPTER_CONV_LOAD_ERROR},
+	{"MQRC_ADAPTER_DEFS_ERROR", MQRC_ADAPTER_DEFS_ERROR},
+	{"MQRC_ADAPTER_DEFS_LOAD_ERROR", MQRC_ADAPTER_DEFS_LOAD_ERROR},
+	{"MQRC_ADAPTER_DISC_LOAD_ERROR", MQRC_ADAPTER_DISC_LOAD_ERROR},
+	{"MQRC_ADAPTER_NOT_AVAILABLE", MQRC_ADAPTER_NOT_AVAILABLE},
+	{"MQRC_ADAPTER_SERV_LOAD_ERROR", MQRC_ADAPTER_SERV_LOAD_ERROR},
+	{"MQRC_ADAPTER_STORAGE_SHORTAGE", MQRC_ADAPTER_STORAGE_SHORTAGE},
+	{"MQRC_ADMIN_TOPIC_STRING_ERROR", MQRC_ADMIN_TOPIC_STRING_ERROR},
+	{"MQRC_AIR_ERROR", MQRC_AIR_ERROR},
+	{"MQRC_ALIAS_BASE_Q_TYPE_ERROR", MQRC_ALIAS_BASE_Q_TYPE_ERROR},
+	{"MQRC_ALIAS_TARGTYPE_CHANGED", MQRC_ALIAS_TARGTYPE_CHANGED},
+	{"MQRC_ALREADY_CONNECTED", MQRC_ALREADY_CONNECTED},
+	{"MQRC_ALREADY_JOINED", MQRC_ALREADY_JOINED},
+	{"MQRC_ALTER_SUB_ERROR", MQRC_ALTER_SUB_ERROR},
+	{"MQRC_AMQP_NOT_AVAILABLE", MQRC_AMQP_NOT_AVAILABLE},
+	{"MQRC_ANOTHER_Q_MGR_CONNECTED", MQRC_ANOTHER_Q_MGR_CONNECTED},
+	{"MQRC_API_EXIT_ERROR", MQRC_API_EXIT_ERROR},
+	{"MQRC_API_EXIT_INIT_ERROR", MQRC_API_EXIT_INIT_ERROR},
+	{"MQRC_API_EXIT_LOAD_ERROR", MQRC_API_EXIT_LOAD_ERROR},
+	{"MQRC_API_EXIT_NOT_FOUND", MQRC_API_EXIT_NOT_FOUND},
+	{"MQRC_API_EXIT_TERM_ERROR", MQRC_API_EXIT_TERM_ERROR},
+	{"MQRC_APPL_FIRST", MQRC_APPL_FIRST},
+	{"MQRC_APPL_LAST", MQRC_APPL_LAST},
+	{"MQRC_ASID_MISMATCH", MQRC_ASID_MISMATCH},
+	{"MQRC_ASYNC_UOW_CONFLICT", MQRC_ASYNC_UOW_CONFLICT},
+	{"MQRC_ASYNC_XA_CONFLICT", MQRC_ASYNC_XA_CONFLICT},
+	{"MQRC_ATTRIBUTE_LOCKED", MQRC_ATTRIBUTE_LOCKED},
+	{"MQRC_AUTH_INFO_CONN_NAME_ERROR", MQRC_AUTH_INFO_CONN_NAME_ERROR},
+	{"MQRC_AUTH_INFO_REC_COUNT_ERROR", MQRC_AUTH_INFO_REC_COUNT_ERROR},
+	{"MQRC_AUTH_INFO_REC_ERROR", MQRC_AUTH_INFO_REC_ERROR},
+	{"MQRC_AUTH_INFO_TYPE_ERROR", MQRC_AUTH_INFO_TYPE_ERROR},
+	{"MQRC_BACKED_OUT", MQRC_BACKED_OUT},
+	{"MQRC_BACKOUT_THRESHOLD_REACHED", MQRC_BACKOUT_THRESHOLD_REACHED},
+	{"MQRC_BAG_CONVERSION_ERROR", MQRC_BAG_CONVERSION_ERROR},
+	{"MQRC_BAG_WRONG_TYPE", MQRC_BAG_WRONG_TYPE},
+	{"MQRC_BINARY_DATA_LENGTH_ERROR", MQRC_BINARY_DATA_LENGTH_ERROR},
+	{"MQRC_BMHO_ERROR", MQRC_BMHO_ERROR},
+	{"MQRC_BNO_ERROR", MQRC_BNO_ERROR},
+	{"MQRC_BO_ERROR", MQRC_BO_ERROR},
+	{"MQRC_BRIDGE_STARTED", MQRC_BRIDGE_STARTED},
+	{"MQRC_BRIDGE_STOPPED", MQRC_BRIDGE_STOPPED},
+	{"MQRC_BUFFER_ERROR", MQRC_BUFFER_ERROR},
+	{"MQRC_BUFFER_LENGTH_ERROR", MQRC_BUFFER_LENGTH_ERROR},
+	{"MQRC_BUFFER_NOT_AUTOMATIC", MQRC_BUFFER_NOT_AUTOMATIC},
+	{"MQRC_CALLBACK_LINK_ERROR", MQRC_CALLBACK_LINK_ERROR},
+	{"MQRC_CALLBACK_NOT_REGISTERED", MQRC_CALLBACK_NOT_REGISTERED},
+	{"MQRC_CALLBACK_ROUTINE_ERROR", MQRC_CALLBACK_ROUTINE_ERROR},
+	{"MQRC_CALLBACK_TYPE_ERROR", MQRC_CALLBACK_TYPE_ERROR},
+	{"MQRC_CALL_INTERRUPTED", MQRC_CALL_INTERRUPTED},
+	{"MQRC_CALL_IN_PROGRESS", MQRC_CALL_IN_PROGRESS},
+	{"MQRC_CBD_ERROR", MQRC_CBD_ERROR},
+	{"MQRC_CBD_OPTIONS_ERROR", MQRC_CBD_OPTIONS_ERROR},
+	{"MQRC_CCDT_URL_ERROR", MQRC_CCDT_URL_ERROR},
+	{"MQRC_CD_ARRAY_ERROR", MQRC_CD_ARRAY_ERROR},
+	{"MQRC_CD_ERROR", MQRC_CD_ERROR},
+	{"MQRC_CERT_LABEL_NOT_ALLOWED", MQRC_CERT_LABEL_NOT_ALLOWED},
+	{"MQRC_CERT_VAL_POLICY_ERROR", MQRC_CERT_VAL_POLICY_ERROR},
+	{"MQRC_CFBF_ERROR", MQRC_CFBF_ERROR},
+	{"MQRC_CFBS_ERROR", MQRC_CFBS_ERROR},
+	{"MQRC_CFGR_ERROR", MQRC_CFGR_ERROR},
+	{"MQRC_CFH_ERROR", MQRC_CFH_ERROR},
+	{"MQRC_CFIF_ERROR", MQRC_CFIF_ERROR},
+	{"MQRC_CFIL_ERROR", MQRC_CFIL_ERROR},
+	{"MQRC_CFIN_ERROR", MQRC_CFIN_ERROR},
+	{"MQRC_CFSF_ERROR", MQRC_CFSF_ERROR},
+	{"MQRC_CFSL_ERROR", MQRC_CFSL_ERROR},
+	{"MQRC_CFST_ERROR", MQRC_CFST_ERROR},
+	{"MQRC_CF_NOT_AVAILABLE", MQRC_CF_NOT_AVAILABLE},
+	{"MQRC_CF_STRUC_AUTH_FAILED", MQRC_CF_STRUC_AUTH_FAILED},
+	{"MQRC_CF_STRUC_ERROR", MQRC_CF_STRUC_ERROR},
+	{"MQRC_CF_STRUC_FAILED", MQRC_CF_STRUC_FAILED},
+	{"MQRC_CF_STRUC_IN_USE", MQRC_CF_STRUC_IN_USE},
+	{"MQRC_CF_STRUC_LIST_HDR_IN_USE", MQRC_CF_STRUC_LIST_HDR_IN_USE},
+	{"MQRC_CHANNEL_ACTIVATED", MQRC_CHANNEL_ACTIVATED},
+	{"MQRC_CHANNEL_AUTO_DEF_ERROR", MQRC_CHANNEL_AUTO_DEF_ERROR},
+	{"MQRC_CHANNEL_AUTO_DEF_OK", MQRC_CHANNEL_AUTO_DEF_OK},
+	{"MQRC_CHANNEL_BLOCKED", MQRC_CHANNEL_BLOCKED},
+	{"MQRC_CHANNEL_BLOCKED_WARNING", MQRC_CHANNEL_BLOCKED_WARNING},
+	{"MQRC_CHANNEL_CONFIG_ERROR", MQRC_CHANNEL_CONFIG_ERROR},
+	{"MQRC_CHANNEL_CONV_ERROR", MQRC_CHANNEL_CONV_ERROR},
+	{"MQRC_CHANNEL_NOT_ACTIVATED", MQRC_CHANNEL_NOT_ACTIVATED},
+	{"MQRC_CHANNEL_NOT_AVAILABLE", MQRC_CHANNEL_NOT_AVAILABLE},
+	{"MQRC_CHANNEL_SSL_ERROR", MQRC_CHANNEL_SSL_ERROR},
+	{"MQRC_CHANNEL_SSL_WARNING", MQRC_CHANNEL_SSL_WARNING},
+	{"MQRC_CHANNEL_STARTED", MQRC_CHANNEL_STARTED},
+	{"MQRC_CHANNEL_STOPPED", MQRC_CHANNEL_STOPPED},
+	{"MQRC_CHANNEL_STOPPED_BY_USER", MQRC_CHANNEL_STOPPED_BY_USER},
+	{"MQRC_CHAR_ATTRS_ERROR", MQRC_CHAR_ATTRS_ERROR},
+	{"MQRC_CHAR_ATTRS_TOO_SHORT", MQRC_CHAR_ATTRS_TOO_SHORT},
+	{"MQRC_CHAR_ATTR_LENGTH_ERROR", MQRC_CHAR_ATTR_LENGTH_ERROR},
+	{"MQRC_CHAR_CONVERSION_ERROR", MQRC_CHAR_CONVERSION_ERROR},
+	{"MQRC_CICS_BRIDGE_RESTRICTION", MQRC_CICS_BRIDGE_RESTRICTION},
+	{"MQRC_CICS_WAIT_FAILED", MQRC_CICS_WAIT_FAILED},
+	{"MQRC_CIPHER_SPEC_NOT_SUITE_B", MQRC_CIPHER_SPEC_NOT_SUITE_B},
+	{"MQRC_CLIENT_CHANNEL_CONFLICT", MQRC_CLIENT_CHANNEL_CONFLICT},
+	{"MQRC_CLIENT_CONN_ERROR", MQRC_CLIENT_CONN_ERROR},
+	{"MQRC_CLIENT_EXIT_ERROR", MQRC_CLIENT_EXIT_ERROR},
+	{"MQRC_CLIENT_EXIT_LOAD_ERROR", MQRC_CLIENT_EXIT_LOAD_ERROR},
+	{"MQRC_CLUSTER_EXIT_ERROR", MQRC_CLUSTER_EXIT_ERROR},
+	{"MQRC_CLUSTER_EXIT_LOAD_ERROR", MQRC_CLUSTER_EXIT_LOAD_ERROR},
+	{"MQRC_CLUSTER_PUT_INHIBITED", MQRC_CLUSTER_PUT_INHIBITED},
+	{"MQRC_CLUSTER_RESOLUTION_ERROR", MQRC_CLUSTER_RESOLUTION_ERROR},
+	{"MQRC_CLUSTER_RESOURCE_ERROR", MQRC_CLUSTER_RESOURCE_ERROR},
+	{"MQRC_CMD_SERVER_NOT_AVAILABLE", MQRC_CMD_SERVER_NOT_AVAILABLE},
+	{"MQRC_CMHO_ERROR", MQRC_CMHO_ERROR},
+	{"MQRC_CNO_ERROR", MQRC_CNO_ERROR},
+	{"MQRC_CODED_CHAR_SET_ID_ERROR", MQRC_CODED_CHAR_SET_ID_ERROR},
+	{"MQRC_COD_NOT_VALID_FOR_XCF_Q", MQRC_COD_NOT_VALID_FOR_XCF_Q},
+	{"MQRC_COMMAND_MQSC", MQRC_COMMAND_MQSC},
+	{"MQRC_COMMAND_PCF", MQRC_COMMAND_PCF},
+	{"MQRC_COMMAND_TYPE_ERROR", MQRC_COMMAND_TYPE_ERROR},
+	{"MQRC_COMMINFO_ERROR", MQRC_COMMINFO_ERROR},
+	{"MQRC_CONFIG_CHANGE_OBJECT", MQRC_CONFIG_CHANGE_OBJECT},
+	{"MQRC_CONFIG_CREATE_OBJECT", MQRC_CONFIG_CREATE_OBJECT},
+	{"MQRC_CONFIG_DELETE_OBJECT", MQRC_CONFIG_DELETE_OBJECT},
+	{"MQRC_CONFIG_REFRESH_OBJECT", MQRC_CONFIG_REFRESH_OBJECT},
+	{"MQRC_CONNECTION_BROKEN", MQRC_CONNECTION_BROKEN},
+	{"MQRC_CONNECTION_ERROR", MQRC_CONNECTION_ERROR},
+	{"MQRC_CONNECTION_NOT_AUTHORIZED", MQRC_CONNECTION_NOT_AUTHORIZED},
+	{"MQRC_CONNECTION_NOT_AVAILABLE", MQRC_CONNECTION_NOT_AVAILABLE},
+	{"MQRC_CONNECTION_QUIESCING", MQRC_CONNECTION_QUIESCING},
+	{"MQRC_CONNECTION_STOPPED", MQRC_CONNECTION_STOPPED},
+	{"MQRC_CONNECTION_STOPPING", MQRC_CONNECTION_STOPPING},
+	{"MQRC_CONNECTION_SUSPENDED", MQRC_CONNECTION_SUSPENDED},
+	{"MQRC_CONN_ID_IN_USE", MQRC_CONN_ID_IN_USE},
+	{"MQRC_CONN_TAG_IN_USE", MQRC_CONN_TAG_IN_USE},
+	{"MQRC_CONN_TAG_NOT_RELEASED", MQRC_CONN_TAG_NOT_RELEASED},
+	{"MQRC_CONN_TAG_NOT_USABLE", MQRC_CONN_TAG_NOT_USABLE},
+	{"MQRC_CONTENT_ERROR", MQRC_CONTENT_ERROR},
+	{"MQRC_CONTEXT_HANDLE_ERROR", MQRC_CONTEXT_HANDLE_ERROR},
+	{"MQRC_CONTEXT_NOT_AVAILABLE", MQRC_CONTEXT_NOT_AVAILABLE},
+	{"MQRC_CONTEXT_OBJECT_NOT_VALID", MQRC_CONTEXT_OBJECT_NOT_VALID},
+	{"MQRC_CONTEXT_OPEN_ERROR", MQRC_CONTEXT_OPEN_ERROR},
+	{"MQRC_CONVERTED_MSG_TOO_BIG", MQRC_CONVERTED_MSG_TOO_BIG},
+	{"MQRC_CONVERTED_STRING_TOO_BIG", MQRC_CONVERTED_STRING_TOO_BIG},
+	{"MQRC_CORREL_ID_ERROR", MQRC_CORREL_ID_ERROR},
+	{"MQRC_CRYPTO_HARDWARE_ERROR", MQRC_CRYPTO_HARDWARE_ERROR},
+	{"MQRC_CSP_ERROR", MQRC_CSP_ERROR},
+	{"MQRC_CTLO_ERROR", MQRC_CTLO_ERROR},
+	{"MQRC_CURRENT_RECORD_ERROR", MQRC_CURRENT_RECORD_ERROR},
+	{"MQRC_CURSOR_NOT_VALID", MQRC_CURSOR_NOT_VALID},
+	{"MQRC_DATA_LENGTH_ERROR", MQRC_DATA_LENGTH_ERROR},
+	{"MQRC_DATA_SET_NOT_AVAILABLE", MQRC_DATA_SET_NOT_AVAILABLE},
+	{"MQRC_DATA_TRUNCATED", MQRC_DATA_TRUNCATED},
+	{"MQRC_DB2_NOT_AVAILABLE", MQRC_DB2_NOT_AVAILABLE},
+	{"MQRC_DBCS_ERROR", MQRC_DBCS_ERROR},
+	{"MQRC_DEF_SYNCPOINT_INHIBITED", MQRC_DEF_SYNCPOINT_INHIBITED},
+	{"MQRC_DEF_XMIT_Q_TYPE_ERROR", MQRC_DEF_XMIT_Q_TYPE_ERROR},
+	{"MQRC_DEF_XMIT_Q_USAGE_ERROR", MQRC_DEF_XMIT_Q_USAGE_ERROR},
+	{"MQRC_DEST_CLASS_NOT_ALTERABLE", MQRC_DEST_CLASS_NOT_ALTERABLE},
+	{"MQRC_DEST_ENV_ERROR", MQRC_DEST_ENV_ERROR},
+	{"MQRC_DEST_NAME_ERROR", MQRC_DEST_NAME_ERROR},
+	{"MQRC_DH_ERROR", MQRC_DH_ERROR},
+	{"MQRC_DISTRIBUTION_LIST_EMPTY", MQRC_DISTRIBUTION_LIST_EMPTY},
+	{"MQRC_DLH_ERROR", MQRC_DLH_ERROR},
+	{"MQRC_DMHO_ERROR", MQRC_DMHO_ERROR},
+	{"MQRC_DMPO_ERROR", MQRC_DMPO_ERROR},
+	{"MQRC_DUPLICATE_GROUP_SUB", MQRC_DUPLICATE_GROUP_SUB},
+	{"MQRC_DUPLICATE_RECOV_COORD", MQRC_DUPLICATE_RECOV_COORD},
+	{"MQRC_DURABILITY_NOT_ALLOWED", MQRC_DURABILITY_NOT_ALLOWED},
+	{"MQRC_DURABILITY_NOT_ALTERABLE", MQRC_DURABILITY_NOT_ALTERABLE},
+	{"MQRC_DYNAMIC_Q_NAME_ERROR", MQRC_DYNAMIC_Q_NAME_ERROR},
+	{"MQRC_ENCODING_ERROR", MQRC_ENCODING_ERROR},
+	{"MQRC_ENCODING_NOT_SUPPORTED", MQRC_ENCODING_NOT_SUPPORTED},
+	{"MQRC_ENVIRONMENT_ERROR", MQRC_ENVIRONMENT_ERROR},
+	{"MQRC_EPH_ERROR", MQRC_EPH_ERROR},
+	{"MQRC_EXIT_PROPS_NOT_SUPPORTED", MQRC_EXIT_PROPS_NOT_SUPPORTED},
+	{"MQRC_EXIT_REASON_ERROR", MQRC_EXIT_REASON_ERROR},
+	{"MQRC_EXPIRY_ERROR", MQRC_EXPIRY_ERROR},
+	{"MQRC_FASTPATH_NOT_AVAILABLE", MQRC_FASTPATH_NOT_AVAILABLE},
+	{"MQRC_FEEDBACK_ERROR", MQRC_FEEDBACK_ERROR},
+	{"MQRC_FILE_NOT_AUDITED", MQRC_FILE_NOT_AUDITED},
+	{"MQRC_FILE_SYSTEM_ERROR", MQRC_FILE_SYSTEM_ERROR},
+	{"MQRC_FILTER_OPERATOR_ERROR", MQRC_FILTER_OPERATOR_ERROR},
+	{"MQRC_FORMAT_ERROR", MQRC_FORMAT_ERROR},
+	{"MQRC_FORMAT_NOT_SUPPORTED", MQRC_FORMAT_NOT_SUPPORTED},
+	{"MQRC_FUNCTION_ERROR", MQRC_FUNCTION_ERROR},
+	{"MQRC_FUNCTION_NOT_SUPPORTED", MQRC_FUNCTION_NOT_SUPPORTED},
+	{"MQRC_GET_ENABLED", MQRC_GET_ENABLED},
+	{"MQRC_GET_INHIBITED", MQRC_GET_INHIBITED},
+	{"MQRC_GLOBAL_UOW_CONFLICT", MQRC_GLOBAL_UOW_CONFLICT},
+	{"MQRC_GMO_ERROR", MQRC_GMO_ERROR},
+	{"MQRC_GROUPING_NOT_ALLOWED", MQRC_GROUPING_NOT_ALLOWED},
+	{"MQRC_GROUPING_NOT_ALTERABLE", MQRC_GROUPING_NOT_ALTERABLE},
+	{"MQRC_GROUP_ADDRESS_ERROR", MQRC_GROUP_ADDRESS_ERROR},
+	{"MQRC_GROUP_ID_ERROR", MQRC_GROUP_ID_ERROR},
+	{"MQRC_HANDLE_IN_USE_FOR_UOW", MQRC_HANDLE_IN_USE_FOR_UOW},
+	{"MQRC_HANDLE_NOT_AVAILABLE", MQRC_HANDLE_NOT_AVAILABLE},
+	{"MQRC_HBAG_ERROR", MQRC_HBAG_ERROR},
+	{"MQRC_HCONFIG_ERROR", MQRC_HCONFIG_ERROR},
+	{"MQRC_HCONN_ASYNC_ACTIVE", MQRC_HCONN_ASYNC_ACTIVE},
+	{"MQRC_HCONN_ERROR", MQRC_HCONN_ERROR},
+	{"MQRC_HEADER_ERROR", MQRC_HEADER_ERROR},
+	{"MQRC_HMSG_ERROR", MQRC_HMSG_ERROR},
+	{"MQRC_HMSG_NOT_AVAILABLE", MQRC_HMSG_NOT_AVAILABLE},
+	{"MQRC_HOBJ_ERROR", MQRC_HOBJ_ERROR},
+	{"MQRC_HOBJ_QUIESCED", MQRC_HOBJ_QUIESCED},
+	{"MQRC_HOBJ_QUIESCED_NO_MSGS", MQRC_HOBJ_QUIESCED_NO_MSGS},
+	{"MQRC_HOST_NOT_AVAILABLE", MQRC_HOST_NOT_AVAILABLE},
+	{"MQRC_HTTPS_KEYSTORE_ERROR", MQRC_HTTPS_KEYSTORE_ERROR},
+	{"MQRC_IDENTITY_MISMATCH", MQRC_IDENTITY_MISMATCH},
+	{"MQRC_IIH_ERROR", MQRC_IIH_ERROR},
+	{"MQRC_IMPO_ERROR", MQRC_IMPO_ERROR},
+	{"MQRC_INCOMPLETE_GROUP", MQRC_INCOMPLETE_GROUP},
+	{"MQRC_INCOMPLETE_MSG", MQRC_INCOMPLETE_MSG},
+	{"MQRC_INCOMPLETE_TRANSACTION", MQRC_INCOMPLETE_TRANSACTION},
+	{"MQRC_INCONSISTENT_BROWSE", MQRC_INCONSISTENT_BROWSE},
+	{"MQRC_INCONSISTENT_CCSIDS", MQRC_INCONSISTENT_CCSIDS},
+	{"MQRC_INCONSISTENT_ENCODINGS", MQRC_INCONSISTENT_ENCODINGS},
+	{"MQRC_INCONSISTENT_FORMAT", MQRC_INCONSISTENT_FORMAT},
+	{"MQRC_INCONSISTENT_ITEM_TYPE", MQRC_INCONSISTENT_ITEM_TYPE},
+	{"MQRC_INCONSISTENT_OBJECT_STATE", MQRC_INCONSISTENT_OBJECT_STATE},
+	{"MQRC_INCONSISTENT_OPEN_OPTIONS", MQRC_INCONSISTENT_OPEN_OPTIONS},
+	{"MQRC_INCONSISTENT_PERSISTENCE", MQRC_INCONSISTENT_PERSISTENCE},
+	{"MQRC_INCONSISTENT_UOW", MQRC_INCONSISTENT_UOW},
+	{"MQRC_INDEX_ERROR", MQRC_INDEX_ERROR},
+	{"MQRC_INDEX_NOT_PRESENT", MQRC_INDEX_NOT_PRESENT},
+	{"MQRC_INHIBIT_VALUE_ERROR", MQRC_INHIBIT_VALUE_ERROR},
+	{"MQRC_INITIALIZATION_FAILED", MQRC_INITIALIZATION_FAILED},
+	{"MQRC_INQUIRY_COMMAND_ERROR", MQRC_INQUIRY_COMMAND_ERROR},
+	{"MQRC_INSTALLATION_MISMATCH", MQRC_INSTALLATION_MISMATCH},
+	{"MQRC_INSTALLATION_MISSING", MQRC_INSTALLATION_MISSING},
+	{"MQRC_INSUFFICIENT_BUFFER", MQRC_INSUFFICIENT_BUFFER},
+	{"MQRC_INSUFFICIENT_DATA", MQRC_INSUFFICIENT_DATA},
+	{"MQRC_INT_ATTRS_ARRAY_ERROR", MQRC_INT_ATTRS_ARRAY_ERROR},
+	{"MQRC_INT_ATTR_COUNT_ERROR", MQRC_INT_ATTR_COUNT_ERROR},
+	{"MQRC_INT_ATTR_COUNT_TOO_SMALL", MQRC_INT_ATTR_COUNT_TOO_SMALL},
+	{"MQRC_INVALID_DESTINATION", MQRC_INVALID_DESTINATION},
+	{"MQRC_INVALID_MSG_UNDER_CURSOR", MQRC_INVALID_MSG_UNDER_CURSOR},
+	{"MQRC_INVALID_SUBSCRIPTION", MQRC_INVALID_SUBSCRIPTION},
+	{"MQRC_ITEM_COUNT_ERROR", MQRC_ITEM_COUNT_ERROR},
+	{"MQRC_ITEM_TYPE_ERROR", MQRC_ITEM_TYPE_ERROR},
+	{"MQRC_ITEM_VALUE_ERROR", MQRC_ITEM_VALUE_ERROR},
+	{"MQRC_JMS_FORMAT_ERROR", MQRC_JMS_FORMAT_ERROR},
+	{"MQRC_JSSE_ERROR", MQRC_JSSE_ERROR},
+	{"MQRC_KEY_REPOSITORY_ERROR", MQRC_KEY_REPOSITORY_ERROR},
+	{"MQRC_LDAP_PASSWORD_ERROR", MQRC_LDAP_PASSWORD_ERROR},
+	{"MQRC_LDAP_USER_NAME_ERROR", MQRC_LDAP_USER_NAME_ERROR},
+	{"MQRC_LDAP_USER_NAME_LENGTH_ERR", MQRC_LDAP_USER_NAME_LENGTH_ERR},
+	{"MQRC_LOCAL_UOW_CONFLICT", MQRC_LOCAL_UOW_CONFLICT},
+	{"MQRC_LOGGER_STATUS", MQRC_LOGGER_STATUS},
+	{"MQRC_LOOPING_PUBLICATION", MQRC_LOOPING_PUBLICATION},
+	{"MQRC_MATCH_OPTIONS_ERROR", MQRC_MATCH_OPTIONS_ERROR},
+	{"MQRC_MAX_CONNS_LIMIT_REACHED", MQRC_MAX_CONNS_LIMIT_REACHED},
+	{"MQRC_MAX_MSG_LENGTH_ERROR", MQRC_MAX_MSG_LENGTH_ERROR},
+	{"MQRC_MCAST_PUB_STATUS", MQRC_MCAST_PUB_STATUS},
+	{"MQRC_MCAST_SUB_STATUS", MQRC_MCAST_SUB_STATUS},
+	{"MQRC_MDE_ERROR", MQRC_MDE_ERROR},
+	{"MQRC_MD_ERROR", MQRC_MD_ERROR},
+	{"MQRC_MHBO_ERROR", MQRC_MHBO_ERROR},
+	{"MQRC_MISSING_REPLY_TO_Q", MQRC_MISSING_REPLY_TO_Q},
+	{"MQRC_MISSING_WIH", MQRC_MISSING_WIH},
+	{"MQRC_MIXED_CONTENT_NOT_ALLOWED", MQRC_MIXED_CONTENT_NOT_ALLOWED},
+	{"MQRC_MODULE_ENTRY_NOT_FOUND", MQRC_MODULE_ENTRY_NOT_FOUND},
+	{"MQRC_MODULE_INVALID", MQRC_MODULE_INVALID},
+	{"MQRC_MODULE_NOT_FOUND", MQRC_MODULE_NOT_FOUND},
+	{"MQRC_MSG_FLAGS_ERROR", MQRC_MSG_FLAGS_ERROR},
+	{"MQRC_MSG_HANDLE_COPY_FAILURE", MQRC_MSG_HANDLE_COPY_FAILURE},
+	{"MQRC_MSG_HANDLE_IN_USE", MQRC_MSG_HANDLE_IN_USE},
+	{"MQRC_MSG_ID_ERROR", MQRC_MSG_ID_ERROR},
+	{"MQRC_MSG_LENGTH_ERROR", MQRC_MSG_LENGTH_ERROR},
+	{"MQRC_MSG_MARKED_BROWSE_CO_OP", MQRC_MSG_MARKED_BROWSE_CO_OP},
+	{"MQRC_MSG_NOT_ALLOWED_IN_GROUP", MQRC_MSG_NOT_ALLOWED_IN_GROUP},
+	{"MQRC_MSG_NOT_MATCHED", MQRC_MSG_NOT_MATCHED},
+	{"MQRC_MSG_SEQ_NUMBER_ERROR", MQRC_MSG_SEQ_NUMBER_ERROR},
+	{"MQRC_MSG_TOKEN_ERROR", MQRC_MSG_TOKEN_ERROR},
+	{"MQRC_MSG_TOO_BIG_FOR_CHANNEL", MQRC_MSG_TOO_BIG_FOR_CHANNEL},
+	{"MQRC_MSG_TOO_BIG_FOR_Q", MQRC_MSG_TOO_BIG_FOR_Q},
+	{"MQRC_MSG_TOO_BIG_FOR_Q_MGR", MQRC_MSG_TOO_BIG_FOR_Q_MGR},
+	{"MQRC_MSG_TYPE_ERROR", MQRC_MSG_TYPE_ERROR},
+	{"MQRC_MULTICAST_CONFIG_ERROR", MQRC_MULTICAST_CONFIG_ERROR},
+	{"MQRC_MULTICAST_INTERFACE_ERROR", MQRC_MULTICAST_INTERFACE_ERROR},
+	{"MQRC_MULTICAST_INTERNAL_ERROR", MQRC_MULTICAST_INTERNAL_ERROR},
+	{"MQRC_MULTICAST_ONLY", MQRC_MULTICAST_ONLY},
+	{"MQRC_MULTICAST_SEND_ERROR", MQRC_MULTICAST_SEND_ERROR},
+	{"MQRC_MULTIPLE_INSTANCE_ERROR", MQRC_MULTIPLE_INSTANCE_ERROR},
+	{"MQRC_MULTIPLE_REASONS", MQRC_MULTIPLE_REASONS},
+	{"MQRC_NAME_IN_USE", MQRC_NAME_IN_USE},
+	{"MQRC_NAME_NOT_VALID_FOR_TYPE", MQRC_NAME_NOT_VALID_FOR_TYPE},
+	{"MQRC_NEGATIVE_LENGTH", MQRC_NEGATIVE_LENGTH},
+	{"MQRC_NEGATIVE_OFFSET", MQRC_NEGATIVE_OFFSET},
+	{"MQRC_NESTED_BAG_NOT_SUPPORTED", MQRC_NESTED_BAG_NOT_SUPPORTED},
+	{"MQRC_NESTED_SELECTOR_ERROR", MQRC_NESTED_SELECTOR_ERROR},
+	{"MQRC_NEXT_OFFSET_ERROR", MQRC_NEXT_OFFSET_ERROR},
+	{"MQRC_NEXT_RECORD_ERROR", MQRC_NEXT_RECORD_ERROR},
+	{"MQRC_NONE", MQRC_NONE},
+	{"MQRC_NOT_AUTHORIZED", MQRC_NOT_AUTHORIZED},
+	{"MQRC_NOT_CONNECTED", MQRC_NOT_CONNECTED},
+	{"MQRC_NOT_CONVERTED", MQRC_NOT_CONVERTED},
+	{"MQRC_NOT_OPEN", MQRC_NOT_OPEN},
+	{"MQRC_NOT_OPEN_FOR_BROWSE", MQRC_NOT_OPEN_FOR_BROWSE},
+	{"MQRC_NOT_OPEN_FOR_INPUT", MQRC_NOT_OPEN_FOR_INPUT},
+	{"MQRC_NOT_OPEN_FOR_INQUIRE", MQRC_NOT_OPEN_FOR_INQUIRE},
+	{"MQRC_NOT_OPEN_FOR_OUTPUT", MQRC_NOT_OPEN_FOR_OUTPUT},
+	{"MQRC_NOT_OPEN_FOR_PASS_ALL", MQRC_NOT_OPEN_FOR_PASS_ALL},
+	{"MQRC_NOT_OPEN_FOR_PASS_IDENT", MQRC_NOT_OPEN_FOR_PASS_IDENT},
+	{"MQRC_NOT_OPEN_FOR_SET", MQRC_NOT_OPEN_FOR_SET},
+	{"MQRC_NOT_OPEN_FOR_SET_ALL", MQRC_NOT_OPEN_FOR_SET_ALL},
+	{"MQRC_NOT_OPEN_FOR_SET_IDENT", MQRC_NOT_OPEN_FOR_SET_IDENT},
+	{"MQRC_NOT_PRIVILEGED", MQRC_NOT_PRIVILEGED},
+	{"MQRC_NO_BUFFER", MQRC_NO_BUFFER},
+	{"MQRC_NO_CALLBACKS_ACTIVE", MQRC_NO_CALLBACKS_ACTIVE},
+	{"MQRC_NO_CONNECTION_REFERENCE", MQRC_NO_CONNECTION_REFERENCE},
+	{"MQRC_NO_DATA_AVAILABLE", MQRC_NO_DATA_AVAILABLE},
+	{"MQRC_NO_DESTINATIONS_AVAILABLE", MQRC_NO_DESTINATIONS_AVAILABLE},
+	{"MQRC_NO_EXTERNAL_PARTICIPANTS", MQRC_NO_EXTERNAL_PARTICIPANTS},
+	{"MQRC_NO_MSG_AVAILABLE", MQRC_NO_MSG_AVAILABLE},
+	{"MQRC_NO_MSG_LOCKED", MQRC_NO_MSG_LOCKED},
+	{"MQRC_NO_MSG_UNDER_CURSOR", MQRC_NO_MSG_UNDER_CURSOR},
+	{"MQRC_NO_RECORD_AVAILABLE", MQRC_NO_RECORD_AVAILABLE},
+	{"MQRC_NO_RETAINED_MSG", MQRC_NO_RETAINED_MSG},
+	{"MQRC_NO_SUBSCRIPTION", MQRC_NO_SUBSCRIPTION},
+	{"MQRC_NO_SUBS_MATCHED", MQRC_NO_SUBS_MATCHED},
+	{"MQRC_NULL_POINTER", MQRC_NULL_POINTER},
+	{"MQRC_OBJECT_ALREADY_EXISTS", MQRC_OBJECT_ALREADY_EXISTS},
+	{"MQRC_OBJECT_CHANGED", MQRC_OBJECT_CHANGED},
+	{"MQRC_OBJECT_DAMAGED", MQRC_OBJECT_DAMAGED},
+	{"MQRC_OBJECT_IN_USE", MQRC_OBJECT_IN_USE},
+	{"MQRC_OBJECT_LEVEL_INCOMPATIBLE", MQRC_OBJECT_LEVEL_INCOMPATIBLE},
+	{"MQRC_OBJECT_NAME_ERROR", MQRC_OBJECT_NAME_ERROR},
+	{"MQRC_OBJECT_NOT_UNIQUE", MQRC_OBJECT_NOT_UNIQUE},
+	{"MQRC_OBJECT_Q_MGR_NAME_ERROR", MQRC_OBJECT_Q_MGR_NAME_ERROR},
+	{"MQRC_OBJECT_RECORDS_ERROR", MQRC_OBJECT_RECORDS_ERROR},
+	{"MQRC_OBJECT_STRING_ERROR", MQRC_OBJECT_STRING_ERROR},
+	{"MQRC_OBJECT_TYPE_ERROR", MQRC_OBJECT_TYPE_ERROR},
+	{"MQRC_OCSP_URL_ERROR", MQRC_OCSP_URL_ERROR},
+	{"MQRC_OD_ERROR", MQRC_OD_ERROR},
+	{"MQRC_OFFSET_ERROR", MQRC_OFFSET_ERROR},
+	{"MQRC_OPEN_FAILED", MQRC_OPEN_FAILED},
+	{"MQRC_OPERATION_ERROR", MQRC_OPERATION_ERROR},
+	{"MQRC_OPERATION_NOT_ALLOWED", MQRC_OPERATION_NOT_ALLOWED},
+	{"MQRC_OPTIONS_CHANGED", MQRC_OPTIONS_CHANGED},
+	{"MQRC_OPTIONS_ERROR", MQRC_OPTIONS_ERROR},
+	{"MQRC_OPTION_ENVIRONMENT_ERROR", MQRC_OPTION_ENVIRONMENT_ERROR},
+	{"MQRC_OPTION_NOT_VALID_FOR_TYPE", MQRC_OPTION_NOT_VALID_FOR_TYPE},
+	{"MQRC_ORIGINAL_LENGTH_ERROR", MQRC_ORIGINAL_LENGTH_ERROR},
+	{"MQRC_OUTBOUND_SNI_NOT_VALID", MQRC_OUTBOUND_SNI_NOT_VALID},
+	{"MQRC_OUTCOME_MIXED", MQRC_OUTCOME_MIXED},
+	{"MQRC_OUTCOME_PENDING", MQRC_OUTCOME_PENDING},
+	{"MQRC_OUT_SELECTOR_ERROR", MQRC_OUT_SELECTOR_ERROR},
+	{"MQRC_PAGESET_ERROR", MQRC_PAGESET_ERROR},
+	{"MQRC_PARAMETER_MISSING", MQRC_PARAMETER_MISSING},
+	{"MQRC_PARTIALLY_CONVERTED", MQRC_PARTIALLY_CONVERTED},
+	{"MQRC_PARTICIPANT_NOT_AVAILABLE", MQRC_PARTICIPANT_NOT_AVAILABLE},
+	{"MQRC_PARTICIPANT_NOT_DEFINED", MQRC_PARTICIPANT_NOT_DEFINED},
+	{"MQRC_PASSWORD_PROTECTION_ERROR", MQRC_PASSWORD_PROTECTION_ERROR},
+	{"MQRC_PCF_ERROR", MQRC_PCF_ERROR},
+	{"MQRC_PD_ERROR", MQRC_PD_ERROR},
+	{"MQRC_PERSISTENCE_ERROR", MQRC_PERSISTENCE_ERROR},
+	{"MQRC_PERSISTENT_NOT_ALLOWED", MQRC_PERSISTENT_NOT_ALLOWED},
+	{"MQRC_PMO_ERROR", MQRC_PMO_ERROR},
+	{"MQRC_PMO_RECORD_FLAGS_ERROR", MQRC_PMO_RECORD_FLAGS_ERROR},
+	{"MQRC_PRECONN_EXIT_ERROR", MQRC_PRECONN_EXIT_ERROR},
+	{"MQRC_PRECONN_EXIT_LOAD_ERROR", MQRC_PRECONN_EXIT_LOAD_ERROR},
+	{"MQRC_PRECONN_EXIT_NOT_FOUND", MQRC_PRECONN_EXIT_NOT_FOUND},
+	{"MQRC_PRIORITY_ERROR", MQRC_PRIORITY_ERROR},
+	{"MQRC_PRIORITY_EXCEEDS_MAXIMUM", MQRC_PRIORITY_EXCEEDS_MAXIMUM},
+	{"MQRC_PROPERTIES_DISABLED", MQRC_PROPERTIES_DISABLED},
+	{"MQRC_PROPERTIES_TOO_BIG", MQRC_PROPERTIES_TOO_BIG},
+	{"MQRC_PROPERTY_NAME_ERROR", MQRC_PROPERTY_NAME_ERROR},
+	{"MQRC_PROPERTY_NAME_LENGTH_ERR", MQRC_PROPERTY_NAME_LENGTH_ERR},
+	{"MQRC_PROPERTY_NAME_TOO_BIG", MQRC_PROPERTY_NAME_TOO_BIG},
+	{"MQRC_PROPERTY_NOT_AVAILABLE", MQRC_PROPERTY_NOT_AVAILABLE},
+	{"MQRC_PROPERTY_TYPE_ERROR", MQRC_PROPERTY_TYPE_ERROR},
+	{"MQRC_PROPERTY_VALUE_TOO_BIG", MQRC_PROPERTY_VALUE_TOO_BIG},
+	{"MQRC_PROP_CONV_NOT_SUPPORTED", MQRC_PROP_CONV_NOT_SUPPORTED},
+	{"MQRC_PROP_NAME_NOT_CONVERTED", MQRC_PROP_NAME_NOT_CONVERTED},
+	{"MQRC_PROP_NUMBER_FORMAT_ERROR", MQRC_PROP_NUMBER_FORMAT_ERROR},
+	{"MQRC_PROP_TYPE_NOT_SUPPORTED", MQRC_PROP_TYPE_NOT_SUPPORTED},
+	{"MQRC_PROP_VALUE_NOT_CONVERTED", MQRC_PROP_VALUE_NOT_CONVERTED},
+	{"MQRC_PUBLICATION_FAILURE", MQRC_PUBLICATION_FAILURE},
+	{"MQRC_PUBLISH_EXIT_ERROR", MQRC_PUBLISH_EXIT_ERROR},
+	{"MQRC_PUBSUB_INHIBITED", MQRC_PUBSUB_INHIBITED},
+	{"MQRC_PUT_INHIBITED", MQRC_PUT_INHIBITED},
+	{"MQRC_PUT_MSG_RECORDS_ERROR", MQRC_PUT_MSG_RECORDS_ERROR},
+	{"MQRC_PUT_NOT_RETAINED", MQRC_PUT_NOT_RETAINED},
+	{"MQRC_Q_ALREADY_EXISTS", MQRC_Q_ALREADY_EXISTS},
+	{"MQRC_Q_DELETED", MQRC_Q_DELETED},
+	{"MQRC_Q_DEPTH_HIGH", MQRC_Q_DEPTH_HIGH},
+	{"MQRC_Q_DEPTH_LOW", MQRC_Q_DEPTH_LOW},
+	{"MQRC_Q_FULL", MQRC_Q_FULL},
+	{"MQRC_Q_INDEX_TYPE_ERROR", MQRC_Q_INDEX_TYPE_ERROR},
+	{"MQRC_Q_MGR_ACTIVE", MQRC_Q_MGR_ACTIVE},
+	{"MQRC_Q_MGR_NAME_ERROR", MQRC_Q_MGR_NAME_ERROR},
+	{"MQRC_Q_MGR_NOT_ACTIVE", MQRC_Q_MGR_NOT_ACTIVE},
+	{"MQRC_Q_MGR_NOT_AVAILABLE", MQRC_Q_MGR_NOT_AVAILABLE},
+	{"MQRC_Q_MGR_QUIESCING", MQRC_Q_MGR_QUIESCING},
+	{"MQRC_Q_MGR_RECONNECT_REQUESTED", MQRC_Q_MGR_RECONNECT_REQUESTED},
+	{"MQRC_Q_MGR_STOPPING", MQRC_Q_MGR_STOPPING},
+	{"MQRC_Q_NOT_EMPTY", MQRC_Q_NOT_EMPTY},
+	{"MQRC_Q_SERVICE_INTERVAL_HIGH", MQRC_Q_SERVICE_INTERVAL_HIGH},
+	{"MQRC_Q_SERVICE_INTERVAL_OK", MQRC_Q_SERVICE_INTERVAL_OK},
+	{"MQRC_Q_SPACE_NOT_AVAILABLE", MQRC_Q_SPACE_NOT_AVAILABLE},
+	{"MQRC_Q_TYPE_ERROR", MQRC_Q_TYPE_ERROR},
+	{"MQRC_RAS_PROPERTY_ERROR", MQRC_RAS_PROPERTY_ERROR},
+	{"MQRC_READ_AHEAD_MSGS", MQRC_READ_AHEAD_MSGS},
+	{"MQRC_RECONNECTED", MQRC_RECONNECTED},
+	{"MQRC_RECONNECTING", MQRC_RECONNECTING},
+	{"MQRC_RECONNECT_FAILED", MQRC_RECONNECT_FAILED},
+	{"MQRC_RECONNECT_INCOMPATIBLE", MQRC_RECONNECT_INCOMPATIBLE},
+	{"MQRC_RECONNECT_QMID_MISMATCH", MQRC_RECONNECT_QMID_MISMATCH},
+	{"MQRC_RECONNECT_Q_MGR_REQD", MQRC_RECONNECT_Q_MGR_REQD},
+	{"MQRC_RECONNECT_TIMED_OUT", MQRC_RECONNECT_TIMED_OUT},
+	{"MQRC_RECS_PRESENT_ERROR", MQRC_RECS_PRESENT_ERROR},
+	{"MQRC_REFERENCE_ERROR", MQRC_REFERENCE_ERROR},
+	{"MQRC_REMOTE_Q_NAME_ERROR", MQRC_REMOTE_Q_NAME_ERROR},
+	{"MQRC_REOPEN_EXCL_INPUT_ERROR", MQRC_REOPEN_EXCL_INPUT_ERROR},
+	{"MQRC_REOPEN_INQUIRE_ERROR", MQRC_REOPEN_INQUIRE_ERROR},
+	{"MQRC_REOPEN_SAVED_CONTEXT_ERR", MQRC_REOPEN_SAVED_CONTEXT_ERR},
+	{"MQRC_REOPEN_TEMPORARY_Q_ERROR", MQRC_REOPEN_TEMPORARY_Q_ERROR},
+	{"MQRC_REPORT_OPTIONS_ERROR", MQRC_REPORT_OPTIONS_ERROR},
+	{"MQRC_RESERVED_VALUE_ERROR", MQRC_RESERVED_VALUE_ERROR},
+	{"MQRC_RESOURCE_PROBLEM", MQRC_RESOURCE_PROBLEM},
+	{"MQRC_RESPONSE_RECORDS_ERROR", MQRC_RESPONSE_RECORDS_ERROR},
+	{"MQRC_RES_OBJECT_STRING_ERROR", MQRC_RES_OBJECT_STRING_ERROR},
+	{"MQRC_RETAINED_MSG_Q_ERROR", MQRC_RETAINED_MSG_Q_ERROR},
+	{"MQRC_RETAINED_NOT_DELIVERED", MQRC_RETAINED_NOT_DELIVERED},
+	{"MQRC_RFH_COMMAND_ERROR", MQRC_RFH_COMMAND_ERROR},
+	{"MQRC_RFH_DUPLICATE_PARM", MQRC_RFH_DUPLICATE_PARM},
+	{"MQRC_RFH_ERROR", MQRC_RFH_ERROR},
+	{"MQRC_RFH_FORMAT_ERROR", MQRC_RFH_FORMAT_ERROR},
+	{"MQRC_RFH_HEADER_FIELD_ERROR", MQRC_RFH_HEADER_FIELD_ERROR},
+	{"MQRC_RFH_PARM_ERROR", MQRC_RFH_PARM_ERROR},
+	{"MQRC_RFH_PARM_MISSING", MQRC_RFH_PARM_MISSING},
+	{"MQRC_RFH_RESTRICTED_FORMAT_ERR", MQRC_RFH_RESTRICTED_FORMAT_ERR},
+	{"MQRC_RFH_STRING_ERROR", MQRC_RFH_STRING_ERROR},
+	{"MQRC_RMH_ERROR", MQRC_RMH_ERROR},
+	{"MQRC_SCO_ERROR", MQRC_SCO_ERROR},
+	{"MQRC_SD_ERROR", MQRC_SD_ERROR},
+	{"MQRC_SECOND_MARK_NOT_ALLOWED", MQRC_SECOND_MARK_NOT_ALLOWED},
+	{"MQRC_SECURITY_ERROR", MQRC_SECURITY_ERROR},
+	{"MQRC_SEGMENTATION_NOT_ALLOWED", MQRC_SEGMENTATION_NOT_ALLOWED},
+	{"MQRC_SEGMENTS_NOT_SUPPORTED", MQRC_SEGMENTS_NOT_SUPPORTED},
+	{"MQRC_SEGMENT_LENGTH_ZERO", MQRC_SEGMENT_LENGTH_ZERO},
+	{"MQRC_SELECTION_NOT_AVAILABLE", MQRC_SELECTION_NOT_AVAILABLE},
+	{"MQRC_SELECTION_STRING_ERROR", MQRC_SELECTION_STRING_ERROR},
+	{"MQRC_SELECTOR_ALWAYS_FALSE", MQRC_SELECTOR_ALWAYS_FALSE},
+	{"MQRC_SELECTOR_COUNT_ERROR", MQRC_SELECTOR_COUNT_ERROR},
+	{"MQRC_SELECTOR_ERROR", MQRC_SELECTOR_ERROR},
+	{"MQRC_SELECTOR_INVALID_FOR_TYPE", MQRC_SELECTOR_INVALID_FOR_TYPE},
+	{"MQRC_SELECTOR_LIMIT_EXCEEDED", MQRC_SELECTOR_LIMIT_EXCEEDED},
+	{"MQRC_SELECTOR_NOT_ALTERABLE", MQRC_SELECTOR_NOT_ALTERABLE},
+	{"MQRC_SELECTOR_NOT_FOR_TYPE", MQRC_SELECTOR_NOT_FOR_TYPE},
+	{"MQRC_SELECTOR_NOT_PRESENT", MQRC_SELECTOR_NOT_PRESENT},
+	{"MQRC_SELECTOR_NOT_SUPPORTED", MQRC_SELECTOR_NOT_SUPPORTED},
+	{"MQRC_SELECTOR_NOT_UNIQUE", MQRC_SELECTOR_NOT_UNIQUE},
+	{"MQRC_SELECTOR_OUT_OF_RANGE", MQRC_SELECTOR_OUT_OF_RANGE},
+	{"MQRC_SELECTOR_SYNTAX_ERROR", MQRC_SELECTOR_SYNTAX_ERROR},
+	{"MQRC_SELECTOR_TYPE_ERROR", MQRC_SELECTOR_TYPE_ERROR},
+	{"MQRC_SELECTOR_WRONG_TYPE", MQRC_SELECTOR_WRONG_TYPE},
+	{"MQRC_SERVICE_ERROR", MQRC_SERVICE_ERROR},
+	{"MQRC_SERVICE_NOT_AVAILABLE", MQRC_SERVICE_NOT_AVAILABLE},
+	{"MQRC_SIGNAL1_ERROR", MQRC_SIGNAL1_ERROR},
+	{"MQRC_SIGNAL_OUTSTANDING", MQRC_SIGNAL_OUTSTANDING},
+	{"MQRC_SIGNAL_REQUEST_ACCEPTED", MQRC_SIGNAL_REQUEST_ACCEPTED},
+	{"MQRC_SMPO_ERROR", MQRC_SMPO_ERROR},
+	{"MQRC_SOAP_AXIS_ERROR", MQRC_SOAP_AXIS_ERROR},
+	{"MQRC_SOAP_DOTNET_ERROR", MQRC_SOAP_DOTNET_ERROR},
+	{"MQRC_SOAP_URL_ERROR", MQRC_SOAP_URL_ERROR},
+	{"MQRC_SOURCE_BUFFER_ERROR", MQRC_SOURCE_BUFFER_ERROR},
+	{"MQRC_SOURCE_CCSID_ERROR", MQRC_SOURCE_CCSID_ERROR},
+	{"MQRC_SOURCE_DECIMAL_ENC_ERROR", MQRC_SOURCE_DECIMAL_ENC_ERROR},
+	{"MQRC_SOURCE_FLOAT_ENC_ERROR", MQRC_SOURCE_FLOAT_ENC_ERROR},
+	{"MQRC_SOURCE_INTEGER_ENC_ERROR", MQRC_SOURCE_INTEGER_ENC_ERROR},
+	{"MQRC_SOURCE_LENGTH_ERROR", MQRC_SOURCE_LENGTH_ERROR},
+	{"MQRC_SRC_ENV_ERROR", MQRC_SRC_ENV_ERROR},
+	{"MQRC_SRC_NAME_ERROR", MQRC_SRC_NAME_ERROR},
+	{"MQRC_SRO_ERROR", MQRC_SRO_ERROR},
+	{"MQRC_SSL_ALREADY_INITIALIZED", MQRC_SSL_ALREADY_INITIALIZED},
+	{"MQRC_SSL_ALT_PROVIDER_REQUIRED", MQRC_SSL_ALT_PROVIDER_REQUIRED},
+	{"MQRC_SSL_CERTIFICATE_REVOKED", MQRC_SSL_CERTIFICATE_REVOKED},
+	{"MQRC_SSL_CERT_STORE_ERROR", MQRC_SSL_CERT_STORE_ERROR},
+	{"MQRC_SSL_CONFIG_ERROR", MQRC_SSL_CONFIG_ERROR},
+	{"MQRC_SSL_INITIALIZATION_ERROR", MQRC_SSL_INITIALIZATION_ERROR},
+	{"MQRC_SSL_KEY_RESET_ERROR", MQRC_SSL_KEY_RESET_ERROR},
+	{"MQRC_SSL_NOT_ALLOWED", MQRC_SSL_NOT_ALLOWED},
+	{"MQRC_SSL_PEER_NAME_ERROR", MQRC_SSL_PEER_NAME_ERROR},
+	{"MQRC_SSL_PEER_NAME_MISMATCH", MQRC_SSL_PEER_NAME_MISMATCH},
+	{"MQRC_STANDBY_Q_MGR", MQRC_STANDBY_Q_MGR},
+	{"MQRC_STAT_TYPE_ERROR", MQRC_STAT_TYPE_ERROR},
+	{"MQRC_STOPPED_BY_CLUSTER_EXIT", MQRC_STOPPED_BY_CLUSTER_EXIT},
+	{"MQRC_STORAGE_CLASS_ERROR", MQRC_STORAGE_CLASS_ERROR},
+	{"MQRC_STORAGE_MEDIUM_FULL", MQRC_STORAGE_MEDIUM_FULL},
+	{"MQRC_STORAGE_NOT_AVAILABLE", MQRC_STORAGE_NOT_AVAILABLE},
+	{"MQRC_STRING_ERROR", MQRC_STRING_ERROR},
+	{"MQRC_STRING_LENGTH_ERROR", MQRC_STRING_LENGTH_ERROR},
+	{"MQRC_STRING_TRUNCATED", MQRC_STRING_TRUNCATED},
+	{"MQRC_STRUC_ID_ERROR", MQRC_STRUC_ID_ERROR},
+	{"MQRC_STRUC_LENGTH_ERROR", MQRC_STRUC_LENGTH_ERROR},
+	{"MQRC_STS_ERROR", MQRC_STS_ERROR},
+	{"MQRC_SUBLEVEL_NOT_ALTERABLE", MQRC_SUBLEVEL_NOT_ALTERABLE},
+	{"MQRC_SUBSCRIPTION_CHANGE", MQRC_SUBSCRIPTION_CHANGE},
+	{"MQRC_SUBSCRIPTION_CREATE", MQRC_SUBSCRIPTION_CREATE},
+	{"MQRC_SUBSCRIPTION_DELETE", MQRC_SUBSCRIPTION_DELETE},
+	{"MQRC_SUBSCRIPTION_IN_USE", MQRC_SUBSCRIPTION_IN_USE},
+	{"MQRC_SUBSCRIPTION_REFRESH", MQRC_SUBSCRIPTION_REFRESH},
+	{"MQRC_SUB_ALREADY_EXISTS", MQRC_SUB_ALREADY_EXISTS},
+	{"MQRC_SUB_INHIBITED", MQRC_SUB_INHIBITED},
+	{"MQRC_SUB_JOIN_NOT_ALTERABLE", MQRC_SUB_JOIN_NOT_ALTERABLE},
+	{"MQRC_SUB_NAME_ERROR", MQRC_SUB_NAME_ERROR},
+	{"MQRC_SUB_USER_DATA_ERROR", MQRC_SUB_USER_DATA_ERROR},
+	{"MQRC_SUITE_B_ERROR", MQRC_SUITE_B_ERROR},
+	{"MQRC_SUPPRESSED_BY_EXIT", MQRC_SUPPRESSED_BY_EXIT},
+	{"MQRC_SYNCPOINT_LIMIT_REACHED", MQRC_SYNCPOINT_LIMIT_REACHED},
+	{"MQRC_SYNCPOINT_NOT_ALLOWED", MQRC_SYNCPOINT_NOT_ALLOWED},
+	{"MQRC_SYNCPOINT_NOT_AVAILABLE", MQRC_SYNCPOINT_NOT_AVAILABLE},
+	{"MQRC_SYSTEM_BAG_NOT_ALTERABLE", MQRC_SYSTEM_BAG_NOT_ALTERABLE},
+	{"MQRC_SYSTEM_BAG_NOT_DELETABLE", MQRC_SYSTEM_BAG_NOT_DELETABLE},
+	{"MQRC_SYSTEM_ITEM_NOT_ALTERABLE", MQRC_SYSTEM_ITEM_NOT_ALTERABLE},
+	{"MQRC_SYSTEM_ITEM_NOT_DELETABLE", MQRC_SYSTEM_ITEM_NOT_DELETABLE},
+	{"MQRC_TARGET_BUFFER_ERROR", MQRC_TARGET_BUFFER_ERROR},
+	{"MQRC_TARGET_CCSID_ERROR", MQRC_TARGET_CCSID_ERROR},
+	{"MQRC_TARGET_DECIMAL_ENC_ERROR", MQRC_TARGET_DECIMAL_ENC_ERROR},
+	{"MQRC_TARGET_FLOAT_ENC_ERROR", MQRC_TARGET_FLOAT_ENC_ERROR},
+	{"MQRC_TARGET_INTEGER_ENC_ERROR", MQRC_TARGET_INTEGER_ENC_ERROR},
+	{"MQRC_TARGET_LENGTH_ERROR", MQRC_TARGET_LENGTH_ERROR},
+	{"MQRC_TERMINATION_FAILED", MQRC_TERMINATION_FAILED},
+	{"MQRC_TMC_ERROR", MQRC_TMC_ERROR},
+	{"MQRC_TM_ERROR", MQRC_TM_ERROR},
+	{"MQRC_TOKEN_TIMESTAMP_NOT_VALID", MQRC_TOKEN_TIMESTAMP_NOT_VALID},
+	{"MQRC_TOPIC_NOT_ALTERABLE", MQRC_TOPIC_NOT_ALTERABLE},
+	{"MQRC_TOPIC_STRING_ERROR", MQRC_TOPIC_STRING_ERROR},
+	{"MQRC_TRIGGER_CONTROL_ERROR", MQRC_TRIGGER_CONTROL_ERROR},
+	{"MQRC_TRIGGER_DEPTH_ERROR", MQRC_TRIGGER_DEPTH_ERROR},
+	{"MQRC_TRIGGER_MSG_PRIORITY_ERR", MQRC_TRIGGER_MSG_PRIORITY_ERR},
+	{"MQRC_TRIGGER_TYPE_ERROR", MQRC_TRIGGER_TYPE_ERROR},
+	{"MQRC_TRUNCATED_MSG_ACCEPTED", MQRC_TRUNCATED_MSG_ACCEPTED},
+	{"MQRC_TRUNCATED_MSG_FAILED", MQRC_TRUNCATED_MSG_FAILED},
+	{"MQRC_UCS2_CONVERSION_ERROR", MQRC_UCS2_CONVERSION_ERROR},
+	{"MQRC_UNEXPECTED_ERROR", MQRC_UNEXPECTED_ERROR},
+	{"MQRC_UNIT_OF_WORK_NOT_STARTED", MQRC_UNIT_OF_WORK_NOT_STARTED},
+	{"MQRC_UNKNOWN_ALIAS_BASE_Q", MQRC_UNKNOWN_ALIAS_BASE_Q},
+	{"MQRC_UNKNOWN_AUTH_ENTITY", MQRC_UNKNOWN_AUTH_ENTITY},
+	{"MQRC_UNKNOWN_CHANNEL_NAME", MQRC_UNKNOWN_CHANNEL_NAME},
+	{"MQRC_UNKNOWN_COMPONENT_NAME", MQRC_UNKNOWN_COMPONENT_NAME},
+	{"MQRC_UNKNOWN_DEF_XMIT_Q", MQRC_UNKNOWN_DEF_XMIT_Q},
+	{"MQRC_UNKNOWN_ENTITY", MQRC_UNKNOWN_ENTITY},
+	{"MQRC_UNKNOWN_OBJECT_NAME", MQRC_UNKNOWN_OBJECT_NAME},
+	{"MQRC_UNKNOWN_OBJECT_Q_MGR", MQRC_UNKNOWN_OBJECT_Q_MGR},
+	{"MQRC_UNKNOWN_Q_NAME", MQRC_UNKNOWN_Q_NAME},
+	{"MQRC_UNKNOWN_REF_OBJECT", MQRC_UNKNOWN_REF_OBJECT},
+	{"MQRC_UNKNOWN_REMOTE_Q_MGR", MQRC_UNKNOWN_REMOTE_Q_MGR},
+	{"MQRC_UNKNOWN_REPORT_OPTION", MQRC_UNKNOWN_REPORT_OPTION},
+	{"MQRC_UNKNOWN_XMIT_Q", MQRC_UNKNOWN_XMIT_Q},
+	{"MQRC_UNSUPPORTED_CIPHER_SUITE", MQRC_UNSUPPORTED_CIPHER_SUITE},
+	{"MQRC_UNSUPPORTED_PROPERTY", MQRC_UNSUPPORTED_PROPERTY},
+	{"MQRC_UOW_CANCELED", MQRC_UOW_CANCELED},
+	{"MQRC_UOW_COMMITTED", MQRC_UOW_COMMITTED},
+	{"MQRC_UOW_ENLISTMENT_ERROR", MQRC_UOW_ENLISTMENT_ERROR},
+	{"MQRC_UOW_IN_PROGRESS", MQRC_UOW_IN_PROGRESS},
+	{"MQRC_UOW_MIX_NOT_SUPPORTED", MQRC_UOW_MIX_NOT_SUPPORTED},
+	{"MQRC_UOW_NOT_AVAILABLE", MQRC_UOW_NOT_AVAILABLE},
+	{"MQRC_USER_ID_NOT_AVAILABLE", MQRC_USER_ID_NOT_AVAILABLE},
+	{"MQRC_WAIT_INTERVAL_ERROR", MQRC_WAIT_INTERVAL_ERROR},
+	{"MQRC_WIH_ERROR", MQRC_WIH_ERROR},
+	{"MQRC_WRONG_CF_LEVEL", MQRC_WRONG_CF_LEVEL},
+	{"MQRC_WRONG_GMO_VERSION", MQRC_WRONG_GMO_VERSION},
+	{"MQRC_WRONG_MD_VERSION", MQRC_WRONG_MD_VERSION},
+	{"MQRC_WRONG_VERSION", MQRC_WRONG_VERSION},
+	{"MQRC_WXP_ERROR", MQRC_WXP_ERROR},
+	{"MQRC_XEPO_ERROR", MQRC_XEPO_ERROR},
+	{"MQRC_XMIT_Q_TYPE_ERROR", MQRC_XMIT_Q_TYPE_ERROR},
+	{"MQRC_XMIT_Q_USAGE_ERROR", MQRC_XMIT_Q_USAGE_ERROR},
+	{"MQRC_XQH_ERROR", MQRC_XQH_ERROR},
+	{"MQRC_XR_NOT_AVAILABLE", MQRC_XR_NOT_AVAILABLE},
+	{"MQRC_XWAIT_CANCELED", MQRC_XWAIT_CANCELED},
+	{"MQRC_XWAIT_ERROR", MQRC_XWAIT_ERROR},
+	{"MQRC_ZERO_LENGTH", MQRC_ZERO_LENGTH},
+	{"MQRDNS_DISABLED", MQRDNS_DISABLED},
+	{"MQRDNS_ENABLED", MQRDNS_ENABLED},
+	{"MQRD_NO_DELAY", MQRD_NO_DELAY},
+	{"MQRD_NO_RECONNECT", MQRD_NO_RECONNECT},
+	{"MQREADA_BACKLOG", MQREADA_BACKLOG},
+	{"MQREADA_DISABLED", MQREADA_DISABLED},
+	{"MQREADA_INHIBITED", MQREADA_INHIBITED},
+	{"MQREADA_NO", MQREADA_NO},
+	{"MQREADA_YES", MQREADA_YES},
+	{"MQRECAUTO_NO", MQRECAUTO_NO},
+	{"MQRECAUTO_YES", MQRECAUTO_YES},
+	{"MQRECORDING_DISABLED", MQRECORDING_DISABLED},
+	{"MQRECORDING_MSG", MQRECORDING_MSG},
+	{"MQRECORDING_Q", MQRECORDING_Q},
+	{"MQREGO_ADD_NAME", MQREGO_ADD_NAME},
+	{"MQREGO_ANONYMOUS", MQREGO_ANONYMOUS},
+	{"MQREGO_CORREL_ID_AS_IDENTITY", MQREGO_CORREL_ID_AS_IDENTITY},
+	{"MQREGO_DEREGISTER_ALL", MQREGO_DEREGISTER_ALL},
+	{"MQREGO_DIRECT_REQUESTS", MQREGO_DIRECT_REQUESTS},
+	{"MQREGO_DUPLICATES_OK", MQREGO_DUPLICATES_OK},
+	{"MQREGO_FULL_RESPONSE", MQREGO_FULL_RESPONSE},
+	{"MQREGO_INCLUDE_STREAM_NAME", MQREGO_INCLUDE_STREAM_NAME},
+	{"MQREGO_INFORM_IF_RETAINED", MQREGO_INFORM_IF_RETAINED},
+	{"MQREGO_JOIN_EXCLUSIVE", MQREGO_JOIN_EXCLUSIVE},
+	{"MQREGO_JOIN_SHARED", MQREGO_JOIN_SHARED},
+	{"MQREGO_LEAVE_ONLY", MQREGO_LEAVE_ONLY},
+	{"MQREGO_LOCAL", MQREGO_LOCAL},
+	{"MQREGO_LOCKED", MQREGO_LOCKED},
+	{"MQREGO_NEW_PUBLICATIONS_ONLY", MQREGO_NEW_PUBLICATIONS_ONLY},
+	{"MQREGO_NONE", MQREGO_NONE},
+	{"MQREGO_NON_PERSISTENT", MQREGO_NON_PERSISTENT},
+	{"MQREGO_NO_ALTERATION", MQREGO_NO_ALTERATION},
+	{"MQREGO_PERSISTENT", MQREGO_PERSISTENT},
+	{"MQREGO_PERSISTENT_AS_PUBLISH", MQREGO_PERSISTENT_AS_PUBLISH},
+	{"MQREGO_PERSISTENT_AS_Q", MQREGO_PERSISTENT_AS_Q},
+	{"MQREGO_PUBLISH_ON_REQUEST_ONLY", MQREGO_PUBLISH_ON_REQUEST_ONLY},
+	{"MQREGO_VARIABLE_USER_ID", MQREGO_VARIABLE_USER_ID},
+	{"MQREORG_DISABLED", MQREORG_DISABLED},
+	{"MQREORG_ENABLED", MQREORG_ENABLED},
+	{"MQRFH2_CURRENT_LENGTH", MQRFH2_CURRENT_LENGTH},
+	{"MQRFH2_LENGTH_2", MQRFH2_LENGTH_2},
+	{"MQRFH_CURRENT_LENGTH", MQRFH_CURRENT_LENGTH},
+	{"MQRFH_FLAGS_RESTRICTED_MASK", MQRFH_FLAGS_RESTRICTED_MASK},
+	{"MQRFH_LENGTH_1", MQRFH_LENGTH_1},
+	{"MQRFH_NONE", MQRFH_NONE},
+	{"MQRFH_NO_FLAGS", MQRFH_NO_FLAGS},
+	{"MQRFH_STRUC_LENGTH_FIXED", MQRFH_STRUC_LENGTH_FIXED},
+	{"MQRFH_STRUC_LENGTH_FIXED_2", MQRFH_STRUC_LENGTH_FIXED_2},
+	{"MQRFH_VERSION_1", MQRFH_VERSION_1},
+	{"MQRFH_VERSION_2", MQRFH_VERSION_2},
+	{"MQRL_UNDEFINED", MQRL_UNDEFINED},
+	{"MQRMHF_LAST", MQRMHF_LAST},
+	{"MQRMHF_NOT_LAST", MQRMHF_NOT_LAST},
+	{"MQRMH_CURRENT_LENGTH", MQRMH_CURRENT_LENGTH},
+	{"MQRMH_CURRENT_VERSION", MQRMH_CURRENT_VERSION},
+	{"MQRMH_LENGTH_1", MQRMH_LENGTH_1},
+	{"MQRMH_VERSION_1", MQRMH_VERSION_1},
+	{"MQROUTE_ACCUMULATE_AND_REPLY", MQROUTE_ACCUMULATE_AND_REPLY},
+	{"MQROUTE_ACCUMULATE_IN_MSG", MQROUTE_ACCUMULATE_IN_MSG},
+	{"MQROUTE_ACCUMULATE_NONE", MQROUTE_ACCUMULATE_NONE},
+	{"MQROUTE_DELIVER_NO", MQROUTE_DELIVER_NO},
+	{"MQROUTE_DELIVER_REJ_UNSUP_MASK", MQROUTE_DELIVER_REJ_UNSUP_MASK},
+	{"MQROUTE_DELIVER_YES", MQROUTE_DELIVER_YES},
+	{"MQROUTE_DETAIL_HIGH", MQROUTE_DETAIL_HIGH},
+	{"MQROUTE_DETAIL_LOW", MQROUTE_DETAIL_LOW},
+	{"MQROUTE_DETAIL_MEDIUM", MQROUTE_DETAIL_MEDIUM},
+	{"MQROUTE_FORWARD_ALL", MQROUTE_FORWARD_ALL},
+	{"MQROUTE_FORWARD_IF_SUPPORTED", MQROUTE_FORWARD_IF_SUPPORTED},
+	{"MQROUTE_FORWARD_REJ_UNSUP_MASK", MQROUTE_FORWARD_REJ_UNSUP_MASK},
+	{"MQROUTE_UNLIMITED_ACTIVITIES", MQROUTE_UNLIMITED_ACTIVITIES},
+	{"MQRO_ACCEPT_UNSUP_IF_XMIT_MASK", MQRO_ACCEPT_UNSUP_IF_XMIT_MASK},
+	{"MQRO_ACCEPT_UNSUP_MASK", MQRO_ACCEPT_UNSUP_MASK},
+	{"MQRO_ACTIVITY", MQRO_ACTIVITY},
+	{"MQRO_COA", MQRO_COA},
+	{"MQRO_COA_WITH_DATA", MQRO_COA_WITH_DATA},
+	{"MQRO_COA_WITH_FULL_DATA", MQRO_COA_WITH_FULL_DATA},
+	{"MQRO_COD", MQRO_COD},
+	{"MQRO_COD_WITH_DATA", MQRO_COD_WITH_DATA},
+	{"MQRO_COD_WITH_FULL_DATA", MQRO_COD_WITH_FULL_DATA},
+	{"MQRO_COPY_MSG_ID_TO_CORREL_ID", MQRO_COPY_MSG_ID_TO_CORREL_ID},
+	{"MQRO_DEAD_LETTER_Q", MQRO_DEAD_LETTER_Q},
+	{"MQRO_DISCARD_MSG", MQRO_DISCARD_MSG},
+	{"MQRO_EXCEPTION", MQRO_EXCEPTION},
+	{"MQRO_EXCEPTION_WITH_DATA", MQRO_EXCEPTION_WITH_DATA},
+	{"MQRO_EXCEPTION_WITH_FULL_DATA", MQRO_EXCEPTION_WITH_FULL_DATA},
+	{"MQRO_EXPIRATION", MQRO_EXPIRATION},
+	{"MQRO_EXPIRATION_WITH_DATA", MQRO_EXPIRATION_WITH_DATA},
+	{"MQRO_EXPIRATION_WITH_FULL_DATA", MQRO_EXPIRATION_WITH_FULL_DATA},
+	{"MQRO_NAN", MQRO_NAN},
+	{"MQRO_NEW_MSG_ID", MQRO_NEW_MSG_ID},
+	{"MQRO_NONE", MQRO_NONE},
+	{"MQRO_PAN", MQRO_PAN},
+	{"MQRO_PASS_CORREL_ID", MQRO_PASS_CORREL_ID},
+	{"MQRO_PASS_DISCARD_AND_EXPIRY", MQRO_PASS_DISCARD_AND_EXPIRY},
+	{"MQRO_PASS_MSG_ID", MQRO_PASS_MSG_ID},
+	{"MQRO_REJECT_UNSUP_MASK", MQRO_REJECT_UNSUP_MASK},
+	{"MQRP_NO", MQRP_NO},
+	{"MQRP_YES", MQRP_YES},
+	{"MQRQ_BRIDGE_STOPPED_ERROR", MQRQ_BRIDGE_STOPPED_ERROR},
+	{"MQRQ_BRIDGE_STOPPED_OK", MQRQ_BRIDGE_STOPPED_OK},
+	{"MQRQ_CAF_NOT_INSTALLED", MQRQ_CAF_NOT_INSTALLED},
+	{"MQRQ_CHANNEL_BLOCKED_ADDRESS", MQRQ_CHANNEL_BLOCKED_ADDRESS},
+	{"MQRQ_CHANNEL_BLOCKED_NOACCESS", MQRQ_CHANNEL_BLOCKED_NOACCESS},
+	{"MQRQ_CHANNEL_BLOCKED_USERID", MQRQ_CHANNEL_BLOCKED_USERID},
+	{"MQRQ_CHANNEL_STOPPED_DISABLED", MQRQ_CHANNEL_STOPPED_DISABLED},
+	{"MQRQ_CHANNEL_STOPPED_ERROR", MQRQ_CHANNEL_STOPPED_ERROR},
+	{"MQRQ_CHANNEL_STOPPED_OK", MQRQ_CHANNEL_STOPPED_OK},
+	{"MQRQ_CHANNEL_STOPPED_RETRY", MQRQ_CHANNEL_STOPPED_RETRY},
+	{"MQRQ_CLIENT_INST_LIMIT", MQRQ_CLIENT_INST_LIMIT},
+	{"MQRQ_CLOSE_NOT_AUTHORIZED", MQRQ_CLOSE_NOT_AUTHORIZED},
+	{"MQRQ_CMD_NOT_AUTHORIZED", MQRQ_CMD_NOT_AUTHORIZED},
+	{"MQRQ_CONN_AUTHORIZED", MQRQ_CONN_AUTHORIZED},
+	{"MQRQ_CONN_NOT_AUTHORIZED", MQRQ_CONN_NOT_AUTHORIZED},
+	{"MQRQ_CSP_NOT_AUTHORIZED", MQRQ_CSP_NOT_AUTHORIZED},
+	{"MQRQ_FAILOVER_NOT_PERMITTED", MQRQ_FAILOVER_NOT_PERMITTED},
+	{"MQRQ_FAILOVER_PERMITTED", MQRQ_FAILOVER_PERMITTED},
+	{"MQRQ_MAX_ACTIVE_CHANNELS", MQRQ_MAX_ACTIVE_CHANNELS},
+	{"MQRQ_MAX_CHANNELS", MQRQ_MAX_CHANNELS},
+	{"MQRQ_OPEN_AUTHORIZED", MQRQ_OPEN_AUTHORIZED},
+	{"MQRQ_OPEN_NOT_AUTHORIZED", MQRQ_OPEN_NOT_AUTHORIZED},
+	{"MQRQ_Q_MGR_QUIESCING", MQRQ_Q_MGR_QUIESCING},
+	{"MQRQ_Q_MGR_STOPPING", MQRQ_Q_MGR_STOPPING},
+	{"MQRQ_REPLICA_ACTIVATED", MQRQ_REPLICA_ACTIVATED},
+	{"MQRQ_SSL_CIPHER_SPEC_ERROR", MQRQ_SSL_CIPHER_SPEC_ERROR},
+	{"MQRQ_SSL_CLIENT_AUTH_ERROR", MQRQ_SSL_CLIENT_AUTH_ERROR},
+	{"MQRQ_SSL_HANDSHAKE_ERROR", MQRQ_SSL_HANDSHAKE_ERROR},
+	{"MQRQ_SSL_PEER_NAME_ERROR", MQRQ_SSL_PEER_NAME_ERROR},
+	{"MQRQ_SSL_UNKNOWN_REVOCATION", MQRQ_SSL_UNKNOWN_REVOCATION},
+	{"MQRQ_STANDBY_ACTIVATED", MQRQ_STANDBY_ACTIVATED},
+	{"MQRQ_SUB_AUTHORIZED", MQRQ_SUB_AUTHORIZED},
+	{"MQRQ_SUB_DEST_AUTHORIZED", MQRQ_SUB_DEST_AUTHORIZED},
+	{"MQRQ_SUB_DEST_NOT_AUTHORIZED", MQRQ_SUB_DEST_NOT_AUTHORIZED},
+	{"MQRQ_SUB_NOT_AUTHORIZED", MQRQ_SUB_NOT_AUTHORIZED},
+	{"MQRQ_SVRCONN_INST_LIMIT", MQRQ_SVRCONN_INST_LIMIT},
+	{"MQRQ_SYS_CONN_NOT_AUTHORIZED", MQRQ_SYS_CONN_NOT_AUTHORIZED},
+	{"MQRT_CONFIGURATION", MQRT_CONFIGURATION},
+	{"MQRT_EXPIRY", MQRT_EXPIRY},
+	{"MQRT_NSPROC", MQRT_NSPROC},
+	{"MQRT_PROXYSUB", MQRT_PROXYSUB},
+	{"MQRT_SUB_CONFIGURATION", MQRT_SUB_CONFIGURATION},
+	{"MQRU_PUBLISH_ALL", MQRU_PUBLISH_ALL},
+	{"MQRU_PUBLISH_ON_REQUEST", MQRU_PUBLISH_ON_REQUEST},
+	{"MQSBC_CURRENT_LENGTH", MQSBC_CURRENT_LENGTH},
+	{"MQSBC_CURRENT_VERSION", MQSBC_CURRENT_VERSION},
+	{"MQSBC_LENGTH_1", MQSBC_LENGTH_1},
+	{"MQSBC_VERSION_1", MQSBC_VERSION_1},
+	{"MQSCA_NEVER_REQUIRED", MQSCA_NEVER_REQUIRED},
+	{"MQSCA_OPTIONAL", MQSCA_OPTIONAL},
+	{"MQSCA_REQUIRED", MQSCA_REQUIRED},
+	{"MQSCOPE_ALL", MQSCOPE_ALL},
+	{"MQSCOPE_AS_PARENT", MQSCOPE_AS_PARENT},
+	{"MQSCOPE_QMGR", MQSCOPE_QMGR},
+	{"MQSCO_CELL", MQSCO_CELL},
+	{"MQSCO_CURRENT_LENGTH", MQSCO_CURRENT_LENGTH},
+	{"MQSCO_CURRENT_VERSION", MQSCO_CURRENT_VERSION},
+	{"MQSCO_LENGTH_1", MQSCO_LENGTH_1},
+	{"MQSCO_LENGTH_2", MQSCO_LENGTH_2},
+	{"MQSCO_LENGTH_3", MQSCO_LENGTH_3},
+	{"MQSCO_LENGTH_4", MQSCO_LENGTH_4},
+	{"MQSCO_LENGTH_5", MQSCO_LENGTH_5},
+	{"MQSCO_LENGTH_6", MQSCO_LENGTH_6},
+	{"MQSCO_LENGTH_7", MQSCO_LENGTH_7},
+	{"MQSCO_Q_MGR", MQSCO_Q_MGR},
+	{"MQSCO_RESET_COUNT_DEFAULT", MQSCO_RESET_COUNT_DEFAULT},
+	{"MQSCO_VERSION_1", MQSCO_VERSION_1},
+	{"MQSCO_VERSION_2", MQSCO_VERSION_2},
+	{"MQSCO_VERSION_3", MQSCO_VERSION_3},
+	{"MQSCO_VERSION_4", MQSCO_VERSION_4},
+	{"MQSCO_VERSION_5", MQSCO_VERSION_5},
+	{"MQSCO_VERSION_6", MQSCO_VERSION_6},
+	{"MQSCO_VERSION_7", MQSCO_VERSION_7},
+	{"MQSCYC_MIXED", MQSCYC_MIXED},
+	{"MQSCYC_UPPER", MQSCYC_UPPER},
+	{"MQSD_CURRENT_LENGTH", MQSD_CURRENT_LENGTH},
+	{"MQSD_CURRENT_VERSION", MQSD_CURRENT_VERSION},
+	{"MQSD_LENGTH_1", MQSD_LENGTH_1},
+	{"MQSD_VERSION_1", MQSD_VERSION_1},
+	{"MQSECCOMM_ANON", MQSECCOMM_ANON},
+	{"MQSECCOMM_NO", MQSECCOMM_NO},
+	{"MQSECCOMM_YES", MQSECCOMM_YES},
+	{"MQSECITEM_ALL", MQSECITEM_ALL},
+	{"MQSECITEM_MQADMIN", MQSECITEM_MQADMIN},
+	{"MQSECITEM_MQCMDS", MQSECITEM_MQCMDS},
+	{"MQSECITEM_MQCONN", MQSECITEM_MQCONN},
+	{"MQSECITEM_MQNLIST", MQSECITEM_MQNLIST},
+	{"MQSECITEM_MQPROC", MQSECITEM_MQPROC},
+	{"MQSECITEM_MQQUEUE", MQSECITEM_MQQUEUE},
+	{"MQSECITEM_MXADMIN", MQSECITEM_MXADMIN},
+	{"MQSECITEM_MXNLIST", MQSECITEM_MXNLIST},
+	{"MQSECITEM_MXPROC", MQSECITEM_MXPROC},
+	{"MQSECITEM_MXQUEUE", MQSECITEM_MXQUEUE},
+	{"MQSECITEM_MXTOPIC", MQSECITEM_MXTOPIC},
+	{"MQSECPROT_NONE", MQSECPROT_NONE},
+	{"MQSECPROT_SSLV30", MQSECPROT_SSLV30},
+	{"MQSECPROT_TLSV10", MQSECPROT_TLSV10},
+	{"MQSECPROT_TLSV12", MQSECPROT_TLSV12},
+	{"MQSECPROT_TLSV13", MQSECPROT_TLSV13},
+	{"MQSECSW_ALTERNATE_USER", MQSECSW_ALTERNATE_USER},
+	{"MQSECSW_COMMAND", MQSECSW_COMMAND},
+	{"MQSECSW_COMMAND_RESOURCES", MQSECSW_COMMAND_RESOURCES},
+	{"MQSECSW_CONNECTION", MQSECSW_CONNECTION},
+	{"MQSECSW_CONTEXT", MQSECSW_CONTEXT},
+	{"MQSECSW_NAMELIST", MQSECSW_NAMELIST},
+	{"MQSECSW_OFF_ERROR", MQSECSW_OFF_ERROR},
+	{"MQSECSW_OFF_FOUND", MQSECSW_OFF_FOUND},
+	{"MQSECSW_OFF_NOT_FOUND", MQSECSW_OFF_NOT_FOUND},
+	{"MQSECSW_ON_FOUND", MQSECSW_ON_FOUND},
+	{"MQSECSW_ON_NOT_FOUND", MQSECSW_ON_NOT_FOUND},
+	{"MQSECSW_ON_OVERRIDDEN", MQSECSW_ON_OVERRIDDEN},
+	{"MQSECSW_PROCESS", MQSECSW_PROCESS},
+	{"MQSECSW_Q", MQSECSW_Q},
+	{"MQSECSW_QSG", MQSECSW_QSG},
+	{"MQSECSW_Q_MGR", MQSECSW_Q_MGR},
+	{"MQSECSW_SUBSYSTEM", MQSECSW_SUBSYSTEM},
+	{"MQSECSW_TOPIC", MQSECSW_TOPIC},
+	{"MQSECTYPE_AUTHSERV", MQSECTYPE_AUTHSERV},
+	{"MQSECTYPE_CLASSES", MQSECTYPE_CLASSES},
+	{"MQSECTYPE_CONNAUTH", MQSECTYPE_CONNAUTH},
+	{"MQSECTYPE_SSL", MQSECTYPE_SSL},
+	{"MQSELTYPE_EXTENDED", MQSELTYPE_EXTENDED},
+	{"MQSELTYPE_NONE", MQSELTYPE_NONE},
+	{"MQSELTYPE_STANDARD", MQSELTYPE_STANDARD},
+	{"MQSEL_ALL_SELECTORS", MQSEL_ALL_SELECTORS},
+	{"MQSEL_ALL_SYSTEM_SELECTORS", MQSEL_ALL_SYSTEM_SELECTORS},
+	{"MQSEL_ALL_USER_SELECTORS", MQSEL_ALL_USER_SELECTORS},
+	{"MQSEL_ANY_SELECTOR", MQSEL_ANY_SELECTOR},
+	{"MQSEL_ANY_SYSTEM_SELECTOR", MQSEL_ANY_SYSTEM_SELECTOR},
+	{"MQSEL_ANY_USER_SELECTOR", MQSEL_ANY_USER_SELECTOR},
+	{"MQSMPO_APPEND_PROPERTY", MQSMPO_APPEND_PROPERTY},
+	{"MQSMPO_CURRENT_LENGTH", MQSMPO_CURRENT_LENGTH},
+	{"MQSMPO_CURRENT_VERSION", MQSMPO_CURRENT_VERSION},
+	{"MQSMPO_LENGTH_1", MQSMPO_LENGTH_1},
+	{"MQSMPO_NONE", MQSMPO_NONE},
+	{"MQSMPO_SET_FIRST", MQSMPO_SET_FIRST},
+	{"MQSMPO_SET_PROP_AFTER_CURSOR", MQSMPO_SET_PROP_AFTER_CURSOR},
+	{"MQSMPO_SET_PROP_BEFORE_CURSOR", MQSMPO_SET_PROP_BEFORE_CURSOR},
+	{"MQSMPO_SET_PROP_UNDER_CURSOR", MQSMPO_SET_PROP_UNDER_CURSOR},
+	{"MQSMPO_VERSION_1", MQSMPO_VERSION_1},
+	{"MQSO_ALTER", MQSO_ALTER},
+	{"MQSO_ALTERNATE_USER_AUTHORITY", MQSO_ALTERNATE_USER_AUTHORITY},
+	{"MQSO_ANY_USERID", MQSO_ANY_USERID},
+	{"MQSO_CREATE", MQSO_CREATE},
+	{"MQSO_DURABLE", MQSO_DURABLE},
+	{"MQSO_FAIL_IF_QUIESCING", MQSO_FAIL_IF_QUIESCING},
+	{"MQSO_FIXED_USERID", MQSO_FIXED_USERID},
+	{"MQSO_GROUP_SUB", MQSO_GROUP_SUB},
+	{"MQSO_MANAGED", MQSO_MANAGED},
+	{"MQSO_NEW_PUBLICATIONS_ONLY", MQSO_NEW_PUBLICATIONS_ONLY},
+	{"MQSO_NONE", MQSO_NONE},
+	{"MQSO_NON_DURABLE", MQSO_NON_DURABLE},
+	{"MQSO_NO_MULTICAST", MQSO_NO_MULTICAST},
+	{"MQSO_NO_READ_AHEAD", MQSO_NO_READ_AHEAD},
+	{"MQSO_PUBLICATIONS_ON_REQUEST", MQSO_PUBLICATIONS_ON_REQUEST},
+	{"MQSO_READ_AHEAD", MQSO_READ_AHEAD},
+	{"MQSO_READ_AHEAD_AS_Q_DEF", MQSO_READ_AHEAD_AS_Q_DEF},
+	{"MQSO_RESUME", MQSO_RESUME},
+	{"MQSO_SCOPE_QMGR", MQSO_SCOPE_QMGR},
+	{"MQSO_SET_CORREL_ID", MQSO_SET_CORREL_ID},
+	{"MQSO_SET_IDENTITY_CONTEXT", MQSO_SET_IDENTITY_CONTEXT},
+	{"MQSO_WILDCARD_CHAR", MQSO_WILDCARD_CHAR},
+	{"MQSO_WILDCARD_TOPIC", MQSO_WILDCARD_TOPIC},
+	{"MQSPL_AS_POLICY", MQSPL_AS_POLICY},
+	{"MQSPL_PASSTHRU", MQSPL_PASSTHRU},
+	{"MQSPL_REMOVE", MQSPL_REMOVE},
+	{"MQSP_AVAILABLE", MQSP_AVAILABLE},
+	{"MQSP_NOT_AVAILABLE", MQSP_NOT_AVAILABLE},
+	{"MQSQQM_IGNORE", MQSQQM_IGNORE},
+	{"MQSQQM_USE", MQSQQM_USE},
+	{"MQSRO_CURRENT_LENGTH", MQSRO_CURRENT_LENGTH},
+	{"MQSRO_CURRENT_VERSION", MQSRO_CURRENT_VERSION},
+	{"MQSRO_FAIL_IF_QUIESCING", MQSRO_FAIL_IF_QUIESCING},
+	{"MQSRO_LENGTH_1", MQSRO_LENGTH_1},
+	{"MQSRO_NONE", MQSRO_NONE},
+	{"MQSRO_VERSION_1", MQSRO_VERSION_1},
+	{"MQSR_ACTION_PUBLICATION", MQSR_ACTION_PUBLICATION},
+	{"MQSSL_FIPS_NO", MQSSL_FIPS_NO},
+	{"MQSSL_FIPS_YES", MQSSL_FIPS_YES},
+	{"MQSTAT_TYPE_ASYNC_ERROR", MQSTAT_TYPE_ASYNC_ERROR},
+	{"MQSTAT_TYPE_RECONNECTION", MQSTAT_TYPE_RECONNECTION},
+	{"MQSTAT_TYPE_RECONNECTION_ERROR", MQSTAT_TYPE_RECONNECTION_ERROR},
+	{"MQSTDBY_NOT_PERMITTED", MQSTDBY_NOT_PERMITTED},
+	{"MQSTDBY_PERMITTED", MQSTDBY_PERMITTED},
+	{"MQSTS_CURRENT_LENGTH", MQSTS_CURRENT_LENGTH},
+	{"MQSTS_CURRENT_VERSION", MQSTS_CURRENT_VERSION},
+	{"MQSTS_LENGTH_1", MQSTS_LENGTH_1},
+	{"MQSTS_LENGTH_2", MQSTS_LENGTH_2},
+	{"MQSTS_VERSION_1", MQSTS_VERSION_1},
+	{"MQSTS_VERSION_2", MQSTS_VERSION_2},
+	{"MQST_BEST_EFFORT", MQST_BEST_EFFORT},
+	{"MQST_MUST_DUP", MQST_MUST_DUP},
+	{"MQSUBTYPE_ADMIN", MQSUBTYPE_ADMIN},
+	{"MQSUBTYPE_ALL", MQSUBTYPE_ALL},
+	{"MQSUBTYPE_API", MQSUBTYPE_API},
+	{"MQSUBTYPE_PROXY", MQSUBTYPE_PROXY},
+	{"MQSUBTYPE_USER", MQSUBTYPE_USER},
+	{"MQSUB_DURABLE_ALL", MQSUB_DURABLE_ALL},
+	{"MQSUB_DURABLE_ALLOWED", MQSUB_DURABLE_ALLOWED},
+	{"MQSUB_DURABLE_AS_PARENT", MQSUB_DURABLE_AS_PARENT},
+	{"MQSUB_DURABLE_INHIBITED", MQSUB_DURABLE_INHIBITED},
+	{"MQSUB_DURABLE_NO", MQSUB_DURABLE_NO},
+	{"MQSUB_DURABLE_YES", MQSUB_DURABLE_YES},
+	{"MQSUS_NO", MQSUS_NO},
+	{"MQSUS_YES", MQSUS_YES},
+	{"MQSVC_CONTROL_MANUAL", MQSVC_CONTROL_MANUAL},
+	{"MQSVC_CONTROL_Q_MGR", MQSVC_CONTROL_Q_MGR},
+	{"MQSVC_CONTROL_Q_MGR_START", MQSVC_CONTROL_Q_MGR_START},
+	{"MQSVC_STATUS_RETRYING", MQSVC_STATUS_RETRYING},
+	{"MQSVC_STATUS_RUNNING", MQSVC_STATUS_RUNNING},
+	{"MQSVC_STATUS_STARTING", MQSVC_STATUS_STARTING},
+	{"MQSVC_STATUS_STOPPED", MQSVC_STATUS_STOPPED},
+	{"MQSVC_STATUS_STOPPING", MQSVC_STATUS_STOPPING},
+	{"MQSVC_TYPE_COMMAND", MQSVC_TYPE_COMMAND},
+	{"MQSVC_TYPE_SERVER", MQSVC_TYPE_SERVER},
+	{"MQSYNCPOINT_IFPER", MQSYNCPOINT_IFPER},
+	{"MQSYNCPOINT_YES", MQSYNCPOINT_YES},
+	{"MQSYSOBJ_NO", MQSYSOBJ_NO},
+	{"MQSYSOBJ_YES", MQSYSOBJ_YES},
+	{"MQSYSP_ALLOC_BLK", MQSYSP_ALLOC_BLK},
+	{"MQSYSP_ALLOC_CYL", MQSYSP_ALLOC_CYL},
+	{"MQSYSP_ALLOC_TRK", MQSYSP_ALLOC_TRK},
+	{"MQSYSP_EXTENDED", MQSYSP_EXTENDED},
+	{"MQSYSP_NO", MQSYSP_NO},
+	{"MQSYSP_STATUS_ALLOC_ARCHIVE", MQSYSP_STATUS_ALLOC_ARCHIVE},
+	{"MQSYSP_STATUS_AVAILABLE", MQSYSP_STATUS_AVAILABLE},
+	{"MQSYSP_STATUS_BUSY", MQSYSP_STATUS_BUSY},
+	{"MQSYSP_STATUS_COPYING_BSDS", MQSYSP_STATUS_COPYING_BSDS},
+	{"MQSYSP_STATUS_COPYING_LOG", MQSYSP_STATUS_COPYING_LOG},
+	{"MQSYSP_STATUS_PREMOUNT", MQSYSP_STATUS_PREMOUNT},
+	{"MQSYSP_STATUS_UNKNOWN", MQSYSP_STATUS_UNKNOWN},
+	{"MQSYSP_TYPE_ARCHIVE_TAPE", MQSYSP_TYPE_ARCHIVE_TAPE},
+	{"MQSYSP_TYPE_INITIAL", MQSYSP_TYPE_INITIAL},
+	{"MQSYSP_TYPE_LOG_COPY", MQSYSP_TYPE_LOG_COPY},
+	{"MQSYSP_TYPE_LOG_STATUS", MQSYSP_TYPE_LOG_STATUS},
+	{"MQSYSP_TYPE_SET", MQSYSP_TYPE_SET},
+	{"MQSYSP_YES", MQSYSP_YES},
+	{"MQS_AVAIL_ERROR", MQS_AVAIL_ERROR},
+	{"MQS_AVAIL_NORMAL", MQS_AVAIL_NORMAL},
+	{"MQS_AVAIL_STOPPED", MQS_AVAIL_STOPPED},
+	{"MQS_EXPANDST_FAILED", MQS_EXPANDST_FAILED},
+	{"MQS_EXPANDST_MAXIMUM", MQS_EXPANDST_MAXIMUM},
+	{"MQS_EXPANDST_NORMAL", MQS_EXPANDST_NORMAL},
+	{"MQS_OPENMODE_NONE", MQS_OPENMODE_NONE},
+	{"MQS_OPENMODE_READONLY", MQS_OPENMODE_READONLY},
+	{"MQS_OPENMODE_RECOVERY", MQS_OPENMODE_RECOVERY},
+	{"MQS_OPENMODE_UPDATE", MQS_OPENMODE_UPDATE},
+	{"MQS_STATUS_ALLOCFAIL", MQS_STATUS_ALLOCFAIL},
+	{"MQS_STATUS_CLOSED", MQS_STATUS_CLOSED},
+	{"MQS_STATUS_CLOSING", MQS_STATUS_CLOSING},
+	{"MQS_STATUS_DATAFAIL", MQS_STATUS_DATAFAIL},
+	{"MQS_STATUS_NOTENABLED", MQS_STATUS_NOTENABLED},
+	{"MQS_STATUS_OPEN", MQS_STATUS_OPEN},
+	{"MQS_STATUS_OPENFAIL", MQS_STATUS_OPENFAIL},
+	{"MQS_STATUS_OPENING", MQS_STATUS_OPENING},
+	{"MQS_STATUS_STGFAIL", MQS_STATUS_STGFAIL},
+	{"MQTA_BLOCK", MQTA_BLOCK},
+	{"MQTA_PASSTHRU", MQTA_PASSTHRU},
+	{"MQTA_PROXY_SUB_FIRSTUSE", MQTA_PROXY_SUB_FIRSTUSE},
+	{"MQTA_PROXY_SUB_FORCE", MQTA_PROXY_SUB_FORCE},
+	{"MQTA_PUB_ALLOWED", MQTA_PUB_ALLOWED},
+	{"MQTA_PUB_AS_PARENT", MQTA_PUB_AS_PARENT},
+	{"MQTA_PUB_INHIBITED", MQTA_PUB_INHIBITED},
+	{"MQTA_SUB_ALLOWED", MQTA_SUB_ALLOWED},
+	{"MQTA_SUB_AS_PARENT", MQTA_SUB_AS_PARENT},
+	{"MQTA_SUB_INHIBITED", MQTA_SUB_INHIBITED},
+	{"MQTCPKEEP_NO", MQTCPKEEP_NO},
+	{"MQTCPKEEP_YES", MQTCPKEEP_YES},
+	{"MQTCPSTACK_MULTIPLE", MQTCPSTACK_MULTIPLE},
+	{"MQTCPSTACK_SINGLE", MQTCPSTACK_SINGLE},
+	{"MQTC_OFF", MQTC_OFF},
+	{"MQTC_ON", MQTC_ON},
+	{"MQTIME_UNIT_MINS", MQTIME_UNIT_MINS},
+	{"MQTIME_UNIT_SECS", MQTIME_UNIT_SECS},
+	{"MQTMC2_CURRENT_LENGTH", MQTMC2_CURRENT_LENGTH},
+	{"MQTMC2_LENGTH_1", MQTMC2_LENGTH_1},
+	{"MQTMC2_LENGTH_2", MQTMC2_LENGTH_2},
+	{"MQTMC_CURRENT_LENGTH", MQTMC_CURRENT_LENGTH},
+	{"MQTMC_LENGTH_1", MQTMC_LENGTH_1},
+	{"MQTM_CURRENT_LENGTH", MQTM_CURRENT_LENGTH},
+	{"MQTM_CURRENT_VERSION", MQTM_CURRENT_VERSION},
+	{"MQTM_LENGTH_1", MQTM_LENGTH_1},
+	{"MQTM_VERSION_1", MQTM_VERSION_1},
+	{"MQTOPT_ALL", MQTOPT_ALL},
+	{"MQTOPT_CLUSTER", MQTOPT_CLUSTER},
+	{"MQTOPT_LOCAL", MQTOPT_LOCAL},
+	{"MQTRAXSTR_NO", MQTRAXSTR_NO},
+	{"MQTRAXSTR_YES", MQTRAXSTR_YES},
+	{"MQTRIGGER_RESTART_NO", MQTRIGGER_RESTART_NO},
+	{"MQTRIGGER_RESTART_YES", MQTRIGGER_RESTART_YES},
+	{"MQTSCOPE_ALL", MQTSCOPE_ALL},
+	{"MQTSCOPE_QMGR", MQTSCOPE_QMGR},
+	{"MQTT_DEPTH", MQTT_DEPTH},
+	{"MQTT_EVERY", MQTT_EVERY},
+	{"MQTT_FIRST", MQTT_FIRST},
+	{"MQTT_NONE", MQTT_NONE},
+	{"MQTYPE_AS_SET", MQTYPE_AS_SET},
+	{"MQTYPE_BOOLEAN", MQTYPE_BOOLEAN},
+	{"MQTYPE_BYTE_STRING", MQTYPE_BYTE_STRING},
+	{"MQTYPE_FLOAT32", MQTYPE_FLOAT32},
+	{"MQTYPE_FLOAT64", MQTYPE_FLOAT64},
+	{"MQTYPE_INT16", MQTYPE_INT16},
+	{"MQTYPE_INT32", MQTYPE_INT32},
+	{"MQTYPE_INT64", MQTYPE_INT64},
+	{"MQTYPE_INT8", MQTYPE_INT8},
+	{"MQTYPE_LONG", MQTYPE_LONG},
+	{"MQTYPE_NULL", MQTYPE_NULL},
+	{"MQTYPE_STRING", MQTYPE_STRING},
+	{"MQUA_FIRST", MQUA_FIRST},
+	{"MQUA_LAST", MQUA_LAST},
+	{"MQUCI_NO", MQUCI_NO},
+	{"MQUCI_YES", MQUCI_YES},
+	{"MQUIDSUPP_NO", MQUIDSUPP_NO},
+	{"MQUIDSUPP_YES", MQUIDSUPP_YES},
+	{"MQUNDELIVERED_DISCARD", MQUNDELIVERED_DISCARD},
+	{"MQUNDELIVERED_KEEP", MQUNDELIVERED_KEEP},
+	{"MQUNDELIVERED_NORMAL", MQUNDELIVERED_NORMAL},
+	{"MQUNDELIVERED_SAFE", MQUNDELIVERED_SAFE},
+	{"MQUOWST_ACTIVE", MQUOWST_ACTIVE},
+	{"MQUOWST_NONE", MQUOWST_NONE},
+	{"MQUOWST_PREPARED", MQUOWST_PREPARED},
+	{"MQUOWST_UNRESOLVED", MQUOWST_UNRESOLVED},
+	{"MQUOWT_CICS", MQUOWT_CICS},
+	{"MQUOWT_IMS", MQUOWT_IMS},
+	{"MQUOWT_Q_MGR", MQUOWT_Q_MGR},
+	{"MQUOWT_RRS", MQUOWT_RRS},
+	{"MQUOWT_XA", MQUOWT_XA},
+	{"MQUSAGE_DS_OLDEST_ACTIVE_UOW", MQUSAGE_DS_OLDEST_ACTIVE_UOW},
+	{"MQUSAGE_DS_OLDEST_CF_RECOVERY", MQUSAGE_DS_OLDEST_CF_RECOVERY},
+	{"MQUSAGE_DS_OLDEST_PS_RECOVERY", MQUSAGE_DS_OLDEST_PS_RECOVERY},
+	{"MQUSAGE_EXPAND_NONE", MQUSAGE_EXPAND_NONE},
+	{"MQUSAGE_EXPAND_SYSTEM", MQUSAGE_EXPAND_SYSTEM},
+	{"MQUSAGE_EXPAND_USER", MQUSAGE_EXPAND_USER},
+	{"MQUSAGE_PS_AVAILABLE", MQUSAGE_PS_AVAILABLE},
+	{"MQUSAGE_PS_DEFINED", MQUSAGE_PS_DEFINED},
+	{"MQUSAGE_PS_NOT_DEFINED", MQUSAGE_PS_NOT_DEFINED},
+	{"MQUSAGE_PS_OFFLINE", MQUSAGE_PS_OFFLINE},
+	{"MQUSAGE_PS_SUSPENDED", MQUSAGE_PS_SUSPENDED},
+	{"MQUSAGE_SMDS_AVAILABLE", MQUSAGE_SMDS_AVAILABLE},
+	{"MQUSAGE_SMDS_NO_DATA", MQUSAGE_SMDS_NO_DATA},
+	{"MQUSEDLQ_AS_PARENT", MQUSEDLQ_AS_PARENT},
+	{"MQUSEDLQ_NO", MQUSEDLQ_NO},
+	{"MQUSEDLQ_YES", MQUSEDLQ_YES},
+	{"MQUSRC_CHANNEL", MQUSRC_CHANNEL},
+	{"MQUSRC_MAP", MQUSRC_MAP},
+	{"MQUSRC_NOACCESS", MQUSRC_NOACCESS},
+	{"MQUS_NORMAL", MQUS_NORMAL},
+	{"MQUS_TRANSMISSION", MQUS_TRANSMISSION},
+	{"MQVL_EMPTY_STRING", MQVL_EMPTY_STRING},
+	{"MQVL_NULL_TERMINATED", MQVL_NULL_TERMINATED},
+	{"MQVS_NULL_TERMINATED", MQVS_NULL_TERMINATED},
+	{"MQVU_ANY_USER", MQVU_ANY_USER},
+	{"MQVU_FIXED_USER", MQVU_FIXED_USER},
+	{"MQWARN_NO", MQWARN_NO},
+	{"MQWARN_YES", MQWARN_YES},
+	{"MQWDR1_CURRENT_LENGTH", MQWDR1_CURRENT_LENGTH},
+	{"MQWDR1_LENGTH_1", MQWDR1_LENGTH_1},
+	{"MQWDR2_CURRENT_LENGTH", MQWDR2_CURRENT_LENGTH},
+	{"MQWDR2_LENGTH_1", MQWDR2_LENGTH_1},
+	{"MQWDR2_LENGTH_2", MQWDR2_LENGTH_2},
+	{"MQWDR_CURRENT_LENGTH", MQWDR_CURRENT_LENGTH},
+	{"MQWDR_CURRENT_VERSION", MQWDR_CURRENT_VERSION},
+	{"MQWDR_LENGTH_1", MQWDR_LENGTH_1},
+	{"MQWDR_LENGTH_2", MQWDR_LENGTH_2},
+	{"MQWDR_VERSION_1", MQWDR_VERSION_1},
+	{"MQWDR_VERSION_2", MQWDR_VERSION_2},
+	{"MQWIH_CURRENT_LENGTH", MQWIH_CURRENT_LENGTH},
+	{"MQWIH_CURRENT_VERSION", MQWIH_CURRENT_VERSION},
+	{"MQWIH_LENGTH_1", MQWIH_LENGTH_1},
+	{"MQWIH_NONE", MQWIH_NONE},
+	{"MQWIH_VERSION_1", MQWIH_VERSION_1},
+	{"MQWI_UNLIMITED", MQWI_UNLIMITED},
+	{"MQWQR1_CURRENT_LENGTH", MQWQR1_CURRENT_LENGTH},
+	{"MQWQR1_LENGTH_1", MQWQR1_LENGTH_1},
+	{"MQWQR2_CURRENT_LENGTH", MQWQR2_CURRENT_LENGTH},
+	{"MQWQR2_LENGTH_1", MQWQR2_LENGTH_1},
+	{"MQWQR2_LENGTH_2", MQWQR2_LENGTH_2},
+	{"MQWQR3_CURRENT_LENGTH", MQWQR3_CURRENT_LENGTH},
+	{"MQWQR3_LENGTH_1", MQWQR3_LENGTH_1},
+	{"MQWQR3_LENGTH_2", MQWQR3_LENGTH_2},
+	{"MQWQR3_LENGTH_3", MQWQR3_LENGTH_3},
+	{"MQWQR4_CURRENT_LENGTH", MQWQR4_CURRENT_LENGTH},
+	{"MQWQR4_LENGTH_1", MQWQR4_LENGTH_1},
+	{"MQWQR4_LENGTH_2", MQWQR4_LENGTH_2},
+	{"MQWQR4_LENGTH_3", MQWQR4_LENGTH_3},
+	{"MQWQR4_LENGTH_4", MQWQR4_LENGTH_4},
+	{"MQWQR_CURRENT_LENGTH", MQWQR_CURRENT_LENGTH},
+	{"MQWQR_CURRENT_VERSION", MQWQR_CURRENT_VERSION},
+	{"MQWQR_LENGTH_1", MQWQR_LENGTH_1},
+	{"MQWQR_LENGTH_2", MQWQR_LENGTH_2},
+	{"MQWQR_LENGTH_3", MQWQR_LENGTH_3},
+	{"MQWQR_LENGTH_4", MQWQR_LENGTH_4},
+	{"MQWQR_VERSION_1", MQWQR_VERSION_1},
+	{"MQWQR_VERSION_2", MQWQR_VERSION_2},
+	{"MQWQR_VERSION_3", MQWQR_VERSION_3},
+	{"MQWQR_VERSION_4", MQWQR_VERSION_4},
+	{"MQWS_CHAR", MQWS_CHAR},
+	{"MQWS_DEFAULT", MQWS_DEFAULT},
+	{"MQWS_TOPIC", MQWS_TOPIC},
+	{"MQWXP1_CURRENT_LENGTH", MQWXP1_CURRENT_LENGTH},
+	{"MQWXP1_LENGTH_1", MQWXP1_LENGTH_1},
+	{"MQWXP2_CURRENT_LENGTH", MQWXP2_CURRENT_LENGTH},
+	{"MQWXP2_LENGTH_1", MQWXP2_LENGTH_1},
+	{"MQWXP2_LENGTH_2", MQWXP2_LENGTH_2},
+	{"MQWXP3_CURRENT_LENGTH", MQWXP3_CURRENT_LENGTH},
+	{"MQWXP3_LENGTH_1", MQWXP3_LENGTH_1},
+	{"MQWXP3_LENGTH_2", MQWXP3_LENGTH_2},
+	{"MQWXP3_LENGTH_3", MQWXP3_LENGTH_3},
+	{"MQWXP4_CURRENT_LENGTH", MQWXP4_CURRENT_LENGTH},
+	{"MQWXP4_LENGTH_1", MQWXP4_LENGTH_1},
+	{"MQWXP4_LENGTH_2", MQWXP4_LENGTH_2},
+	{"MQWXP4_LENGTH_3", MQWXP4_LENGTH_3},
+	{"MQWXP4_LENGTH_4", MQWXP4_LENGTH_4},
+	{"MQWXP_CURRENT_LENGTH", MQWXP_CURRENT_LENGTH},
+	{"MQWXP_CURRENT_VERSION", MQWXP_CURRENT_VERSION},
+	{"MQWXP_LENGTH_1", MQWXP_LENGTH_1},
+	{"MQWXP_LENGTH_2", MQWXP_LENGTH_2},
+	{"MQWXP_LENGTH_3", MQWXP_LENGTH_3},
+	{"MQWXP_LENGTH_4", MQWXP_LENGTH_4},
+	{"MQWXP_PUT_BY_CLUSTER_CHL", MQWXP_PUT_BY_CLUSTER_CHL},
+	{"MQWXP_VERSION_1", MQWXP_VERSION_1},
+	{"MQWXP_VERSION_2", MQWXP_VERSION_2},
+	{"MQWXP_VERSION_3", MQWXP_VERSION_3},
+	{"MQWXP_VERSION_4", MQWXP_VERSION_4},
+	{"MQXACT_EXTERNAL", MQXACT_EXTERNAL},
+	{"MQXACT_INTERNAL", MQXACT_INTERNAL},
+	{"MQXCC_CLOSE_CHANNEL", MQXCC_CLOSE_CHANNEL},
+	{"MQXCC_FAILED", MQXCC_FAILED},
+	{"MQXCC_OK", MQXCC_OK},
+	{"MQXCC_REQUEST_ACK", MQXCC_REQUEST_ACK},
+	{"MQXCC_SEND_AND_REQUEST_SEC_MSG", MQXCC_SEND_AND_REQUEST_SEC_MSG},
+	{"MQXCC_SEND_SEC_MSG", MQXCC_SEND_SEC_MSG},
+	{"MQXCC_SKIP_FUNCTION", MQXCC_SKIP_FUNCTION},
+	{"MQXCC_SUPPRESS_EXIT", MQXCC_SUPPRESS_EXIT},
+	{"MQXCC_SUPPRESS_FUNCTION", MQXCC_SUPPRESS_FUNCTION},
+	{"MQXC_CALLBACK", MQXC_CALLBACK},
+	{"MQXC_MQBACK", MQXC_MQBACK},
+	{"MQXC_MQCB", MQXC_MQCB},
+	{"MQXC_MQCLOSE", MQXC_MQCLOSE},
+	{"MQXC_MQCMIT", MQXC_MQCMIT},
+	{"MQXC_MQCTL", MQXC_MQCTL},
+	{"MQXC_MQGET", MQXC_MQGET},
+	{"MQXC_MQINQ", MQXC_MQINQ},
+	{"MQXC_MQOPEN", MQXC_MQOPEN},
+	{"MQXC_MQPUT", MQXC_MQPUT},
+	{"MQXC_MQPUT1", MQXC_MQPUT1},
+	{"MQXC_MQSET", MQXC_MQSET},
+	{"MQXC_MQSTAT", MQXC_MQSTAT},
+	{"MQXC_MQSUB", MQXC_MQSUB},
+	{"MQXC_MQSUBRQ", MQXC_MQSUBRQ},
+	{"MQXDR_CONVERSION_FAILED", MQXDR_CONVERSION_FAILED},
+	{"MQXDR_OK", MQXDR_OK},
+	{"MQXEPO_CURRENT_LENGTH", MQXEPO_CURRENT_LENGTH},
+	{"MQXEPO_CURRENT_VERSION", MQXEPO_CURRENT_VERSION},
+	{"MQXEPO_LENGTH_1", MQXEPO_LENGTH_1},
+	{"MQXEPO_NONE", MQXEPO_NONE},
+	{"MQXEPO_VERSION_1", MQXEPO_VERSION_1},
+	{"MQXE_COMMAND_SERVER", MQXE_COMMAND_SERVER},
+	{"MQXE_MCA", MQXE_MCA},
+	{"MQXE_MCA_CLNTCONN", MQXE_MCA_CLNTCONN},
+	{"MQXE_MCA_SVRCONN", MQXE_MCA_SVRCONN},
+	{"MQXE_MQSC", MQXE_MQSC},
+	{"MQXE_OTHER", MQXE_OTHER},
+	{"MQXF_AXREG", MQXF_AXREG},
+	{"MQXF_AXUNREG", MQXF_AXUNREG},
+	{"MQXF_BACK", MQXF_BACK},
+	{"MQXF_BEGIN", MQXF_BEGIN},
+	{"MQXF_CALLBACK", MQXF_CALLBACK},
+	{"MQXF_CB", MQXF_CB},
+	{"MQXF_CLOSE", MQXF_CLOSE},
+	{"MQXF_CMIT", MQXF_CMIT},
+	{"MQXF_CONN", MQXF_CONN},
+	{"MQXF_CONNX", MQXF_CONNX},
+	{"MQXF_CTL", MQXF_CTL},
+	{"MQXF_DATA_CONV_ON_GET", MQXF_DATA_CONV_ON_GET},
+	{"MQXF_DISC", MQXF_DISC},
+	{"MQXF_GET", MQXF_GET},
+	{"MQXF_INIT", MQXF_INIT},
+	{"MQXF_INQ", MQXF_INQ},
+	{"MQXF_OPEN", MQXF_OPEN},
+	{"MQXF_PUT", MQXF_PUT},
+	{"MQXF_PUT1", MQXF_PUT1},
+	{"MQXF_SET", MQXF_SET},
+	{"MQXF_STAT", MQXF_STAT},
+	{"MQXF_SUB", MQXF_SUB},
+	{"MQXF_SUBRQ", MQXF_SUBRQ},
+	{"MQXF_TERM", MQXF_TERM},
+	{"MQXF_XACLOSE", MQXF_XACLOSE},
+	{"MQXF_XACOMMIT", MQXF_XACOMMIT},
+	{"MQXF_XACOMPLETE", MQXF_XACOMPLETE},
+	{"MQXF_XAEND", MQXF_XAEND},
+	{"MQXF_XAFORGET", MQXF_XAFORGET},
+	{"MQXF_XAOPEN", MQXF_XAOPEN},
+	{"MQXF_XAPREPARE", MQXF_XAPREPARE},
+	{"MQXF_XARECOVER", MQXF_XARECOVER},
+	{"MQXF_XAROLLBACK", MQXF_XAROLLBACK},
+	{"MQXF_XASTART", MQXF_XASTART},
+	{"MQXPT_ALL", MQXPT_ALL},
+	{"MQXPT_DECNET", MQXPT_DECNET},
+	{"MQXPT_LOCAL", MQXPT_LOCAL},
+	{"MQXPT_LU62", MQXPT_LU62},
+	{"MQXPT_NETBIOS", MQXPT_NETBIOS},
+	{"MQXPT_SPX", MQXPT_SPX},
+	{"MQXPT_TCP", MQXPT_TCP},
+	{"MQXPT_UDP", MQXPT_UDP},
+	{"MQXP_CURRENT_LENGTH", MQXP_CURRENT_LENGTH},
+	{"MQXP_LENGTH_1", MQXP_LENGTH_1},
+	{"MQXP_VERSION_1", MQXP_VERSION_1},
+	{"MQXQH_CURRENT_LENGTH", MQXQH_CURRENT_LENGTH},
+	{"MQXQH_CURRENT_VERSION", MQXQH_CURRENT_VERSION},
+	{"MQXQH_LENGTH_1", MQXQH_LENGTH_1},
+	{"MQXQH_VERSION_1", MQXQH_VERSION_1},
+	{"MQXR2_CONTINUE_CHAIN", MQXR2_CONTINUE_CHAIN},
+	{"MQXR2_DEFAULT_CONTINUATION", MQXR2_DEFAULT_CONTINUATION},
+	{"MQXR2_DYNAMIC_CACHE", MQXR2_DYNAMIC_CACHE},
+	{"MQXR2_PUT_WITH_DEF_ACTION", MQXR2_PUT_WITH_DEF_ACTION},
+	{"MQXR2_PUT_WITH_DEF_USERID", MQXR2_PUT_WITH_DEF_USERID},
+	{"MQXR2_PUT_WITH_MSG_USERID", MQXR2_PUT_WITH_MSG_USERID},
+	{"MQXR2_STATIC_CACHE", MQXR2_STATIC_CACHE},
+	{"MQXR2_SUPPRESS_CHAIN", MQXR2_SUPPRESS_CHAIN},
+	{"MQXR2_USE_AGENT_BUFFER", MQXR2_USE_AGENT_BUFFER},
+	{"MQXR2_USE_EXIT_BUFFER", MQXR2_USE_EXIT_BUFFER},
+	{"MQXR_ACK_RECEIVED", MQXR_ACK_RECEIVED},
+	{"MQXR_AFTER", MQXR_AFTER},
+	{"MQXR_AUTO_CLUSRCVR", MQXR_AUTO_CLUSRCVR},
+	{"MQXR_AUTO_CLUSSDR", MQXR_AUTO_CLUSSDR},
+	{"MQXR_AUTO_RECEIVER", MQXR_AUTO_RECEIVER},
+	{"MQXR_AUTO_SVRCONN", MQXR_AUTO_SVRCONN},
+	{"MQXR_BEFORE", MQXR_BEFORE},
+	{"MQXR_BEFORE_CONVERT", MQXR_BEFORE_CONVERT},
+	{"MQXR_CLWL_MOVE", MQXR_CLWL_MOVE},
+	{"MQXR_CLWL_OPEN", MQXR_CLWL_OPEN},
+	{"MQXR_CLWL_PUT", MQXR_CLWL_PUT},
+	{"MQXR_CLWL_REPOS", MQXR_CLWL_REPOS},
+	{"MQXR_CLWL_REPOS_MOVE", MQXR_CLWL_REPOS_MOVE},
+	{"MQXR_CONNECTION", MQXR_CONNECTION},
+	{"MQXR_END_BATCH", MQXR_END_BATCH},
+	{"MQXR_INIT", MQXR_INIT},
+	{"MQXR_INIT_SEC", MQXR_INIT_SEC},
+	{"MQXR_MSG", MQXR_MSG},
+	{"MQXR_PRECONNECT", MQXR_PRECONNECT},
+	{"MQXR_PUBLICATION", MQXR_PUBLICATION},
+	{"MQXR_RETRY", MQXR_RETRY},
+	{"MQXR_SEC_MSG", MQXR_SEC_MSG},
+	{"MQXR_SEC_PARMS", MQXR_SEC_PARMS},
+	{"MQXR_TERM", MQXR_TERM},
+	{"MQXR_XMIT", MQXR_XMIT},
+	{"MQXT_API_CROSSING_EXIT", MQXT_API_CROSSING_EXIT},
+	{"MQXT_API_EXIT", MQXT_API_EXIT},
+	{"MQXT_CHANNEL_AUTO_DEF_EXIT", MQXT_CHANNEL_AUTO_DEF_EXIT},
+	{"MQXT_CHANNEL_MSG_EXIT", MQXT_CHANNEL_MSG_EXIT},
+	{"MQXT_CHANNEL_MSG_RETRY_EXIT", MQXT_CHANNEL_MSG_RETRY_EXIT},
+	{"MQXT_CHANNEL_RCV_EXIT", MQXT_CHANNEL_RCV_EXIT},
+	{"MQXT_CHANNEL_SEC_EXIT", MQXT_CHANNEL_SEC_EXIT},
+	{"MQXT_CHANNEL_SEND_EXIT", MQXT_CHANNEL_SEND_EXIT},
+	{"MQXT_CLUSTER_WORKLOAD_EXIT", MQXT_CLUSTER_WORKLOAD_EXIT},
+	{"MQXT_PRECONNECT_EXIT", MQXT_PRECONNECT_EXIT},
+	{"MQXT_PUBLISH_EXIT", MQXT_PUBLISH_EXIT},
+	{"MQXT_PUBSUB_ROUTING_EXIT", MQXT_PUBSUB_ROUTING_EXIT},
+	{"MQXWD_CURRENT_LENGTH", MQXWD_CURRENT_LENGTH},
+	{"MQXWD_LENGTH_1", MQXWD_LENGTH_1},
+	{"MQXWD_VERSION_1", MQXWD_VERSION_1},
+	{"MQZAC_CURRENT_LENGTH", MQZAC_CURRENT_LENGTH},
+	{"MQZAC_CURRENT_VERSION", MQZAC_CURRENT_VERSION},
+	{"MQZAC_LENGTH_1", MQZAC_LENGTH_1},
+	{"MQZAC_VERSION_1", MQZAC_VERSION_1},
+	{"MQZAD_CURRENT_LENGTH", MQZAD_CURRENT_LENGTH},
+	{"MQZAD_CURRENT_VERSION", MQZAD_CURRENT_VERSION},
+	{"MQZAD_LENGTH_1", MQZAD_LENGTH_1},
+	{"MQZAD_LENGTH_2", MQZAD_LENGTH_2},
+	{"MQZAD_VERSION_1", MQZAD_VERSION_1},
+	{"MQZAD_VERSION_2", MQZAD_VERSION_2},
+	{"MQZAET_GROUP", MQZAET_GROUP},
+	{"MQZAET_NONE", MQZAET_NONE},
+	{"MQZAET_PRINCIPAL", MQZAET_PRINCIPAL},
+	{"MQZAET_UNKNOWN", MQZAET_UNKNOWN},
+	{"MQZAO_ALL", MQZAO_ALL},
+	{"MQZAO_ALL_ADMIN", MQZAO_ALL_ADMIN},
+	{"MQZAO_ALL_MQI", MQZAO_ALL_MQI},
+	{"MQZAO_ALTERNATE_USER_AUTHORITY", MQZAO_ALTERNATE_USER_AUTHORITY},
+	{"MQZAO_AUTHORIZE", MQZAO_AUTHORIZE},
+	{"MQZAO_BROWSE", MQZAO_BROWSE},
+	{"MQZAO_CHANGE", MQZAO_CHANGE},
+	{"MQZAO_CLEAR", MQZAO_CLEAR},
+	{"MQZAO_CONNECT", MQZAO_CONNECT},
+	{"MQZAO_CONTROL", MQZAO_CONTROL},
+	{"MQZAO_CONTROL_EXTENDED", MQZAO_CONTROL_EXTENDED},
+	{"MQZAO_CREATE", MQZAO_CREATE},
+	{"MQZAO_CREATE_ONLY", MQZAO_CREATE_ONLY},
+	{"MQZAO_DELETE", MQZAO_DELETE},
+	{"MQZAO_DISPLAY", MQZAO_DISPLAY},
+	{"MQZAO_INPUT", MQZAO_INPUT},
+	{"MQZAO_INQUIRE", MQZAO_INQUIRE},
+	{"MQZAO_NONE", MQZAO_NONE},
+	{"MQZAO_OUTPUT", MQZAO_OUTPUT},
+	{"MQZAO_PASS_ALL_CONTEXT", MQZAO_PASS_ALL_CONTEXT},
+	{"MQZAO_PASS_IDENTITY_CONTEXT", MQZAO_PASS_IDENTITY_CONTEXT},
+	{"MQZAO_PUBLISH", MQZAO_PUBLISH},
+	{"MQZAO_REMOVE", MQZAO_REMOVE},
+	{"MQZAO_RESUME", MQZAO_RESUME},
+	{"MQZAO_SET", MQZAO_SET},
+	{"MQZAO_SET_ALL_CONTEXT", MQZAO_SET_ALL_CONTEXT},
+	{"MQZAO_SET_IDENTITY_CONTEXT", MQZAO_SET_IDENTITY_CONTEXT},
+	{"MQZAO_SUBSCRIBE", MQZAO_SUBSCRIBE},
+	{"MQZAO_SYSTEM", MQZAO_SYSTEM},
+	{"MQZAS_VERSION_1", MQZAS_VERSION_1},
+	{"MQZAS_VERSION_2", MQZAS_VERSION_2},
+	{"MQZAS_VERSION_3", MQZAS_VERSION_3},
+	{"MQZAS_VERSION_4", MQZAS_VERSION_4},
+	{"MQZAS_VERSION_5", MQZAS_VERSION_5},
+	{"MQZAS_VERSION_6", MQZAS_VERSION_6},
+	{"MQZAT_CHANGE_CONTEXT", MQZAT_CHANGE_CONTEXT},
+	{"MQZAT_INITIAL_CONTEXT", MQZAT_INITIAL_CONTEXT},
+	{"MQZCI_CONTINUE", MQZCI_CONTINUE},
+	{"MQZCI_DEFAULT", MQZCI_DEFAULT},
+	{"MQZCI_STOP", MQZCI_STOP},
+	{"MQZED_CURRENT_LENGTH", MQZED_CURRENT_LENGTH},
+	{"MQZED_CURRENT_VERSION", MQZED_CURRENT_VERSION},
+	{"MQZED_LENGTH_1", MQZED_LENGTH_1},
+	{"MQZED_LENGTH_2", MQZED_LENGTH_2},
+	{"MQZED_VERSION_1", MQZED_VERSION_1},
+	{"MQZED_VERSION_2", MQZED_VERSION_2},
+	{"MQZFP_CURRENT_LENGTH", MQZFP_CURRENT_LENGTH},
+	{"MQZFP_CURRENT_VERSION", MQZFP_CURRENT_VERSION},
+	{"MQZFP_LENGTH_1", MQZFP_LENGTH_1},
+	{"MQZFP_VERSION_1", MQZFP_VERSION_1},
+	{"MQZIC_CURRENT_LENGTH", MQZIC_CURRENT_LENGTH},
+	{"MQZIC_CURRENT_VERSION", MQZIC_CURRENT_VERSION},
+	{"MQZIC_LENGTH_1", MQZIC_LENGTH_1},
+	{"MQZIC_LENGTH_2", MQZIC_LENGTH_2},
+	{"MQZIC_VERSION_1", MQZIC_VERSION_1},
+	{"MQZIC_VERSION_2", MQZIC_VERSION_2},
+	{"MQZID_AUTHENTICATE_USER", MQZID_AUTHENTICATE_USER},
+	{"MQZID_CHECK_AUTHORITY", MQZID_CHECK_AUTHORITY},
+	{"MQZID_CHECK_PRIVILEGED", MQZID_CHECK_PRIVILEGED},
+	{"MQZID_COPY_ALL_AUTHORITY", MQZID_COPY_ALL_AUTHORITY},
+	{"MQZID_DELETE_AUTHORITY", MQZID_DELETE_AUTHORITY},
+	{"MQZID_DELETE_NAME", MQZID_DELETE_NAME},
+	{"MQZID_ENUMERATE_AUTHORITY_DATA", MQZID_ENUMERATE_AUTHORITY_DATA},
+	{"MQZID_FIND_USERID", MQZID_FIND_USERID},
+	{"MQZID_FREE_USER", MQZID_FREE_USER},
+	{"MQZID_GET_AUTHORITY", MQZID_GET_AUTHORITY},
+	{"MQZID_GET_EXPLICIT_AUTHORITY", MQZID_GET_EXPLICIT_AUTHORITY},
+	{"MQZID_INIT", MQZID_INIT},
+	{"MQZID_INIT_AUTHORITY", MQZID_INIT_AUTHORITY},
+	{"MQZID_INIT_NAME", MQZID_INIT_NAME},
+	{"MQZID_INIT_USERID", MQZID_INIT_USERID},
+	{"MQZID_INQUIRE", MQZID_INQUIRE},
+	{"MQZID_INSERT_NAME", MQZID_INSERT_NAME},
+	{"MQZID_LOOKUP_NAME", MQZID_LOOKUP_NAME},
+	{"MQZID_REFRESH_CACHE", MQZID_REFRESH_CACHE},
+	{"MQZID_SET_AUTHORITY", MQZID_SET_AUTHORITY},
+	{"MQZID_TERM", MQZID_TERM},
+	{"MQZID_TERM_AUTHORITY", MQZID_TERM_AUTHORITY},
+	{"MQZID_TERM_NAME", MQZID_TERM_NAME},
+	{"MQZID_TERM_USERID", MQZID_TERM_USERID},
+	{"MQZIO_PRIMARY", MQZIO_PRIMARY},
+	{"MQZIO_SECONDARY", MQZIO_SECONDARY},
+	{"MQZNS_VERSION_1", MQZNS_VERSION_1},
+	{"MQZSE_CONTINUE", MQZSE_CONTINUE},
+	{"MQZSE_START", MQZSE_START},
+	{"MQZSL_NOT_RETURNED", MQZSL_NOT_RETURNED},
+	{"MQZSL_RETURNED", MQZSL_RETURNED},
+	{"MQZTO_PRIMARY", MQZTO_PRIMARY},
+	{"MQZTO_SECONDARY", MQZTO_SECONDARY},
+	{"MQZUS_VERSION_1", MQZUS_VERSION_1},
+	{"MQ_ABEND_CODE_LENGTH", MQ_ABEND_CODE_LENGTH},
+	{"MQ_ACCOUNTING_TOKEN_LENGTH", MQ_ACCOUNTING_TOKEN_LENGTH},
+	{"MQ_AMQP_CLIENT_ID_LENGTH", MQ_AMQP_CLIENT_ID_LENGTH},
+	{"MQ_APPL_DESC_LENGTH", MQ_APPL_DESC_LENGTH},
+	{"MQ_APPL_FUNCTION_NAME_LENGTH", MQ_APPL_FUNCTION_NAME_LENGTH},
+	{"MQ_APPL_IDENTITY_DATA_LENGTH", MQ_APPL_IDENTITY_DATA_LENGTH},
+	{"MQ_APPL_NAME_LENGTH", MQ_APPL_NAME_LENGTH},
+	{"MQ_APPL_ORIGIN_DATA_LENGTH", MQ_APPL_ORIGIN_DATA_LENGTH},
+	{"MQ_APPL_TAG_LENGTH", MQ_APPL_TAG_LENGTH},
+	{"MQ_ARCHIVE_PFX_LENGTH", MQ_ARCHIVE_PFX_LENGTH},
+	{"MQ_ARCHIVE_UNIT_LENGTH", MQ_ARCHIVE_UNIT_LENGTH},
+	{"MQ_ARM_SUFFIX_LENGTH", MQ_ARM_SUFFIX_LENGTH},
+	{"MQ_ASID_LENGTH", MQ_ASID_LENGTH},
+	{"MQ_ATTENTION_ID_LENGTH", MQ_ATTENTION_ID_LENGTH},
+	{"MQ_AUTHENTICATOR_LENGTH", MQ_AUTHENTICATOR_LENGTH},
+	{"MQ_AUTH_INFO_CONN_NAME_LENGTH", MQ_AUTH_INFO_CONN_NAME_LENGTH},
+	{"MQ_AUTH_INFO_DESC_LENGTH", MQ_AUTH_INFO_DESC_LENGTH},
+	{"MQ_AUTH_INFO_NAME_LENGTH", MQ_AUTH_INFO_NAME_LENGTH},
+	{"MQ_AUTH_INFO_OCSP_URL_LENGTH", MQ_AUTH_INFO_OCSP_URL_LENGTH},
+	{"MQ_AUTH_PROFILE_NAME_LENGTH", MQ_AUTH_PROFILE_NAME_LENGTH},
+	{"MQ_AUTO_REORG_CATALOG_LENGTH", MQ_AUTO_REORG_CATALOG_LENGTH},
+	{"MQ_AUTO_REORG_TIME_LENGTH", MQ_AUTO_REORG_TIME_LENGTH},
+	{"MQ_BATCH_INTERFACE_ID_LENGTH", MQ_BATCH_INTERFACE_ID_LENGTH},
+	{"MQ_BRIDGE_NAME_LENGTH", MQ_BRIDGE_NAME_LENGTH},
+	{"MQ_CANCEL_CODE_LENGTH", MQ_CANCEL_CODE_LENGTH},
+	{"MQ_CERT_LABEL_LENGTH", MQ_CERT_LABEL_LENGTH},
+	{"MQ_CERT_VAL_POLICY_ANY", MQ_CERT_VAL_POLICY_ANY},
+	{"MQ_CERT_VAL_POLICY_DEFAULT", MQ_CERT_VAL_POLICY_DEFAULT},
+	{"MQ_CERT_VAL_POLICY_NONE", MQ_CERT_VAL_POLICY_NONE},
+	{"MQ_CERT_VAL_POLICY_RFC5280", MQ_CERT_VAL_POLICY_RFC5280},
+	{"MQ_CF_LEID_LENGTH", MQ_CF_LEID_LENGTH},
+	{"MQ_CF_STRUC_DESC_LENGTH", MQ_CF_STRUC_DESC_LENGTH},
+	{"MQ_CF_STRUC_NAME_LENGTH", MQ_CF_STRUC_NAME_LENGTH},
+	{"MQ_CHANNEL_DATE_LENGTH", MQ_CHANNEL_DATE_LENGTH},
+	{"MQ_CHANNEL_DESC_LENGTH", MQ_CHANNEL_DESC_LENGTH},
+	{"MQ_CHANNEL_NAME_LENGTH", MQ_CHANNEL_NAME_LENGTH},
+	{"MQ_CHANNEL_TIME_LENGTH", MQ_CHANNEL_TIME_LENGTH},
+	{"MQ_CHINIT_SERVICE_PARM_LENGTH", MQ_CHINIT_SERVICE_PARM_LENGTH},
+	{"MQ_CHLAUTH_DESC_LENGTH", MQ_CHLAUTH_DESC_LENGTH},
+	{"MQ_CICS_FILE_NAME_LENGTH", MQ_CICS_FILE_NAME_LENGTH},
+	{"MQ_CLIENT_ID_LENGTH", MQ_CLIENT_ID_LENGTH},
+	{"MQ_CLIENT_USER_ID_LENGTH", MQ_CLIENT_USER_ID_LENGTH},
+	{"MQ_CLUSTER_NAME_LENGTH", MQ_CLUSTER_NAME_LENGTH},
+	{"MQ_COMMAND_MQSC_LENGTH", MQ_COMMAND_MQSC_LENGTH},
+	{"MQ_COMM_INFO_DESC_LENGTH", MQ_COMM_INFO_DESC_LENGTH},
+	{"MQ_COMM_INFO_NAME_LENGTH", MQ_COMM_INFO_NAME_LENGTH},
+	{"MQ_CONNECTION_ID_LENGTH", MQ_CONNECTION_ID_LENGTH},
+	{"MQ_CONN_NAME_LENGTH", MQ_CONN_NAME_LENGTH},
+	{"MQ_CONN_TAG_LENGTH", MQ_CONN_TAG_LENGTH},
+	{"MQ_CORREL_ID_LENGTH", MQ_CORREL_ID_LENGTH},
+	{"MQ_CREATION_DATE_LENGTH", MQ_CREATION_DATE_LENGTH},
+	{"MQ_CREATION_TIME_LENGTH", MQ_CREATION_TIME_LENGTH},
+	{"MQ_CSP_PASSWORD_LENGTH", MQ_CSP_PASSWORD_LENGTH},
+	{"MQ_CSP_TOKEN_LENGTH", MQ_CSP_TOKEN_LENGTH},
+	{"MQ_CUSTOM_LENGTH", MQ_CUSTOM_LENGTH},
+	{"MQ_DATA_SET_NAME_LENGTH", MQ_DATA_SET_NAME_LENGTH},
+	{"MQ_DATE_LENGTH", MQ_DATE_LENGTH},
+	{"MQ_DB2_NAME_LENGTH", MQ_DB2_NAME_LENGTH},
+	{"MQ_DISTINGUISHED_NAME_LENGTH", MQ_DISTINGUISHED_NAME_LENGTH},
+	{"MQ_DNS_GROUP_NAME_LENGTH", MQ_DNS_GROUP_NAME_LENGTH},
+	{"MQ_DSG_NAME_LENGTH", MQ_DSG_NAME_LENGTH},
+	{"MQ_ENTITY_NAME_LENGTH", MQ_ENTITY_NAME_LENGTH},
+	{"MQ_ENV_INFO_LENGTH", MQ_ENV_INFO_LENGTH},
+	{"MQ_EXIT_DATA_LENGTH", MQ_EXIT_DATA_LENGTH},
+	{"MQ_EXIT_INFO_NAME_LENGTH", MQ_EXIT_INFO_NAME_LENGTH},
+	{"MQ_EXIT_NAME_LENGTH", MQ_EXIT_NAME_LENGTH},
+	{"MQ_EXIT_PD_AREA_LENGTH", MQ_EXIT_PD_AREA_LENGTH},
+	{"MQ_EXIT_USER_AREA_LENGTH", MQ_EXIT_USER_AREA_LENGTH},
+	{"MQ_FACILITY_LENGTH", MQ_FACILITY_LENGTH},
+	{"MQ_FACILITY_LIKE_LENGTH", MQ_FACILITY_LIKE_LENGTH},
+	{"MQ_FORMAT_LENGTH", MQ_FORMAT_LENGTH},
+	{"MQ_FUNCTION_LENGTH", MQ_FUNCTION_LENGTH},
+	{"MQ_GROUP_ADDRESS_LENGTH", MQ_GROUP_ADDRESS_LENGTH},
+	{"MQ_GROUP_ID_LENGTH", MQ_GROUP_ID_LENGTH},
+	{"MQ_HOST_NAME_LENGTH", MQ_HOST_NAME_LENGTH},
+	{"MQ_HTTPSCERTREV_DEFAULT", MQ_HTTPSCERTREV_DEFAULT},
+	{"MQ_HTTPSCERTREV_DISABLED", MQ_HTTPSCERTREV_DISABLED},
+	{"MQ_HTTPSCERTREV_OPTIONAL", MQ_HTTPSCERTREV_OPTIONAL},
+	{"MQ_HTTPSCERTREV_REQUIRED", MQ_HTTPSCERTREV_REQUIRED},
+	{"MQ_HTTPSCERTVAL_ANY", MQ_HTTPSCERTVAL_ANY},
+	{"MQ_HTTPSCERTVAL_DEFAULT", MQ_HTTPSCERTVAL_DEFAULT},
+	{"MQ_HTTPSCERTVAL_HOSTNAMECN", MQ_HTTPSCERTVAL_HOSTNAMECN},
+	{"MQ_HTTPSCERTVAL_NONE", MQ_HTTPSCERTVAL_NONE},
+	{"MQ_INITIAL_KEY_LENGTH", MQ_INITIAL_KEY_LENGTH},
+	{"MQ_INSTALLATION_DESC_LENGTH", MQ_INSTALLATION_DESC_LENGTH},
+	{"MQ_INSTALLATION_NAME_LENGTH", MQ_INSTALLATION_NAME_LENGTH},
+	{"MQ_INSTALLATION_PATH_LENGTH", MQ_INSTALLATION_PATH_LENGTH},
+	{"MQ_IP_ADDRESS_LENGTH", MQ_IP_ADDRESS_LENGTH},
+	{"MQ_ISOTIME_LENGTH", MQ_ISOTIME_LENGTH},
+	{"MQ_JAAS_CONFIG_LENGTH", MQ_JAAS_CONFIG_LENGTH},
+	{"MQ_LDAP_BASE_DN_LENGTH", MQ_LDAP_BASE_DN_LENGTH},
+	{"MQ_LDAP_CLASS_LENGTH", MQ_LDAP_CLASS_LENGTH},
+	{"MQ_LDAP_FIELD_LENGTH", MQ_LDAP_FIELD_LENGTH},
+	{"MQ_LDAP_MCA_USER_ID_LENGTH", MQ_LDAP_MCA_USER_ID_LENGTH},
+	{"MQ_LDAP_PASSWORD_LENGTH", MQ_LDAP_PASSWORD_LENGTH},
+	{"MQ_LISTENER_DESC_LENGTH", MQ_LISTENER_DESC_LENGTH},
+	{"MQ_LISTENER_NAME_LENGTH", MQ_LISTENER_NAME_LENGTH},
+	{"MQ_LOCAL_ADDRESS_LENGTH", MQ_LOCAL_ADDRESS_LENGTH},
+	{"MQ_LOG_CORREL_ID_LENGTH", MQ_LOG_CORREL_ID_LENGTH},
+	{"MQ_LOG_EXTENT_NAME_LENGTH", MQ_LOG_EXTENT_NAME_LENGTH},
+	{"MQ_LOG_PATH_LENGTH", MQ_LOG_PATH_LENGTH},
+	{"MQ_LONG_USER_ID_LENGTH", MQ_LONG_USER_ID_LENGTH},
+	{"MQ_LRSN_LENGTH", MQ_LRSN_LENGTH},
+	{"MQ_LSN_LENGTH", MQ_LSN_LENGTH},
+	{"MQ_LTERM_OVERRIDE_LENGTH", MQ_LTERM_OVERRIDE_LENGTH},
+	{"MQ_LUWID_LENGTH", MQ_LUWID_LENGTH},
+	{"MQ_LU_NAME_LENGTH", MQ_LU_NAME_LENGTH},
+	{"MQ_MAX_EXIT_NAME_LENGTH", MQ_MAX_EXIT_NAME_LENGTH},
+	{"MQ_MAX_LDAP_MCA_USER_ID_LENGTH", MQ_MAX_LDAP_MCA_USER_ID_LENGTH},
+	{"MQ_MAX_MCA_USER_ID_LENGTH", MQ_MAX_MCA_USER_ID_LENGTH},
+	{"MQ_MAX_PROPERTY_NAME_LENGTH", MQ_MAX_PROPERTY_NAME_LENGTH},
+	{"MQ_MAX_USER_ID_LENGTH", MQ_MAX_USER_ID_LENGTH},
+	{"MQ_MCA_JOB_NAME_LENGTH", MQ_MCA_JOB_NAME_LENGTH},
+	{"MQ_MCA_NAME_LENGTH", MQ_MCA_NAME_LENGTH},
+	{"MQ_MCA_USER_DATA_LENGTH", MQ_MCA_USER_DATA_LENGTH},
+	{"MQ_MCA_USER_ID_LENGTH", MQ_MCA_USER_ID_LENGTH},
+	{"MQ_MFS_MAP_NAME_LENGTH", MQ_MFS_MAP_NAME_LENGTH},
+	{"MQ_MODE_NAME_LENGTH", MQ_MODE_NAME_LENGTH},
+	{"MQ_MQTT_MAX_KEEP_ALIVE", MQ_MQTT_MAX_KEEP_ALIVE},
+	{"MQ_MSG_HEADER_LENGTH", MQ_MSG_HEADER_LENGTH},
+	{"MQ_MSG_ID_LENGTH", MQ_MSG_ID_LENGTH},
+	{"MQ_MSG_TOKEN_LENGTH", MQ_MSG_TOKEN_LENGTH},
+	{"MQ_NAMELIST_DESC_LENGTH", MQ_NAMELIST_DESC_LENGTH},
+	{"MQ_NAMELIST_NAME_LENGTH", MQ_NAMELIST_NAME_LENGTH},
+	{"MQ_NHA_GROUP_NAME_LENGTH", MQ_NHA_GROUP_NAME_LENGTH},
+	{"MQ_NHA_INSTANCE_NAME_LENGTH", MQ_NHA_INSTANCE_NAME_LENGTH},
+	{"MQ_NHA_REPL_ADDRESS_LENGTH", MQ_NHA_REPL_ADDRESS_LENGTH},
+	{"MQ_OBJECT_INSTANCE_ID_LENGTH", MQ_OBJECT_INSTANCE_ID_LENGTH},
+	{"MQ_OBJECT_NAME_LENGTH", MQ_OBJECT_NAME_LENGTH},
+	{"MQ_OPERATOR_MESSAGE_LENGTH", MQ_OPERATOR_MESSAGE_LENGTH},
+	{"MQ_ORIGIN_NAME_LENGTH", MQ_ORIGIN_NAME_LENGTH},
+	{"MQ_PASSWORD_LENGTH", MQ_PASSWORD_LENGTH},
+	{"MQ_PASS_TICKET_APPL_LENGTH", MQ_PASS_TICKET_APPL_LENGTH},
+	{"MQ_PROCESS_APPL_ID_LENGTH", MQ_PROCESS_APPL_ID_LENGTH},
+	{"MQ_PROCESS_DESC_LENGTH", MQ_PROCESS_DESC_LENGTH},
+	{"MQ_PROCESS_ENV_DATA_LENGTH", MQ_PROCESS_ENV_DATA_LENGTH},
+	{"MQ_PROCESS_NAME_LENGTH", MQ_PROCESS_NAME_LENGTH},
+	{"MQ_PROCESS_USER_DATA_LENGTH", MQ_PROCESS_USER_DATA_LENGTH},
+	{"MQ_PROGRAM_NAME_LENGTH", MQ_PROGRAM_NAME_LENGTH},
+	{"MQ_PSB_NAME_LENGTH", MQ_PSB_NAME_LENGTH},
+	{"MQ_PST_ID_LENGTH", MQ_PST_ID_LENGTH},
+	{"MQ_PUT_APPL_NAME_LENGTH", MQ_PUT_APPL_NAME_LENGTH},
+	{"MQ_PUT_DATE_LENGTH", MQ_PUT_DATE_LENGTH},
+	{"MQ_PUT_TIME_LENGTH", MQ_PUT_TIME_LENGTH},
+	{"MQ_QSG_NAME_LENGTH", MQ_QSG_NAME_LENGTH},
+	{"MQ_Q_DESC_LENGTH", MQ_Q_DESC_LENGTH},
+	{"MQ_Q_MGR_CPF_LENGTH", MQ_Q_MGR_CPF_LENGTH},
+	{"MQ_Q_MGR_DATA_PATH_LENGTH", MQ_Q_MGR_DATA_PATH_LENGTH},
+	{"MQ_Q_MGR_DESC_LENGTH", MQ_Q_MGR_DESC_LENGTH},
+	{"MQ_Q_MGR_IDENTIFIER_LENGTH", MQ_Q_MGR_IDENTIFIER_LENGTH},
+	{"MQ_Q_MGR_NAME_LENGTH", MQ_Q_MGR_NAME_LENGTH},
+	{"MQ_Q_NAME_LENGTH", MQ_Q_NAME_LENGTH},
+	{"MQ_RBA_LENGTH", MQ_RBA_LENGTH},
+	{"MQ_REMOTE_PRODUCT_LENGTH", MQ_REMOTE_PRODUCT_LENGTH},
+	{"MQ_REMOTE_SYS_ID_LENGTH", MQ_REMOTE_SYS_ID_LENGTH},
+	{"MQ_REMOTE_VERSION_LENGTH", MQ_REMOTE_VERSION_LENGTH},
+	{"MQ_RESPONSE_ID_LENGTH", MQ_RESPONSE_ID_LENGTH},
+	{"MQ_SECURITY_ID_LENGTH", MQ_SECURITY_ID_LENGTH},
+	{"MQ_SECURITY_PROFILE_LENGTH", MQ_SECURITY_PROFILE_LENGTH},
+	{"MQ_SELECTOR_LENGTH", MQ_SELECTOR_LENGTH},
+	{"MQ_SERVICE_ARGS_LENGTH", MQ_SERVICE_ARGS_LENGTH},
+	{"MQ_SERVICE_COMMAND_LENGTH", MQ_SERVICE_COMMAND_LENGTH},
+	{"MQ_SERVICE_COMPONENT_LENGTH", MQ_SERVICE_COMPONENT_LENGTH},
+	{"MQ_SERVICE_DESC_LENGTH", MQ_SERVICE_DESC_LENGTH},
+	{"MQ_SERVICE_NAME_LENGTH", MQ_SERVICE_NAME_LENGTH},
+	{"MQ_SERVICE_PATH_LENGTH", MQ_SERVICE_PATH_LENGTH},
+	{"MQ_SERVICE_STEP_LENGTH", MQ_SERVICE_STEP_LENGTH},
+	{"MQ_SHORT_CONN_NAME_LENGTH", MQ_SHORT_CONN_NAME_LENGTH},
+	{"MQ_SHORT_DNAME_LENGTH", MQ_SHORT_DNAME_LENGTH},
+	{"MQ_SMDS_NAME_LENGTH", MQ_SMDS_NAME_LENGTH},
+	{"MQ_SSL_CIPHER_SPEC_LENGTH", MQ_SSL_CIPHER_SPEC_LENGTH},
+	{"MQ_SSL_CIPHER_SUITE_LENGTH", MQ_SSL_CIPHER_SUITE_LENGTH},
+	{"MQ_SSL_CRYPTO_HARDWARE_LENGTH", MQ_SSL_CRYPTO_HARDWARE_LENGTH},
+	{"MQ_SSL_ENCRYP_KEY_REPO_PWD_LEN", MQ_SSL_ENCRYP_KEY_REPO_PWD_LEN},
+	{"MQ_SSL_HANDSHAKE_STAGE_LENGTH", MQ_SSL_HANDSHAKE_STAGE_LENGTH},
+	{"MQ_SSL_KEY_LIBRARY_LENGTH", MQ_SSL_KEY_LIBRARY_LENGTH},
+	{"MQ_SSL_KEY_MEMBER_LENGTH", MQ_SSL_KEY_MEMBER_LENGTH},
+	{"MQ_SSL_KEY_PASSPHRASE_LENGTH", MQ_SSL_KEY_PASSPHRASE_LENGTH},
+	{"MQ_SSL_KEY_REPOSITORY_LENGTH", MQ_SSL_KEY_REPOSITORY_LENGTH},
+	{"MQ_SSL_KEY_REPO_PWD_LEN", MQ_SSL_KEY_REPO_PWD_LEN},
+	{"MQ_SSL_PEER_NAME_LENGTH", MQ_SSL_PEER_NAME_LENGTH},
+	{"MQ_SSL_SHORT_PEER_NAME_LENGTH", MQ_SSL_SHORT_PEER_NAME_LENGTH},
+	{"MQ_START_CODE_LENGTH", MQ_START_CODE_LENGTH},
+	{"MQ_STORAGE_CLASS_DESC_LENGTH", MQ_STORAGE_CLASS_DESC_LENGTH},
+	{"MQ_STORAGE_CLASS_LENGTH", MQ_STORAGE_CLASS_LENGTH},
+	{"MQ_SUB_IDENTITY_LENGTH", MQ_SUB_IDENTITY_LENGTH},
+	{"MQ_SUB_NAME_LENGTH", MQ_SUB_NAME_LENGTH},
+	{"MQ_SUB_POINT_LENGTH", MQ_SUB_POINT_LENGTH},
+	{"MQ_SUITE_B_128_BIT", MQ_SUITE_B_128_BIT},
+	{"MQ_SUITE_B_192_BIT", MQ_SUITE_B_192_BIT},
+	{"MQ_SUITE_B_NONE", MQ_SUITE_B_NONE},
+	{"MQ_SUITE_B_NOT_AVAILABLE", MQ_SUITE_B_NOT_AVAILABLE},
+	{"MQ_SUITE_B_SIZE", MQ_SUITE_B_SIZE},
+	{"MQ_SYSP_SERVICE_LENGTH", MQ_SYSP_SERVICE_LENGTH},
+	{"MQ_SYSTEM_NAME_LENGTH", MQ_SYSTEM_NAME_LENGTH},
+	{"MQ_TASK_NUMBER_LENGTH", MQ_TASK_NUMBER_LENGTH},
+	{"MQ_TCP_NAME_LENGTH", MQ_TCP_NAME_LENGTH},
+	{"MQ_TEMPORARY_Q_PREFIX_LENGTH", MQ_TEMPORARY_Q_PREFIX_LENGTH},
+	{"MQ_TIME_LENGTH", MQ_TIME_LENGTH},
+	{"MQ_TOPIC_DESC_LENGTH", MQ_TOPIC_DESC_LENGTH},
+	{"MQ_TOPIC_NAME_LENGTH", MQ_TOPIC_NAME_LENGTH},
+	{"MQ_TOPIC_STR_LENGTH", MQ_TOPIC_STR_LENGTH},
+	{"MQ_TOTAL_EXIT_DATA_LENGTH", MQ_TOTAL_EXIT_DATA_LENGTH},
+	{"MQ_TOTAL_EXIT_NAME_LENGTH", MQ_TOTAL_EXIT_NAME_LENGTH},
+	{"MQ_TPIPE_NAME_LENGTH", MQ_TPIPE_NAME_LENGTH},
+	{"MQ_TPIPE_PFX_LENGTH", MQ_TPIPE_PFX_LENGTH},
+	{"MQ_TP_NAME_LENGTH", MQ_TP_NAME_LENGTH},
+	{"MQ_TRANSACTION_ID_LENGTH", MQ_TRANSACTION_ID_LENGTH},
+	{"MQ_TRAN_INSTANCE_ID_LENGTH", MQ_TRAN_INSTANCE_ID_LENGTH},
+	{"MQ_TRIGGER_DATA_LENGTH", MQ_TRIGGER_DATA_LENGTH},
+	{"MQ_TRIGGER_PROGRAM_NAME_LENGTH", MQ_TRIGGER_PROGRAM_NAME_LENGTH},
+	{"MQ_TRIGGER_TERM_ID_LENGTH", MQ_TRIGGER_TERM_ID_LENGTH},
+	{"MQ_TRIGGER_TRANS_ID_LENGTH", MQ_TRIGGER_TRANS_ID_LENGTH},
+	{"MQ_UOW_ID_LENGTH", MQ_UOW_ID_LENGTH},
+	{"MQ_USER_DATA_LENGTH", MQ_USER_DATA_LENGTH},
+	{"MQ_USER_ID_LENGTH", MQ_USER_ID_LENGTH},
+	{"MQ_VERSION_LENGTH", MQ_VERSION_LENGTH},
+	{"MQ_VOLSER_LENGTH", MQ_VOLSER_LENGTH},
+	{"MQ_XCF_GROUP_NAME_LENGTH", MQ_XCF_GROUP_NAME_LENGTH},
+	{"MQ_XCF_MEMBER_NAME_LENGTH", MQ_XCF_MEMBER_NAME_LENGTH},
+}
@@ -0,0 +1,164 @@
+//go:build ignore
+
+package main
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This program creates mqiconstants.go from the cmqc_*.go files. It is run by
+"go generate" whenever a new level of the cmqc files is added to the package.
+
+The int32 definitions are taken in the order they appear in cmqc_linux_amd64.go.
+Every platform's file must define the same names, as the table is built on all of them.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	source = "cmqc_linux_amd64.go"
+	output = "mqiconstants.go"
+)
+
+const header = `// Code generated by mqiconstants_gen.go from the cmqc files. DO NOT EDIT.
+
+package ibmmq
+
+/*
+****************************************************************
+*
+*
+*                     IBM MQ for Go on all platforms
+* FILE NAME:      mqiconstants.go
+*
+* This file lists the names of the integer MQI definitions, so
+* that they can be looked up from strings. The values come from
+* the platform-specific cmqc_*.go files.
+****************************************************************
+* Copyright (c) IBM Corporation 2026
+*
+* Licensed under the Apache License, Version 2.0 (the "License");
+* you may not use this file except in compliance with the License.
+* You may obtain a copy of the License at
+*
+* http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing, software
+* distributed under the License is distributed on an "AS IS" BASIS,
+* WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+* See the License for the specific language governing permissions and
+* limitations under the License.
+****************************************************************
+*
+ */
+
+//go:generate go run mqiconstants_gen.go
+
+type mqiConstant struct {
+	name  string
+	value int32
+}
+
+// In the same order as the cmqc definitions
+var mqiConstants = []mqiConstant{
+`
+
+func main() {
+	names, err := int32Names(source)
+	if err != nil {
+		fail(err)
+	}
+
+	// The table has to compile on every platform
+	files, err := filepath.Glob("cmqc_*.go")
+	if err != nil {
+		fail(err)
+	}
+	for _, f := range files {
+		if f == source {
+			continue
+		}
+		other, err := int32Names(f)
+		if err != nil {
+			fail(err)
+		}
+		if strings.Join(other, " ") != strings.Join(names, " ") {
+			fail(fmt.Errorf("%s does not have the same int32 definitions as %s", f, source))
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	for _, n := range names {
+		fmt.Fprintf(&b, "\t{%q, %s},\n", n, n)
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		fail(err)
+	}
+	if err = os.WriteFile(output, src, 0644); err != nil {
+		fail(err)
+	}
+}
+
+// Return the names of the int32 constants in the file, in the order they are defined
+func int32Names(file string) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if id, ok := vs.Type.(*ast.Ident); !ok || id.Name != "int32" {
+				continue
+			}
+			for _, n := range vs.Names {
+				names = append(names, n.Name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%s: no int32 definitions found", file)
+	}
+	return names, nil
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "mqiconstants_gen: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,219 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file goes in the opposite direction to MQItoString, turning the names
+of MQI constants into their values. That lets configuration files and command
+line options say things like
+
+	MQOO_INPUT_SHARED | MQOO_FAIL_IF_QUIESCING
+
+instead of a number. FormatMQIFlags does the reverse for a value made from bit flags,
+which is useful when logging the options that were used on a verb.
+*/
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Classes that include related sets, in the same way as MQItoString
+var mqiClassFamilies = map[string][]string{
+	"CA": {"CA", "CACF", "CACH", "CAMO"},
+	"IA": {"IA", "IACF", "IACH", "IAMO", "IAMO64"},
+	"RC": {"RC", "RCCF"},
+}
+
+// Names that describe the structures rather than being option values
+var mqiStructureConstant = regexp.MustCompile(`_(CURRENT_VERSION|CURRENT_LENGTH|VERSION_[0-9]+|LENGTH_[0-9]+)$`)
+
+var mqiConstantIndex struct {
+	once    sync.Once
+	byName  map[string]int32
+	byClass map[string][]mqiConstant
+}
+
+func buildConstantIndex() {
+	idx := &mqiConstantIndex
+	idx.byName = make(map[string]int32, len(mqiConstants))
+	idx.byClass = make(map[string][]mqiConstant)
+	for _, c := range mqiConstants {
+		idx.byName[c.name] = c.value
+		class := mqiConstantClass(c.name)
+		idx.byClass[class] = append(idx.byClass[class], c)
+	}
+}
+
+// The class of a constant is the part between "MQ" and the first "_"
+func mqiConstantClass(name string) string {
+	s := strings.TrimPrefix(name, "MQ")
+	if i := strings.IndexByte(s, '_'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// Remove any prefix from the class, as MQItoString does
+func normaliseConstantClass(class string) string {
+	class = strings.ToUpper(strings.TrimSpace(class))
+	if strings.HasPrefix(class, "MQ_") {
+		class = class[3:]
+	} else if strings.HasPrefix(class, "MQ") {
+		class = class[2:]
+	} else if strings.HasPrefix(class, "_") {
+		class = class[1:]
+	}
+	return strings.TrimSuffix(class, "_")
+}
+
+func constantFamily(class string) []string {
+	if f, ok := mqiClassFamilies[class]; ok {
+		return f
+	}
+	return []string{class}
+}
+
+/*
+ParseMQIConstant returns the value of an expression such as "MQOO_INPUT_SHARED | MQOO_FAIL_IF_QUIESCING".
+Each part of the expression can be a full name, a name without the prefix for the
+class (for example "Q_FULL" when the class is "RC"), or a number in decimal or hexadecimal.
+The parts are combined with a bitwise OR. The class can be empty, in which case only full names
+are accepted. Names are not case-sensitive.
+*/
+func ParseMQIConstant(class string, expr string) (int32, error) {
+	mqiConstantIndex.once.Do(buildConstantIndex)
+
+	class = normaliseConstantClass(class)
+	if strings.TrimSpace(expr) == "" {
+		return 0, fmt.Errorf("no value given")
+	}
+
+	value := int32(0)
+	for _, term := range strings.Split(expr, "|") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return 0, fmt.Errorf("empty value in %q", expr)
+		}
+		v, err := parseConstantTerm(class, term)
+		if err != nil {
+			return 0, err
+		}
+		value |= v
+	}
+	return value, nil
+}
+
+func parseConstantTerm(class string, term string) (int32, error) {
+	if c := term[0]; (c >= '0' && c <= '9') || c == '-' || c == '+' {
+		n, err := strconv.ParseInt(term, 0, 64)
+		if err != nil || n < -(1<<31) || n > (1<<32)-1 {
+			return 0, fmt.Errorf("invalid number %q", term)
+		}
+		// Allow flag values such as 0x80000000 to be written as unsigned numbers
+		return int32(uint32(n)), nil
+	}
+
+	name := strings.ToUpper(term)
+	if class == "" {
+		if v, ok := mqiConstantIndex.byName[name]; ok {
+			return v, nil
+		}
+		return 0, fmt.Errorf("unknown MQI constant %s", term)
+	}
+
+	family := constantFamily(class)
+	for _, c := range family {
+		if strings.HasPrefix(name, "MQ"+c+"_") {
+			if v, ok := mqiConstantIndex.byName[name]; ok {
+				return v, nil
+			}
+		}
+	}
+	for _, c := range family {
+		if v, ok := mqiConstantIndex.byName["MQ"+c+"_"+name]; ok {
+			return v, nil
+		}
+	}
+
+	if _, ok := mqiConstantIndex.byName[name]; ok {
+		return 0, fmt.Errorf("%s is not an MQ%s value", term, class)
+	}
+	return 0, fmt.Errorf("unknown MQ%s value %s", class, term)
+}
+
+/*
+FormatMQIFlags splits a value made of bit flags, such as MQOO or MQGMO options, into
+the names of its parts joined with " | ". Bits that have no name are shown
+in hexadecimal. Where several names have the same value, the first in alphabetical order is used.
+*/
+func FormatMQIFlags(class string, value int32) string {
+	mqiConstantIndex.once.Do(buildConstantIndex)
+
+	class = normaliseConstantClass(class)
+	names := make(map[int32]string)
+	zeroName := ""
+	for _, c := range mqiConstantIndex.byClass[class] {
+		if mqiStructureConstant.MatchString(c.name) {
+			continue
+		}
+		if c.value == 0 {
+			if zeroName == "" || strings.HasSuffix(c.name, "_NONE") {
+				zeroName = c.name
+			}
+			continue
+		}
+		// Only single bits
+		if c.value&(c.value-1) != 0 {
+			continue
+		}
+		if _, ok := names[c.value]; !ok {
+			names[c.value] = c.name
+		}
+	}
+
+	if value == 0 {
+		if zeroName != "" {
+			return zeroName
+		}
+		return "0"
+	}
+
+	var parts []string
+	unknown := uint32(0)
+	for i := 0; i < 32; i++ {
+		bit := int32(uint32(1) << uint(i))
+		if value&bit == 0 {
+			continue
+		}
+		if n, ok := names[bit]; ok {
+			parts = append(parts, n)
+		} else {
+			unknown |= uint32(bit)
+		}
+	}
+	if unknown != 0 {
+		parts = append(parts, fmt.Sprintf("0x%08X", unknown))
+	}
+	return strings.Join(parts, " | ")
+}
@@ -0,0 +1,392 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file provides a way to supply the authentication details for a connection
+at the time that the connection is made, instead of fixing them in the MQCSP.
+Set the CredentialProvider field in the MQCNO and Connx will ask for the current
+credentials each time it is called. That includes the connections made by a Pool and the
+reconnections made by a ReconnectingQueueManager, so an expired token is replaced
+without any extra application code.
+
+Reconnections done by the MQ client itself, with MQCNO_RECONNECT, reuse the
+credentials from the original connection and cannot be refreshed in this way.
+*/
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+Credentials are the values that go into the MQCSP. If Token is set, it is used in
+preference to the userid and password.
+*/
+type Credentials struct {
+	UserId   string
+	Password string
+	Token    string
+	Expiry   time.Time // When the credentials stop working. Zero if not known
+}
+
+/*
+CredentialProvider is called by Connx to get the credentials for a new connection
+*/
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+/*
+CredentialError is returned by Connx when the provider cannot give any credentials
+*/
+type CredentialError struct {
+	Err error
+}
+
+func (e *CredentialError) Error() string {
+	return fmt.Sprintf("MQCONNX: cannot get credentials: %v", e.Err)
+}
+
+/*
+Unwrap gives access to the error from the provider
+*/
+func (e *CredentialError) Unwrap() error {
+	return e.Err
+}
+
+// How long a provider has to respond when called from Connx
+const credentialTimeout = 60 * time.Second
+
+// Default for how long before expiry a cached token is replaced
+const defaultRefreshBefore = 60 * time.Second
+
+// Build the CNO that is passed to the MQI, with the MQCSP filled in from the provider.
+// Other fields in any MQCSP that the application set, such as InitialKey, are kept.
+func applyCredentials(gocno *MQCNO) (*MQCNO, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialTimeout)
+	defer cancel()
+
+	creds, err := gocno.CredentialProvider.Credentials(ctx)
+	if err != nil {
+		return nil, &CredentialError{Err: err}
+	}
+
+	cno := *gocno
+	csp := NewMQCSP()
+	if gocno.SecurityParms != nil {
+		*csp = *gocno.SecurityParms
+	}
+
+	if creds.Token != "" {
+		csp.AuthenticationType = MQCSP_AUTH_ID_TOKEN
+		csp.Token = creds.Token
+		csp.UserId = ""
+		csp.Password = ""
+	} else if creds.UserId != "" {
+		csp.AuthenticationType = MQCSP_AUTH_USER_ID_AND_PWD
+		csp.UserId = creds.UserId
+		csp.Password = creds.Password
+		csp.Token = ""
+	}
+	cno.SecurityParms = csp
+
+	return &cno, nil
+}
+
+/*
+StaticCredentialProvider always returns the same values
+*/
+type StaticCredentialProvider struct {
+	UserId   string
+	Password string
+	Token    string
+}
+
+/*
+Credentials implements CredentialProvider
+*/
+func (p *StaticCredentialProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	return &Credentials{UserId: p.UserId, Password: p.Password, Token: p.Token}, nil
+}
+
+/*
+FileCredentialProvider reads a password, or a token, from a file such as a mounted
+Kubernetes secret. The file is read again whenever it has changed.
+*/
+type FileCredentialProvider struct {
+	UserId string // If empty, the file contains a token. Otherwise it is the password for this user
+	File   string
+
+	mutex   sync.Mutex
+	modTime time.Time
+	size    int64
+	creds   *Credentials
+}
+
+/*
+NewFileCredentialProvider creates a provider for the password of the given user, or for a
+token if the user is empty
+*/
+func NewFileCredentialProvider(userId string, file string) *FileCredentialProvider {
+	p := new(FileCredentialProvider)
+	p.UserId = userId
+	p.File = file
+	return p
+}
+
+/*
+Credentials implements CredentialProvider
+*/
+func (p *FileCredentialProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	fi, err := os.Stat(p.File)
+	if err != nil {
+		return nil, err
+	}
+	if p.creds != nil && fi.ModTime().Equal(p.modTime) && fi.Size() == p.size {
+		return p.creds, nil
+	}
+
+	b, err := os.ReadFile(p.File)
+	if err != nil {
+		return nil, err
+	}
+	secret := strings.TrimSpace(string(b))
+	if secret == "" {
+		return nil, fmt.Errorf("%s is empty", p.File)
+	}
+
+	logTrace("FileCredentialProvider: Read new credentials from %s", p.File)
+	p.creds = secretToCredentials(p.UserId, secret)
+	p.modTime = fi.ModTime()
+	p.size = fi.Size()
+	return p.creds, nil
+}
+
+// The secret is a password when there is a user, and a token otherwise
+func secretToCredentials(userId string, secret string) *Credentials {
+	if userId != "" {
+		return &Credentials{UserId: userId, Password: secret}
+	}
+	return &Credentials{Token: secret, Expiry: tokenExpiry(secret)}
+}
+
+// Find the "exp" claim in a JWT. The signature is not checked, as that is the
+// queue manager's job; we only want to know when to get a new token.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if json.Unmarshal(b, &claims) != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+// Shared by the providers that fetch credentials with a limited lifetime
+type cachedCredentials struct {
+	mutex sync.Mutex
+	creds *Credentials
+}
+
+// Return the cached credentials unless they are within refreshBefore of expiring, or have
+// no known expiry. If fetching new ones fails, credentials that have not actually
+// expired are still returned.
+func (c *cachedCredentials) get(ctx context.Context, refreshBefore time.Duration, fetch func(context.Context) (*Credentials, error)) (*Credentials, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	if c.creds != nil && !c.creds.Expiry.IsZero() && c.creds.Expiry.Sub(now) > refreshBefore {
+		return c.creds, nil
+	}
+
+	creds, err := fetch(ctx)
+	if err != nil {
+		if c.creds != nil && c.creds.Expiry.After(now) {
+			logError("Credentials: Refresh failed, using existing credentials: %v", err)
+			return c.creds, nil
+		}
+		return nil, err
+	}
+	c.creds = creds
+	return creds, nil
+}
+
+/*
+TokenEndpointProvider gets a token from an OpenID Connect token endpoint, using the
+client credentials grant, or the password grant if UserName is set. The token is
+kept until it is close to expiring.
+*/
+type TokenEndpointProvider struct {
+	Endpoint      string // For example "https://host:8443/realms/mq/protocol/openid-connect/token"
+	ClientId      string
+	ClientSecret  string
+	UserName      string // Only for the password grant, which is deprecated
+	Password      string
+	Scope         string
+	HTTPClient    *http.Client
+	RefreshBefore time.Duration // Get a new token when the current one expires within this time
+
+	cache cachedCredentials
+}
+
+/*
+NewTokenEndpointProvider creates a provider that uses the client credentials grant
+*/
+func NewTokenEndpointProvider(endpoint string, clientId string, clientSecret string) *TokenEndpointProvider {
+	p := new(TokenEndpointProvider)
+	p.Endpoint = endpoint
+	p.ClientId = clientId
+	p.ClientSecret = clientSecret
+	p.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	p.RefreshBefore = defaultRefreshBefore
+	return p
+}
+
+/*
+Credentials implements CredentialProvider
+*/
+func (p *TokenEndpointProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	return p.cache.get(ctx, p.RefreshBefore, p.fetch)
+}
+
+func (p *TokenEndpointProvider) fetch(ctx context.Context) (*Credentials, error) {
+	form := url.Values{"client_id": {p.ClientId}}
+	if p.UserName != "" {
+		form.Set("grant_type", "password")
+		form.Set("username", p.UserName)
+		form.Set("password", p.Password)
+	} else {
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_secret", p.ClientSecret)
+	}
+	if p.Scope != "" {
+		form.Set("scope", p.Scope)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.Endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+
+	var tr struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, fmt.Errorf("cannot parse token endpoint response: %v", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("token endpoint response has no access_token")
+	}
+
+	creds := &Credentials{Token: tr.AccessToken}
+	if tr.ExpiresIn > 0 {
+		creds.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	} else {
+		creds.Expiry = tokenExpiry(tr.AccessToken)
+	}
+	logTrace("TokenEndpointProvider: New token expires at %v", creds.Expiry)
+	return creds, nil
+}
+
+/*
+ExecCredentialProvider runs a command that writes a secret to stdout. As with
+FileCredentialProvider, the secret is a token unless UserId is set. A token that is
+a JWT is kept until it is close to expiring; otherwise the command is run for every connection.
+*/
+type ExecCredentialProvider struct {
+	UserId        string
+	Command       string
+	Args          []string
+	RefreshBefore time.Duration
+
+	cache cachedCredentials
+}
+
+/*
+NewExecCredentialProvider creates a provider that runs the command with its arguments
+*/
+func NewExecCredentialProvider(userId string, command string, args ...string) *ExecCredentialProvider {
+	p := new(ExecCredentialProvider)
+	p.UserId = userId
+	p.Command = command
+	p.Args = args
+	p.RefreshBefore = defaultRefreshBefore
+	return p
+}
+
+/*
+Credentials implements CredentialProvider
+*/
+func (p *ExecCredentialProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	return p.cache.get(ctx, p.RefreshBefore, func(ctx context.Context) (*Credentials, error) {
+		out, err := exec.CommandContext(ctx, p.Command, p.Args...).Output()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", p.Command, err)
+		}
+		secret := strings.TrimSpace(string(out))
+		if secret == "" {
+			return nil, fmt.Errorf("%s: no output", p.Command)
+		}
+		return secretToCredentials(p.UserId, secret), nil
+	})
+}
@@ -0,0 +1,785 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file formats a message in the style of the amqsbcg sample program: the MQMD,
+any MQ headers at the start of the message, the message properties and a hex dump of the
+body. It is intended for diagnostic tools and for attaching to problem reports.
+
+The headers are found by following the Format, Encoding and CodedCharSetId fields
+from one structure to the next, starting with the MQMD. The MQDLH, MQXQH, MQRFH, MQRFH2
+and MQMDE are decoded fully. Other headers that start with the standard fields
+(StrucId, Version, StrucLength, Encoding, CodedCharSetId, Format and Flags) such as the
+MQCIH have just those fields shown. Nothing in the buffer is trusted: if a
+header is too short or its length is wrong, decoding stops and everything from that point
+is shown as the body.
+
+The data is read the same way regardless of the platform the program runs on, so a message
+from z/OS can be dumped on Linux with its EBCDIC text shown as readable characters, using
+the converters in mqiccsid.go.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+DumpFormat selects the style of output from MessageDump
+*/
+type DumpFormat int
+
+const (
+	DumpText     DumpFormat = iota // Similar to amqsbcg
+	DumpJSON                       // A single JSON object
+	DumpMarkdown                   // Tables, suitable for pasting into an issue
+)
+
+/*
+DumpOptions control what is written by MessageDump
+*/
+type DumpOptions struct {
+	Format       DumpFormat
+	MaxData      int // Largest number of bytes of the body to show. 0 shows all of it
+	BytesPerLine int // Width of the hex dump
+}
+
+/*
+NewDumpOptions fills in default values for the DumpOptions structure
+*/
+func NewDumpOptions() *DumpOptions {
+	o := new(DumpOptions)
+	o.Format = DumpText
+	o.MaxData = 0
+	o.BytesPerLine = 16
+	return o
+}
+
+/*
+DumpField is one named value in a formatted structure
+*/
+type DumpField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+/*
+DumpHeader is an MQ header found at the start of the message data
+*/
+type DumpHeader struct {
+	Name   string      `json:"name"` // For example "MQDLH"
+	Offset int         `json:"offset"`
+	Length int         `json:"length"`
+	Fields []DumpField `json:"fields"`
+}
+
+/*
+MessageDump holds a message broken into the parts that are shown by Write
+*/
+type MessageDump struct {
+	MD         *MQMD
+	Headers    []DumpHeader
+	Properties map[string]interface{}
+	Data       []byte // The whole buffer, including the headers
+
+	// Where the body starts after the headers, and how to interpret it
+	BodyOffset         int
+	BodyFormat         string
+	BodyEncoding       int32
+	BodyCodedCharSetId int32
+
+	// Why decoding the headers stopped early, if it did
+	HeaderError string
+}
+
+/*
+NewMessageDump decodes the headers in the message. The properties can be nil, or
+taken from a message handle using its Properties method.
+*/
+func NewMessageDump(md *MQMD, props map[string]interface{}, buf []byte) *MessageDump {
+	if md == nil {
+		md = NewMQMD()
+	}
+	d := &MessageDump{MD: md, Properties: props, Data: buf}
+	d.decodeHeaders()
+	return d
+}
+
+/*
+FormatMessage is a convenience function that returns the dump of a message as a string.
+A nil opts uses the defaults, which give the text format.
+*/
+func FormatMessage(md *MQMD, props map[string]interface{}, buf []byte, opts *DumpOptions) string {
+	var b strings.Builder
+	NewMessageDump(md, props, buf).Write(&b, opts)
+	return b.String()
+}
+
+/*
+Write sends the formatted message to w. A nil opts uses the defaults.
+*/
+func (d *MessageDump) Write(w io.Writer, opts *DumpOptions) error {
+	if opts == nil {
+		opts = NewDumpOptions()
+	}
+	if opts.BytesPerLine <= 0 {
+		opts.BytesPerLine = 16
+	}
+
+	switch opts.Format {
+	case DumpJSON:
+		return d.writeJSON(w, opts)
+	case DumpMarkdown:
+		return d.writeMarkdown(w, opts)
+	default:
+		return d.writeText(w, opts)
+	}
+}
+
+/*
+Properties returns all of the message properties in the handle, keyed by name
+*/
+func (handle *MQMessageHandle) Properties() (map[string]interface{}, error) {
+	props := make(map[string]interface{})
+
+	impo := NewMQIMPO()
+	pd := NewMQPD()
+	impo.Options = MQIMPO_CONVERT_VALUE | MQIMPO_INQ_FIRST
+	for {
+		name, value, err := handle.InqMP(impo, pd, "%")
+		if err != nil {
+			if mqret, ok := err.(*MQReturn); ok && mqret.MQRC == MQRC_PROPERTY_NOT_AVAILABLE {
+				break
+			}
+			return props, err
+		}
+		props[name] = value
+		impo.Options = MQIMPO_CONVERT_VALUE | MQIMPO_INQ_NEXT
+	}
+	return props, nil
+}
+
+// The part of the body that is shown, and its total length
+func (d *MessageDump) body(opts *DumpOptions) ([]byte, int) {
+	b := d.Data[d.BodyOffset:]
+	total := len(b)
+	if opts.MaxData > 0 && len(b) > opts.MaxData {
+		b = b[:opts.MaxData]
+	}
+	return b, total
+}
+
+// ----------------------------------------------------------------------
+// Decoding the headers
+
+// Reads fields from a header. The caller checks that the buffer is long
+// enough for the fixed part of each structure before reading it.
+type dumpReader struct {
+	buf   []byte
+	off   int
+	order binary.ByteOrder
+	ccsid int32
+}
+
+func (r *dumpReader) int32() int32 {
+	if r.off+4 > len(r.buf) {
+		r.off = len(r.buf)
+		return 0
+	}
+	v := int32(r.order.Uint32(r.buf[r.off:]))
+	r.off += 4
+	return v
+}
+
+func (r *dumpReader) bytes(n int) []byte {
+	if r.off+n > len(r.buf) {
+		n = len(r.buf) - r.off
+	}
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *dumpReader) string(n int) string {
+	return dumpString(r.bytes(n), r.ccsid)
+}
+
+// The integer encoding says which way round the numbers are. If it
+// is not set, assume the numbers are in the local format.
+func dumpByteOrder(encoding int32) binary.ByteOrder {
+	switch encoding & MQENC_INTEGER_MASK {
+	case MQENC_INTEGER_REVERSED:
+		return binary.LittleEndian
+	case MQENC_INTEGER_NORMAL:
+		return binary.BigEndian
+	}
+	return endian
+}
+
+// Length of the standard fields at the start of most headers
+const dumpStdHeaderLength = 36
+
+func (d *MessageDump) decodeHeaders() {
+	format := d.MD.Format
+	encoding := d.MD.Encoding
+	ccsid := d.MD.CodedCharSetId
+	off := 0
+
+	for off < len(d.Data) {
+		f := strings.TrimRight(format, " ")
+		if !isDumpHeaderFormat(f) {
+			break
+		}
+
+		r := &dumpReader{buf: d.Data, off: off, order: dumpByteOrder(encoding), ccsid: ccsid}
+		remaining := len(d.Data) - off
+		if remaining < 8 {
+			d.HeaderError = fmt.Sprintf("format %s at offset %d but only %d bytes remain", f, off, remaining)
+			break
+		}
+
+		strucId := dumpString(d.Data[off:off+4], ccsid)
+		hdr := DumpHeader{Name: "MQ" + strings.TrimRight(strucId, " "), Offset: off}
+		var next struct {
+			format   string
+			encoding int32
+			ccsid    int32
+		}
+		var err error
+
+		switch f {
+		case MQFMT_DEAD_LETTER_HEADER:
+			err = decodeDLH(r, &hdr, &next.format, &next.encoding, &next.ccsid)
+		case MQFMT_XMIT_Q_HEADER:
+			err = decodeXQH(r, &hdr, &next.format, &next.encoding, &next.ccsid)
+		default:
+			err = decodeStdHeader(r, &hdr, &next.format, &next.encoding, &next.ccsid)
+		}
+		if err != nil {
+			d.HeaderError = fmt.Sprintf("cannot decode %s at offset %d: %v", hdr.Name, off, err)
+			break
+		}
+
+		d.Headers = append(d.Headers, hdr)
+		off += hdr.Length
+		format = next.format
+		encoding = next.encoding
+		if next.ccsid != MQCCSI_INHERIT {
+			ccsid = next.ccsid
+		}
+	}
+
+	d.BodyOffset = off
+	d.BodyFormat = strings.TrimRight(format, " ")
+	d.BodyEncoding = encoding
+	d.BodyCodedCharSetId = ccsid
+}
+
+// Formats that are known to be followed by an MQ header
+func isDumpHeaderFormat(f string) bool {
+	switch f {
+	case MQFMT_DEAD_LETTER_HEADER, MQFMT_XMIT_Q_HEADER, MQFMT_CICS, MQFMT_IMS:
+		return true
+	}
+	// All of the other MQ headers have formats starting "MQH"
+	return strings.HasPrefix(f, "MQH") && f != MQFMT_IMS_VAR_STRING
+}
+
+func checkStrucId(r *dumpReader, want string) error {
+	got := dumpString(r.buf[r.off:r.off+4], r.ccsid)
+	if got != want {
+		return fmt.Errorf("StrucId is '%s', expected '%s'", got, want)
+	}
+	return nil
+}
+
+func decodeDLH(r *dumpReader, hdr *DumpHeader, format *string, encoding *int32, ccsid *int32) error {
+	if len(r.buf)-r.off < int(MQDLH_CURRENT_LENGTH) {
+		return fmt.Errorf("only %d bytes remain", len(r.buf)-r.off)
+	}
+	if err := checkStrucId(r, "DLH"); err != nil {
+		return err
+	}
+	start := r.off
+	r.bytes(4)
+	hdr.Fields = append(hdr.Fields,
+		intField("Version", r.int32()),
+		symbolField("Reason", "RC", r.int32()),
+		stringField("DestQName", r.string(int(MQ_Q_NAME_LENGTH))),
+		stringField("DestQMgrName", r.string(int(MQ_Q_MGR_NAME_LENGTH))))
+	*encoding = r.int32()
+	*ccsid = r.int32()
+	*format = r.string(int(MQ_FORMAT_LENGTH))
+	hdr.Fields = append(hdr.Fields,
+		intField("Encoding", *encoding),
+		intField("CodedCharSetId", *ccsid),
+		stringField("Format", *format),
+		symbolField("PutApplType", "AT", r.int32()),
+		stringField("PutApplName", r.string(int(MQ_PUT_APPL_NAME_LENGTH))),
+		stringField("PutDate", r.string(int(MQ_PUT_DATE_LENGTH))),
+		stringField("PutTime", r.string(int(MQ_PUT_TIME_LENGTH))))
+	hdr.Length = r.off - start
+	return nil
+}
+
+// The MQXQH contains a version 1 MQMD, which describes the rest of the message
+func decodeXQH(r *dumpReader, hdr *DumpHeader, format *string, encoding *int32, ccsid *int32) error {
+	if len(r.buf)-r.off < int(MQXQH_CURRENT_LENGTH) {
+		return fmt.Errorf("only %d bytes remain", len(r.buf)-r.off)
+	}
+	if err := checkStrucId(r, "XQH"); err != nil {
+		return err
+	}
+	start := r.off
+	r.bytes(4)
+	hdr.Fields = append(hdr.Fields,
+		intField("Version", r.int32()),
+		stringField("RemoteQName", r.string(int(MQ_Q_NAME_LENGTH))),
+		stringField("RemoteQMgrName", r.string(int(MQ_Q_MGR_NAME_LENGTH))))
+
+	if err := checkStrucId(r, "MD"); err != nil {
+		return fmt.Errorf("MsgDesc %v", err)
+	}
+	md := decodeMD1(r)
+	for _, f := range mdFields(md) {
+		f.Name = "MsgDesc." + f.Name
+		hdr.Fields = append(hdr.Fields, f)
+	}
+	*format = md.Format
+	*encoding = md.Encoding
+	*ccsid = md.CodedCharSetId
+	hdr.Length = r.off - start
+	return nil
+}
+
+func decodeMD1(r *dumpReader) *MQMD {
+	md := new(MQMD)
+	r.bytes(4)
+	md.Version = r.int32()
+	md.Report = r.int32()
+	md.MsgType = r.int32()
+	md.Expiry = r.int32()
+	md.Feedback = r.int32()
+	md.Encoding = r.int32()
+	md.CodedCharSetId = r.int32()
+	md.Format = r.string(int(MQ_FORMAT_LENGTH))
+	md.Priority = r.int32()
+	md.Persistence = r.int32()
+	md.MsgId = r.bytes(int(MQ_MSG_ID_LENGTH))
+	md.CorrelId = r.bytes(int(MQ_CORREL_ID_LENGTH))
+	md.BackoutCount = r.int32()
+	md.ReplyToQ = r.string(int(MQ_Q_NAME_LENGTH))
+	md.ReplyToQMgr = r.string(int(MQ_Q_MGR_NAME_LENGTH))
+	md.UserIdentifier = r.string(int(MQ_USER_ID_LENGTH))
+	md.AccountingToken = r.bytes(int(MQ_ACCOUNTING_TOKEN_LENGTH))
+	md.ApplIdentityData = r.string(int(MQ_APPL_IDENTITY_DATA_LENGTH))
+	md.PutApplType = r.int32()
+	md.PutApplName = r.string(int(MQ_PUT_APPL_NAME_LENGTH))
+	md.PutDate = r.string(int(MQ_PUT_DATE_LENGTH))
+	md.PutTime = r.string(int(MQ_PUT_TIME_LENGTH))
+	md.ApplOriginData = r.string(int(MQ_APPL_ORIGIN_DATA_LENGTH))
+	return md
+}
+
+// Headers that begin with StrucId, Version, StrucLength, Encoding, CodedCharSetId,
+// Format and Flags. Some of them have more fields that are decoded here.
+func decodeStdHeader(r *dumpReader, hdr *DumpHeader, format *string, encoding *int32, ccsid *int32) error {
+	if len(r.buf)-r.off < int(MQRFH_STRUC_LENGTH_FIXED) {
+		return fmt.Errorf("only %d bytes remain", len(r.buf)-r.off)
+	}
+	start := r.off
+	strucId := r.string(4)
+	version := r.int32()
+	strucLength := r.int32()
+
+	// The RFH version 1 has no NameValueCCSID so its fixed part is shorter
+	// than the standard header
+	minLength := dumpStdHeaderLength
+	if strucId == "RFH" && version == MQRFH_VERSION_1 {
+		minLength = int(MQRFH_STRUC_LENGTH_FIXED)
+	}
+	if int(strucLength) < minLength || int(strucLength) > len(r.buf)-start {
+		return fmt.Errorf("StrucLength %d is not valid", strucLength)
+	}
+	*encoding = r.int32()
+	*ccsid = r.int32()
+	*format = r.string(int(MQ_FORMAT_LENGTH))
+
+	if strucId == "RFH" && version == MQRFH_VERSION_2 {
+		hdr.Name = "MQRFH2"
+	}
+	hdr.Length = int(strucLength)
+	hdr.Fields = append(hdr.Fields,
+		intField("Version", version),
+		intField("StrucLength", strucLength),
+		intField("Encoding", *encoding),
+		intField("CodedCharSetId", *ccsid),
+		stringField("Format", *format))
+
+	end := start + int(strucLength)
+	switch {
+	case strucId == "RFH" && version == MQRFH_VERSION_1:
+		hdr.Fields = append(hdr.Fields, intField("Flags", r.int32()))
+		if r.off < end {
+			hdr.Fields = append(hdr.Fields, stringField("NameValueString", dumpString(r.buf[r.off:end], r.ccsid)))
+		}
+
+	case hdr.Name == "MQRFH2":
+		hdr.Fields = append(hdr.Fields, intField("Flags", r.int32()))
+		nvCCSID := r.int32()
+		hdr.Fields = append(hdr.Fields, intField("NameValueCCSID", nvCCSID))
+		for i := 1; r.off+4 <= end; i++ {
+			l := int(r.int32())
+			if l < 0 || r.off+l > end {
+				return fmt.Errorf("NameValueLength %d is not valid", l)
+			}
+			hdr.Fields = append(hdr.Fields, stringField("NameValueData."+strconv.Itoa(i), dumpString(r.buf[r.off:r.off+l], nvCCSID)))
+			r.off += l
+		}
+
+	case strucId == "MDE" && strucLength >= MQMDE_LENGTH_2:
+		hdr.Fields = append(hdr.Fields,
+			intField("Flags", r.int32()),
+			bytesField("GroupId", r.bytes(int(MQ_GROUP_ID_LENGTH))),
+			intField("MsgSeqNumber", r.int32()),
+			intField("Offset", r.int32()),
+			flagsField("MsgFlags", "MF", r.int32()),
+			intField("OriginalLength", r.int32()))
+
+	default:
+		hdr.Fields = append(hdr.Fields, intField("Flags", r.int32()))
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------
+// Field formatting
+
+func intField(name string, v int32) DumpField {
+	return DumpField{name, strconv.Itoa(int(v))}
+}
+
+func stringField(name string, v string) DumpField {
+	return DumpField{name, "'" + v + "'"}
+}
+
+func bytesField(name string, v []byte) DumpField {
+	return DumpField{name, "X'" + strings.ToUpper(hex.EncodeToString(v)) + "'"}
+}
+
+// An enumerated value is shown with its name if there is one
+func symbolField(name string, class string, v int32) DumpField {
+	s := MQItoString(class, int(v))
+	if s == "" {
+		return intField(name, v)
+	}
+	return DumpField{name, fmt.Sprintf("%s [%d]", s, v)}
+}
+
+func flagsField(name string, class string, v int32) DumpField {
+	return DumpField{name, fmt.Sprintf("%s [%d]", FormatMQIFlags(class, v), v)}
+}
+
+func mdFields(md *MQMD) []DumpField {
+	f := []DumpField{
+		intField("Version", md.Version),
+		flagsField("Report", "RO", md.Report),
+		symbolField("MsgType", "MT", md.MsgType),
+		intField("Expiry", md.Expiry),
+		symbolField("Feedback", "FB", md.Feedback),
+		intField("Encoding", md.Encoding),
+		intField("CodedCharSetId", md.CodedCharSetId),
+		stringField("Format", strings.TrimRight(md.Format, " ")),
+		intField("Priority", md.Priority),
+		symbolField("Persistence", "PER", md.Persistence),
+		bytesField("MsgId", md.MsgId),
+		bytesField("CorrelId", md.CorrelId),
+		intField("BackoutCount", md.BackoutCount),
+		stringField("ReplyToQ", md.ReplyToQ),
+		stringField("ReplyToQMgr", md.ReplyToQMgr),
+		stringField("UserIdentifier", md.UserIdentifier),
+		bytesField("AccountingToken", md.AccountingToken),
+		stringField("ApplIdentityData", md.ApplIdentityData),
+		symbolField("PutApplType", "AT", md.PutApplType),
+		stringField("PutApplName", md.PutApplName),
+		stringField("PutDate", md.PutDate),
+		stringField("PutTime", md.PutTime),
+	}
+	if !md.PutDateTime.IsZero() {
+		f = append(f, DumpField{"PutDateTime", timeToJSON(md.PutDateTime)})
+	}
+	f = append(f, stringField("ApplOriginData", md.ApplOriginData))
+	if md.Version >= MQMD_VERSION_2 {
+		f = append(f,
+			bytesField("GroupId", md.GroupId),
+			intField("MsgSeqNumber", md.MsgSeqNumber),
+			intField("Offset", md.Offset),
+			flagsField("MsgFlags", "MF", md.MsgFlags),
+			intField("OriginalLength", md.OriginalLength))
+	}
+	return f
+}
+
+// Property values are shown the same way as the MQMD fields
+func propertyFields(props map[string]interface{}) []DumpField {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	f := make([]DumpField, 0, len(names))
+	for _, name := range names {
+		f = append(f, propertyField(name, props[name]))
+	}
+	return f
+}
+
+func propertyField(name string, v interface{}) DumpField {
+	switch pv := v.(type) {
+	case string:
+		return stringField(name, pv)
+	case []byte:
+		return bytesField(name, pv)
+	case nil:
+		return DumpField{name, "NULL"}
+	}
+	return DumpField{name, fmt.Sprint(v)}
+}
+
+// ----------------------------------------------------------------------
+// Character sets
+
+// Convert a string field to something printable, removing the padding. If the
+// CCSID is not known, assume the field is ASCII or UTF-8.
+func dumpString(b []byte, ccsid int32) string {
+	s := decodeFixedString(b, ccsid)
+	if !utf8.ValidString(s) {
+		s = string(dumpChars([]byte(s), 0))
+	}
+	return strings.TrimRight(s, " ")
+}
+
+// One printable character for each byte of a hex dump. Single-byte codepages
+// such as EBCDIC are converted; anything else is shown as ASCII.
+func dumpChars(b []byte, ccsid int32) []byte {
+	c := make([]byte, len(b))
+	t := sbcsTable(ccsid)
+	for i, x := range b {
+		r := rune(x)
+		if t != nil {
+			r = t[x]
+		}
+		if r >= 0x20 && r < 0x7F {
+			c[i] = byte(r)
+		} else {
+			c[i] = '.'
+		}
+	}
+	return c
+}
+
+// Lines in the style of amqsbcg, with pairs of bytes in hex and the
+// characters at the end
+func hexDumpLines(b []byte, perLine int, ccsid int32) []string {
+	var lines []string
+	width := perLine*2 + (perLine+1)/2
+	for off := 0; off < len(b); off += perLine {
+		end := off + perLine
+		if end > len(b) {
+			end = len(b)
+		}
+		chunk := b[off:end]
+
+		var h strings.Builder
+		for i := 0; i < len(chunk); i += 2 {
+			h.WriteString(strings.ToUpper(hex.EncodeToString(chunk[i:min2(i+2, len(chunk))])))
+			h.WriteByte(' ')
+		}
+		lines = append(lines, fmt.Sprintf("%08X:  %-*s '%s'", off, width, h.String(), dumpChars(chunk, ccsid)))
+	}
+	return lines
+}
+
+func min2(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// ----------------------------------------------------------------------
+// Output formats
+
+func writeTextFields(w io.Writer, indent string, fields []DumpField) {
+	width := 0
+	for _, f := range fields {
+		if len(f.Name) > width {
+			width = len(f.Name)
+		}
+	}
+	for _, f := range fields {
+		fmt.Fprintf(w, "%s%-*s : %s\n", indent, width, f.Name, f.Value)
+	}
+}
+
+func (d *MessageDump) bodySummary(shown int, total int) string {
+	return fmt.Sprintf("length - %d of %d bytes, offset %d, format '%s', encoding %d, CCSID %d",
+		shown, total, d.BodyOffset, d.BodyFormat, d.BodyEncoding, d.BodyCodedCharSetId)
+}
+
+func (d *MessageDump) writeText(w io.Writer, opts *DumpOptions) error {
+	bw := &bytes.Buffer{}
+
+	fmt.Fprintf(bw, "****Message descriptor****\n\n")
+	writeTextFields(bw, "  ", mdFields(d.MD))
+
+	if len(d.Headers) > 0 || d.HeaderError != "" {
+		fmt.Fprintf(bw, "\n****Message headers****\n")
+		for _, h := range d.Headers {
+			fmt.Fprintf(bw, "\n  %s at offset %d, length %d\n", h.Name, h.Offset, h.Length)
+			writeTextFields(bw, "    ", h.Fields)
+		}
+		if d.HeaderError != "" {
+			fmt.Fprintf(bw, "\n  Error: %s\n", d.HeaderError)
+		}
+	}
+
+	fmt.Fprintf(bw, "\n****Message properties****\n\n")
+	if len(d.Properties) == 0 {
+		fmt.Fprintf(bw, "  None\n")
+	} else {
+		writeTextFields(bw, "  ", propertyFields(d.Properties))
+	}
+
+	b, total := d.body(opts)
+	fmt.Fprintf(bw, "\n****Message data****\n\n  %s\n\n", d.bodySummary(len(b), total))
+	for _, l := range hexDumpLines(b, opts.BytesPerLine, d.BodyCodedCharSetId) {
+		fmt.Fprintln(bw, l)
+	}
+
+	_, err := w.Write(bw.Bytes())
+	return err
+}
+
+// Markdown tables need the '|' in values such as option lists escaped
+func writeMarkdownFields(w io.Writer, heading string, fields []DumpField) {
+	fmt.Fprintf(w, "| %s | Value |\n| --- | --- |\n", heading)
+	for _, f := range fields {
+		fmt.Fprintf(w, "| %s | `%s` |\n", f.Name, strings.ReplaceAll(f.Value, "|", "\\|"))
+	}
+}
+
+func (d *MessageDump) writeMarkdown(w io.Writer, opts *DumpOptions) error {
+	bw := &bytes.Buffer{}
+
+	fmt.Fprintf(bw, "## Message descriptor\n\n")
+	writeMarkdownFields(bw, "Field", mdFields(d.MD))
+
+	if len(d.Headers) > 0 || d.HeaderError != "" {
+		fmt.Fprintf(bw, "\n## Message headers\n")
+		for _, h := range d.Headers {
+			fmt.Fprintf(bw, "\n### %s (offset %d, length %d)\n\n", h.Name, h.Offset, h.Length)
+			writeMarkdownFields(bw, "Field", h.Fields)
+		}
+		if d.HeaderError != "" {
+			fmt.Fprintf(bw, "\n**Error:** %s\n", d.HeaderError)
+		}
+	}
+
+	fmt.Fprintf(bw, "\n## Message properties\n\n")
+	if len(d.Properties) == 0 {
+		fmt.Fprintf(bw, "None\n")
+	} else {
+		writeMarkdownFields(bw, "Name", propertyFields(d.Properties))
+	}
+
+	b, total := d.body(opts)
+	fmt.Fprintf(bw, "\n## Message data\n\n%s\n\n```\n", d.bodySummary(len(b), total))
+	for _, l := range hexDumpLines(b, opts.BytesPerLine, d.BodyCodedCharSetId) {
+		fmt.Fprintln(bw, l)
+	}
+	fmt.Fprintf(bw, "```\n")
+
+	_, err := w.Write(bw.Bytes())
+	return err
+}
+
+type jsonDumpData struct {
+	Offset         int    `json:"offset"`
+	Length         int    `json:"length"`
+	Shown          int    `json:"shown"`
+	Format         string `json:"format"`
+	Encoding       int32  `json:"encoding"`
+	CodedCharSetId int32  `json:"codedCharSetId"`
+	Hex            string `json:"hex"`
+	Text           string `json:"text"`
+}
+
+type jsonDump struct {
+	MD          *MQMD                  `json:"md"`
+	Headers     []DumpHeader           `json:"headers,omitempty"`
+	HeaderError string                 `json:"headerError,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+	Data        jsonDumpData           `json:"data"`
+}
+
+func (d *MessageDump) writeJSON(w io.Writer, opts *DumpOptions) error {
+	b, total := d.body(opts)
+	j := jsonDump{
+		MD:          d.MD,
+		Headers:     d.Headers,
+		HeaderError: d.HeaderError,
+		Data: jsonDumpData{
+			Offset:         d.BodyOffset,
+			Length:         total,
+			Shown:          len(b),
+			Format:         d.BodyFormat,
+			Encoding:       d.BodyEncoding,
+			CodedCharSetId: d.BodyCodedCharSetId,
+			Hex:            hex.EncodeToString(b),
+			Text:           string(dumpChars(b, d.BodyCodedCharSetId)),
+		},
+	}
+
+	// Byte array properties are shown in hex, as they are elsewhere
+	if len(d.Properties) > 0 {
+		j.Properties = make(map[string]interface{}, len(d.Properties))
+		for k, v := range d.Properties {
+			if b, ok := v.([]byte); ok {
+				v = hex.EncodeToString(b)
+			}
+			j.Properties[k] = v
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(j)
+}
@@ -0,0 +1,329 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file makes the MQReturn error easier to work with. Sentinel errors can be
+used with errors.Is instead of converting the error to an MQReturn and checking
+the MQRC. For example
+
+	if errors.Is(err, ibmmq.ErrNoMsgAvailable) {
+	  ...
+	}
+
+The classifier functions put every reason code into one of a few groups
+so that retry and reconnect logic does not need its own list of codes.
+*/
+
+import (
+	"errors"
+)
+
+// The verb used in the sentinel errors, which are not tied to a specific call
+const sentinelVerb = "MQI"
+
+func newSentinel(mqrc int32) error {
+	return &MQReturn{MQCC: MQCC_FAILED, MQRC: mqrc, verb: sentinelVerb}
+}
+
+/*
+Sentinel errors for commonly-checked reason codes. An MQReturn matches one of
+these with errors.Is when the MQRC is the same, regardless of MQCC and verb.
+*/
+var (
+	ErrBackedOut            = newSentinel(MQRC_BACKED_OUT)
+	ErrCallInterrupted      = newSentinel(MQRC_CALL_INTERRUPTED)
+	ErrConnectionBroken     = newSentinel(MQRC_CONNECTION_BROKEN)
+	ErrGetInhibited         = newSentinel(MQRC_GET_INHIBITED)
+	ErrHConnError           = newSentinel(MQRC_HCONN_ERROR)
+	ErrHObjError            = newSentinel(MQRC_HOBJ_ERROR)
+	ErrHostNotAvailable     = newSentinel(MQRC_HOST_NOT_AVAILABLE)
+	ErrMsgTooBigForQ        = newSentinel(MQRC_MSG_TOO_BIG_FOR_Q)
+	ErrNoMsgAvailable       = newSentinel(MQRC_NO_MSG_AVAILABLE)
+	ErrNoSubscription       = newSentinel(MQRC_NO_SUBSCRIPTION)
+	ErrNotAuthorized        = newSentinel(MQRC_NOT_AUTHORIZED)
+	ErrObjectInUse          = newSentinel(MQRC_OBJECT_IN_USE)
+	ErrPutInhibited         = newSentinel(MQRC_PUT_INHIBITED)
+	ErrQMgrNotAvailable     = newSentinel(MQRC_Q_MGR_NOT_AVAILABLE)
+	ErrQMgrQuiescing        = newSentinel(MQRC_Q_MGR_QUIESCING)
+	ErrQMgrStopping         = newSentinel(MQRC_Q_MGR_STOPPING)
+	ErrQueueFull            = newSentinel(MQRC_Q_FULL)
+	ErrReconnectFailed      = newSentinel(MQRC_RECONNECT_FAILED)
+	ErrSecurityError        = newSentinel(MQRC_SECURITY_ERROR)
+	ErrSubscriptionInUse    = newSentinel(MQRC_SUBSCRIPTION_IN_USE)
+	ErrTruncatedMsgAccepted = newSentinel(MQRC_TRUNCATED_MSG_ACCEPTED)
+	ErrTruncatedMsgFailed   = newSentinel(MQRC_TRUNCATED_MSG_FAILED)
+	ErrUnknownObjectName    = newSentinel(MQRC_UNKNOWN_OBJECT_NAME)
+)
+
+/*
+Is allows errors.Is to compare an MQReturn with the sentinel errors, or with
+any other MQReturn. Only the reason code is compared.
+*/
+func (e *MQReturn) Is(target error) bool {
+	t, ok := target.(*MQReturn)
+	if !ok || e == nil || t == nil {
+		return false
+	}
+	return e.MQRC == t.MQRC
+}
+
+/*
+ReasonClass is the broad category of a reason code
+*/
+type ReasonClass int
+
+const (
+	// MQRC_NONE
+	ReasonClassNone ReasonClass = iota
+	// A temporary condition; the same operation might work if tried again later on the same connection
+	ReasonClassRetryable
+	// The connection to the queue manager has gone, or is going. A new connection is needed
+	ReasonClassConnectionLost
+	// Something must be changed in the queue manager or client configuration, or in the
+	// security definitions, before the operation can succeed
+	ReasonClassConfiguration
+	// Everything else, including programming errors and problems with the message content.
+	// Repeating the same call will give the same result
+	ReasonClassOther
+)
+
+/*
+String returns a printable version of the class
+*/
+func (c ReasonClass) String() string {
+	switch c {
+	case ReasonClassNone:
+		return "None"
+	case ReasonClassRetryable:
+		return "Retryable"
+	case ReasonClassConnectionLost:
+		return "ConnectionLost"
+	case ReasonClassConfiguration:
+		return "Configuration"
+	default:
+		return "Other"
+	}
+}
+
+/*
+ClassifyReason returns the category for any reason code. Codes that are not
+specifically listed, including ones added in later versions of MQ, are
+ReasonClassOther.
+*/
+func ClassifyReason(mqrc int32) ReasonClass {
+	switch mqrc {
+	case MQRC_NONE:
+		return ReasonClassNone
+
+	case MQRC_ADAPTER_STORAGE_SHORTAGE,
+		MQRC_BACKED_OUT,
+		MQRC_CALL_INTERRUPTED,
+		MQRC_CF_NOT_AVAILABLE,
+		MQRC_CF_STRUC_FAILED,
+		MQRC_CF_STRUC_IN_USE,
+		MQRC_CLUSTER_PUT_INHIBITED,
+		MQRC_CLUSTER_RESOURCE_ERROR,
+		MQRC_CMD_SERVER_NOT_AVAILABLE,
+		MQRC_CONN_ID_IN_USE,
+		MQRC_CONN_TAG_IN_USE,
+		MQRC_CONN_TAG_NOT_RELEASED,
+		MQRC_DATA_SET_NOT_AVAILABLE,
+		MQRC_DB2_NOT_AVAILABLE,
+		MQRC_GET_INHIBITED,
+		MQRC_HANDLE_NOT_AVAILABLE,
+		MQRC_MAX_CONNS_LIMIT_REACHED,
+		MQRC_MSG_MARKED_BROWSE_CO_OP,
+		MQRC_NO_DESTINATIONS_AVAILABLE,
+		MQRC_OBJECT_IN_USE,
+		MQRC_PARTICIPANT_NOT_AVAILABLE,
+		MQRC_PUT_INHIBITED,
+		MQRC_Q_FULL,
+		MQRC_Q_SPACE_NOT_AVAILABLE,
+		MQRC_RECONNECTING,
+		MQRC_RESOURCE_PROBLEM,
+		MQRC_STORAGE_MEDIUM_FULL,
+		MQRC_STORAGE_NOT_AVAILABLE,
+		MQRC_SUB_INHIBITED,
+		MQRC_SUBSCRIPTION_IN_USE,
+		MQRC_SYNCPOINT_LIMIT_REACHED:
+		return ReasonClassRetryable
+
+	case MQRC_CHANNEL_NOT_AVAILABLE,
+		MQRC_CHANNEL_STOPPED_BY_USER,
+		MQRC_CONNECTION_BROKEN,
+		MQRC_CONNECTION_NOT_AVAILABLE,
+		MQRC_CONNECTION_QUIESCING,
+		MQRC_CONNECTION_STOPPED,
+		MQRC_CONNECTION_STOPPING,
+		MQRC_HOST_NOT_AVAILABLE,
+		MQRC_Q_MGR_NOT_ACTIVE,
+		MQRC_Q_MGR_NOT_AVAILABLE,
+		MQRC_Q_MGR_QUIESCING,
+		MQRC_Q_MGR_RECONNECT_REQUESTED,
+		MQRC_Q_MGR_STOPPING,
+		MQRC_RECONNECT_FAILED,
+		MQRC_RECONNECT_INCOMPATIBLE,
+		MQRC_RECONNECT_QMID_MISMATCH,
+		MQRC_RECONNECT_TIMED_OUT,
+		MQRC_STANDBY_Q_MGR:
+		return ReasonClassConnectionLost
+
+	case MQRC_ADAPTER_CONN_LOAD_ERROR,
+		MQRC_ADAPTER_CONV_LOAD_ERROR,
+		MQRC_ADAPTER_DEFS_ERROR,
+		MQRC_ADAPTER_DEFS_LOAD_ERROR,
+		MQRC_ADAPTER_DISC_LOAD_ERROR,
+		MQRC_ADAPTER_SERV_LOAD_ERROR,
+		MQRC_ALIAS_BASE_Q_TYPE_ERROR,
+		MQRC_AMQP_NOT_AVAILABLE,
+		MQRC_API_EXIT_LOAD_ERROR,
+		MQRC_API_EXIT_NOT_FOUND,
+		MQRC_AUTH_INFO_CONN_NAME_ERROR,
+		MQRC_AUTH_INFO_REC_COUNT_ERROR,
+		MQRC_AUTH_INFO_REC_ERROR,
+		MQRC_AUTH_INFO_TYPE_ERROR,
+		MQRC_CCDT_URL_ERROR,
+		MQRC_CERT_LABEL_NOT_ALLOWED,
+		MQRC_CERT_VAL_POLICY_ERROR,
+		MQRC_CHANNEL_BLOCKED,
+		MQRC_CHANNEL_CONFIG_ERROR,
+		MQRC_CHANNEL_SSL_ERROR,
+		MQRC_CIPHER_SPEC_NOT_SUITE_B,
+		MQRC_CLIENT_CHANNEL_CONFLICT,
+		MQRC_CLIENT_CONN_ERROR,
+		MQRC_CLIENT_EXIT_LOAD_ERROR,
+		MQRC_CLUSTER_EXIT_LOAD_ERROR,
+		MQRC_CLUSTER_RESOLUTION_ERROR,
+		MQRC_COMMINFO_ERROR,
+		MQRC_CONNECTION_ERROR,
+		MQRC_CONNECTION_NOT_AUTHORIZED,
+		MQRC_CRYPTO_HARDWARE_ERROR,
+		MQRC_CSP_ERROR,
+		MQRC_DEF_SYNCPOINT_INHIBITED,
+		MQRC_DEF_XMIT_Q_TYPE_ERROR,
+		MQRC_DEF_XMIT_Q_USAGE_ERROR,
+		MQRC_DURABILITY_NOT_ALLOWED,
+		MQRC_FASTPATH_NOT_AVAILABLE,
+		MQRC_HTTPS_KEYSTORE_ERROR,
+		MQRC_INSTALLATION_MISMATCH,
+		MQRC_INSTALLATION_MISSING,
+		MQRC_KEY_REPOSITORY_ERROR,
+		MQRC_LDAP_PASSWORD_ERROR,
+		MQRC_LDAP_USER_NAME_ERROR,
+		MQRC_LDAP_USER_NAME_LENGTH_ERR,
+		MQRC_MODULE_ENTRY_NOT_FOUND,
+		MQRC_MODULE_INVALID,
+		MQRC_MODULE_NOT_FOUND,
+		MQRC_MSG_TOO_BIG_FOR_CHANNEL,
+		MQRC_MSG_TOO_BIG_FOR_Q,
+		MQRC_MSG_TOO_BIG_FOR_Q_MGR,
+		MQRC_MULTICAST_CONFIG_ERROR,
+		MQRC_NOT_AUTHORIZED,
+		MQRC_NOT_PRIVILEGED,
+		MQRC_OCSP_URL_ERROR,
+		MQRC_OUTBOUND_SNI_NOT_VALID,
+		MQRC_PASSWORD_PROTECTION_ERROR,
+		MQRC_PERSISTENT_NOT_ALLOWED,
+		MQRC_PRECONN_EXIT_LOAD_ERROR,
+		MQRC_PRECONN_EXIT_NOT_FOUND,
+		MQRC_PROPERTIES_DISABLED,
+		MQRC_PUBSUB_INHIBITED,
+		MQRC_Q_MGR_NAME_ERROR,
+		MQRC_Q_TYPE_ERROR,
+		MQRC_RETAINED_MSG_Q_ERROR,
+		MQRC_SECURITY_ERROR,
+		MQRC_SEGMENTATION_NOT_ALLOWED,
+		MQRC_SEGMENTS_NOT_SUPPORTED,
+		MQRC_SELECTION_NOT_AVAILABLE,
+		MQRC_SSL_ALT_PROVIDER_REQUIRED,
+		MQRC_SSL_CERT_STORE_ERROR,
+		MQRC_SSL_CERTIFICATE_REVOKED,
+		MQRC_SSL_CONFIG_ERROR,
+		MQRC_SSL_INITIALIZATION_ERROR,
+		MQRC_SSL_NOT_ALLOWED,
+		MQRC_SSL_PEER_NAME_ERROR,
+		MQRC_SSL_PEER_NAME_MISMATCH,
+		MQRC_SUITE_B_ERROR,
+		MQRC_UNKNOWN_ALIAS_BASE_Q,
+		MQRC_UNKNOWN_AUTH_ENTITY,
+		MQRC_UNKNOWN_CHANNEL_NAME,
+		MQRC_UNKNOWN_DEF_XMIT_Q,
+		MQRC_UNKNOWN_ENTITY,
+		MQRC_UNKNOWN_OBJECT_NAME,
+		MQRC_UNKNOWN_OBJECT_Q_MGR,
+		MQRC_UNKNOWN_Q_NAME,
+		MQRC_UNKNOWN_REMOTE_Q_MGR,
+		MQRC_UNKNOWN_XMIT_Q,
+		MQRC_UNSUPPORTED_CIPHER_SUITE,
+		MQRC_USER_ID_NOT_AVAILABLE,
+		MQRC_XMIT_Q_TYPE_ERROR,
+		MQRC_XMIT_Q_USAGE_ERROR:
+		return ReasonClassConfiguration
+	}
+
+	return ReasonClassOther
+}
+
+// Extract the reason code from an error, which might be wrapped.
+func reasonFromError(err error) (int32, bool) {
+	var mqreturn *MQReturn
+	if err == nil || !errors.As(err, &mqreturn) {
+		return 0, false
+	}
+	return mqreturn.MQRC, true
+}
+
+// Whether the error is only a warning, so the verb has still done its work. After
+// MQCONNX, that means there is a connection that has to be used or disconnected.
+func isWarning(err error) bool {
+	var mqreturn *MQReturn
+	return errors.As(err, &mqreturn) && mqreturn.MQCC == MQCC_WARNING
+}
+
+/*
+IsRetryable returns true if the error is a temporary condition, such as a full queue or a
+unit of work that was backed out, where repeating the operation on the same
+connection after a delay might succeed. Errors that are not from the MQI return false.
+A lost connection is not included: see IsConnectionLost.
+*/
+func IsRetryable(err error) bool {
+	rc, ok := reasonFromError(err)
+	return ok && ClassifyReason(rc) == ReasonClassRetryable
+}
+
+/*
+IsConnectionLost returns true if the error means that the connection to the queue
+manager is no longer usable, and the application needs to reconnect.
+*/
+func IsConnectionLost(err error) bool {
+	rc, ok := reasonFromError(err)
+	return ok && ClassifyReason(rc) == ReasonClassConnectionLost
+}
+
+/*
+IsConfigurationError returns true if the error is caused by the environment rather
+than the application logic - for example a missing queue, an authorisation failure, or
+a TLS problem. Retrying is unlikely to help until an administrator has made changes.
+*/
+func IsConfigurationError(err error) bool {
+	rc, ok := reasonFromError(err)
+	return ok && ClassifyReason(rc) == ReasonClassConfiguration
+}
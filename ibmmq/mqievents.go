@@ -0,0 +1,270 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file turns the connection-level events that MQ reports to an event handler
+into a Go channel. It means that an application using MQCNO_RECONNECT
+can follow the state of its connection - for health checks or metrics - without
+writing its own MQCB callback.
+
+The event handler is called by MQ even when the application is using synchronous
+MQGET, so there is no need to call Ctl.
+*/
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+/*
+ConnEventType says what happened to the connection
+*/
+type ConnEventType int
+
+const (
+	ConnEventReconnecting ConnEventType = iota + 1
+	ConnEventReconnected
+	ConnEventReconnectFailed
+	ConnEventQMgrQuiescing
+	ConnEventConnectionBroken
+)
+
+/*
+String returns a printable version of the event type
+*/
+func (t ConnEventType) String() string {
+	switch t {
+	case ConnEventReconnecting:
+		return "Reconnecting"
+	case ConnEventReconnected:
+		return "Reconnected"
+	case ConnEventReconnectFailed:
+		return "ReconnectFailed"
+	case ConnEventQMgrQuiescing:
+		return "QMgrQuiescing"
+	case ConnEventConnectionBroken:
+		return "ConnectionBroken"
+	default:
+		return "Unknown"
+	}
+}
+
+/*
+ConnEvent is delivered on the channel returned by Events.
+
+A Reconnected event does not say which CONNAME entry was used. The MQI does not give
+that to a client application: MQINQ cannot be used on channel objects, the MQCD passed
+in the MQCNO is input-only and is not updated by a reconnection, and the event handler's
+MQCBC has no address fields. The only route is a PCF Inquire Channel Status, which needs
+administrative authority that a normal application should not have. The queue manager
+name and identifier are usually enough to tell which instance or member of a cluster
+the connection has moved to.
+*/
+type ConnEvent struct {
+	Type           ConnEventType
+	Time           time.Time
+	Reason         int32         // The MQRC reported to the event handler
+	ReconnectDelay time.Duration // For Reconnecting events, how long before the next attempt
+	// For Reconnected events, the queue manager that the connection now goes to. These
+	// come from an MQINQ after the reconnection, so they are empty if that fails.
+	// There is no CONNAME: see the ConnEvent description.
+	QMgrName string
+	QMgrId   string
+	Err      error // The original event reason as an error, or the reason the MQINQ failed
+}
+
+// Number of events that can be waiting before newer ones are discarded
+const connEventQueueLength = 32
+
+type connEvents struct {
+	mutex  sync.Mutex
+	ch     chan ConnEvent
+	closed bool
+}
+
+// Indexed by the hConn. Set up by Events and removed by Disc.
+var connEventsMap = make(map[int32]*connEvents)
+var connEventsMutex sync.Mutex
+
+/*
+Events returns a channel on which changes to the connection state are reported.
+The first call registers an event handler for the connection; later calls return the
+same channel. The channel is closed when the connection is disconnected.
+
+Events are not queued indefinitely: if the application does not read from the channel,
+new events are discarded once it is full.
+
+A connection can only have one event handler, so this should not be mixed with
+a handler registered directly through the MQQueueManager CB function.
+*/
+func (x *MQQueueManager) Events() (<-chan ConnEvent, error) {
+	traceEntry("Events")
+
+	key := x.GetValue()
+
+	connEventsMutex.Lock()
+	defer connEventsMutex.Unlock()
+
+	if ce, ok := connEventsMap[key]; ok {
+		traceExitF("Events", 1, "Existing channel")
+		return ce.ch, nil
+	}
+
+	ce := &connEvents{ch: make(chan ConnEvent, connEventQueueLength)}
+
+	cbd := NewMQCBD()
+	cbd.CallbackType = MQCBT_EVENT_HANDLER
+	cbd.CallbackFunction = func(qMgr *MQQueueManager, _ *MQObject, _ *MQMD, _ *MQGMO, _ []byte, cbc *MQCBC, mqreturn *MQReturn) {
+		ce.handle(qMgr, cbc, mqreturn)
+	}
+
+	err := x.CB(MQOP_REGISTER, cbd)
+	if err != nil {
+		traceExitErr("Events", 2, err)
+		return nil, err
+	}
+
+	connEventsMap[key] = ce
+
+	traceExit("Events")
+	return ce.ch, nil
+}
+
+// Called from Disc so that anyone reading the channel knows there will be nothing more
+func closeConnEvents(hConn int32) {
+	connEventsMutex.Lock()
+	ce, ok := connEventsMap[hConn]
+	delete(connEventsMap, hConn)
+	connEventsMutex.Unlock()
+
+	if ok {
+		ce.mutex.Lock()
+		ce.closed = true
+		close(ce.ch)
+		ce.mutex.Unlock()
+	}
+}
+
+// Convert the reason given to the event handler into one of our event types. Other
+// event reasons are not reported.
+func connEventType(mqrc int32) (ConnEventType, bool) {
+	switch mqrc {
+	case MQRC_RECONNECTING:
+		return ConnEventReconnecting, true
+	case MQRC_RECONNECTED:
+		return ConnEventReconnected, true
+	case MQRC_RECONNECT_FAILED,
+		MQRC_RECONNECT_INCOMPATIBLE,
+		MQRC_RECONNECT_QMID_MISMATCH,
+		MQRC_RECONNECT_TIMED_OUT:
+		return ConnEventReconnectFailed, true
+	case MQRC_Q_MGR_QUIESCING,
+		MQRC_CONNECTION_QUIESCING:
+		return ConnEventQMgrQuiescing, true
+	case MQRC_CONNECTION_BROKEN,
+		MQRC_CONNECTION_STOPPING,
+		MQRC_Q_MGR_STOPPING,
+		MQRC_Q_MGR_NOT_AVAILABLE:
+		return ConnEventConnectionBroken, true
+	}
+	return 0, false
+}
+
+func (ce *connEvents) handle(qMgr *MQQueueManager, cbc *MQCBC, mqreturn *MQReturn) {
+	if cbc.CallType != MQCBCT_EVENT_CALL {
+		return
+	}
+
+	evType, ok := connEventType(mqreturn.MQRC)
+	if !ok {
+		logTrace("Events: Ignoring event reason %d", mqreturn.MQRC)
+		return
+	}
+
+	ev := ConnEvent{
+		Type:   evType,
+		Time:   time.Now(),
+		Reason: mqreturn.MQRC,
+		Err:    mqreturn,
+	}
+	if evType == ConnEventReconnecting {
+		ev.ReconnectDelay = time.Duration(cbc.ReconnectDelay) * time.Millisecond
+	}
+
+	if evType == ConnEventReconnected {
+		// We should not make MQI calls from inside the event handler, so find out where
+		// we are now connected in a separate goroutine. That delivers the event.
+		go func() {
+			ev.QMgrName, ev.QMgrId, ev.Err = inqQMgrIdentity(qMgr)
+			ce.send(ev)
+		}()
+		return
+	}
+
+	ce.send(ev)
+}
+
+func (ce *connEvents) send(ev ConnEvent) {
+	ce.mutex.Lock()
+	defer ce.mutex.Unlock()
+
+	if ce.closed {
+		return
+	}
+	select {
+	case ce.ch <- ev:
+	default:
+		logError("Events: Channel is full. Discarding %s event", ev.Type)
+	}
+}
+
+// Find the name and identifier of the queue manager. The application might be using the
+// connection at the same time, which can give MQRC_CALL_IN_PROGRESS, so we try a few times.
+func inqQMgrIdentity(qMgr *MQQueueManager) (string, string, error) {
+	var err error
+	var values map[int32]interface{}
+
+	od := NewMQOD()
+	od.ObjectType = MQOT_Q_MGR
+
+	for attempt := 0; attempt < 10; attempt++ {
+		if attempt > 0 {
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		var qMgrObject MQObject
+		qMgrObject, err = qMgr.Open(od, MQOO_INQUIRE)
+		if err == nil {
+			values, err = qMgrObject.Inq([]int32{MQCA_Q_MGR_NAME, MQCA_Q_MGR_IDENTIFIER})
+			qMgrObject.Close(0)
+		}
+		if err == nil {
+			return values[MQCA_Q_MGR_NAME].(string), values[MQCA_Q_MGR_IDENTIFIER].(string), nil
+		}
+		if !errors.Is(err, &MQReturn{MQRC: MQRC_CALL_IN_PROGRESS}) {
+			break
+		}
+	}
+
+	logTrace("Events: Cannot inquire queue manager after reconnect: %v", err)
+	return "", "", err
+}
@@ -0,0 +1,359 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file contains helpers for message groups and segmented messages.
+
+A group is a set of related messages that are put in order, and which a
+consuming application can retrieve only when the whole set is available. A
+segmented message is a single logical message that has been split into several physical
+messages, typically because it is larger than the MAXMSGL of the queue. Both need
+version 2 of the MQMD, and are easiest to handle using the MQPMO_LOGICAL_ORDER and
+MQGMO_LOGICAL_ORDER options which let the queue manager take care of the
+GroupId, MsgSeqNumber and Offset fields.
+
+When putting, messages bigger than the queue's MAXMSGL are automatically split
+into segments. When getting, segments are reassembled so the application sees only
+complete logical messages.
+*/
+
+import (
+	"fmt"
+	"sync"
+)
+
+/*
+Message is a message descriptor and its body, used when handling
+several messages in a single call.
+*/
+type Message struct {
+	MD   *MQMD
+	Data []byte
+}
+
+/*
+PutGroup puts the messages as a single group, in the order given. The queue
+manager assigns a GroupId which is returned in each message's MQMD. A nil MD
+in any of the messages uses the defaults, and a nil PMO uses the defaults. Any message
+that is too large for the queue is segmented.
+
+The group is not complete until the last message has been put, so the PMO
+ought to specify MQPMO_SYNCPOINT; then if an error is returned, the caller
+can back out and no partial group is visible to consumers.
+*/
+func (object MQObject) PutGroup(msgs []Message, gopmo *MQPMO) error {
+	var err error
+
+	traceEntry("PutGroup")
+
+	if len(msgs) == 0 {
+		traceExitF("PutGroup", 1, "No messages")
+		return nil
+	}
+	if gopmo == nil {
+		gopmo = NewMQPMO()
+	}
+
+	segSize := object.maxMsgLength()
+
+	savedOptions := gopmo.Options
+	gopmo.Options |= MQPMO_LOGICAL_ORDER
+	defer func() { gopmo.Options = savedOptions }()
+
+	for i := range msgs {
+		if msgs[i].MD == nil {
+			msgs[i].MD = NewMQMD()
+		}
+		md := msgs[i].MD
+		if md.Version < MQMD_VERSION_2 {
+			md.Version = MQMD_VERSION_2
+		}
+		md.MsgFlags &^= (MQMF_MSG_IN_GROUP | MQMF_LAST_MSG_IN_GROUP)
+		if i == len(msgs)-1 {
+			md.MsgFlags |= MQMF_LAST_MSG_IN_GROUP
+		} else {
+			md.MsgFlags |= MQMF_MSG_IN_GROUP
+		}
+
+		err = object.putSegments(md, gopmo, msgs[i].Data, segSize)
+		if err != nil {
+			traceExitErr("PutGroup", 2, err)
+			return err
+		}
+	}
+
+	traceExit("PutGroup")
+	return nil
+}
+
+/*
+PutSegmented puts a single message, splitting it into segments if it is
+larger than the MAXMSGL of the queue. As with PutGroup, the PMO ought to
+specify MQPMO_SYNCPOINT so that consumers do not see a partial message.
+*/
+func (object MQObject) PutSegmented(gomd *MQMD, gopmo *MQPMO, buffer []byte) error {
+	traceEntry("PutSegmented")
+
+	if gopmo == nil {
+		gopmo = NewMQPMO()
+	}
+	if gomd.Version < MQMD_VERSION_2 {
+		gomd.Version = MQMD_VERSION_2
+	}
+
+	savedOptions := gopmo.Options
+	gopmo.Options |= MQPMO_LOGICAL_ORDER
+	err := object.putSegments(gomd, gopmo, buffer, object.maxMsgLength())
+	gopmo.Options = savedOptions
+
+	traceExitErr("PutSegmented", 0, err)
+	return err
+}
+
+// Put one logical message, as a series of segments if necessary. A segSize of 0
+// means that we don't know the limit, so the message is put in one go.
+// The MsgFlags in the MQMD are left as they were on entry; the other
+// output fields are as set by the final MQPUT.
+func (object MQObject) putSegments(gomd *MQMD, gopmo *MQPMO, buffer []byte, segSize int) error {
+	savedFlags := gomd.MsgFlags
+	defer func() { gomd.MsgFlags = savedFlags }()
+
+	gomd.MsgFlags &^= (MQMF_SEGMENT | MQMF_LAST_SEGMENT)
+	segs := splitSegments(len(buffer), segSize, gomd.MsgFlags)
+	if len(segs) > 1 {
+		logTrace("putSegments: Message length %d split into %d segments of %d", len(buffer), len(segs), segSize)
+	}
+	for _, seg := range segs {
+		gomd.MsgFlags = seg.flags
+		err := object.Put(gomd, gopmo, buffer[seg.offset:seg.end])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// One physical message that is part of a logical message
+type segment struct {
+	offset int
+	end    int
+	flags  int32
+}
+
+// Work out how a message of the given length is split. The queue manager sets the
+// Offset field in each MQMD because of MQPMO_LOGICAL_ORDER, and that matches the offset
+// here. A message that does not need splitting is a single piece without any
+// segment flags.
+func splitSegments(length int, segSize int, baseFlags int32) []segment {
+	if segSize <= 0 || length <= segSize {
+		return []segment{{offset: 0, end: length, flags: baseFlags}}
+	}
+
+	segs := make([]segment, 0, (length+segSize-1)/segSize)
+	for offset := 0; offset < length; offset += segSize {
+		seg := segment{offset: offset, end: offset + segSize, flags: baseFlags | MQMF_SEGMENT}
+		if seg.end >= length {
+			seg.end = length
+			seg.flags = baseFlags | MQMF_LAST_SEGMENT
+		}
+		segs = append(segs, seg)
+	}
+	return segs
+}
+
+// The MAXMSGL for each open object, so that a series of PutSegmented or PutGroup
+// calls does not need an MQINQ every time. Entries are removed when the object is closed.
+var maxMsgLengthCache sync.Map
+
+func objectCacheKey(object MQObject) string {
+	return fmt.Sprintf("%d/%d", object.qMgr.GetValue(), object.hObj)
+}
+
+// Find the MAXMSGL for the queue. Returns 0 if it cannot be discovered, for example
+// when the object is a remote queue definition. Failures are not cached, as they
+// might be caused by something temporary.
+func (object MQObject) maxMsgLength() int {
+	if object.qMgr == nil {
+		return 0
+	}
+
+	key := objectCacheKey(object)
+	if v, ok := maxMsgLengthCache.Load(key); ok {
+		return v.(int)
+	}
+
+	values, err := object.inqWithOpen([]int32{MQIA_MAX_MSG_LENGTH})
+	if err != nil {
+		logTrace("maxMsgLength: Cannot inquire MAXMSGL for %s: %v", object.Name, err)
+		return 0
+	}
+	if v, ok := values[MQIA_MAX_MSG_LENGTH].(int32); ok {
+		maxMsgLengthCache.Store(key, int(v))
+		return int(v)
+	}
+	return 0
+}
+
+/*
+GetGroup retrieves all the messages in the next available group, in logical order.
+The first MQGET does not complete until every message in the group is on the queue,
+so the WaitInterval in the GMO applies to the whole group. Segmented
+messages are reassembled. The supplied MQMD is used, and updated, for the first message;
+the others have their own MQMD. A nil MD or GMO uses the defaults.
+
+If the next message on the queue is not part of a group, it is returned
+on its own. The GMO ought to specify MQGMO_SYNCPOINT so that the group can be backed
+out if there is a failure part way through it. In that case, the messages already
+retrieved are returned along with the error.
+*/
+func (object MQObject) GetGroup(gomd *MQMD, gogmo *MQGMO) ([]Message, error) {
+	var msgs []Message
+	var warning error
+
+	traceEntry("GetGroup")
+
+	if gomd == nil {
+		gomd = NewMQMD()
+	}
+	if gogmo == nil {
+		gogmo = NewMQGMO()
+	}
+	if gomd.Version < MQMD_VERSION_2 {
+		gomd.Version = MQMD_VERSION_2
+	}
+	if gogmo.Version < MQGMO_VERSION_2 {
+		gogmo.Version = MQGMO_VERSION_2
+	}
+
+	savedOptions := gogmo.Options
+	gogmo.Options |= MQGMO_LOGICAL_ORDER | MQGMO_ALL_MSGS_AVAILABLE | MQGMO_ALL_SEGMENTS_AVAILABLE
+	gogmo.Options &^= (MQGMO_COMPLETE_MSG | MQGMO_ACCEPT_TRUNCATED_MSG)
+	defer func() { gogmo.Options = savedOptions }()
+
+	md := gomd
+	for {
+		data, err := object.getLogicalMsg(md, gogmo)
+		if isGetFailure(err) {
+			traceExitErr("GetGroup", 1, err)
+			return msgs, err
+		}
+		if err != nil {
+			warning = err
+		}
+
+		msgs = append(msgs, Message{MD: md, Data: data})
+		if gogmo.GroupStatus != MQGS_MSG_IN_GROUP {
+			break
+		}
+
+		md = NewMQMD()
+		md.Version = MQMD_VERSION_2
+	}
+
+	traceExitErr("GetGroup", 0, warning)
+	return msgs, warning
+}
+
+/*
+GetSegmented retrieves a single logical message, reassembling it if it was
+put in segments. The first MQGET waits until all of the segments are available.
+A nil MD or GMO uses the defaults.
+*/
+func (object MQObject) GetSegmented(gomd *MQMD, gogmo *MQGMO) ([]byte, error) {
+	traceEntry("GetSegmented")
+
+	if gomd == nil {
+		gomd = NewMQMD()
+	}
+	if gogmo == nil {
+		gogmo = NewMQGMO()
+	}
+	if gomd.Version < MQMD_VERSION_2 {
+		gomd.Version = MQMD_VERSION_2
+	}
+	if gogmo.Version < MQGMO_VERSION_2 {
+		gogmo.Version = MQGMO_VERSION_2
+	}
+
+	savedOptions := gogmo.Options
+	gogmo.Options |= MQGMO_LOGICAL_ORDER | MQGMO_ALL_SEGMENTS_AVAILABLE
+	gogmo.Options &^= (MQGMO_COMPLETE_MSG | MQGMO_ACCEPT_TRUNCATED_MSG)
+
+	data, err := object.getLogicalMsg(gomd, gogmo)
+	gogmo.Options = savedOptions
+
+	traceExitErr("GetSegmented", 0, err)
+	return data, err
+}
+
+// Get the next logical message, joining together any segments. On return, the MQMD
+// is the one from the first segment, with the segment flags removed.
+func (object MQObject) getLogicalMsg(gomd *MQMD, gogmo *MQGMO) ([]byte, error) {
+	var warning error
+
+	msg, err := object.GetAutoSize(gomd, gogmo, 0, 0)
+	if isGetFailure(err) {
+		return nil, err
+	}
+	if err != nil {
+		warning = err
+	}
+	if gogmo.SegmentStatus == MQSS_NOT_A_SEGMENT {
+		return msg, warning
+	}
+
+	for gogmo.SegmentStatus == MQSS_SEGMENT {
+		segmd := NewMQMD()
+		segmd.Version = MQMD_VERSION_2
+		data, err := object.GetAutoSize(segmd, gogmo, segmentBufferSize(len(msg)), 0)
+		if isGetFailure(err) {
+			return nil, err
+		}
+		if err != nil {
+			warning = err
+		}
+		msg = append(msg, data...)
+	}
+
+	gomd.MsgFlags &^= (MQMF_SEGMENT | MQMF_LAST_SEGMENT)
+	return msg, warning
+}
+
+// Later segments are usually the same size as the first, but an empty or very
+// short first segment should not force tiny buffers on the rest.
+func segmentBufferSize(firstLen int) int {
+	if firstLen < defaultGetBufferSize {
+		return defaultGetBufferSize
+	}
+	return firstLen
+}
+
+// A warning such as a data conversion problem still returns a message; anything
+// else means we have nothing to work with.
+func isGetFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if mqreturn, ok := err.(*MQReturn); ok && mqreturn.MQCC == MQCC_WARNING {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,706 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file converts the main MQI structures to and from JSON, so they can be logged or
+stored in a readable form. Enumerated fields such as MsgType are written using the names
+from MQItoString, option fields are written as lists of flags in the style of
+FormatMQIFlags, and byte arrays such as the MsgId are written in hex. Unmarshalling
+accepts the same forms, or plain numbers, and gives back the original values.
+
+Fields that refer to live objects, such as message handles and the Context field of the
+MQPMO, are not included. Nor are passwords and tokens in the MQCNO, so that the JSON
+can be written to a log without revealing them.
+*/
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The names of the MQFMT values. Where two names have the same value, the first is used
+var mqiFormats = []struct {
+	name  string
+	value string
+}{
+	{"MQFMT_NONE", MQFMT_NONE},
+	{"MQFMT_ADMIN", MQFMT_ADMIN},
+	{"MQFMT_AMQP", MQFMT_AMQP},
+	{"MQFMT_CHANNEL_COMPLETED", MQFMT_CHANNEL_COMPLETED},
+	{"MQFMT_CICS", MQFMT_CICS},
+	{"MQFMT_COMMAND_1", MQFMT_COMMAND_1},
+	{"MQFMT_COMMAND_2", MQFMT_COMMAND_2},
+	{"MQFMT_DEAD_LETTER_HEADER", MQFMT_DEAD_LETTER_HEADER},
+	{"MQFMT_DIST_HEADER", MQFMT_DIST_HEADER},
+	{"MQFMT_EMBEDDED_PCF", MQFMT_EMBEDDED_PCF},
+	{"MQFMT_EVENT", MQFMT_EVENT},
+	{"MQFMT_IMS", MQFMT_IMS},
+	{"MQFMT_IMS_VAR_STRING", MQFMT_IMS_VAR_STRING},
+	{"MQFMT_MD_EXTENSION", MQFMT_MD_EXTENSION},
+	{"MQFMT_PCF", MQFMT_PCF},
+	{"MQFMT_REF_MSG_HEADER", MQFMT_REF_MSG_HEADER},
+	{"MQFMT_RF_HEADER", MQFMT_RF_HEADER},
+	{"MQFMT_RF_HEADER_2", MQFMT_RF_HEADER_2},
+	{"MQFMT_STRING", MQFMT_STRING},
+	{"MQFMT_TRIGGER", MQFMT_TRIGGER},
+	{"MQFMT_WORK_INFO_HEADER", MQFMT_WORK_INFO_HEADER},
+	{"MQFMT_XMIT_Q_HEADER", MQFMT_XMIT_Q_HEADER},
+}
+
+// The Format field is padded with spaces when it goes to the queue manager, so
+// trailing spaces do not make a difference to which name is used.
+func formatToJSON(f string) string {
+	t := strings.TrimRight(f, " ")
+	for _, mf := range mqiFormats {
+		if t == mf.value {
+			return mf.name
+		}
+	}
+	return f
+}
+
+func formatFromJSON(s string) string {
+	for _, mf := range mqiFormats {
+		if strings.EqualFold(s, mf.name) {
+			return mf.value
+		}
+	}
+	return s
+}
+
+func enumToJSON(class string, v int32) string {
+	s := MQItoString(class, int(v))
+	if s == "" {
+		s = strconv.Itoa(int(v))
+	}
+	return s
+}
+
+// Both enumerated and option fields are parsed the same way, with an
+// error that says which field was wrong
+func constantFromJSON(field string, class string, s string, v *int32) error {
+	n, err := ParseMQIConstant(class, s)
+	if err != nil {
+		return fmt.Errorf("%s: %v", field, err)
+	}
+	*v = n
+	return nil
+}
+
+func bytesToJSON(b []byte) string {
+	return hex.EncodeToString(b)
+}
+
+func bytesFromJSON(field string, s string, b *[]byte) error {
+	if s == "" {
+		*b = nil
+		return nil
+	}
+	n, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("%s: %v", field, err)
+	}
+	*b = n
+	return nil
+}
+
+func timeToJSON(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func timeFromJSON(field string, s string, t *time.Time) error {
+	if s == "" {
+		*t = time.Time{}
+		return nil
+	}
+	n, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return fmt.Errorf("%s: %v", field, err)
+	}
+	*t = n
+	return nil
+}
+
+func runeToJSON(r rune) string {
+	return string(r)
+}
+
+func runeFromJSON(field string, s string, r *rune) error {
+	rs := []rune(s)
+	if len(rs) != 1 {
+		return fmt.Errorf("%s: '%s' is not a single character", field, s)
+	}
+	*r = rs[0]
+	return nil
+}
+
+// Each structure has a partner that holds the JSON form. Unmarshalling starts
+// from the JSON form of the current value, so fields missing from the input, and
+// fields that are never in the JSON, keep the values they had.
+
+type jsonMQMD struct {
+	Version          int32  `json:"version"`
+	Report           string `json:"report"`
+	MsgType          string `json:"msgType"`
+	Expiry           int32  `json:"expiry"`
+	Feedback         string `json:"feedback"`
+	Encoding         int32  `json:"encoding"`
+	CodedCharSetId   int32  `json:"codedCharSetId"`
+	Format           string `json:"format"`
+	Priority         int32  `json:"priority"`
+	Persistence      string `json:"persistence"`
+	MsgId            string `json:"msgId"`
+	CorrelId         string `json:"correlId"`
+	BackoutCount     int32  `json:"backoutCount"`
+	ReplyToQ         string `json:"replyToQ"`
+	ReplyToQMgr      string `json:"replyToQMgr"`
+	UserIdentifier   string `json:"userIdentifier"`
+	AccountingToken  string `json:"accountingToken"`
+	ApplIdentityData string `json:"applIdentityData"`
+	PutApplType      string `json:"putApplType"`
+	PutApplName      string `json:"putApplName"`
+	PutDate          string `json:"putDate,omitempty"`
+	PutTime          string `json:"putTime,omitempty"`
+	PutDateTime      string `json:"putDateTime,omitempty"`
+	ApplOriginData   string `json:"applOriginData"`
+	GroupId          string `json:"groupId"`
+	MsgSeqNumber     int32  `json:"msgSeqNumber"`
+	Offset           int32  `json:"offset"`
+	MsgFlags         string `json:"msgFlags"`
+	OriginalLength   int32  `json:"originalLength"`
+}
+
+func (md *MQMD) toJSON() jsonMQMD {
+	return jsonMQMD{
+		Version:          md.Version,
+		Report:           FormatMQIFlags("RO", md.Report),
+		MsgType:          enumToJSON("MT", md.MsgType),
+		Expiry:           md.Expiry,
+		Feedback:         enumToJSON("FB", md.Feedback),
+		Encoding:         md.Encoding,
+		CodedCharSetId:   md.CodedCharSetId,
+		Format:           formatToJSON(md.Format),
+		Priority:         md.Priority,
+		Persistence:      enumToJSON("PER", md.Persistence),
+		MsgId:            bytesToJSON(md.MsgId),
+		CorrelId:         bytesToJSON(md.CorrelId),
+		BackoutCount:     md.BackoutCount,
+		ReplyToQ:         md.ReplyToQ,
+		ReplyToQMgr:      md.ReplyToQMgr,
+		UserIdentifier:   md.UserIdentifier,
+		AccountingToken:  bytesToJSON(md.AccountingToken),
+		ApplIdentityData: md.ApplIdentityData,
+		PutApplType:      enumToJSON("AT", md.PutApplType),
+		PutApplName:      md.PutApplName,
+		PutDate:          md.PutDate,
+		PutTime:          md.PutTime,
+		PutDateTime:      timeToJSON(md.PutDateTime),
+		ApplOriginData:   md.ApplOriginData,
+		GroupId:          bytesToJSON(md.GroupId),
+		MsgSeqNumber:     md.MsgSeqNumber,
+		Offset:           md.Offset,
+		MsgFlags:         FormatMQIFlags("MF", md.MsgFlags),
+		OriginalLength:   md.OriginalLength,
+	}
+}
+
+func (j *jsonMQMD) apply(md *MQMD) error {
+	md.Version = j.Version
+	md.Expiry = j.Expiry
+	md.Encoding = j.Encoding
+	md.CodedCharSetId = j.CodedCharSetId
+	md.Format = formatFromJSON(j.Format)
+	md.Priority = j.Priority
+	md.BackoutCount = j.BackoutCount
+	md.ReplyToQ = j.ReplyToQ
+	md.ReplyToQMgr = j.ReplyToQMgr
+	md.UserIdentifier = j.UserIdentifier
+	md.ApplIdentityData = j.ApplIdentityData
+	md.PutApplName = j.PutApplName
+	md.PutDate = j.PutDate
+	md.PutTime = j.PutTime
+	md.ApplOriginData = j.ApplOriginData
+	md.MsgSeqNumber = j.MsgSeqNumber
+	md.Offset = j.Offset
+	md.OriginalLength = j.OriginalLength
+
+	return firstError(
+		constantFromJSON("report", "RO", j.Report, &md.Report),
+		constantFromJSON("msgType", "MT", j.MsgType, &md.MsgType),
+		constantFromJSON("feedback", "FB", j.Feedback, &md.Feedback),
+		constantFromJSON("persistence", "PER", j.Persistence, &md.Persistence),
+		constantFromJSON("putApplType", "AT", j.PutApplType, &md.PutApplType),
+		constantFromJSON("msgFlags", "MF", j.MsgFlags, &md.MsgFlags),
+		bytesFromJSON("msgId", j.MsgId, &md.MsgId),
+		bytesFromJSON("correlId", j.CorrelId, &md.CorrelId),
+		bytesFromJSON("accountingToken", j.AccountingToken, &md.AccountingToken),
+		bytesFromJSON("groupId", j.GroupId, &md.GroupId),
+		timeFromJSON("putDateTime", j.PutDateTime, &md.PutDateTime))
+}
+
+/*
+MarshalJSON writes the MQMD with names for its enumerated and option fields
+*/
+func (md MQMD) MarshalJSON() ([]byte, error) {
+	return json.Marshal(md.toJSON())
+}
+
+/*
+UnmarshalJSON reads an MQMD written by MarshalJSON. Fields that are not in the
+JSON keep their current values, so start from NewMQMD to get the usual defaults.
+*/
+func (md *MQMD) UnmarshalJSON(b []byte) error {
+	j := md.toJSON()
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	n := *md
+	if err := j.apply(&n); err != nil {
+		return err
+	}
+	*md = n
+	return nil
+}
+
+type jsonMQOD struct {
+	Version             int32  `json:"version"`
+	ObjectType          string `json:"objectType"`
+	ObjectName          string `json:"objectName"`
+	ObjectQMgrName      string `json:"objectQMgrName"`
+	DynamicQName        string `json:"dynamicQName"`
+	AlternateUserId     string `json:"alternateUserId"`
+	AlternateSecurityId string `json:"alternateSecurityId"`
+	ResolvedQName       string `json:"resolvedQName"`
+	ResolvedQMgrName    string `json:"resolvedQMgrName"`
+	ObjectString        string `json:"objectString"`
+	SelectionString     string `json:"selectionString"`
+	ResObjectString     string `json:"resObjectString"`
+	ResolvedType        string `json:"resolvedType"`
+}
+
+func (od *MQOD) toJSON() jsonMQOD {
+	return jsonMQOD{
+		Version:             od.Version,
+		ObjectType:          enumToJSON("OT", od.ObjectType),
+		ObjectName:          od.ObjectName,
+		ObjectQMgrName:      od.ObjectQMgrName,
+		DynamicQName:        od.DynamicQName,
+		AlternateUserId:     od.AlternateUserId,
+		AlternateSecurityId: bytesToJSON(od.AlternateSecurityId),
+		ResolvedQName:       od.ResolvedQName,
+		ResolvedQMgrName:    od.ResolvedQMgrName,
+		ObjectString:        od.ObjectString,
+		SelectionString:     od.SelectionString,
+		ResObjectString:     od.ResObjectString,
+		ResolvedType:        enumToJSON("OT", od.ResolvedType),
+	}
+}
+
+func (j *jsonMQOD) apply(od *MQOD) error {
+	od.Version = j.Version
+	od.ObjectName = j.ObjectName
+	od.ObjectQMgrName = j.ObjectQMgrName
+	od.DynamicQName = j.DynamicQName
+	od.AlternateUserId = j.AlternateUserId
+	od.ResolvedQName = j.ResolvedQName
+	od.ResolvedQMgrName = j.ResolvedQMgrName
+	od.ObjectString = j.ObjectString
+	od.SelectionString = j.SelectionString
+	od.ResObjectString = j.ResObjectString
+
+	return firstError(
+		constantFromJSON("objectType", "OT", j.ObjectType, &od.ObjectType),
+		constantFromJSON("resolvedType", "OT", j.ResolvedType, &od.ResolvedType),
+		bytesFromJSON("alternateSecurityId", j.AlternateSecurityId, &od.AlternateSecurityId))
+}
+
+/*
+MarshalJSON writes the MQOD with names for its enumerated fields
+*/
+func (od MQOD) MarshalJSON() ([]byte, error) {
+	return json.Marshal(od.toJSON())
+}
+
+/*
+UnmarshalJSON reads an MQOD written by MarshalJSON. Fields that are not in the
+JSON keep their current values.
+*/
+func (od *MQOD) UnmarshalJSON(b []byte) error {
+	j := od.toJSON()
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	n := *od
+	if err := j.apply(&n); err != nil {
+		return err
+	}
+	*od = n
+	return nil
+}
+
+type jsonMQPMO struct {
+	Version          int32  `json:"version"`
+	Options          string `json:"options"`
+	Timeout          int32  `json:"timeout"`
+	KnownDestCount   int32  `json:"knownDestCount"`
+	UnknownDestCount int32  `json:"unknownDestCount"`
+	InvalidDestCount int32  `json:"invalidDestCount"`
+	ResolvedQName    string `json:"resolvedQName"`
+	ResolvedQMgrName string `json:"resolvedQMgrName"`
+	Action           string `json:"action"`
+	PubLevel         int32  `json:"pubLevel"`
+}
+
+func (pmo *MQPMO) toJSON() jsonMQPMO {
+	return jsonMQPMO{
+		Version:          pmo.Version,
+		Options:          FormatMQIFlags("PMO", pmo.Options),
+		Timeout:          pmo.Timeout,
+		KnownDestCount:   pmo.KnownDestCount,
+		UnknownDestCount: pmo.UnknownDestCount,
+		InvalidDestCount: pmo.InvalidDestCount,
+		ResolvedQName:    pmo.ResolvedQName,
+		ResolvedQMgrName: pmo.ResolvedQMgrName,
+		Action:           enumToJSON("ACTP", pmo.Action),
+		PubLevel:         pmo.PubLevel,
+	}
+}
+
+func (j *jsonMQPMO) apply(pmo *MQPMO) error {
+	pmo.Version = j.Version
+	pmo.Timeout = j.Timeout
+	pmo.KnownDestCount = j.KnownDestCount
+	pmo.UnknownDestCount = j.UnknownDestCount
+	pmo.InvalidDestCount = j.InvalidDestCount
+	pmo.ResolvedQName = j.ResolvedQName
+	pmo.ResolvedQMgrName = j.ResolvedQMgrName
+	pmo.PubLevel = j.PubLevel
+
+	return firstError(
+		constantFromJSON("options", "PMO", j.Options, &pmo.Options),
+		constantFromJSON("action", "ACTP", j.Action, &pmo.Action))
+}
+
+/*
+MarshalJSON writes the MQPMO with its options as a list of names. The Context
+object and the message handles are not included.
+*/
+func (pmo MQPMO) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pmo.toJSON())
+}
+
+/*
+UnmarshalJSON reads an MQPMO written by MarshalJSON. Fields that are not in the
+JSON keep their current values.
+*/
+func (pmo *MQPMO) UnmarshalJSON(b []byte) error {
+	j := pmo.toJSON()
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	n := *pmo
+	if err := j.apply(&n); err != nil {
+		return err
+	}
+	*pmo = n
+	return nil
+}
+
+type jsonMQGMO struct {
+	Version        int32  `json:"version"`
+	Options        string `json:"options"`
+	WaitInterval   int32  `json:"waitInterval"`
+	Signal1        int32  `json:"signal1"`
+	Signal2        int32  `json:"signal2"`
+	ResolvedQName  string `json:"resolvedQName"`
+	MatchOptions   string `json:"matchOptions"`
+	GroupStatus    string `json:"groupStatus"`
+	SegmentStatus  string `json:"segmentStatus"`
+	Segmentation   string `json:"segmentation"`
+	MsgToken       string `json:"msgToken"`
+	ReturnedLength int32  `json:"returnedLength"`
+}
+
+func (gmo *MQGMO) toJSON() jsonMQGMO {
+	return jsonMQGMO{
+		Version:        gmo.Version,
+		Options:        FormatMQIFlags("GMO", gmo.Options),
+		WaitInterval:   gmo.WaitInterval,
+		Signal1:        gmo.Signal1,
+		Signal2:        gmo.Signal2,
+		ResolvedQName:  gmo.ResolvedQName,
+		MatchOptions:   FormatMQIFlags("MO", gmo.MatchOptions),
+		GroupStatus:    runeToJSON(gmo.GroupStatus),
+		SegmentStatus:  runeToJSON(gmo.SegmentStatus),
+		Segmentation:   runeToJSON(gmo.Segmentation),
+		MsgToken:       bytesToJSON(gmo.MsgToken),
+		ReturnedLength: gmo.ReturnedLength,
+	}
+}
+
+func (j *jsonMQGMO) apply(gmo *MQGMO) error {
+	gmo.Version = j.Version
+	gmo.WaitInterval = j.WaitInterval
+	gmo.Signal1 = j.Signal1
+	gmo.Signal2 = j.Signal2
+	gmo.ResolvedQName = j.ResolvedQName
+	gmo.ReturnedLength = j.ReturnedLength
+
+	return firstError(
+		constantFromJSON("options", "GMO", j.Options, &gmo.Options),
+		constantFromJSON("matchOptions", "MO", j.MatchOptions, &gmo.MatchOptions),
+		runeFromJSON("groupStatus", j.GroupStatus, &gmo.GroupStatus),
+		runeFromJSON("segmentStatus", j.SegmentStatus, &gmo.SegmentStatus),
+		runeFromJSON("segmentation", j.Segmentation, &gmo.Segmentation),
+		bytesFromJSON("msgToken", j.MsgToken, &gmo.MsgToken))
+}
+
+/*
+MarshalJSON writes the MQGMO with its options as lists of names. The message
+handle and the PoisonHandler are not included.
+*/
+func (gmo MQGMO) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gmo.toJSON())
+}
+
+/*
+UnmarshalJSON reads an MQGMO written by MarshalJSON. Fields that are not in the
+JSON keep their current values.
+*/
+func (gmo *MQGMO) UnmarshalJSON(b []byte) error {
+	j := gmo.toJSON()
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	n := *gmo
+	if err := j.apply(&n); err != nil {
+		return err
+	}
+	*gmo = n
+	return nil
+}
+
+// Only the fields of the MQCSP that are safe to log
+type jsonMQCSP struct {
+	AuthenticationType string `json:"authenticationType"`
+	UserId             string `json:"userId"`
+}
+
+type jsonMQCNO struct {
+	Version       int32      `json:"version"`
+	Options       string     `json:"options"`
+	SecurityParms *jsonMQCSP `json:"securityParms,omitempty"`
+	CCDTUrl       string     `json:"ccdtUrl,omitempty"`
+	ClientConn    *MQCD      `json:"clientConn,omitempty"`
+	SSLConfig     *MQSCO     `json:"sslConfig,omitempty"`
+	ApplName      string     `json:"applName,omitempty"`
+	BalanceParms  *MQBNO     `json:"balanceParms,omitempty"`
+}
+
+// The nested structures are copied so that unmarshalling into them
+// does not change the originals
+func (cno *MQCNO) toJSON() jsonMQCNO {
+	j := jsonMQCNO{
+		Version:  cno.Version,
+		Options:  FormatMQIFlags("CNO", cno.Options),
+		CCDTUrl:  cno.CCDTUrl,
+		ApplName: cno.ApplName,
+	}
+	if cno.SecurityParms != nil {
+		j.SecurityParms = &jsonMQCSP{
+			AuthenticationType: enumToJSON("CSP", cno.SecurityParms.AuthenticationType),
+			UserId:             cno.SecurityParms.UserId,
+		}
+	}
+	if cno.ClientConn != nil {
+		cd := *cno.ClientConn
+		j.ClientConn = &cd
+	}
+	if cno.SSLConfig != nil {
+		sco := *cno.SSLConfig
+		sco.KeyRepoPassword = ""
+		j.SSLConfig = &sco
+	}
+	if cno.BalanceParms != nil {
+		bno := *cno.BalanceParms
+		j.BalanceParms = &bno
+	}
+	return j
+}
+
+func (j *jsonMQCNO) apply(cno *MQCNO) error {
+	cno.Version = j.Version
+	cno.CCDTUrl = j.CCDTUrl
+	cno.ApplName = j.ApplName
+	cno.ClientConn = j.ClientConn
+	cno.BalanceParms = j.BalanceParms
+
+	// Keep any password that is already set
+	if j.SSLConfig != nil && cno.SSLConfig != nil {
+		j.SSLConfig.KeyRepoPassword = cno.SSLConfig.KeyRepoPassword
+	}
+	cno.SSLConfig = j.SSLConfig
+
+	var err error
+	if j.SecurityParms == nil {
+		cno.SecurityParms = nil
+	} else {
+		csp := NewMQCSP()
+		if cno.SecurityParms != nil {
+			*csp = *cno.SecurityParms
+		}
+		csp.UserId = j.SecurityParms.UserId
+		err = constantFromJSON("authenticationType", "CSP", j.SecurityParms.AuthenticationType, &csp.AuthenticationType)
+		cno.SecurityParms = csp
+	}
+
+	return firstError(
+		constantFromJSON("options", "CNO", j.Options, &cno.Options),
+		err)
+}
+
+/*
+MarshalJSON writes the MQCNO with its options as a list of names. Passwords
+and tokens are not included, nor are the CredentialProvider and TLSOptions fields.
+*/
+func (cno MQCNO) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cno.toJSON())
+}
+
+/*
+UnmarshalJSON reads an MQCNO written by MarshalJSON. Fields that are not in the
+JSON keep their current values, including any passwords.
+*/
+func (cno *MQCNO) UnmarshalJSON(b []byte) error {
+	j := cno.toJSON()
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	n := *cno
+	if err := j.apply(&n); err != nil {
+		return err
+	}
+	*cno = n
+	return nil
+}
+
+type jsonMQSD struct {
+	Version             int32  `json:"version"`
+	Options             string `json:"options"`
+	ObjectName          string `json:"objectName"`
+	AlternateUserId     string `json:"alternateUserId"`
+	AlternateSecurityId string `json:"alternateSecurityId"`
+	SubExpiry           int32  `json:"subExpiry"`
+	ObjectString        string `json:"objectString"`
+	SubName             string `json:"subName"`
+	SubUserData         string `json:"subUserData"`
+	SubCorrelId         string `json:"subCorrelId"`
+	PubPriority         int32  `json:"pubPriority"`
+	PubAccountingToken  string `json:"pubAccountingToken"`
+	PubApplIdentityData string `json:"pubApplIdentityData"`
+	SelectionString     string `json:"selectionString"`
+	SubLevel            int32  `json:"subLevel"`
+	ResObjectString     string `json:"resObjectString"`
+}
+
+func (sd *MQSD) toJSON() jsonMQSD {
+	return jsonMQSD{
+		Version:             sd.Version,
+		Options:             FormatMQIFlags("SO", sd.Options),
+		ObjectName:          sd.ObjectName,
+		AlternateUserId:     sd.AlternateUserId,
+		AlternateSecurityId: bytesToJSON(sd.AlternateSecurityId),
+		SubExpiry:           sd.SubExpiry,
+		ObjectString:        sd.ObjectString,
+		SubName:             sd.SubName,
+		SubUserData:         sd.SubUserData,
+		SubCorrelId:         bytesToJSON(sd.SubCorrelId),
+		PubPriority:         sd.PubPriority,
+		PubAccountingToken:  bytesToJSON(sd.PubAccountingToken),
+		PubApplIdentityData: sd.PubApplIdentityData,
+		SelectionString:     sd.SelectionString,
+		SubLevel:            sd.SubLevel,
+		ResObjectString:     sd.ResObjectString,
+	}
+}
+
+func (j *jsonMQSD) apply(sd *MQSD) error {
+	sd.Version = j.Version
+	sd.ObjectName = j.ObjectName
+	sd.AlternateUserId = j.AlternateUserId
+	sd.SubExpiry = j.SubExpiry
+	sd.ObjectString = j.ObjectString
+	sd.SubName = j.SubName
+	sd.SubUserData = j.SubUserData
+	sd.PubPriority = j.PubPriority
+	sd.PubApplIdentityData = j.PubApplIdentityData
+	sd.SelectionString = j.SelectionString
+	sd.SubLevel = j.SubLevel
+	sd.ResObjectString = j.ResObjectString
+
+	return firstError(
+		constantFromJSON("options", "SO", j.Options, &sd.Options),
+		bytesFromJSON("alternateSecurityId", j.AlternateSecurityId, &sd.AlternateSecurityId),
+		bytesFromJSON("subCorrelId", j.SubCorrelId, &sd.SubCorrelId),
+		bytesFromJSON("pubAccountingToken", j.PubAccountingToken, &sd.PubAccountingToken))
+}
+
+/*
+MarshalJSON writes the MQSD with its options as a list of names
+*/
+func (sd MQSD) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sd.toJSON())
+}
+
+/*
+UnmarshalJSON reads an MQSD written by MarshalJSON. Fields that are not in the
+JSON keep their current values.
+*/
+func (sd *MQSD) UnmarshalJSON(b []byte) error {
+	j := sd.toJSON()
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	n := *sd
+	if err := j.apply(&n); err != nil {
+		return err
+	}
+	*sd = n
+	return nil
+}
+
+// All the conversions are done before looking at the errors, which keeps the
+// apply functions short. Only the first error is reported.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file adds structured logging to the package. Once a Logger has been set with
+SetLogger, each MQI verb writes a Debug record with attributes such as the verb, queue
+manager, object, CompCode, Reason and the time taken, so the records can be filtered
+by a log pipeline instead of being parsed from text. The trace and error messages
+that would otherwise go to stderr are sent to the same Logger.
+
+The Logger interface has the same methods as *slog.Logger, so on Go 1.21 or later an
+application can do
+
+	ibmmq.SetLogger(slog.New(slog.NewJSONHandler(os.Stderr, nil)))
+
+For older levels of Go, NewJSONLogger gives a simple logger that writes one JSON
+object per line. The mqmetric, pubsub and ibmmqotel packages write to the same Logger,
+so this one call covers all of them.
+*/
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+Logger is the interface for structured logging. The args are alternating
+keys and values, as with *slog.Logger, which can be used directly.
+*/
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// Held in a struct as atomic.Value needs the same concrete type each time
+type loggerHolder struct {
+	l Logger
+}
+
+var structuredLogger atomic.Value
+
+/*
+SetLogger sends the package's log records to l. Use nil to go back to the text
+output on stderr.
+*/
+func SetLogger(l Logger) {
+	structuredLogger.Store(loggerHolder{l: l})
+}
+
+/*
+GetLogger returns the Logger given to SetLogger, or nil if there is none
+*/
+func GetLogger() Logger {
+	return getLogger()
+}
+
+func getLogger() Logger {
+	if h, ok := structuredLogger.Load().(loggerHolder); ok {
+		return h.l
+	}
+	return nil
+}
+
+// Turn one of the traditional format strings into a message for the Logger
+func logMessage(format string, v ...interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintf(format, v...), "\n")
+}
+
+// Returns the time that a verb starts, if it is going to be logged. This
+// avoids reading the clock when there is no Logger.
+func verbStart() time.Time {
+	if getLogger() == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// Write the record for an MQI verb. The object and md are optional. Any extra
+// attributes can be given as key/value pairs in args.
+func logVerb(verb string, start time.Time, qMgr *MQQueueManager, object string, mqreturn *MQReturn, md *MQMD, args ...interface{}) {
+	l := getLogger()
+	if l == nil {
+		return
+	}
+
+	attrs := []interface{}{"verb", verb}
+	if qMgr != nil {
+		attrs = append(attrs, "qmgr", qMgr.Name)
+	}
+	if object != "" {
+		attrs = append(attrs, "object", object)
+	}
+	if mqreturn != nil {
+		attrs = append(attrs,
+			"compCode", mqreturn.MQCC,
+			"reason", mqreturn.MQRC,
+			"reasonName", MQItoString("RC", int(mqreturn.MQRC)))
+	}
+	if !start.IsZero() {
+		attrs = append(attrs, "duration", time.Since(start))
+	}
+	if md != nil {
+		attrs = append(attrs,
+			"msgId", hex.EncodeToString(md.MsgId),
+			"correlId", hex.EncodeToString(md.CorrelId),
+			"format", strings.TrimSpace(md.Format),
+			"persistence", md.Persistence,
+			"msgType", md.MsgType)
+	}
+	attrs = append(attrs, args...)
+
+	l.Debug("MQI call", attrs...)
+}
+
+/*
+JSONLogger is a Logger that writes each record as a single line of JSON,
+in the same layout as the JSON handler in log/slog.
+*/
+type JSONLogger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	debug bool
+}
+
+/*
+NewJSONLogger returns a JSONLogger writing to w. Debug records, which
+include the MQI verb records, are only written if withDebug is true.
+*/
+func NewJSONLogger(w io.Writer, withDebug bool) *JSONLogger {
+	return &JSONLogger{w: w, debug: withDebug}
+}
+
+func (j *JSONLogger) Debug(msg string, args ...interface{}) {
+	if j.debug {
+		j.write("DEBUG", msg, args)
+	}
+}
+
+func (j *JSONLogger) Info(msg string, args ...interface{}) {
+	j.write("INFO", msg, args)
+}
+
+func (j *JSONLogger) Warn(msg string, args ...interface{}) {
+	j.write("WARN", msg, args)
+}
+
+func (j *JSONLogger) Error(msg string, args ...interface{}) {
+	j.write("ERROR", msg, args)
+}
+
+func (j *JSONLogger) write(level string, msg string, args []interface{}) {
+	var b bytes.Buffer
+
+	b.WriteString(`{"time":`)
+	writeJSONValue(&b, time.Now().Format(time.RFC3339Nano))
+	b.WriteString(`,"level":`)
+	writeJSONValue(&b, level)
+	b.WriteString(`,"msg":`)
+	writeJSONValue(&b, msg)
+
+	for i := 0; i < len(args); i += 2 {
+		// A value without a key gets the same treatment as slog gives it
+		key := "!BADKEY"
+		v := args[i]
+		if i+1 < len(args) {
+			key = fmt.Sprint(args[i])
+			v = args[i+1]
+		}
+		b.WriteByte(',')
+		writeJSONValue(&b, key)
+		b.WriteByte(':')
+		writeJSONValue(&b, v)
+	}
+	b.WriteString("}\n")
+
+	j.mu.Lock()
+	j.w.Write(b.Bytes())
+	j.mu.Unlock()
+}
+
+func writeJSONValue(b *bytes.Buffer, v interface{}) {
+	// Errors would otherwise be written as empty objects
+	if err, ok := v.(error); ok {
+		v = err.Error()
+	}
+
+	// Messages often contain '<' and '>', which do not need escaping here
+	var s bytes.Buffer
+	enc := json.NewEncoder(&s)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		s.Reset()
+		enc.Encode(fmt.Sprint(v))
+	}
+	b.Write(bytes.TrimSuffix(s.Bytes(), []byte("\n")))
+}
@@ -0,0 +1,420 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file has the parsers for PCF messages and for the MQDLH and MQRFH2 headers.
+Messages on event, statistics and admin queues come from outside the program, so
+none of the lengths and counts in them can be trusted. Every one is checked against
+the bytes that remain in the buffer before it is used, and anything that does not
+fit is returned as an error wrapping ErrMalformedMessage instead of causing a panic.
+
+The older ReadPCFHeader, ReadPCFEmbeddedHeader and ReadPCFParameter functions call
+these, and keep their original signatures for existing applications.
+*/
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+/*
+ErrMalformedMessage is wrapped by the errors from the Parse functions
+when a message is too short or has inconsistent lengths. Use errors.Is to check for it.
+*/
+var ErrMalformedMessage = errors.New("malformed message")
+
+// Groups can contain groups. Real PCF messages only go a few levels
+// deep, so this is just to stop a hostile message using up the stack.
+const maxPCFGroupDepth = 32
+
+func malformed(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrMalformedMessage, fmt.Sprintf(format, args...))
+}
+
+// A parseReader reads fields from a buffer whose length has already been checked.
+// The Parse functions make sure there are enough bytes before creating one.
+type parseReader struct {
+	buf   []byte
+	off   int
+	order binary.ByteOrder
+}
+
+func (r *parseReader) int32() int32 {
+	v := int32(r.order.Uint32(r.buf[r.off:]))
+	r.off += 4
+	return v
+}
+
+func (r *parseReader) int64() int64 {
+	v := int64(r.order.Uint64(r.buf[r.off:]))
+	r.off += 8
+	return v
+}
+
+func (r *parseReader) bytes(n int) []byte {
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *parseReader) string(n int, ccsid int32) string {
+	return trimToNull(decodeFixedString(r.bytes(n), ccsid))
+}
+
+/*
+ParsePCFHeader extracts the MQCFH from the start of a PCF message. It returns
+the header and the number of bytes used. A StrucLength other than MQCFH_STRUC_LENGTH
+is an error, although ReadPCFHeader still accepts it.
+*/
+func ParsePCFHeader(buf []byte) (*MQCFH, int, error) {
+	return parsePCFHeader(buf, true)
+}
+
+func parsePCFHeader(buf []byte, checkLength bool) (*MQCFH, int, error) {
+	if len(buf) < int(MQCFH_STRUC_LENGTH) {
+		return nil, 0, malformed("MQCFH needs %d bytes but the buffer has %d", MQCFH_STRUC_LENGTH, len(buf))
+	}
+
+	r := &parseReader{buf: buf, order: endian}
+	cfh := new(MQCFH)
+	cfh.Type = r.int32()
+	cfh.StrucLength = r.int32()
+	cfh.Version = r.int32()
+	cfh.Command = r.int32()
+	cfh.MsgSeqNumber = r.int32()
+	cfh.Control = r.int32()
+	cfh.CompCode = r.int32()
+	cfh.Reason = r.int32()
+	cfh.ParameterCount = r.int32()
+
+	if checkLength && cfh.StrucLength != MQCFH_STRUC_LENGTH {
+		return nil, 0, malformed("MQCFH StrucLength is %d", cfh.StrucLength)
+	}
+	if cfh.ParameterCount < 0 {
+		return nil, 0, malformed("MQCFH ParameterCount is %d", cfh.ParameterCount)
+	}
+	return cfh, r.off, nil
+}
+
+/*
+ParsePCFEmbeddedHeader extracts the MQEPH from the start of a PCF message. As with
+ReadPCFEmbeddedHeader, the MQCFH inside it is not read; the returned length is the
+offset of that MQCFH.
+*/
+func ParsePCFEmbeddedHeader(buf []byte) (*MQEPH, int, error) {
+	if len(buf) < int(MQEPH_STRUC_LENGTH_FIXED) {
+		return nil, 0, malformed("MQEPH needs %d bytes but the buffer has %d", MQEPH_STRUC_LENGTH_FIXED, len(buf))
+	}
+
+	r := &parseReader{buf: buf, order: endian}
+	eph := new(MQEPH)
+	r.bytes(4) // StrucId
+	eph.Version = r.int32()
+	eph.StrucLength = r.int32()
+	eph.Encoding = r.int32()
+	eph.CodedCharSetId = r.int32()
+	eph.Format = r.string(int(MQ_FORMAT_LENGTH), eph.CodedCharSetId)
+	eph.Flags = r.int32()
+
+	if eph.StrucLength < MQEPH_STRUC_LENGTH_FIXED || int(eph.StrucLength) > len(buf) {
+		return nil, 0, malformed("MQEPH StrucLength is %d with %d bytes in the buffer", eph.StrucLength, len(buf))
+	}
+	return eph, r.off, nil
+}
+
+/*
+ParsePCFParameter extracts the next PCF parameter element from a message. It returns
+the element and the number of bytes used, which for a group includes all of the
+elements in the group. Elements of an unknown type are skipped using their StrucLength,
+and are returned with only the Type set.
+*/
+func ParsePCFParameter(buf []byte) (*PCFParameter, int, error) {
+	return parsePCFParameter(buf, 0)
+}
+
+// The fixed part of each type of element, which must fit inside its StrucLength
+var pcfFixedLength = map[int32]int32{
+	MQCFT_INTEGER:            MQCFIN_STRUC_LENGTH,
+	MQCFT_INTEGER_LIST:       MQCFIL_STRUC_LENGTH_FIXED,
+	MQCFT_INTEGER64:          MQCFIN64_STRUC_LENGTH,
+	MQCFT_INTEGER64_LIST:     MQCFIL64_STRUC_LENGTH_FIXED,
+	MQCFT_STRING:             MQCFST_STRUC_LENGTH_FIXED,
+	MQCFT_STRING_LIST:        MQCFSL_STRUC_LENGTH_FIXED,
+	MQCFT_GROUP:              MQCFGR_STRUC_LENGTH,
+	MQCFT_BYTE_STRING:        MQCFBS_STRUC_LENGTH_FIXED,
+	MQCFT_INTEGER_FILTER:     MQCFIF_STRUC_LENGTH,
+	MQCFT_STRING_FILTER:      MQCFSF_STRUC_LENGTH_FIXED,
+	MQCFT_BYTE_STRING_FILTER: MQCFBF_STRUC_LENGTH_FIXED,
+}
+
+func parsePCFParameter(buf []byte, depth int) (*PCFParameter, int, error) {
+	if len(buf) < 8 {
+		return nil, 0, malformed("PCF element needs at least 8 bytes but the buffer has %d", len(buf))
+	}
+
+	r := &parseReader{buf: buf, order: endian}
+	p := new(PCFParameter)
+	p.Type = r.int32()
+	p.strucLength = r.int32()
+
+	fixed, known := pcfFixedLength[p.Type]
+	if !known {
+		fixed = 8
+	}
+	if p.strucLength < fixed || int(p.strucLength) > len(buf) {
+		return nil, 0, malformed("PCF element type %d has StrucLength %d with %d bytes in the buffer", p.Type, p.strucLength, len(buf))
+	}
+	end := int(p.strucLength)
+
+	// Check that a variable-length part fits between the current offset and the end of the element
+	fits := func(count int32, size int32) error {
+		// A count larger than the element cannot be right, even if the values have no length
+		if count < 0 || size < 0 || int64(count) > int64(end) {
+			return malformed("PCF element type %d for parameter %d has count %d and length %d",
+				p.Type, p.Parameter, count, size)
+		}
+		if need := int64(r.off) + int64(count)*int64(size); need > int64(end) {
+			return malformed("PCF element type %d for parameter %d needs %d bytes but StrucLength is %d",
+				p.Type, p.Parameter, need, p.strucLength)
+		}
+		return nil
+	}
+
+	switch p.Type {
+	case MQCFT_INTEGER:
+		p.Parameter = r.int32()
+		p.Int64Value = append(p.Int64Value, int64(r.int32()))
+
+	case MQCFT_INTEGER_LIST:
+		p.Parameter = r.int32()
+		count := r.int32()
+		if err := fits(count, 4); err != nil {
+			return nil, 0, err
+		}
+		p.Int64Value = make([]int64, count)
+		for i := range p.Int64Value {
+			p.Int64Value[i] = int64(r.int32())
+		}
+
+	case MQCFT_INTEGER64:
+		p.Parameter = r.int32()
+		r.int32() // Reserved, for alignment
+		p.Int64Value = append(p.Int64Value, r.int64())
+
+	case MQCFT_INTEGER64_LIST:
+		p.Parameter = r.int32()
+		count := r.int32()
+		if err := fits(count, 8); err != nil {
+			return nil, 0, err
+		}
+		p.Int64Value = make([]int64, count)
+		for i := range p.Int64Value {
+			p.Int64Value[i] = r.int64()
+		}
+
+	case MQCFT_STRING:
+		p.Parameter = r.int32()
+		p.CodedCharSetId = r.int32()
+		p.stringLength = r.int32()
+		if err := fits(1, p.stringLength); err != nil {
+			return nil, 0, err
+		}
+		p.String = append(p.String, trimToNull(string(r.bytes(int(p.stringLength)))))
+
+	case MQCFT_STRING_LIST:
+		p.Parameter = r.int32()
+		p.CodedCharSetId = r.int32()
+		count := r.int32()
+		p.stringLength = r.int32()
+		if err := fits(count, p.stringLength); err != nil {
+			return nil, 0, err
+		}
+		p.String = make([]string, count)
+		for i := range p.String {
+			p.String[i] = trimToNull(string(r.bytes(int(p.stringLength))))
+		}
+
+	case MQCFT_GROUP:
+		// The group's StrucLength only covers its own fields. The elements
+		// follow it, and are counted in the returned length.
+		p.Parameter = r.int32()
+		p.ParameterCount = r.int32()
+		if depth >= maxPCFGroupDepth {
+			return nil, 0, malformed("PCF groups are nested more than %d deep", maxPCFGroupDepth)
+		}
+		// Each element takes at least 8 bytes, so a larger count cannot be right
+		if p.ParameterCount < 0 || int64(p.ParameterCount)*8 > int64(len(buf)-end) {
+			return nil, 0, malformed("PCF group %d has ParameterCount %d with %d bytes remaining",
+				p.Parameter, p.ParameterCount, len(buf)-end)
+		}
+		offset := end
+		p.GroupList = make([]*PCFParameter, p.ParameterCount)
+		for i := range p.GroupList {
+			elem, n, err := parsePCFParameter(buf[offset:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			p.GroupList[i] = elem
+			offset += n
+		}
+		return p, offset, nil
+
+	case MQCFT_BYTE_STRING:
+		// The byte string is converted to a hex string as that's how
+		// we expect to use it in reporting
+		p.Parameter = r.int32()
+		p.stringLength = r.int32()
+		if err := fits(1, p.stringLength); err != nil {
+			return nil, 0, err
+		}
+		p.String = append(p.String, hex.EncodeToString(r.bytes(int(p.stringLength))))
+
+	case MQCFT_INTEGER_FILTER:
+		p.Filter.Type = p.Type
+		p.Filter.Parameter = r.int32()
+		p.Filter.Operator = r.int32()
+		p.Filter.FilterValue = int64(r.int32())
+
+	case MQCFT_STRING_FILTER:
+		p.Filter.Type = p.Type
+		p.Filter.Parameter = r.int32()
+		p.Filter.Operator = r.int32()
+		p.CodedCharSetId = r.int32()
+		p.stringLength = r.int32()
+		if err := fits(1, p.stringLength); err != nil {
+			return nil, 0, err
+		}
+		p.Filter.FilterValue = trimToNull(string(r.bytes(int(p.stringLength))))
+
+	case MQCFT_BYTE_STRING_FILTER:
+		p.Filter.Type = p.Type
+		p.Filter.Parameter = r.int32()
+		p.Filter.Operator = r.int32()
+		p.stringLength = r.int32()
+		if err := fits(1, p.stringLength); err != nil {
+			return nil, 0, err
+		}
+		p.Filter.FilterValue = hex.EncodeToString(r.bytes(int(p.stringLength)))
+	}
+
+	return p, end, nil
+}
+
+/*
+ParseHeaderDLH extracts the MQDLH from the start of a message. The md describes
+the message, and gives the encoding and CCSID of the header.
+*/
+func ParseHeaderDLH(md *MQMD, buf []byte) (*MQDLH, int, error) {
+	if len(buf) < int(MQDLH_CURRENT_LENGTH) {
+		return nil, 0, malformed("MQDLH needs %d bytes but the buffer has %d", MQDLH_CURRENT_LENGTH, len(buf))
+	}
+
+	// The strings in the header are in the same CCSID as the header itself
+	ccsid := md.CodedCharSetId
+
+	dlh := NewMQDLH(nil)
+	r := &parseReader{buf: buf, order: dumpByteOrder(md.Encoding)}
+
+	if id := r.string(4, ccsid); id != "DLH" {
+		return nil, 0, malformed("MQDLH StrucId is '%s'", id)
+	}
+	r.int32() // Version
+	dlh.Reason = r.int32()
+	dlh.DestQName = r.string(int(MQ_OBJECT_NAME_LENGTH), ccsid)
+	dlh.DestQMgrName = r.string(int(MQ_Q_MGR_NAME_LENGTH), ccsid)
+	dlh.Encoding = r.int32()
+	dlh.CodedCharSetId = r.int32()
+	dlh.Format = r.string(int(MQ_FORMAT_LENGTH), ccsid)
+	dlh.PutApplType = r.int32()
+	dlh.PutApplName = r.string(int(MQ_PUT_APPL_NAME_LENGTH), ccsid)
+	dlh.PutDate = r.string(int(MQ_PUT_DATE_LENGTH), ccsid)
+	dlh.PutTime = r.string(int(MQ_PUT_TIME_LENGTH), ccsid)
+	dlh.PutDateTime = createGoDateTime(dlh.PutDate, dlh.PutTime)
+
+	return dlh, dlh.strucLength, nil
+}
+
+/*
+ParseHeaderRFH2 extracts the fixed part of an MQRFH2 from the start of a message. The
+returned length is the StrucLength, which includes the name/value strings.
+Use ParseNameValues to get those.
+*/
+func ParseHeaderRFH2(md *MQMD, buf []byte) (*MQRFH2, int, error) {
+	if len(buf) < int(MQRFH_STRUC_LENGTH_FIXED_2) {
+		return nil, 0, malformed("MQRFH2 needs %d bytes but the buffer has %d", MQRFH_STRUC_LENGTH_FIXED_2, len(buf))
+	}
+
+	ccsid := md.CodedCharSetId
+
+	rfh2 := NewMQRFH2(nil)
+	r := &parseReader{buf: buf, order: dumpByteOrder(md.Encoding)}
+
+	if id := r.string(4, ccsid); id != "RFH" {
+		return nil, 0, malformed("MQRFH2 StrucId is '%s'", id)
+	}
+	if version := r.int32(); version != MQRFH_VERSION_2 {
+		return nil, 0, malformed("MQRFH2 Version is %d", version)
+	}
+	rfh2.StrucLength = r.int32()
+	rfh2.Encoding = r.int32()
+	rfh2.CodedCharSetId = r.int32()
+	rfh2.Format = r.string(int(MQ_FORMAT_LENGTH), ccsid)
+	rfh2.Flags = r.int32()
+	rfh2.NameValueCCSID = r.int32()
+
+	if rfh2.StrucLength < MQRFH_STRUC_LENGTH_FIXED_2 || int(rfh2.StrucLength) > len(buf) {
+		return nil, 0, malformed("MQRFH2 StrucLength is %d with %d bytes in the buffer", rfh2.StrucLength, len(buf))
+	}
+	return rfh2, int(rfh2.StrucLength), nil
+}
+
+/*
+ParseNameValues splits the name/value strings in the RFH2 into a string array. The
+buffer starts with the RFH2 itself, as it does for ParseHeaderRFH2. Each string
+is a complete XML-like folder which needs further parsing to get individual properties.
+The integer encoding of the lengths is taken from the md.
+*/
+func (hdr *MQRFH2) ParseNameValues(md *MQMD, buf []byte) ([]string, error) {
+	return hdr.parseNameValues(buf, dumpByteOrder(md.Encoding))
+}
+
+func (hdr *MQRFH2) parseNameValues(buf []byte, order binary.ByteOrder) ([]string, error) {
+	props := make([]string, 0)
+	if hdr.StrucLength < MQRFH_STRUC_LENGTH_FIXED_2 || int(hdr.StrucLength) > len(buf) {
+		return props, malformed("MQRFH2 StrucLength is %d with %d bytes in the buffer", hdr.StrucLength, len(buf))
+	}
+
+	// Each string is preceded by its length
+	r := &parseReader{buf: buf[:hdr.StrucLength], off: int(MQRFH_STRUC_LENGTH_FIXED_2), order: order}
+	for r.off < len(r.buf) {
+		if len(r.buf)-r.off < 4 {
+			return props, malformed("MQRFH2 has %d bytes left over after the name/value strings", len(r.buf)-r.off)
+		}
+		l := r.int32()
+		if l < 0 || int(l) > len(r.buf)-r.off {
+			return props, malformed("MQRFH2 NameValueLength is %d with %d bytes remaining", l, len(r.buf)-r.off)
+		}
+		props = append(props, r.string(int(l), hdr.NameValueCCSID))
+	}
+	return props, nil
+}
@@ -0,0 +1,367 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file deals with unprocessable or "poison" messages. It does the same job as the
+amqsbo.go sample, but is done automatically during an MQGET when the application
+has set the PoisonHandler field in the MQGMO.
+
+A message whose BackoutCount has reached the queue's BOTHRESH is moved to the
+queue named in BOQNAME. If there is no BOQNAME, the queue manager's dead letter queue
+is used instead, with an MQDLH added to the front of the message. The MQGET is then
+retried so the application only sees messages that it has a chance of processing.
+
+When the MQGET is under syncpoint, the MQPUT1 of the poison message is done in the same
+unit of work, and it is up to the application to commit or back out as it would for
+any other message. If the MQPUT1 fails, the error is returned so that the application
+can back out and leave the message on the input queue.
+
+The message is moved with MQPMO_PASS_ALL_CONTEXT so that it keeps its original context
+fields. That needs the input queue to be opened with MQOO_SAVE_ALL_CONTEXT, and passall
+authority on the destination queue. If the MQPUT1 fails with MQRC_CONTEXT_HANDLE_ERROR or
+MQRC_NOT_AUTHORIZED, it is tried again with default context, so the message is still moved
+but the context fields are set by the queue manager.
+
+Message properties that were returned in the MQGMO MsgHandle are forwarded with the message.
+If the ibmmqotel package has been told to remove the RFH2 from messages, it is not moved
+either: only the body that the application would have seen is put to the backout queue.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+/*
+PoisonEvent describes what the PoisonHandler did with a message. It is passed
+to the Hook function so that the application can log or alert on it.
+*/
+type PoisonEvent struct {
+	QMgrName         string
+	QName            string // The queue the message was read from
+	DestQName        string // Where it was moved to
+	UsedDeadLetterQ  bool   // True if there was no BOQNAME so the DEADQ was used
+	BackoutCount     int32
+	BackoutThreshold int32
+	MD               *MQMD // A copy of the original message descriptor
+	Err              error // Set if the message could not be moved
+}
+
+/*
+PoisonHandler holds the configuration for automatic handling of poison messages, and
+caches the queue attributes that control it. A single handler can be shared by
+several queues and connections.
+*/
+type PoisonHandler struct {
+	// Hook is called after each attempt to move a message
+	Hook func(*PoisonEvent)
+	// Reason is put into the MQDLH when one is created
+	Reason int32
+	// Also add an MQDLH when moving a message to the BOQNAME queue
+	AddDLHToBackoutQ bool
+
+	mutex  sync.Mutex
+	queues map[string]*poisonQueueInfo
+	deadQ  map[int32]string
+}
+
+type poisonQueueInfo struct {
+	threshold int32
+	boQName   string
+}
+
+/*
+NewPoisonHandler fills in default values for the PoisonHandler structure. The
+hook function can be nil.
+*/
+func NewPoisonHandler(hook func(*PoisonEvent)) *PoisonHandler {
+	h := new(PoisonHandler)
+	h.Hook = hook
+	h.Reason = MQRC_UNEXPECTED_ERROR
+	h.AddDLHToBackoutQ = false
+	h.queues = make(map[string]*poisonQueueInfo)
+	h.deadQ = make(map[int32]string)
+	return h
+}
+
+/*
+Refresh discards the cached BOTHRESH, BOQNAME and DEADQ values so that they
+are inquired again on next use. Call it if the queue definitions are changed.
+*/
+func (h *PoisonHandler) Refresh() {
+	h.mutex.Lock()
+	h.queues = make(map[string]*poisonQueueInfo)
+	h.deadQ = make(map[int32]string)
+	h.mutex.Unlock()
+}
+
+// This is called from the Get/GetSlice functions in place of the direct getInternal call.
+// When a message has been moved, the MQMD is reset to its input values and we try again.
+func (object MQObject) getPoisonChecked(gomd *MQMD, gogmo *MQGMO, buffer []byte, useCap bool) (int, int, error) {
+	h := gogmo.PoisonHandler
+	if h == nil {
+		return object.getInternal(gomd, gogmo, buffer, useCap)
+	}
+
+	savedMD := cloneMD(gomd)
+	for {
+		datalen, removed, err := object.getInternal(gomd, gogmo, buffer, useCap)
+		if err != nil || gomd.BackoutCount == 0 {
+			return datalen, removed, err
+		}
+
+		moved, err := h.check(object, gomd, gogmo, buffer[removed:datalen])
+		if err != nil {
+			return 0, 0, err
+		}
+		if !moved {
+			return datalen, removed, nil
+		}
+
+		*gomd = *cloneMD(savedMD)
+	}
+}
+
+// Decide if the message needs to be moved and, if so, do it. The returned
+// boolean says whether the message has gone. The buffer holds the message body
+// described by the MQMD; properties in the MQGMO MsgHandle are forwarded separately.
+func (h *PoisonHandler) check(object MQObject, gomd *MQMD, gogmo *MQGMO, buffer []byte) (bool, error) {
+	traceEntry("poisonCheck")
+
+	if gogmo.Options&(MQGMO_BROWSE_FIRST|MQGMO_BROWSE_NEXT|MQGMO_BROWSE_MSG_UNDER_CURSOR) != 0 {
+		traceExitF("poisonCheck", 1, "Browsing")
+		return false, nil
+	}
+
+	info, err := h.queueInfo(object)
+	if err != nil {
+		// If we can't find the attributes, the application gets the message as normal
+		logTrace("poisonCheck: Cannot inquire backout attributes for %s: %v", object.Name, err)
+		traceExitErr("poisonCheck", 2, err)
+		return false, nil
+	}
+	if !overBackoutThreshold(gomd.BackoutCount, info.threshold) {
+		traceExitF("poisonCheck", 3, "BackoutCount %d Threshold %d", gomd.BackoutCount, info.threshold)
+		return false, nil
+	}
+
+	syncpoint := gogmo.Options&MQGMO_SYNCPOINT != 0 ||
+		(gogmo.Options&MQGMO_SYNCPOINT_IF_PERSISTENT != 0 && gomd.Persistence == MQPER_PERSISTENT)
+
+	ev := &PoisonEvent{
+		QMgrName:         object.qMgr.Name,
+		QName:            object.Name,
+		BackoutCount:     gomd.BackoutCount,
+		BackoutThreshold: info.threshold,
+		MD:               cloneMD(gomd),
+	}
+
+	destQ, usedDLQ, addDLH := h.destination(info, func() string { return h.deadQName(object.qMgr) })
+	ev.DestQName = destQ
+	ev.UsedDeadLetterQ = usedDLQ
+
+	if destQ == "" {
+		ev.Err = &MQReturn{MQCC: MQCC_FAILED,
+			MQRC: MQRC_UNKNOWN_OBJECT_NAME,
+			verb: "MQPUT1",
+		}
+		h.callHook(ev)
+		traceExitErr("poisonCheck", 4, ev.Err)
+		return false, nil
+	}
+
+	md := cloneMD(gomd)
+	data := buffer
+	if addDLH {
+		// Creating the DLH also updates the MQMD to refer to the new format
+		dlh := NewMQDLH(md)
+		dlh.Reason = h.Reason
+		dlh.DestQName = object.Name
+		dlh.DestQMgrName = object.qMgr.Name
+		data = append(dlh.Bytes(), buffer...)
+	}
+
+	od := NewMQOD()
+	od.ObjectType = MQOT_Q
+	od.ObjectName = destQ
+
+	// The context comes from the MQGET on the input queue, so the moved
+	// message keeps its original identity and origin fields
+	pmo := NewMQPMO()
+	pmo.Options = MQPMO_PASS_ALL_CONTEXT
+	pmo.Context = &object
+	if syncpoint {
+		pmo.Options |= MQPMO_SYNCPOINT
+	} else {
+		pmo.Options |= MQPMO_NO_SYNCPOINT
+	}
+	if IsUsableHandle(gogmo.MsgHandle) {
+		pmo.OriginalMsgHandle = gogmo.MsgHandle
+		pmo.Action = MQACTP_FORWARD
+	}
+
+	err = object.qMgr.Put1(od, md, pmo, data)
+	if err != nil && retryWithDefaultContext(err) {
+		logTrace("poisonCheck: Cannot pass context to %s (%v). Using default context", destQ, err)
+		pmo.Options &^= MQPMO_PASS_ALL_CONTEXT
+		pmo.Options |= MQPMO_DEFAULT_CONTEXT
+		pmo.Context = nil
+		err = object.qMgr.Put1(od, md, pmo, data)
+	}
+	ev.Err = err
+	h.callHook(ev)
+
+	if err != nil {
+		if !syncpoint {
+			// The message has already been removed from the queue so
+			// the application must get it rather than lose it
+			traceExitErr("poisonCheck", 5, err)
+			return false, nil
+		}
+		// The application backs out to put the message back on the input queue
+		traceExitErr("poisonCheck", 6, err)
+		return false, err
+	}
+
+	traceExitF("poisonCheck", 0, "Moved to %s", destQ)
+	return true, nil
+}
+
+// Whether a failed MQPUT1 with MQPMO_PASS_ALL_CONTEXT should be tried again with default
+// context. The input queue might not have been opened with MQOO_SAVE_ALL_CONTEXT, or the
+// application might not have passall authority on the destination.
+func retryWithDefaultContext(err error) bool {
+	var mqreturn *MQReturn
+	if errors.As(err, &mqreturn) {
+		switch mqreturn.MQRC {
+		case MQRC_CONTEXT_HANDLE_ERROR, MQRC_NOT_AUTHORIZED:
+			return true
+		}
+	}
+	return false
+}
+
+// A threshold of zero means that the queue does not have backout processing
+func overBackoutThreshold(backoutCount int32, threshold int32) bool {
+	return threshold > 0 && backoutCount >= threshold
+}
+
+// Work out where a poison message goes, and whether it needs an MQDLH. The
+// deadQ function is only called when there is no BOQNAME, as it might need an MQINQ.
+func (h *PoisonHandler) destination(info *poisonQueueInfo, deadQ func() string) (string, bool, bool) {
+	if info.boQName != "" {
+		return info.boQName, false, h.AddDLHToBackoutQ
+	}
+	return deadQ(), true, true
+}
+
+func (h *PoisonHandler) callHook(ev *PoisonEvent) {
+	if h.Hook != nil {
+		h.Hook(ev)
+	}
+}
+
+// Find the BOTHRESH and BOQNAME values for the queue. The object might not have been
+// opened with MQOO_INQUIRE, so if necessary we do a separate open of the queue.
+func (h *PoisonHandler) queueInfo(object MQObject) (*poisonQueueInfo, error) {
+	key := fmt.Sprintf("%d/%s", object.qMgr.GetValue(), object.Name)
+
+	h.mutex.Lock()
+	info, ok := h.queues[key]
+	h.mutex.Unlock()
+	if ok {
+		return info, nil
+	}
+
+	selectors := []int32{
+		MQCA_BACKOUT_REQ_Q_NAME,
+		MQIA_BACKOUT_THRESHOLD,
+	}
+
+	values, err := object.Inq(selectors)
+	if err != nil {
+		od := NewMQOD()
+		od.ObjectType = MQOT_Q
+		od.ObjectName = object.Name
+		inqObject, openErr := object.qMgr.Open(od, MQOO_INQUIRE)
+		if openErr != nil {
+			return nil, openErr
+		}
+		values, err = inqObject.Inq(selectors)
+		inqObject.Close(0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	info = &poisonQueueInfo{
+		boQName:   values[MQCA_BACKOUT_REQ_Q_NAME].(string),
+		threshold: values[MQIA_BACKOUT_THRESHOLD].(int32),
+	}
+	logTrace("poisonCheck: Queue %s BOQNAME=%s BOTHRESH=%d", object.Name, info.boQName, info.threshold)
+
+	h.mutex.Lock()
+	if h.queues == nil {
+		h.queues = make(map[string]*poisonQueueInfo)
+	}
+	h.queues[key] = info
+	h.mutex.Unlock()
+
+	return info, nil
+}
+
+// Find the DEADQ for the queue manager. An empty string is returned if it's not
+// set or cannot be discovered.
+func (h *PoisonHandler) deadQName(qMgr *MQQueueManager) string {
+	key := qMgr.GetValue()
+
+	h.mutex.Lock()
+	name, ok := h.deadQ[key]
+	h.mutex.Unlock()
+	if ok {
+		return name
+	}
+
+	od := NewMQOD()
+	od.ObjectType = MQOT_Q_MGR
+	qMgrObject, err := qMgr.Open(od, MQOO_INQUIRE)
+	if err != nil {
+		logTrace("poisonCheck: Cannot open queue manager for inquire: %v", err)
+		return ""
+	}
+	values, err := qMgrObject.Inq([]int32{MQCA_DEAD_LETTER_Q_NAME})
+	qMgrObject.Close(0)
+	if err != nil {
+		logTrace("poisonCheck: Cannot inquire DEADQ: %v", err)
+		return ""
+	}
+
+	name = values[MQCA_DEAD_LETTER_Q_NAME].(string)
+	h.mutex.Lock()
+	if h.deadQ == nil {
+		h.deadQ = make(map[int32]string)
+	}
+	h.deadQ[key] = name
+	h.mutex.Unlock()
+
+	return name
+}
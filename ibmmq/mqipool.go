@@ -0,0 +1,465 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file provides a pool of connections to a queue manager, for applications
+such as web services that would otherwise need an MQCONNX for each request. Each
+pooled connection also keeps the objects that have been opened with it, so
+that repeated requests to the same queue do not need an MQOPEN every time.
+
+A connection taken from the pool belongs to one goroutine until it is released.
+Goroutines are not tied to threads, so every pooled connection is made with a
+shareable handle: MQCNO_HANDLE_SHARE_NONE is removed from the CNO, and
+MQCNO_HANDLE_SHARE_BLOCK is added if neither of the sharing options was given.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+/*
+ErrPoolClosed is returned by Pool.Get after the pool has been closed
+*/
+var ErrPoolClosed = errors.New("ibmmq: connection pool is closed")
+
+/*
+PoolHealthCheck selects how an idle connection is tested before it is reused
+*/
+type PoolHealthCheck int
+
+const (
+	PoolHealthCheckNone PoolHealthCheck = iota // Connections are not tested
+	PoolHealthCheckInq                         // Use MQINQ on the queue manager object
+)
+
+/*
+Pool is a set of connections to a queue manager. The exported fields can be
+changed after NewPool but before the first call to Get or Fill.
+*/
+type Pool struct {
+	MinIdle         int             // Number of idle connections to keep ready
+	MaxIdle         int             // Idle connections beyond this are disconnected when released
+	MaxOpen         int             // Limit on total connections. 0 means no limit
+	MaxLifetime     time.Duration   // Connections older than this are not reused. 0 means no limit
+	HealthCheck     PoolHealthCheck // How to test an idle connection before reuse
+	HealthCheckIdle time.Duration   // Only test connections that have been idle for at least this long
+	// Issue MQBACK when a connection is released, in case the application left a unit of
+	// work open. The default is true; it can be turned off by applications that never use syncpoint
+	BackoutOnRelease bool
+
+	qMgrName string
+	cno      MQCNO
+
+	once      sync.Once
+	connMutex sync.Mutex // Serialises MQCONNX as the CNO substructures are shared
+	mutex     sync.Mutex
+	idle      []*PooledConn
+	open      int
+	filling   bool
+	closed    bool
+	wake      chan struct{} // Signalled when a connection is released or disconnected
+}
+
+/*
+PoolStats gives the current size of the pool
+*/
+type PoolStats struct {
+	Open int // All connections, idle or in use
+	Idle int
+}
+
+/*
+PooledConn is a connection taken from a Pool. It must only be used by one
+goroutine at a time, and must be given back with Release when the work is done.
+*/
+type PooledConn struct {
+	pool     *Pool
+	qMgr     MQQueueManager
+	objects  map[string]pooledObject
+	created  time.Time
+	lastUsed time.Time
+	broken   bool
+	released bool
+}
+
+// An object in a connection's cache, with the MQOD as it was after the MQOPEN
+type pooledObject struct {
+	obj MQObject
+	od  MQOD
+}
+
+/*
+NewPool creates a pool of connections for the queue manager. The CNO is copied,
+and is used for every connection. No connections are made until they are needed,
+or until Fill is called.
+*/
+func NewPool(goQMgrName string, gocno *MQCNO) *Pool {
+	p := new(Pool)
+	p.MinIdle = 0
+	p.MaxIdle = 2
+	p.MaxOpen = 10
+	p.MaxLifetime = 30 * time.Minute
+	p.HealthCheck = PoolHealthCheckInq
+	p.HealthCheckIdle = 5 * time.Second
+	p.BackoutOnRelease = true
+	p.qMgrName = goQMgrName
+	p.cno = poolCNO(gocno)
+	return p
+}
+
+// Take a copy of the application's CNO with the handle sharing options that a pool needs
+func poolCNO(gocno *MQCNO) MQCNO {
+	var cno MQCNO
+	if gocno != nil {
+		cno = *gocno
+	} else {
+		cno = *NewMQCNO()
+	}
+	cno.Options &^= MQCNO_HANDLE_SHARE_NONE
+	if cno.Options&(MQCNO_HANDLE_SHARE_BLOCK|MQCNO_HANDLE_SHARE_NO_BLOCK) == 0 {
+		cno.Options |= MQCNO_HANDLE_SHARE_BLOCK
+	}
+	return cno
+}
+
+// The key for the object cache. Anything in the MQOD that can select a different
+// object is included.
+func poolObjectKey(good *MQOD, goOpenOptions int32) string {
+	return fmt.Sprintf("%d/%s/%s/%s/%d", good.ObjectType, good.ObjectQMgrName, good.ObjectName, good.ObjectString, goOpenOptions)
+}
+
+func (p *Pool) init() {
+	p.once.Do(func() {
+		n := p.MaxOpen
+		if n <= 0 {
+			n = 1
+		}
+		p.wake = make(chan struct{}, n)
+	})
+}
+
+// Let a waiting Get know that it might be able to continue
+func (p *Pool) signal() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+/*
+Get returns a connection from the pool. An idle connection is used if there is
+one; otherwise a new connection is made unless MaxOpen has been reached, in which case
+Get waits until another connection is released or the context is done.
+*/
+func (p *Pool) Get(ctx context.Context) (*PooledConn, error) {
+	traceEntry("PoolGet")
+	p.init()
+
+	for {
+		p.mutex.Lock()
+		if p.closed {
+			p.mutex.Unlock()
+			traceExitErr("PoolGet", 1, ErrPoolClosed)
+			return nil, ErrPoolClosed
+		}
+
+		if n := len(p.idle); n > 0 {
+			c := p.idle[n-1]
+			p.idle = p.idle[:n-1]
+			p.mutex.Unlock()
+			p.maintain()
+
+			if p.usable(c) {
+				c.released = false
+				traceExitF("PoolGet", 0, "Reused connection")
+				return c, nil
+			}
+			p.discard(c)
+			continue
+		}
+
+		if p.MaxOpen <= 0 || p.open < p.MaxOpen {
+			p.open++
+			p.mutex.Unlock()
+
+			c, err := p.connect()
+			if err != nil {
+				traceExitErr("PoolGet", 2, err)
+				return nil, err
+			}
+			p.maintain()
+			traceExitF("PoolGet", 0, "New connection")
+			return c, nil
+		}
+		p.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			traceExitErr("PoolGet", 3, ctx.Err())
+			return nil, ctx.Err()
+		case <-p.wake:
+		}
+	}
+}
+
+/*
+Fill makes enough connections for there to be MinIdle idle connections, within the
+MaxOpen limit. It can be used at startup so that the first requests do not wait for a connection.
+*/
+func (p *Pool) Fill() error {
+	p.init()
+
+	// Connections beyond MaxIdle would be disconnected as soon as they were released
+	target := p.MinIdle
+	if target > p.MaxIdle {
+		target = p.MaxIdle
+	}
+
+	for {
+		p.mutex.Lock()
+		if p.closed || len(p.idle) >= target || (p.MaxOpen > 0 && p.open >= p.MaxOpen) {
+			p.mutex.Unlock()
+			return nil
+		}
+		p.open++
+		p.mutex.Unlock()
+
+		c, err := p.connect()
+		if err != nil {
+			logError("Pool: Cannot make idle connection: %v", err)
+			return err
+		}
+		c.Release()
+	}
+}
+
+// Start filling the pool in the background if it has dropped below MinIdle
+func (p *Pool) maintain() {
+	p.mutex.Lock()
+	start := !p.closed && !p.filling && len(p.idle) < p.MinIdle && len(p.idle) < p.MaxIdle
+	if start {
+		p.filling = true
+	}
+	p.mutex.Unlock()
+
+	if start {
+		go func() {
+			p.Fill()
+			p.mutex.Lock()
+			p.filling = false
+			p.mutex.Unlock()
+		}()
+	}
+}
+
+// Make a new connection. The caller has already counted it in p.open.
+func (p *Pool) connect() (*PooledConn, error) {
+	p.connMutex.Lock()
+	cno := p.cno
+	qMgr, err := Connx(p.qMgrName, &cno)
+	p.connMutex.Unlock()
+
+	// A warning such as MQRC_SSL_ALREADY_INITIALIZED still gives a working connection
+	if err != nil && isWarning(err) {
+		logTrace("Pool: Connection made with warning: %v", err)
+		err = nil
+	}
+	if err != nil {
+		p.mutex.Lock()
+		p.open--
+		p.mutex.Unlock()
+		p.signal()
+		return nil, err
+	}
+
+	now := time.Now()
+	c := &PooledConn{pool: p, qMgr: qMgr, objects: make(map[string]pooledObject), created: now, lastUsed: now}
+	return c, nil
+}
+
+func (p *Pool) expired(c *PooledConn) bool {
+	return p.MaxLifetime > 0 && time.Since(c.created) >= p.MaxLifetime
+}
+
+// Decide whether an idle connection can be handed out again
+func (p *Pool) usable(c *PooledConn) bool {
+	if p.expired(c) {
+		logTrace("Pool: Connection has reached its maximum lifetime")
+		return false
+	}
+	if p.HealthCheck == PoolHealthCheckNone || time.Since(c.lastUsed) < p.HealthCheckIdle {
+		return true
+	}
+
+	// An MQINQ has no side effects, unlike MQSTAT which would clear any
+	// asynchronous put errors that the application has not yet collected
+	od := NewMQOD()
+	od.ObjectType = MQOT_Q_MGR
+	qMgrObject, err := c.Open(od, MQOO_INQUIRE)
+	if err == nil {
+		_, err = qMgrObject.Inq([]int32{MQCA_Q_MGR_NAME})
+	}
+	if err != nil {
+		logTrace("Pool: Health check failed: %v", err)
+		return false
+	}
+	return true
+}
+
+// Close the cached objects and disconnect
+func (p *Pool) discard(c *PooledConn) {
+	if !c.broken {
+		for _, po := range c.objects {
+			po.obj.Close(0)
+		}
+	}
+	c.objects = nil
+	if err := c.qMgr.Disc(); err != nil {
+		logTrace("Pool: Disconnect failed: %v", err)
+	}
+
+	p.mutex.Lock()
+	p.open--
+	p.mutex.Unlock()
+	p.signal()
+}
+
+/*
+Stats returns the number of connections in the pool
+*/
+func (p *Pool) Stats() PoolStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return PoolStats{Open: p.open, Idle: len(p.idle)}
+}
+
+/*
+Close disconnects the idle connections. Connections that are in use are
+disconnected when they are released. Get returns ErrPoolClosed after this.
+*/
+func (p *Pool) Close() {
+	traceEntry("PoolClose")
+	p.init()
+
+	p.mutex.Lock()
+	p.closed = true
+	idle := p.idle
+	p.idle = nil
+	p.mutex.Unlock()
+
+	for _, c := range idle {
+		p.discard(c)
+	}
+	traceExit("PoolClose")
+}
+
+/*
+QMgr returns the underlying connection. It must not be disconnected directly.
+*/
+func (c *PooledConn) QMgr() *MQQueueManager {
+	return &c.qMgr
+}
+
+/*
+Open returns an object opened on this connection. If the same object has already
+been opened with the same options it is reused, so the application should not
+close it; it stays open for as long as the connection is in the pool. When a cached
+object is returned, the output fields of the MQOD are set from the original MQOPEN.
+
+Objects that should not be shared between requests, such as model queues which
+create a new dynamic queue each time, should be opened directly through QMgr instead.
+*/
+func (c *PooledConn) Open(good *MQOD, goOpenOptions int32) (MQObject, error) {
+	key := poolObjectKey(good, goOpenOptions)
+	if po, ok := c.objects[key]; ok {
+		copyODOutput(good, &po.od)
+		return po.obj, nil
+	}
+
+	obj, err := c.qMgr.Open(good, goOpenOptions)
+	if err != nil {
+		if IsConnectionLost(err) {
+			c.broken = true
+		}
+		return obj, err
+	}
+	c.objects[key] = pooledObject{obj: obj, od: cloneOD(good)}
+	return obj, nil
+}
+
+// Set the fields in the MQOD that MQOPEN returns
+func copyODOutput(good *MQOD, from *MQOD) {
+	good.ResolvedQName = from.ResolvedQName
+	good.ResolvedQMgrName = from.ResolvedQMgrName
+	good.ResObjectString = from.ResObjectString
+	good.ResolvedType = from.ResolvedType
+}
+
+/*
+Discard marks the connection as unusable, for example after an MQI call has
+failed with a connection error. It is disconnected instead of being
+returned to the pool when it is released.
+*/
+func (c *PooledConn) Discard() {
+	c.broken = true
+}
+
+/*
+Release gives the connection back to the pool. Unless BackoutOnRelease has been turned off,
+any unit of work that the application left open is backed out first, so that the next
+user of the connection cannot commit it. If that fails, or
+the connection is broken or has reached its maximum lifetime, or there are already
+enough idle connections, it is disconnected. Calling Release more than once has no effect.
+*/
+func (c *PooledConn) Release() {
+	if c.released {
+		return
+	}
+	c.released = true
+	p := c.pool
+
+	if !c.broken && p.BackoutOnRelease {
+		if err := c.qMgr.Back(); err != nil {
+			logTrace("Pool: Backout on release failed: %v", err)
+			c.broken = true
+		}
+	}
+
+	if c.broken || p.expired(c) {
+		p.discard(c)
+		return
+	}
+
+	c.lastUsed = time.Now()
+	p.mutex.Lock()
+	if p.closed || len(p.idle) >= p.MaxIdle {
+		p.mutex.Unlock()
+		p.discard(c)
+		return
+	}
+	p.idle = append(p.idle, c)
+	p.mutex.Unlock()
+	p.signal()
+}
@@ -0,0 +1,646 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file provides an application-managed alternative to the MQCNO_RECONNECT
+option. The ReconnectingQueueManager remembers how it connected and which
+objects and subscriptions have been opened through it. When any verb fails because the
+connection has gone, it reconnects - retrying with backoff - and reopens everything.
+
+Unlike automatic client reconnection, the application is told when work has been
+lost. If a unit of work was in progress, the uncommitted operations cannot be
+recovered so the verb returns a StateLostError. Otherwise the failed call is
+simply run again on the new connection.
+
+Some state cannot be carried across a reconnection even when there is no unit of work:
+browse cursors start again from the beginning, and temporary dynamic queues are
+created afresh (with a new name) so any messages on them are gone. A non-transactional
+put that failed with a connection error might already have been done, so replaying
+it can give a duplicate message.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+/*
+StateLostError is returned when the connection was lost while a unit of work was in
+progress. The connection has been re-established, but the work done in the unit of
+work has been backed out by the queue manager, or its outcome is unknown if
+the failure happened during the commit. The application should redo the whole unit of work.
+*/
+type StateLostError struct {
+	Verb string
+	Err  error // The error that showed the connection had gone
+}
+
+func (e *StateLostError) Error() string {
+	return fmt.Sprintf("%s: connection lost during a unit of work. Uncommitted work has been lost: %v", e.Verb, e.Err)
+}
+
+/*
+Unwrap gives access to the original MQReturn
+*/
+func (e *StateLostError) Unwrap() error {
+	return e.Err
+}
+
+const defaultReconnectJitter = 0.2
+
+/*
+ReconnectingQueueManager wraps a connection, and the objects opened with it, so
+that it can be transparently replaced after a failure
+*/
+type ReconnectingQueueManager struct {
+	qMgrName string
+	cno      MQCNO
+	policy   *BackoffPolicy
+
+	mutex      sync.Mutex
+	qMgr       *MQQueueManager
+	objects    []*ReconnectingObject
+	inUOW      bool
+	generation int
+
+	// Set while a reconnection is in progress, so that other goroutines can wait for it
+	// without the mutex being held across the delays between attempts
+	reconnecting chan struct{}
+	reconnectErr error
+}
+
+/*
+ReconnectingObject is an object or subscription opened through a ReconnectingQueueManager.
+The underlying MQObject changes after a reconnection.
+*/
+type ReconnectingObject struct {
+	rqm *ReconnectingQueueManager
+	obj MQObject
+
+	od          MQOD
+	openOptions int32
+
+	sd      *MQSD               // Set for subscriptions
+	dest    *ReconnectingObject // The destination queue for a subscription
+	managed bool                // This is a managed destination, reopened with its subscription
+}
+
+/*
+ConnxReconnecting connects to the queue manager in the same way as Connx, and
+returns a ReconnectingQueueManager. The CNO is copied, so that the same options can be
+used to reconnect; any MQCNO_RECONNECT options in it are replaced by MQCNO_RECONNECT_DISABLED
+as the two mechanisms would conflict. The policy controls how many times, and how often, a
+reconnection is tried. A nil policy uses the values from NewBackoffPolicy, but with a Jitter
+of 0.2 so that applications that lost their connections at the same moment, for example
+when the queue manager restarted, do not all try to reconnect together.
+*/
+func ConnxReconnecting(goQMgrName string, gocno *MQCNO, policy *BackoffPolicy) (*ReconnectingQueueManager, error) {
+	traceEntry("ConnxReconnecting")
+
+	r := new(ReconnectingQueueManager)
+	r.qMgrName = goQMgrName
+	if gocno != nil {
+		r.cno = *gocno
+	} else {
+		r.cno = *NewMQCNO()
+	}
+	r.cno.Options &^= (MQCNO_RECONNECT | MQCNO_RECONNECT_Q_MGR)
+	r.cno.Options |= MQCNO_RECONNECT_DISABLED
+
+	if policy == nil {
+		policy = NewBackoffPolicy()
+		policy.Jitter = defaultReconnectJitter
+	}
+	r.policy = policy
+
+	qMgr, err := Connx(r.qMgrName, &r.cno)
+	if err != nil && isWarning(err) {
+		// For example MQRC_SSL_ALREADY_INITIALIZED. The connection is still usable
+		logTrace("ConnxReconnecting: Connected with warning: %v", err)
+		err = nil
+	}
+	if err != nil {
+		traceExitErr("ConnxReconnecting", 1, err)
+		return nil, err
+	}
+	r.qMgr = &qMgr
+
+	traceExit("ConnxReconnecting")
+	return r, nil
+}
+
+/*
+QMgr returns the current underlying connection. It changes after a reconnection, so
+should not be saved.
+*/
+func (r *ReconnectingQueueManager) QMgr() *MQQueueManager {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.qMgr
+}
+
+/*
+Object returns the current underlying object. It changes after a reconnection, so
+should not be saved.
+*/
+func (o *ReconnectingObject) Object() MQObject {
+	o.rqm.mutex.Lock()
+	defer o.rqm.mutex.Unlock()
+	return o.obj
+}
+
+/*
+Disc disconnects from the queue manager. Recorded objects are forgotten.
+*/
+func (r *ReconnectingQueueManager) Disc() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.objects = nil
+	r.inUOW = false
+	return r.qMgr.Disc()
+}
+
+/*
+Open an object, and remember it so it can be reopened after a reconnection
+*/
+func (r *ReconnectingQueueManager) Open(good *MQOD, goOpenOptions int32) (*ReconnectingObject, error) {
+	o := &ReconnectingObject{rqm: r, od: cloneOD(good), openOptions: goOpenOptions}
+
+	err := r.run("MQOPEN", false, func(qMgr *MQQueueManager) error {
+		// The MQOD is updated by MQOPEN, for example with the name of a dynamic
+		// queue, so each attempt starts with a fresh copy
+		od := cloneOD(&o.od)
+		obj, err := qMgr.Open(&od, goOpenOptions)
+		if err == nil {
+			*good = od
+			o.obj = obj
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.record(o)
+	return o, nil
+}
+
+/*
+Sub creates or resumes a subscription, and remembers it so it can be reopened after a
+reconnection. For a managed subscription, qObject should be a new ReconnectingObject
+(for example &ibmmq.ReconnectingObject{}) which is filled in with the managed
+queue. Otherwise it must be a queue opened through this ReconnectingQueueManager.
+
+When a durable subscription is reopened after a reconnection, MQSO_RESUME is
+used in place of MQSO_CREATE.
+*/
+func (r *ReconnectingQueueManager) Sub(gosd *MQSD, qObject *ReconnectingObject) (*ReconnectingObject, error) {
+	o := r.newSubscription(gosd, qObject)
+
+	err := r.run("MQSUB", false, func(qMgr *MQQueueManager) error {
+		sd := cloneSD(o.sd)
+		dest := o.dest.Object()
+		obj, err := qMgr.Sub(sd, &dest)
+		if err == nil {
+			*gosd = *sd
+			o.obj = obj
+			r.mutex.Lock()
+			o.dest.obj = dest
+			r.mutex.Unlock()
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.record(o)
+	return o, nil
+}
+
+// Create the record for a subscription. The destination is recorded as belonging to
+// this ReconnectingQueueManager whether or not it was opened through it, so that
+// it is reopened along with the subscription.
+func (r *ReconnectingQueueManager) newSubscription(gosd *MQSD, qObject *ReconnectingObject) *ReconnectingObject {
+	if qObject == nil {
+		qObject = &ReconnectingObject{}
+	}
+	qObject.rqm = r
+	if gosd.Options&MQSO_MANAGED != 0 {
+		qObject.managed = true
+	}
+	return &ReconnectingObject{rqm: r, sd: cloneSD(gosd), dest: qObject}
+}
+
+/*
+Inq inquires on attributes of the queue manager object. It opens and
+closes the queue manager for each call.
+*/
+func (r *ReconnectingQueueManager) Inq(goSelectors []int32) (map[int32]interface{}, error) {
+	var values map[int32]interface{}
+	err := r.run("MQINQ", false, func(qMgr *MQQueueManager) error {
+		od := NewMQOD()
+		od.ObjectType = MQOT_Q_MGR
+		qMgrObject, err := qMgr.Open(od, MQOO_INQUIRE)
+		if err != nil {
+			return err
+		}
+		values, err = qMgrObject.Inq(goSelectors)
+		qMgrObject.Close(0)
+		return err
+	})
+	return values, err
+}
+
+/*
+Stat returns status information. After a reconnection, the information is about the
+new connection; an MQSTAT_TYPE_ASYNC_ERROR failure that was reported on the
+old connection cannot be retrieved.
+*/
+func (r *ReconnectingQueueManager) Stat(statusType int32, gosts *MQSTS) error {
+	return r.run("MQSTAT", false, func(qMgr *MQQueueManager) error {
+		return qMgr.Stat(statusType, gosts)
+	})
+}
+
+/*
+Begin starts a global unit of work. It is only available for locally-bound
+connections.
+*/
+func (r *ReconnectingQueueManager) Begin(gobo *MQBO) error {
+	// Nothing has been done in the unit of work yet, so a failure can be retried
+	err := r.run("MQBEGIN", false, func(qMgr *MQQueueManager) error {
+		return qMgr.Begin(gobo)
+	})
+	if err == nil {
+		r.mutex.Lock()
+		r.inUOW = true
+		r.mutex.Unlock()
+	}
+	return err
+}
+
+/*
+Put1 puts a single message
+*/
+func (r *ReconnectingQueueManager) Put1(good *MQOD, gomd *MQMD, gopmo *MQPMO, buffer []byte) error {
+	return r.run("MQPUT1", gopmo.Options&MQPMO_SYNCPOINT != 0, func(qMgr *MQQueueManager) error {
+		return qMgr.Put1(good, gomd, gopmo, buffer)
+	})
+}
+
+/*
+Cmit commits the unit of work. If the connection is lost during the commit, the
+outcome is unknown and a StateLostError is returned.
+*/
+func (r *ReconnectingQueueManager) Cmit() error {
+	err := r.run("MQCMIT", true, func(qMgr *MQQueueManager) error {
+		return qMgr.Cmit()
+	})
+	if err == nil {
+		r.endUOW()
+	}
+	return err
+}
+
+/*
+Back backs out the unit of work. When the connection has been lost, the queue manager
+has already backed out the work so, after reconnecting, this returns no error.
+*/
+func (r *ReconnectingQueueManager) Back() error {
+	qMgr, gen := r.current()
+
+	err := qMgr.Back()
+	if err != nil && r.isLost(err, gen) {
+		err = r.reconnect(gen)
+	}
+	if err == nil {
+		r.endUOW()
+	}
+	return err
+}
+
+/*
+Put a message
+*/
+func (o *ReconnectingObject) Put(gomd *MQMD, gopmo *MQPMO, buffer []byte) error {
+	return o.rqm.run("MQPUT", gopmo.Options&MQPMO_SYNCPOINT != 0, func(*MQQueueManager) error {
+		return o.Object().Put(gomd, gopmo, buffer)
+	})
+}
+
+/*
+Get a message
+*/
+func (o *ReconnectingObject) Get(gomd *MQMD, gogmo *MQGMO, buffer []byte) (int, error) {
+	var datalen int
+	err := o.rqm.run("MQGET", isSyncpointGet(gogmo), func(*MQQueueManager) error {
+		var err error
+		datalen, err = o.Object().Get(gomd, gogmo, buffer)
+		return err
+	})
+	return datalen, err
+}
+
+/*
+GetSlice gets a message, returning the buffer sliced to the message length
+*/
+func (o *ReconnectingObject) GetSlice(gomd *MQMD, gogmo *MQGMO, buffer []byte) ([]byte, int, error) {
+	var data []byte
+	var datalen int
+	err := o.rqm.run("MQGET", isSyncpointGet(gogmo), func(*MQQueueManager) error {
+		var err error
+		data, datalen, err = o.Object().GetSlice(gomd, gogmo, buffer)
+		return err
+	})
+	return data, datalen, err
+}
+
+/*
+Inq inquires on attributes of the object
+*/
+func (o *ReconnectingObject) Inq(goSelectors []int32) (map[int32]interface{}, error) {
+	var values map[int32]interface{}
+	err := o.rqm.run("MQINQ", false, func(*MQQueueManager) error {
+		var err error
+		values, err = o.Object().Inq(goSelectors)
+		return err
+	})
+	return values, err
+}
+
+/*
+Set changes attributes of the object. The change is made on the queue manager,
+so it is not repeated when the object is reopened after a reconnection.
+*/
+func (o *ReconnectingObject) Set(goSelectors map[int32]interface{}) error {
+	return o.rqm.run("MQSET", false, func(*MQQueueManager) error {
+		return o.Object().Set(goSelectors)
+	})
+}
+
+/*
+Subrq makes a request for the retained publication of a subscription
+*/
+func (o *ReconnectingObject) Subrq(gosro *MQSRO, action int32) error {
+	return o.rqm.run("MQSUBRQ", false, func(*MQQueueManager) error {
+		obj := o.Object()
+		return obj.Subrq(gosro, action)
+	})
+}
+
+/*
+Close the object, and stop recording it. A connection failure during the close is
+not an error, as the object has gone with the connection.
+*/
+func (o *ReconnectingObject) Close(goCloseOptions int32) error {
+	r := o.rqm
+	r.mutex.Lock()
+	for i, ro := range r.objects {
+		if ro == o {
+			r.objects = append(r.objects[:i], r.objects[i+1:]...)
+			break
+		}
+	}
+	obj := o.obj
+	r.mutex.Unlock()
+
+	err := obj.Close(goCloseOptions)
+	if err != nil && IsConnectionLost(err) {
+		err = nil
+	}
+	return err
+}
+
+func isSyncpointGet(gogmo *MQGMO) bool {
+	return gogmo.Options&(MQGMO_SYNCPOINT|MQGMO_SYNCPOINT_IF_PERSISTENT) != 0
+}
+
+func (r *ReconnectingQueueManager) record(o *ReconnectingObject) {
+	r.mutex.Lock()
+	r.objects = append(r.objects, o)
+	r.mutex.Unlock()
+}
+
+func (r *ReconnectingQueueManager) endUOW() {
+	r.mutex.Lock()
+	r.inUOW = false
+	r.mutex.Unlock()
+}
+
+// Run the operation. If it fails because the connection has gone, reconnect and either
+// try again or, if there was a unit of work, report the lost state.
+func (r *ReconnectingQueueManager) run(verb string, transactional bool, fn func(*MQQueueManager) error) error {
+	for attempt := 1; ; attempt++ {
+		qMgr, gen := r.current()
+
+		err := fn(qMgr)
+		if err == nil || !r.isLost(err, gen) {
+			if err == nil && transactional {
+				r.mutex.Lock()
+				r.inUOW = true
+				r.mutex.Unlock()
+			}
+			return err
+		}
+
+		logTrace("ReconnectingQueueManager: %s failed with %v", verb, err)
+		r.mutex.Lock()
+		uowLost := r.inUOW || transactional
+		r.mutex.Unlock()
+
+		if reconnErr := r.reconnect(gen); reconnErr != nil {
+			return reconnErr
+		}
+		if uowLost {
+			r.endUOW()
+			return &StateLostError{Verb: verb, Err: err}
+		}
+		if r.policy.MaxAttempts > 0 && attempt >= r.policy.MaxAttempts {
+			return err
+		}
+	}
+}
+
+// Return the connection to use, waiting for any reconnection that is in progress
+func (r *ReconnectingQueueManager) current() (*MQQueueManager, int) {
+	r.mutex.Lock()
+	for r.reconnecting != nil {
+		done := r.reconnecting
+		r.mutex.Unlock()
+		<-done
+		r.mutex.Lock()
+	}
+	qMgr := r.qMgr
+	gen := r.generation
+	r.mutex.Unlock()
+	return qMgr, gen
+}
+
+// Whether an error means the operation needs a new connection. As well as the
+// connection failures, a handle error counts if another goroutine has started
+// replacing the connection since the operation began.
+func (r *ReconnectingQueueManager) isLost(err error, gen int) bool {
+	if IsConnectionLost(err) {
+		return true
+	}
+	if !errors.Is(err, ErrHConnError) && !errors.Is(err, ErrHObjError) {
+		return false
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.generation != gen || r.reconnecting != nil
+}
+
+// Replace the connection, unless another goroutine has already done it since
+// the caller's operation started. Then reopen the recorded objects in the order
+// they were first opened, so that subscription destinations are ready before the
+// subscriptions. Only one goroutine does the work; any others wait for it to finish
+// and get the same result. The mutex is not held while waiting between attempts,
+// so calls such as QMgr and Object do not block for the whole of the backoff.
+func (r *ReconnectingQueueManager) reconnect(gen int) error {
+	var err error
+
+	traceEntry("reconnect")
+
+	r.mutex.Lock()
+	if done := r.reconnecting; done != nil {
+		r.mutex.Unlock()
+		<-done
+		r.mutex.Lock()
+		err = r.reconnectErr
+		r.mutex.Unlock()
+		traceExitErr("reconnect", 1, err)
+		return err
+	}
+	if r.generation != gen {
+		r.mutex.Unlock()
+		traceExitF("reconnect", 2, "Already reconnected")
+		return nil
+	}
+	done := make(chan struct{})
+	r.reconnecting = done
+
+	// Clean up anything still associated with the old connection. Other goroutines
+	// might still be using the old structure, so it is a copy that is disconnected.
+	old := *r.qMgr
+	r.mutex.Unlock()
+	old.Disc()
+
+	for attempt := 1; ; attempt++ {
+		var qMgr MQQueueManager
+		cno := r.cno
+		qMgr, err = Connx(r.qMgrName, &cno)
+		if err != nil && isWarning(err) {
+			logTrace("ReconnectingQueueManager: Connected with warning: %v", err)
+			err = nil
+		}
+		if err == nil {
+			r.mutex.Lock()
+			// Operations that already have the old structure keep it, and fail with a
+			// handle error that isLost recognises. New ones get this one from current().
+			r.qMgr = &qMgr
+			r.generation++
+			err = r.reopen()
+			r.mutex.Unlock()
+			if err != nil {
+				// Nobody else can have this connection while reconnecting is set
+				qMgr.Disc()
+			}
+			if err == nil {
+				logTrace("ReconnectingQueueManager: reconnected after %d attempts", attempt)
+				break
+			}
+		}
+
+		if !IsConnectionLost(err) && !IsRetryable(err) {
+			break
+		}
+		if r.policy.MaxAttempts > 0 && attempt >= r.policy.MaxAttempts {
+			break
+		}
+
+		d := r.policy.jitteredDelay(attempt)
+		logTrace("ReconnectingQueueManager: reconnect attempt %d failed with %v. Retrying after %v", attempt, err, d)
+		time.Sleep(d)
+	}
+
+	r.mutex.Lock()
+	r.reconnecting = nil
+	r.reconnectErr = err
+	r.mutex.Unlock()
+	close(done)
+
+	traceExitErr("reconnect", 0, err)
+	return err
+}
+
+// Called with the mutex held
+func (r *ReconnectingQueueManager) reopen() error {
+	for _, o := range r.objects {
+		if o.sd == nil {
+			od := cloneOD(&o.od)
+			obj, err := r.qMgr.Open(&od, o.openOptions)
+			if err != nil {
+				logError("ReconnectingQueueManager: cannot reopen %s: %v", o.od.ObjectName, err)
+				return err
+			}
+			o.obj = obj
+			continue
+		}
+
+		sd := cloneSD(o.sd)
+		if sd.Options&MQSO_DURABLE != 0 && sd.Options&MQSO_CREATE != 0 {
+			sd.Options &^= MQSO_CREATE
+			sd.Options |= MQSO_RESUME
+		}
+		dest := o.dest.obj
+		if o.dest.managed {
+			dest = MQObject{}
+		}
+		obj, err := r.qMgr.Sub(sd, &dest)
+		if err != nil {
+			logError("ReconnectingQueueManager: cannot resubscribe to %s: %v", o.sd.ObjectString, err)
+			return err
+		}
+		o.obj = obj
+		o.dest.obj = dest
+	}
+	return nil
+}
+
+func cloneOD(good *MQOD) MQOD {
+	od := *good
+	od.AlternateSecurityId = append([]byte(nil), good.AlternateSecurityId...)
+	return od
+}
+
+func cloneSD(gosd *MQSD) *MQSD {
+	sd := new(MQSD)
+	*sd = *gosd
+	sd.AlternateSecurityId = append([]byte(nil), gosd.AlternateSecurityId...)
+	sd.SubCorrelId = append([]byte(nil), gosd.SubCorrelId...)
+	sd.PubAccountingToken = append([]byte(nil), gosd.PubAccountingToken...)
+	return sd
+}
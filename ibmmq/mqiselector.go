@@ -0,0 +1,1526 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file deals with the message selectors that go into the SelectionString
+field of the MQOD and MQSD. The queue manager only reports a bad selector with
+MQRC_SELECTION_STRING_ERROR when the object is opened, with no indication of what is wrong.
+ParseSelector checks the syntax beforehand and says where the problem is.
+
+The syntax is the SQL92 subset used by JMS, with the MQ extension that allows
+fields of the message descriptor to be named as Root.MQMD.<field>:
+
+	colour = 'blue' AND size BETWEEN 3 AND 5
+	Root.MQMD.Priority > 4 OR name LIKE 'A\_%' ESCAPE '\'
+	Root.MQMD.CorrelId = "0x414D5120"
+
+Strings are in single quotes; byte strings are in double quotes with a 0x prefix.
+
+A parsed Selector can also test a set of message properties, so that applications and
+test programs can see which messages a selector would choose without going to the queue manager.
+The evaluation follows the SQL rules for missing values: a comparison with a property
+that is not set is neither true nor false, and the message is not selected.
+
+Selectors can be built in code instead of by formatting strings, which takes care of quoting:
+
+	s, err := ibmmq.Prop("colour").Eq("blue").And(ibmmq.MDField("Priority").Gt(4)).Build()
+*/
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+SelectorError describes a problem found by ParseSelector
+*/
+type SelectorError struct {
+	Selector string
+	Pos      int // Character position in the selector, starting from 1
+	Msg      string
+}
+
+func (e *SelectorError) Error() string {
+	return fmt.Sprintf("selector error at position %d: %s", e.Pos, e.Msg)
+}
+
+/*
+Selector is a parsed message selector
+*/
+type Selector struct {
+	text string
+	root selNode // nil for an empty selector, which selects everything
+}
+
+// The MQMD fields that can be named in a selector
+var selectorMDFields = map[string]func(md *MQMD) interface{}{
+	"Report":           func(md *MQMD) interface{} { return md.Report },
+	"MsgType":          func(md *MQMD) interface{} { return md.MsgType },
+	"Expiry":           func(md *MQMD) interface{} { return md.Expiry },
+	"Feedback":         func(md *MQMD) interface{} { return md.Feedback },
+	"Encoding":         func(md *MQMD) interface{} { return md.Encoding },
+	"CodedCharSetId":   func(md *MQMD) interface{} { return md.CodedCharSetId },
+	"Format":           func(md *MQMD) interface{} { return md.Format },
+	"Priority":         func(md *MQMD) interface{} { return md.Priority },
+	"Persistence":      func(md *MQMD) interface{} { return md.Persistence },
+	"MsgId":            func(md *MQMD) interface{} { return md.MsgId },
+	"CorrelId":         func(md *MQMD) interface{} { return md.CorrelId },
+	"BackoutCount":     func(md *MQMD) interface{} { return md.BackoutCount },
+	"ReplyToQ":         func(md *MQMD) interface{} { return md.ReplyToQ },
+	"ReplyToQMgr":      func(md *MQMD) interface{} { return md.ReplyToQMgr },
+	"UserIdentifier":   func(md *MQMD) interface{} { return md.UserIdentifier },
+	"AccountingToken":  func(md *MQMD) interface{} { return md.AccountingToken },
+	"ApplIdentityData": func(md *MQMD) interface{} { return md.ApplIdentityData },
+	"PutApplType":      func(md *MQMD) interface{} { return md.PutApplType },
+	"PutApplName":      func(md *MQMD) interface{} { return md.PutApplName },
+	"PutDate":          func(md *MQMD) interface{} { return md.PutDate },
+	"PutTime":          func(md *MQMD) interface{} { return md.PutTime },
+	"ApplOriginData":   func(md *MQMD) interface{} { return md.ApplOriginData },
+	"GroupId":          func(md *MQMD) interface{} { return md.GroupId },
+	"MsgSeqNumber":     func(md *MQMD) interface{} { return md.MsgSeqNumber },
+	"Offset":           func(md *MQMD) interface{} { return md.Offset },
+	"MsgFlags":         func(md *MQMD) interface{} { return md.MsgFlags },
+	"OriginalLength":   func(md *MQMD) interface{} { return md.OriginalLength },
+}
+
+// The JMS header fields that can be named in a selector. JMSX and JMS_ properties
+// are not checked.
+var selectorJMSFields = map[string]bool{
+	"JMSCorrelationID": true,
+	"JMSDeliveryMode":  true,
+	"JMSMessageID":     true,
+	"JMSPriority":      true,
+	"JMSTimestamp":     true,
+	"JMSType":          true,
+}
+
+const selectorMDPrefix = "Root.MQMD."
+
+/*
+ParseSelector checks the syntax of a selector. An empty string is valid and selects every message.
+The error, if there is one, is a *SelectorError.
+*/
+func ParseSelector(s string) (*Selector, error) {
+	traceEntry("ParseSelector")
+
+	sel := &Selector{text: s}
+	p := &selParser{text: s}
+	err := p.tokenise()
+	if err == nil && p.toks[0].kind != selTokEOF {
+		sel.root, err = p.parse()
+	}
+	if err != nil {
+		traceExitErr("ParseSelector", 1, err)
+		return nil, err
+	}
+
+	traceExit("ParseSelector")
+	return sel, nil
+}
+
+/*
+ValidateSelector checks the syntax of a selector without keeping the result
+*/
+func ValidateSelector(s string) error {
+	_, err := ParseSelector(s)
+	return err
+}
+
+/*
+String returns the selector as it was given to ParseSelector
+*/
+func (sel *Selector) String() string {
+	return sel.text
+}
+
+/*
+Matches says whether a message with these properties would be selected. Values
+can be any of the Go types that are used for message properties. Names
+are case-sensitive.
+*/
+func (sel *Selector) Matches(props map[string]interface{}) bool {
+	return sel.match(func(name string) selValue {
+		return selValueOf(props[name])
+	})
+}
+
+/*
+MatchesMessage is like Matches, but also takes Root.MQMD fields, and the JMS header fields that
+come from them, from the message descriptor. The md can be nil.
+*/
+func (sel *Selector) MatchesMessage(md *MQMD, props map[string]interface{}) bool {
+	return sel.match(func(name string) selValue {
+		if md != nil {
+			if strings.HasPrefix(name, selectorMDPrefix) {
+				if f, ok := selectorMDFields[name[len(selectorMDPrefix):]]; ok {
+					return selValueOf(f(md))
+				}
+			}
+			if v, ok := jmsHeaderValue(md, name); ok {
+				return selValueOf(v)
+			}
+		}
+		return selValueOf(props[name])
+	})
+}
+
+func (sel *Selector) match(env selEnv) bool {
+	if sel.root == nil {
+		return true
+	}
+	v := sel.root.eval(env)
+	return v.kind == selBool && v.b
+}
+
+// The JMS header fields that are held in the MQMD
+func jmsHeaderValue(md *MQMD, name string) (interface{}, bool) {
+	switch name {
+	case "JMSPriority":
+		return md.Priority, true
+	case "JMSDeliveryMode":
+		if md.Persistence == MQPER_PERSISTENT {
+			return "PERSISTENT", true
+		}
+		return "NON_PERSISTENT", true
+	case "JMSMessageID":
+		return "ID:" + hex.EncodeToString(md.MsgId), true
+	case "JMSCorrelationID":
+		if len(bytes.Trim(md.CorrelId, "\x00")) == 0 {
+			return nil, true
+		}
+		return "ID:" + hex.EncodeToString(md.CorrelId), true
+	case "JMSTimestamp":
+		if md.PutDateTime.IsZero() {
+			return nil, true
+		}
+		return md.PutDateTime.UnixNano() / 1000000, true
+	}
+	return nil, false
+}
+
+// ----------------------------------------------------------------------
+// Values
+// ----------------------------------------------------------------------
+
+type selKind int
+
+const (
+	selNull selKind = iota // Also used for the "unknown" boolean result
+	selBool
+	selInt
+	selFloat
+	selString
+	selBytes
+)
+
+type selValue struct {
+	kind selKind
+	b    bool
+	i    int64
+	f    float64
+	s    string
+	by   []byte
+}
+
+var selUnknown = selValue{kind: selNull}
+
+func selBoolValue(b bool) selValue {
+	return selValue{kind: selBool, b: b}
+}
+
+func (v selValue) isNumber() bool {
+	return v.kind == selInt || v.kind == selFloat
+}
+
+func (v selValue) float() float64 {
+	if v.kind == selInt {
+		return float64(v.i)
+	}
+	return v.f
+}
+
+// Convert a property value. Types that cannot appear in a selector are treated as not set.
+func selValueOf(v interface{}) selValue {
+	switch x := v.(type) {
+	case bool:
+		return selBoolValue(x)
+	case string:
+		return selValue{kind: selString, s: x}
+	case []byte:
+		return selValue{kind: selBytes, by: x}
+	case int:
+		return selValue{kind: selInt, i: int64(x)}
+	case int8:
+		return selValue{kind: selInt, i: int64(x)}
+	case int16:
+		return selValue{kind: selInt, i: int64(x)}
+	case int32:
+		return selValue{kind: selInt, i: int64(x)}
+	case int64:
+		return selValue{kind: selInt, i: x}
+	case uint8:
+		return selValue{kind: selInt, i: int64(x)}
+	case uint16:
+		return selValue{kind: selInt, i: int64(x)}
+	case uint32:
+		return selValue{kind: selInt, i: int64(x)}
+	case float32:
+		return selValue{kind: selFloat, f: float64(x)}
+	case float64:
+		return selValue{kind: selFloat, f: x}
+	}
+	return selUnknown
+}
+
+// ----------------------------------------------------------------------
+// Tokens
+// ----------------------------------------------------------------------
+
+type selTokKind int
+
+const (
+	selTokEOF selTokKind = iota
+	selTokIdent
+	selTokKeyword
+	selTokLiteral
+	selTokOp
+)
+
+type selToken struct {
+	kind selTokKind
+	text string // Keywords are upper case
+	pos  int
+	val  selValue
+}
+
+var selKeywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "BETWEEN": true, "IN": true,
+	"LIKE": true, "ESCAPE": true, "IS": true, "NULL": true, "TRUE": true, "FALSE": true,
+}
+
+type selParser struct {
+	text string
+	toks []selToken
+	next int
+}
+
+func (p *selParser) errorf(pos int, format string, v ...interface{}) error {
+	return &SelectorError{Selector: p.text, Pos: utf8.RuneCountInString(p.text[:pos]) + 1, Msg: fmt.Sprintf(format, v...)}
+}
+
+func isSelIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == '$'
+}
+
+func isSelIdentPart(r rune) bool {
+	return isSelIdentStart(r) || unicode.IsDigit(r) || r == '.'
+}
+
+func (p *selParser) tokenise() error {
+	s := p.text
+	i := 0
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n' || s[i] == '\r') {
+			i++
+		}
+		if i >= len(s) {
+			p.toks = append(p.toks, selToken{kind: selTokEOF, pos: i})
+			return nil
+		}
+
+		start := i
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case isSelIdentStart(r):
+			for i < len(s) {
+				r, size = utf8.DecodeRuneInString(s[i:])
+				if !isSelIdentPart(r) {
+					break
+				}
+				i += size
+			}
+			word := s[start:i]
+			upper := strings.ToUpper(word)
+			if selKeywords[upper] {
+				if upper == "TRUE" || upper == "FALSE" {
+					p.toks = append(p.toks, selToken{kind: selTokLiteral, text: word, pos: start, val: selBoolValue(upper == "TRUE")})
+				} else {
+					p.toks = append(p.toks, selToken{kind: selTokKeyword, text: upper, pos: start})
+				}
+				continue
+			}
+			if err := p.checkIdentifier(word, start); err != nil {
+				return err
+			}
+			p.toks = append(p.toks, selToken{kind: selTokIdent, text: word, pos: start})
+
+		case r == '\'':
+			var sb strings.Builder
+			i++
+			for {
+				if i >= len(s) {
+					return p.errorf(start, "string is not terminated")
+				}
+				if s[i] == '\'' {
+					if i+1 < len(s) && s[i+1] == '\'' {
+						sb.WriteByte('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteByte(s[i])
+				i++
+			}
+			p.toks = append(p.toks, selToken{kind: selTokLiteral, text: s[start:i], pos: start, val: selValue{kind: selString, s: sb.String()}})
+
+		case r == '"':
+			end := strings.IndexByte(s[i+1:], '"')
+			if end < 0 {
+				return p.errorf(start, "byte string is not terminated")
+			}
+			i += end + 2
+			lit := s[start+1 : i-1]
+			if len(lit) < 2 || !strings.EqualFold(lit[:2], "0x") {
+				return p.errorf(start, "double quotes are only used for byte strings such as \"0x0A1B\"")
+			}
+			b, err := hex.DecodeString(lit[2:])
+			if err != nil {
+				return p.errorf(start, "byte string %s is not an even number of hexadecimal digits", s[start:i])
+			}
+			p.toks = append(p.toks, selToken{kind: selTokLiteral, text: s[start:i], pos: start, val: selValue{kind: selBytes, by: b}})
+
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9'):
+			tok, err := p.number(start)
+			if err != nil {
+				return err
+			}
+			i = start + len(tok.text)
+			p.toks = append(p.toks, tok)
+
+		default:
+			op := ""
+			for _, o := range []string{"<>", "<=", ">=", "!=", "==", "=", "<", ">", "+", "-", "*", "/", "(", ")", ","} {
+				if strings.HasPrefix(s[i:], o) {
+					op = o
+					break
+				}
+			}
+			switch op {
+			case "":
+				return p.errorf(start, "unexpected character %q", r)
+			case "!=":
+				return p.errorf(start, "use <> for not equal")
+			case "==":
+				return p.errorf(start, "use = for equal")
+			}
+			i += len(op)
+			p.toks = append(p.toks, selToken{kind: selTokOp, text: op, pos: start})
+		}
+	}
+}
+
+// Read a decimal, hexadecimal or floating point number
+func (p *selParser) number(start int) (selToken, error) {
+	s := p.text
+	i := start
+	isFloat := false
+
+	digits := func() {
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+
+	if strings.HasPrefix(s[i:], "0x") || strings.HasPrefix(s[i:], "0X") {
+		i += 2
+		for i < len(s) && strings.IndexByte("0123456789abcdefABCDEF", s[i]) >= 0 {
+			i++
+		}
+	} else {
+		digits()
+		if i < len(s) && s[i] == '.' {
+			isFloat = true
+			i++
+			digits()
+		}
+		if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+			isFloat = true
+			i++
+			if i < len(s) && (s[i] == '+' || s[i] == '-') {
+				i++
+			}
+			expStart := i
+			digits()
+			if i == expStart {
+				return selToken{}, p.errorf(start, "exponent has no digits")
+			}
+		}
+	}
+
+	text := s[start:i]
+	if i < len(s) {
+		if r, _ := utf8.DecodeRuneInString(s[i:]); isSelIdentPart(r) {
+			return selToken{}, p.errorf(start, "invalid number")
+		}
+	}
+
+	tok := selToken{kind: selTokLiteral, text: text, pos: start}
+	if isFloat {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return selToken{}, p.errorf(start, "invalid number %s", text)
+		}
+		tok.val = selValue{kind: selFloat, f: f}
+	} else {
+		n, err := strconv.ParseInt(text, 0, 64)
+		if err != nil {
+			return selToken{}, p.errorf(start, "invalid number %s", text)
+		}
+		tok.val = selValue{kind: selInt, i: n}
+	}
+	return tok, nil
+}
+
+// Check the names that have a meaning to MQ or JMS
+func (p *selParser) checkIdentifier(name string, pos int) error {
+	if strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+		return p.errorf(pos, "invalid name %s", name)
+	}
+	if strings.HasPrefix(name, "Root.") {
+		if !strings.HasPrefix(name, selectorMDPrefix) {
+			return p.errorf(pos, "%s cannot be selected. Only Root.MQMD fields are allowed", name)
+		}
+		if _, ok := selectorMDFields[name[len(selectorMDPrefix):]]; !ok {
+			return p.errorf(pos, "%s is not a field of the MQMD", name)
+		}
+	}
+	if strings.HasPrefix(name, "JMS") && !strings.HasPrefix(name, "JMSX") && !strings.HasPrefix(name, "JMS_") {
+		if !selectorJMSFields[name] {
+			return p.errorf(pos, "%s is not a JMS header field that can be selected", name)
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------
+// Parser
+// ----------------------------------------------------------------------
+
+func (p *selParser) peek() selToken {
+	return p.toks[p.next]
+}
+
+func (p *selParser) take() selToken {
+	t := p.toks[p.next]
+	if t.kind != selTokEOF {
+		p.next++
+	}
+	return t
+}
+
+func (p *selParser) isKeyword(kw string) bool {
+	t := p.peek()
+	return t.kind == selTokKeyword && t.text == kw
+}
+
+func (p *selParser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == selTokOp && t.text == op
+}
+
+func (p *selParser) expectKeyword(kw string) error {
+	if !p.isKeyword(kw) {
+		return p.unexpected(kw)
+	}
+	p.take()
+	return nil
+}
+
+func (p *selParser) unexpected(wanted string) error {
+	t := p.peek()
+	if t.kind == selTokEOF {
+		return p.errorf(t.pos, "expected %s but the selector ended", wanted)
+	}
+	return p.errorf(t.pos, "expected %s but found %s", wanted, t.text)
+}
+
+func (p *selParser) parse() (selNode, error) {
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != selTokEOF {
+		return nil, p.errorf(t.pos, "unexpected %s", t.text)
+	}
+	if !n.typ().is(selTypeBool) {
+		return nil, p.errorf(0, "selector is not a condition")
+	}
+	return n, nil
+}
+
+func (p *selParser) parseOr() (selNode, error) {
+	return p.parseLogical("OR", p.parseAnd)
+}
+
+func (p *selParser) parseAnd() (selNode, error) {
+	return p.parseLogical("AND", p.parseNot)
+}
+
+func (p *selParser) parseLogical(op string, operand func() (selNode, error)) (selNode, error) {
+	l, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword(op) {
+		t := p.take()
+		r, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if !l.typ().is(selTypeBool) || !r.typ().is(selTypeBool) {
+			return nil, p.errorf(t.pos, "%s needs conditions on both sides", op)
+		}
+		l = &selLogical{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *selParser) parseNot() (selNode, error) {
+	if p.isKeyword("NOT") {
+		t := p.take()
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if !x.typ().is(selTypeBool) {
+			return nil, p.errorf(t.pos, "NOT needs a condition")
+		}
+		return &selNot{x: x}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *selParser) parseComparison() (selNode, error) {
+	l, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	if t.kind == selTokOp {
+		switch t.text {
+		case "=", "<>", "<", ">", "<=", ">=":
+			p.take()
+			r, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.checkComparable(t, l.typ(), r.typ()); err != nil {
+				return nil, err
+			}
+			return &selCompare{op: t.text, l: l, r: r}, nil
+		}
+		return l, nil
+	}
+
+	if t.kind != selTokKeyword {
+		return l, nil
+	}
+
+	not := false
+	if t.text == "NOT" {
+		p.take()
+		not = true
+		t = p.peek()
+		if t.kind != selTokKeyword || (t.text != "BETWEEN" && t.text != "IN" && t.text != "LIKE") {
+			return nil, p.unexpected("BETWEEN, IN or LIKE")
+		}
+	}
+
+	switch t.text {
+	case "BETWEEN":
+		return p.parseBetween(l, not)
+	case "IN":
+		return p.parseIn(l, not)
+	case "LIKE":
+		return p.parseLike(l, not)
+	case "IS":
+		p.take()
+		isNot := false
+		if p.isKeyword("NOT") {
+			p.take()
+			isNot = true
+		}
+		if err := p.expectKeyword("NULL"); err != nil {
+			return nil, err
+		}
+		if _, ok := l.(*selIdent); !ok {
+			return nil, p.errorf(t.pos, "IS NULL can only test a property")
+		}
+		return &selIsNull{x: l, not: isNot}, nil
+	}
+	return l, nil
+}
+
+func (p *selParser) checkComparable(op selToken, l selType, r selType) error {
+	if l != selTypeAny && r != selTypeAny && l != r {
+		return p.errorf(op.pos, "cannot compare %s with %s", l, r)
+	}
+	if op.text != "=" && op.text != "<>" {
+		for _, t := range []selType{l, r} {
+			if t == selTypeString || t == selTypeBool || t == selTypeBytes {
+				return p.errorf(op.pos, "only = and <> can be used with %s values", t)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *selParser) parseBetween(x selNode, not bool) (selNode, error) {
+	t := p.take()
+	lo, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expectKeyword("AND"); err != nil {
+		return nil, err
+	}
+	hi, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range []selNode{x, lo, hi} {
+		if !n.typ().is(selTypeNumber) {
+			return nil, p.errorf(t.pos, "BETWEEN can only be used with numbers")
+		}
+	}
+	return &selBetween{x: x, lo: lo, hi: hi, not: not}, nil
+}
+
+func (p *selParser) parseIn(x selNode, not bool) (selNode, error) {
+	t := p.take()
+	if !p.isOp("(") {
+		return nil, p.unexpected("(")
+	}
+	p.take()
+
+	n := &selIn{x: x, not: not}
+	for {
+		v, err := p.parseSignedLiteral()
+		if err != nil {
+			return nil, err
+		}
+		if v.kind != selString && !v.isNumber() {
+			return nil, p.errorf(t.pos, "IN lists can only contain strings and numbers")
+		}
+		n.list = append(n.list, v)
+
+		if p.isOp(",") {
+			p.take()
+			continue
+		}
+		if p.isOp(")") {
+			p.take()
+			break
+		}
+		return nil, p.unexpected(", or )")
+	}
+
+	first := selTypeOfValue(n.list[0])
+	for _, v := range n.list {
+		if selTypeOfValue(v) != first {
+			return nil, p.errorf(t.pos, "IN list mixes strings and numbers")
+		}
+	}
+	if err := p.checkComparable(selToken{text: "=", pos: t.pos}, x.typ(), first); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// A literal, which can have a sign if it is a number
+func (p *selParser) parseSignedLiteral() (selValue, error) {
+	neg := false
+	if p.isOp("-") || p.isOp("+") {
+		neg = p.take().text == "-"
+		if t := p.peek(); t.kind != selTokLiteral || !t.val.isNumber() {
+			return selValue{}, p.unexpected("a number")
+		}
+	}
+	t := p.peek()
+	if t.kind != selTokLiteral {
+		return selValue{}, p.unexpected("a literal value")
+	}
+	p.take()
+	v := t.val
+	if neg {
+		v = negate(v)
+	}
+	return v, nil
+}
+
+func (p *selParser) parseLike(x selNode, not bool) (selNode, error) {
+	t := p.take()
+	if !x.typ().is(selTypeString) {
+		return nil, p.errorf(t.pos, "LIKE can only be used with strings")
+	}
+
+	pt := p.peek()
+	if pt.kind != selTokLiteral || pt.val.kind != selString {
+		return nil, p.unexpected("a pattern in quotes")
+	}
+	p.take()
+
+	escape := rune(-1)
+	if p.isKeyword("ESCAPE") {
+		p.take()
+		et := p.peek()
+		if et.kind != selTokLiteral || et.val.kind != selString || utf8.RuneCountInString(et.val.s) != 1 {
+			return nil, p.errorf(et.pos, "ESCAPE needs a single character in quotes")
+		}
+		p.take()
+		escape, _ = utf8.DecodeRuneInString(et.val.s)
+	}
+
+	pattern, ok := compileLike(pt.val.s, escape)
+	if !ok {
+		return nil, p.errorf(pt.pos, "pattern ends with the escape character")
+	}
+	return &selLike{x: x, pattern: pattern, not: not}, nil
+}
+
+func (p *selParser) parseAdditive() (selNode, error) {
+	return p.parseArith([]string{"+", "-"}, p.parseMultiplicative)
+}
+
+func (p *selParser) parseMultiplicative() (selNode, error) {
+	return p.parseArith([]string{"*", "/"}, p.parseUnary)
+}
+
+func (p *selParser) parseArith(ops []string, operand func() (selNode, error)) (selNode, error) {
+	l, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != selTokOp || (t.text != ops[0] && t.text != ops[1]) {
+			return l, nil
+		}
+		p.take()
+		r, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if !l.typ().is(selTypeNumber) || !r.typ().is(selTypeNumber) {
+			return nil, p.errorf(t.pos, "%s can only be used with numbers", t.text)
+		}
+		l = &selArith{op: t.text, l: l, r: r}
+	}
+}
+
+func (p *selParser) parseUnary() (selNode, error) {
+	if p.isOp("-") || p.isOp("+") {
+		t := p.take()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if !x.typ().is(selTypeNumber) {
+			return nil, p.errorf(t.pos, "%s can only be used with numbers", t.text)
+		}
+		if t.text == "+" {
+			return x, nil
+		}
+		return &selNeg{x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *selParser) parsePrimary() (selNode, error) {
+	t := p.peek()
+	switch t.kind {
+	case selTokLiteral:
+		p.take()
+		return &selLiteral{v: t.val}, nil
+	case selTokIdent:
+		p.take()
+		return &selIdent{name: t.text}, nil
+	case selTokOp:
+		if t.text == "(" {
+			p.take()
+			n, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if !p.isOp(")") {
+				return nil, p.unexpected(")")
+			}
+			p.take()
+			return n, nil
+		}
+	case selTokKeyword:
+		if t.text == "NULL" {
+			return nil, p.errorf(t.pos, "use IS NULL or IS NOT NULL to test for missing properties")
+		}
+	}
+	return nil, p.unexpected("a property or value")
+}
+
+// ----------------------------------------------------------------------
+// Syntax tree
+// ----------------------------------------------------------------------
+
+// The type of an expression, as far as it can be known before the properties are seen
+type selType int
+
+const (
+	selTypeAny selType = iota // A property, which can hold any type
+	selTypeBool
+	selTypeNumber
+	selTypeString
+	selTypeBytes
+)
+
+func (t selType) String() string {
+	switch t {
+	case selTypeBool:
+		return "boolean"
+	case selTypeNumber:
+		return "numeric"
+	case selTypeString:
+		return "string"
+	case selTypeBytes:
+		return "byte string"
+	default:
+		return "property"
+	}
+}
+
+func (t selType) is(want selType) bool {
+	return t == selTypeAny || t == want
+}
+
+func selTypeOfValue(v selValue) selType {
+	switch v.kind {
+	case selBool:
+		return selTypeBool
+	case selInt, selFloat:
+		return selTypeNumber
+	case selString:
+		return selTypeString
+	case selBytes:
+		return selTypeBytes
+	}
+	return selTypeAny
+}
+
+type selEnv func(name string) selValue
+
+type selNode interface {
+	eval(env selEnv) selValue
+	typ() selType
+}
+
+type selLiteral struct{ v selValue }
+type selIdent struct{ name string }
+type selNot struct{ x selNode }
+type selNeg struct{ x selNode }
+
+type selLogical struct {
+	op   string
+	l, r selNode
+}
+
+type selCompare struct {
+	op   string
+	l, r selNode
+}
+
+type selArith struct {
+	op   string
+	l, r selNode
+}
+
+type selBetween struct {
+	x, lo, hi selNode
+	not       bool
+}
+
+type selIn struct {
+	x    selNode
+	list []selValue
+	not  bool
+}
+
+type selLike struct {
+	x       selNode
+	pattern []likeElem
+	not     bool
+}
+
+type selIsNull struct {
+	x   selNode
+	not bool
+}
+
+func (n *selLiteral) typ() selType { return selTypeOfValue(n.v) }
+func (n *selIdent) typ() selType   { return selTypeAny }
+func (n *selNot) typ() selType     { return selTypeBool }
+func (n *selNeg) typ() selType     { return selTypeNumber }
+func (n *selLogical) typ() selType { return selTypeBool }
+func (n *selCompare) typ() selType { return selTypeBool }
+func (n *selArith) typ() selType   { return selTypeNumber }
+func (n *selBetween) typ() selType { return selTypeBool }
+func (n *selIn) typ() selType      { return selTypeBool }
+func (n *selLike) typ() selType    { return selTypeBool }
+func (n *selIsNull) typ() selType  { return selTypeBool }
+
+func (n *selLiteral) eval(env selEnv) selValue {
+	return n.v
+}
+
+func (n *selIdent) eval(env selEnv) selValue {
+	return env(n.name)
+}
+
+// NOT of unknown is unknown
+func (n *selNot) eval(env selEnv) selValue {
+	return not3(n.x.eval(env))
+}
+
+func not3(v selValue) selValue {
+	if v.kind != selBool {
+		return selUnknown
+	}
+	return selBoolValue(!v.b)
+}
+
+func (n *selLogical) eval(env selEnv) selValue {
+	l := n.l.eval(env)
+	if l.kind != selBool {
+		l = selUnknown
+	}
+	// Avoid evaluating the right hand side when the answer is already known
+	if l.kind == selBool && l.b == (n.op == "OR") {
+		return l
+	}
+	r := n.r.eval(env)
+	if r.kind != selBool {
+		r = selUnknown
+	}
+	if n.op == "AND" {
+		return and3(l, r)
+	}
+	if r.kind == selBool && r.b {
+		return r
+	}
+	if l.kind == selBool && r.kind == selBool {
+		return selBoolValue(false)
+	}
+	return selUnknown
+}
+
+func and3(l selValue, r selValue) selValue {
+	if (l.kind == selBool && !l.b) || (r.kind == selBool && !r.b) {
+		return selBoolValue(false)
+	}
+	if l.kind == selBool && r.kind == selBool {
+		return selBoolValue(true)
+	}
+	return selUnknown
+}
+
+func (n *selCompare) eval(env selEnv) selValue {
+	return compare3(n.op, n.l.eval(env), n.r.eval(env))
+}
+
+// Compare two values. Unknown if either is not set or the types do not match.
+func compare3(op string, l selValue, r selValue) selValue {
+	if l.kind == selNull || r.kind == selNull {
+		return selUnknown
+	}
+
+	if l.isNumber() && r.isNumber() {
+		c := 0
+		if l.kind == selInt && r.kind == selInt {
+			if l.i < r.i {
+				c = -1
+			} else if l.i > r.i {
+				c = 1
+			}
+		} else {
+			lf, rf := l.float(), r.float()
+			if math.IsNaN(lf) || math.IsNaN(rf) {
+				return selUnknown
+			}
+			if lf < rf {
+				c = -1
+			} else if lf > rf {
+				c = 1
+			}
+		}
+		switch op {
+		case "=":
+			return selBoolValue(c == 0)
+		case "<>":
+			return selBoolValue(c != 0)
+		case "<":
+			return selBoolValue(c < 0)
+		case ">":
+			return selBoolValue(c > 0)
+		case "<=":
+			return selBoolValue(c <= 0)
+		case ">=":
+			return selBoolValue(c >= 0)
+		}
+		return selUnknown
+	}
+
+	if l.kind != r.kind {
+		return selUnknown
+	}
+	eq := false
+	switch l.kind {
+	case selString:
+		eq = l.s == r.s
+	case selBool:
+		eq = l.b == r.b
+	case selBytes:
+		eq = bytes.Equal(l.by, r.by)
+	}
+	switch op {
+	case "=":
+		return selBoolValue(eq)
+	case "<>":
+		return selBoolValue(!eq)
+	}
+	return selUnknown
+}
+
+func (n *selArith) eval(env selEnv) selValue {
+	l := n.l.eval(env)
+	r := n.r.eval(env)
+	if !l.isNumber() || !r.isNumber() {
+		return selUnknown
+	}
+
+	if l.kind == selInt && r.kind == selInt {
+		switch n.op {
+		case "+":
+			return selValue{kind: selInt, i: l.i + r.i}
+		case "-":
+			return selValue{kind: selInt, i: l.i - r.i}
+		case "*":
+			return selValue{kind: selInt, i: l.i * r.i}
+		case "/":
+			if r.i == 0 {
+				return selUnknown
+			}
+			return selValue{kind: selInt, i: l.i / r.i}
+		}
+	}
+
+	lf, rf := l.float(), r.float()
+	switch n.op {
+	case "+":
+		return selValue{kind: selFloat, f: lf + rf}
+	case "-":
+		return selValue{kind: selFloat, f: lf - rf}
+	case "*":
+		return selValue{kind: selFloat, f: lf * rf}
+	case "/":
+		if rf == 0 {
+			return selUnknown
+		}
+		return selValue{kind: selFloat, f: lf / rf}
+	}
+	return selUnknown
+}
+
+func (n *selNeg) eval(env selEnv) selValue {
+	return negate(n.x.eval(env))
+}
+
+func negate(v selValue) selValue {
+	switch v.kind {
+	case selInt:
+		return selValue{kind: selInt, i: -v.i}
+	case selFloat:
+		return selValue{kind: selFloat, f: -v.f}
+	}
+	return selUnknown
+}
+
+func (n *selBetween) eval(env selEnv) selValue {
+	x := n.x.eval(env)
+	v := and3(compare3(">=", x, n.lo.eval(env)), compare3("<=", x, n.hi.eval(env)))
+	if n.not {
+		return not3(v)
+	}
+	return v
+}
+
+func (n *selIn) eval(env selEnv) selValue {
+	x := n.x.eval(env)
+	if x.kind == selNull {
+		return selUnknown
+	}
+	found := false
+	for _, v := range n.list {
+		if r := compare3("=", x, v); r.kind == selBool && r.b {
+			found = true
+			break
+		}
+	}
+	return selBoolValue(found != n.not)
+}
+
+func (n *selLike) eval(env selEnv) selValue {
+	x := n.x.eval(env)
+	if x.kind != selString {
+		return selUnknown
+	}
+	return selBoolValue(likeMatch(n.pattern, []rune(x.s)) != n.not)
+}
+
+func (n *selIsNull) eval(env selEnv) selValue {
+	return selBoolValue((n.x.eval(env).kind == selNull) != n.not)
+}
+
+// ----------------------------------------------------------------------
+// LIKE patterns
+// ----------------------------------------------------------------------
+
+type likeElem struct {
+	kind byte // 'c' for a character, '_' for any character, '%' for any sequence
+	r    rune
+}
+
+// Split a pattern into its elements. An escape of -1 means there is none.
+func compileLike(pattern string, escape rune) ([]likeElem, bool) {
+	var elems []likeElem
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			elems = append(elems, likeElem{kind: 'c', r: r})
+			escaped = false
+		case r == escape:
+			escaped = true
+		case r == '%':
+			elems = append(elems, likeElem{kind: '%'})
+		case r == '_':
+			elems = append(elems, likeElem{kind: '_'})
+		default:
+			elems = append(elems, likeElem{kind: 'c', r: r})
+		}
+	}
+	return elems, !escaped
+}
+
+// Match the whole string against the pattern, going back to the most recent
+// '%' when there is a mismatch
+func likeMatch(p []likeElem, s []rune) bool {
+	pi, si := 0, 0
+	star, mark := -1, 0
+	for si < len(s) {
+		if pi < len(p) && (p[pi].kind == '_' || (p[pi].kind == 'c' && p[pi].r == s[si])) {
+			pi++
+			si++
+		} else if pi < len(p) && p[pi].kind == '%' {
+			star = pi
+			mark = si
+			pi++
+		} else if star >= 0 {
+			pi = star + 1
+			mark++
+			si = mark
+		} else {
+			return false
+		}
+	}
+	for pi < len(p) && p[pi].kind == '%' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+// ----------------------------------------------------------------------
+// Builder
+// ----------------------------------------------------------------------
+
+/*
+SelectorExpr is a condition built with Prop or MDField. Conditions can be combined
+with And, Or and Not, and Build gives the selector string to put in an MQOD or MQSD.
+*/
+type SelectorExpr struct {
+	text     string
+	compound bool // Made with AND or OR, so needs parentheses when combined again
+	err      error
+}
+
+/*
+SelectorProp names a property or field for building a condition
+*/
+type SelectorProp struct {
+	name string
+}
+
+/*
+Prop starts a condition on a message property
+*/
+func Prop(name string) SelectorProp {
+	return SelectorProp{name: name}
+}
+
+/*
+MDField starts a condition on a field of the message descriptor, such as "Priority"
+*/
+func MDField(field string) SelectorProp {
+	return SelectorProp{name: selectorMDPrefix + field}
+}
+
+// Format a Go value as a selector literal
+func selectorLiteral(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case string:
+		return "'" + strings.ReplaceAll(x, "'", "''") + "'", nil
+	case []byte:
+		return "\"0x" + strings.ToUpper(hex.EncodeToString(x)) + "\"", nil
+	case bool:
+		if x {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case float32:
+		return selectorFloat(float64(x))
+	case float64:
+		return selectorFloat(x)
+	}
+
+	sv := selValueOf(v)
+	if sv.kind == selInt {
+		return strconv.FormatInt(sv.i, 10), nil
+	}
+	return "", fmt.Errorf("cannot use a value of type %T in a selector", v)
+}
+
+func selectorFloat(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("cannot use %v in a selector", f)
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s, nil
+}
+
+func (p SelectorProp) compare(op string, v interface{}) SelectorExpr {
+	lit, err := selectorLiteral(v)
+	return SelectorExpr{text: p.name + " " + op + " " + lit, err: err}
+}
+
+/*
+Eq tests that the property is equal to the value
+*/
+func (p SelectorProp) Eq(v interface{}) SelectorExpr { return p.compare("=", v) }
+
+/*
+Ne tests that the property is not equal to the value
+*/
+func (p SelectorProp) Ne(v interface{}) SelectorExpr { return p.compare("<>", v) }
+
+/*
+Lt tests that the property is less than the value
+*/
+func (p SelectorProp) Lt(v interface{}) SelectorExpr { return p.compare("<", v) }
+
+/*
+Le tests that the property is less than or equal to the value
+*/
+func (p SelectorProp) Le(v interface{}) SelectorExpr { return p.compare("<=", v) }
+
+/*
+Gt tests that the property is greater than the value
+*/
+func (p SelectorProp) Gt(v interface{}) SelectorExpr { return p.compare(">", v) }
+
+/*
+Ge tests that the property is greater than or equal to the value
+*/
+func (p SelectorProp) Ge(v interface{}) SelectorExpr { return p.compare(">=", v) }
+
+/*
+Between tests that the property is in a range, including both ends
+*/
+func (p SelectorProp) Between(lo interface{}, hi interface{}) SelectorExpr {
+	l, err := selectorLiteral(lo)
+	h, err2 := selectorLiteral(hi)
+	if err == nil {
+		err = err2
+	}
+	return SelectorExpr{text: p.name + " BETWEEN " + l + " AND " + h, err: err}
+}
+
+/*
+In tests that the property has one of the values
+*/
+func (p SelectorProp) In(values ...interface{}) SelectorExpr {
+	return p.in("IN", values)
+}
+
+/*
+NotIn tests that the property has none of the values
+*/
+func (p SelectorProp) NotIn(values ...interface{}) SelectorExpr {
+	return p.in("NOT IN", values)
+}
+
+func (p SelectorProp) in(op string, values []interface{}) SelectorExpr {
+	if len(values) == 0 {
+		return SelectorExpr{err: fmt.Errorf("%s %s needs at least one value", p.name, op)}
+	}
+	lits := make([]string, len(values))
+	for i, v := range values {
+		lit, err := selectorLiteral(v)
+		if err != nil {
+			return SelectorExpr{err: err}
+		}
+		lits[i] = lit
+	}
+	return SelectorExpr{text: p.name + " " + op + " (" + strings.Join(lits, ", ") + ")"}
+}
+
+/*
+Like tests the property against a pattern, where '%' matches any sequence of
+characters and '_' matches any one character
+*/
+func (p SelectorProp) Like(pattern string) SelectorExpr {
+	lit, _ := selectorLiteral(pattern)
+	return SelectorExpr{text: p.name + " LIKE " + lit}
+}
+
+/*
+LikeEscape is like Like, with a character that removes the special meaning of
+the '%' or '_' after it
+*/
+func (p SelectorProp) LikeEscape(pattern string, escape rune) SelectorExpr {
+	lit, _ := selectorLiteral(pattern)
+	esc, _ := selectorLiteral(string(escape))
+	return SelectorExpr{text: p.name + " LIKE " + lit + " ESCAPE " + esc}
+}
+
+/*
+IsNull tests that the property is not set
+*/
+func (p SelectorProp) IsNull() SelectorExpr {
+	return SelectorExpr{text: p.name + " IS NULL"}
+}
+
+/*
+IsNotNull tests that the property is set
+*/
+func (p SelectorProp) IsNotNull() SelectorExpr {
+	return SelectorExpr{text: p.name + " IS NOT NULL"}
+}
+
+func combineSelectors(op string, exprs []SelectorExpr) SelectorExpr {
+	var used []SelectorExpr
+	for _, e := range exprs {
+		if e.err != nil {
+			return SelectorExpr{err: e.err}
+		}
+		if e.text != "" {
+			used = append(used, e)
+		}
+	}
+	if len(used) == 0 {
+		return SelectorExpr{}
+	}
+	if len(used) == 1 {
+		return used[0]
+	}
+
+	parts := make([]string, len(used))
+	for i, e := range used {
+		if e.compound {
+			parts[i] = "(" + e.text + ")"
+		} else {
+			parts[i] = e.text
+		}
+	}
+	return SelectorExpr{text: strings.Join(parts, " "+op+" "), compound: true}
+}
+
+/*
+And requires this condition and all the others to be true
+*/
+func (e SelectorExpr) And(others ...SelectorExpr) SelectorExpr {
+	return combineSelectors("AND", append([]SelectorExpr{e}, others...))
+}
+
+/*
+Or requires this condition or any of the others to be true
+*/
+func (e SelectorExpr) Or(others ...SelectorExpr) SelectorExpr {
+	return combineSelectors("OR", append([]SelectorExpr{e}, others...))
+}
+
+/*
+Not reverses the condition
+*/
+func (e SelectorExpr) Not() SelectorExpr {
+	if e.err != nil || e.text == "" {
+		return e
+	}
+	return SelectorExpr{text: "NOT (" + e.text + ")"}
+}
+
+/*
+String returns the selector text, even if it is not valid
+*/
+func (e SelectorExpr) String() string {
+	return e.text
+}
+
+/*
+Build checks the condition, for example that the property names are valid, and
+returns the selector string
+*/
+func (e SelectorExpr) Build() (string, error) {
+	if e.err != nil {
+		return "", e.err
+	}
+	if err := ValidateSelector(e.text); err != nil {
+		return "", err
+	}
+	return e.text, nil
+}
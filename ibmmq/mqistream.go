@@ -0,0 +1,288 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file provides io.Writer and io.Reader implementations so that large payloads
+such as files can be streamed to and from a queue without holding the
+whole thing in memory.
+
+The writer splits the data into segments of a single logical message, or
+into the messages of a group. Segmentation is not available on z/OS, so
+the group form can be used there. The reader handles both forms, returning the
+data from each piece in logical order, with only one piece held in memory at a time.
+*/
+
+import (
+	"io"
+)
+
+// Used when we cannot discover the queue's MAXMSGL. It is the default value for a
+// queue and for the queue manager.
+const defaultStreamChunkSize = 4 * 1024 * 1024
+
+// The largest MAXMSGL that a queue can have. A reader does not accept
+// anything bigger unless the application asks for it.
+const defaultMaxPieceSize = 100 * 1024 * 1024
+
+/*
+MessageWriter is an io.WriteCloser that puts the data written to it as a
+segmented message, or as a group of messages if UseGroup is set. The fields can be
+changed after NewMessageWriter but before the first Write.
+
+No message is put until a chunk of data has been filled and we know there is more
+to come, and the last piece is only put by Close. The PMO ought to specify
+MQPMO_SYNCPOINT so that consumers do not see a partial message if something fails;
+the application is then responsible for committing after Close.
+*/
+type MessageWriter struct {
+	PMO       *MQPMO
+	UseGroup  bool // Put a group of messages instead of segments of one message
+	ChunkSize int  // Size of each piece. 0 means use the queue's MAXMSGL
+
+	object  MQObject
+	put     func(*MQMD, *MQPMO, []byte) error
+	md      *MQMD
+	buf     []byte
+	puts    int
+	started bool
+	closed  bool
+	err     error
+}
+
+/*
+NewMessageWriter creates a MessageWriter for the object, which must have
+been opened for output. The MQMD is used for every piece that is put and its
+output fields are updated by each MQPUT. A nil MD uses the defaults.
+*/
+func NewMessageWriter(object MQObject, gomd *MQMD) *MessageWriter {
+	if gomd == nil {
+		gomd = NewMQMD()
+	}
+	w := new(MessageWriter)
+	w.PMO = NewMQPMO()
+	w.UseGroup = false
+	w.ChunkSize = 0
+	w.object = object
+	w.put = object.Put
+	w.md = gomd
+	return w
+}
+
+/*
+Write implements io.Writer. Data is copied into an internal buffer; when
+the buffer is full and more data is written, the buffer is put to the queue.
+*/
+func (w *MessageWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, io.ErrClosedPipe
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	if !w.started {
+		w.start()
+	}
+
+	n := 0
+	for len(p) > 0 {
+		if len(w.buf) == w.ChunkSize {
+			if err := w.putChunk(false); err != nil {
+				return n, err
+			}
+		}
+		space := w.ChunkSize - len(w.buf)
+		if space > len(p) {
+			space = len(p)
+		}
+		w.buf = append(w.buf, p[:space]...)
+		p = p[space:]
+		n += space
+	}
+	return n, nil
+}
+
+/*
+Close puts whatever data remains as the final segment or message. If all the
+data fitted in a single chunk, it is put as an ordinary message. Calling
+Close again has no effect.
+*/
+func (w *MessageWriter) Close() error {
+	if w.closed {
+		return w.err
+	}
+	w.closed = true
+	if w.err != nil {
+		return w.err
+	}
+	if !w.started {
+		w.start()
+	}
+	return w.putChunk(true)
+}
+
+func (w *MessageWriter) start() {
+	w.started = true
+	if w.ChunkSize <= 0 {
+		w.ChunkSize = w.object.maxMsgLength()
+		if w.ChunkSize <= 0 {
+			w.ChunkSize = defaultStreamChunkSize
+		}
+	}
+	if w.PMO == nil {
+		w.PMO = NewMQPMO()
+	}
+	if w.md.Version < MQMD_VERSION_2 {
+		w.md.Version = MQMD_VERSION_2
+	}
+	w.buf = make([]byte, 0, w.ChunkSize)
+}
+
+// Put the buffer with the flags that say where it fits in the stream. A single-piece
+// message written as segments has no segment flags at all.
+func (w *MessageWriter) putChunk(last bool) error {
+	savedFlags := w.md.MsgFlags
+	savedOptions := w.PMO.Options
+
+	w.md.MsgFlags &^= (MQMF_SEGMENT | MQMF_LAST_SEGMENT | MQMF_MSG_IN_GROUP | MQMF_LAST_MSG_IN_GROUP)
+	if w.UseGroup {
+		if last {
+			w.md.MsgFlags |= MQMF_LAST_MSG_IN_GROUP
+		} else {
+			w.md.MsgFlags |= MQMF_MSG_IN_GROUP
+		}
+	} else if !last {
+		w.md.MsgFlags |= MQMF_SEGMENT
+	} else if w.puts > 0 {
+		w.md.MsgFlags |= MQMF_LAST_SEGMENT
+	}
+	w.PMO.Options |= MQPMO_LOGICAL_ORDER
+
+	err := w.put(w.md, w.PMO, w.buf)
+
+	w.md.MsgFlags = savedFlags
+	w.PMO.Options = savedOptions
+
+	if err != nil {
+		logTrace("MessageWriter: Put of chunk %d failed: %v", w.puts, err)
+		w.err = err
+		return err
+	}
+	w.puts++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+/*
+MessageReader is an io.Reader that returns the body of the next logical message
+from a queue, reassembling segments and joining all the messages of a group.
+Only one physical message is held in memory at a time. Read returns io.EOF
+after the last piece.
+
+The MD field can be set before the first Read to select a message; after
+the first Read it contains the descriptor of the first piece. The GMO
+ought to specify MQGMO_SYNCPOINT so that a failure part way through can be
+backed out.
+
+MaxPieceSize limits the buffer used for each physical message. A piece that
+is bigger causes Read to fail with MQRC_TRUNCATED_MSG_FAILED, leaving the
+message on the queue. Setting it to 0 removes the limit.
+*/
+type MessageReader struct {
+	MD           *MQMD
+	MaxPieceSize int
+
+	get     func(*MQMD, *MQGMO, int, int) ([]byte, error)
+	gmo     *MQGMO
+	data    []byte
+	started bool
+	more    bool
+	err     error
+}
+
+/*
+NewMessageReader creates a MessageReader for the object, which must have been
+opened for input. A nil GMO uses the defaults. The first MQGET waits, using the
+WaitInterval in the GMO, until every piece is available.
+*/
+func NewMessageReader(object MQObject, gogmo *MQGMO) *MessageReader {
+	if gogmo == nil {
+		gogmo = NewMQGMO()
+	}
+	r := new(MessageReader)
+	r.MD = NewMQMD()
+	r.MD.Version = MQMD_VERSION_2
+	r.MaxPieceSize = defaultMaxPieceSize
+	r.get = object.GetAutoSize
+	r.gmo = gogmo
+	return r
+}
+
+/*
+Read implements io.Reader
+*/
+func (r *MessageReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.started && !r.more {
+			return 0, io.EOF
+		}
+		r.getNext()
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// Get the next piece. The first MQGET uses the application's MD;
+// later ones use a fresh MD as it is the logical ordering that selects them.
+func (r *MessageReader) getNext() {
+	md := r.MD
+	if r.started {
+		md = NewMQMD()
+		md.Version = MQMD_VERSION_2
+	} else if md.Version < MQMD_VERSION_2 {
+		md.Version = MQMD_VERSION_2
+	}
+	if r.gmo.Version < MQGMO_VERSION_2 {
+		r.gmo.Version = MQGMO_VERSION_2
+	}
+
+	savedOptions := r.gmo.Options
+	r.gmo.Options |= MQGMO_LOGICAL_ORDER | MQGMO_ALL_MSGS_AVAILABLE | MQGMO_ALL_SEGMENTS_AVAILABLE
+	r.gmo.Options &^= (MQGMO_COMPLETE_MSG | MQGMO_ACCEPT_TRUNCATED_MSG)
+
+	data, err := r.get(md, r.gmo, 0, r.MaxPieceSize)
+
+	r.gmo.Options = savedOptions
+	r.started = true
+
+	if isGetFailure(err) {
+		logTrace("MessageReader: Get failed: %v", err)
+		r.err = err
+		return
+	}
+
+	r.data = data
+	r.more = r.gmo.SegmentStatus == MQSS_SEGMENT || r.gmo.GroupStatus == MQGS_MSG_IN_GROUP
+}
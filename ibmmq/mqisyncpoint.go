@@ -0,0 +1,355 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file provides a helper for running a group of MQI operations inside a
+single unit of work. The application supplies a function that does the work;
+the helper forces the syncpoint options on each operation, commits if the function
+succeeds and backs out if it returns an error or panics. Some failures, such as
+a unit of work being backed out by the queue manager, are worth retrying so the
+whole function can be run again based on a BackoffPolicy.
+*/
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+/*
+BackoffPolicy controls how often, and how quickly, an operation is retried after a
+transient failure. The delay before retry N is InitialDelay * Multiplier^(N-1), capped
+at MaxDelay. Jitter then adds or removes a random part of that delay so that
+many applications recovering from the same failure do not all retry at the same moment.
+*/
+type BackoffPolicy struct {
+	MaxAttempts  int           // Total number of attempts, including the first. 0 means no limit
+	InitialDelay time.Duration // Delay before the first retry
+	MaxDelay     time.Duration // Upper limit for the delay between attempts
+	Multiplier   float64       // Growth factor for the delay. Values below 1 are treated as 1
+	Jitter       float64       // Fraction of the delay, between 0 and 1, that is randomised. The default is 0
+}
+
+/*
+NewBackoffPolicy fills in default values for the BackoffPolicy structure
+*/
+func NewBackoffPolicy() *BackoffPolicy {
+	p := new(BackoffPolicy)
+	p.MaxAttempts = 5
+	p.InitialDelay = 100 * time.Millisecond
+	p.MaxDelay = 5 * time.Second
+	p.Multiplier = 2.0
+	p.Jitter = 0
+	return p
+}
+
+// Calculate how long to wait after a given (1-based) failed attempt
+func (p *BackoffPolicy) delay(attempt int) time.Duration {
+	d := float64(p.InitialDelay)
+	m := p.Multiplier
+	if m < 1 {
+		m = 1
+	}
+	for i := 1; i < attempt; i++ {
+		d *= m
+		if p.MaxDelay > 0 && d >= float64(p.MaxDelay) {
+			return p.MaxDelay
+		}
+	}
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+	return time.Duration(d)
+}
+
+// The delay for an attempt, with the jitter applied. With a Jitter of 0.2, the
+// result is somewhere between 80% and 120% of the calculated delay.
+func (p *BackoffPolicy) jitteredDelay(attempt int) time.Duration {
+	d := p.delay(attempt)
+	j := p.Jitter
+	if j <= 0 {
+		return d
+	}
+	if j > 1 {
+		j = 1
+	}
+	return time.Duration(float64(d) * (1 + j*(2*rand.Float64()-1)))
+}
+
+/*
+Tx is given to the function called by RunInSyncpoint. Its methods wrap the
+regular verbs, adding MQPMO_SYNCPOINT or MQGMO_SYNCPOINT to the options so
+that everything done through the Tx is part of the same unit of work. The options
+field of the caller's PMO or GMO is updated in place. Browse operations
+are passed through unchanged as they cannot be done under syncpoint.
+*/
+type Tx struct {
+	qMgr *MQQueueManager
+}
+
+/*
+QMgr returns the connection that owns the unit of work
+*/
+func (tx Tx) QMgr() *MQQueueManager {
+	return tx.qMgr
+}
+
+/*
+Put a message as part of the unit of work. A nil PMO uses the defaults.
+*/
+func (tx Tx) Put(object MQObject, gomd *MQMD, gopmo *MQPMO, buffer []byte) error {
+	if err := tx.checkObject(object, "MQPUT"); err != nil {
+		return err
+	}
+	if gopmo == nil {
+		gopmo = NewMQPMO()
+	}
+	gopmo.Options = syncpointPMOOptions(gopmo.Options)
+	return object.Put(gomd, gopmo, buffer)
+}
+
+/*
+Put1 puts a single message as part of the unit of work. A nil PMO uses the defaults.
+*/
+func (tx Tx) Put1(good *MQOD, gomd *MQMD, gopmo *MQPMO, buffer []byte) error {
+	if err := tx.checkConn("MQPUT1"); err != nil {
+		return err
+	}
+	if gopmo == nil {
+		gopmo = NewMQPMO()
+	}
+	gopmo.Options = syncpointPMOOptions(gopmo.Options)
+	return tx.qMgr.Put1(good, gomd, gopmo, buffer)
+}
+
+/*
+Get a message as part of the unit of work. A nil GMO uses the defaults.
+*/
+func (tx Tx) Get(object MQObject, gomd *MQMD, gogmo *MQGMO, buffer []byte) (int, error) {
+	if err := tx.checkObject(object, "MQGET"); err != nil {
+		return 0, err
+	}
+	if gogmo == nil {
+		gogmo = NewMQGMO()
+	}
+	gogmo.Options = syncpointGMOOptions(gogmo.Options)
+	return object.Get(gomd, gogmo, buffer)
+}
+
+/*
+GetSlice gets a message as part of the unit of work, returning the
+buffer sliced to the message length. A nil GMO uses the defaults.
+*/
+func (tx Tx) GetSlice(object MQObject, gomd *MQMD, gogmo *MQGMO, buffer []byte) ([]byte, int, error) {
+	if err := tx.checkObject(object, "MQGET"); err != nil {
+		return buffer[:0], 0, err
+	}
+	if gogmo == nil {
+		gogmo = NewMQGMO()
+	}
+	gogmo.Options = syncpointGMOOptions(gogmo.Options)
+	return object.GetSlice(gomd, gogmo, buffer)
+}
+
+// A Tx that was not created by RunInSyncpoint has no connection to do the work on
+func (tx Tx) checkConn(verb string) error {
+	if tx.qMgr == nil {
+		return &MQReturn{MQCC: MQCC_FAILED,
+			MQRC: MQRC_HCONN_ERROR,
+			verb: verb,
+		}
+	}
+	return nil
+}
+
+// An object opened on a different connection would not be part of this
+// unit of work, so we reject it rather than silently doing the work outside it.
+func (tx Tx) checkObject(object MQObject, verb string) error {
+	if err := tx.checkConn(verb); err != nil {
+		return err
+	}
+	if object.qMgr != nil && object.qMgr.GetValue() != tx.qMgr.GetValue() {
+		return &MQReturn{MQCC: MQCC_FAILED,
+			MQRC: MQRC_HCONN_ERROR,
+			verb: verb,
+		}
+	}
+	return nil
+}
+
+func syncpointPMOOptions(opts int32) int32 {
+	opts &^= MQPMO_NO_SYNCPOINT
+	return opts | MQPMO_SYNCPOINT
+}
+
+func syncpointGMOOptions(opts int32) int32 {
+	if opts&(MQGMO_BROWSE_FIRST|MQGMO_BROWSE_NEXT|MQGMO_BROWSE_MSG_UNDER_CURSOR) != 0 {
+		return opts
+	}
+	opts &^= (MQGMO_NO_SYNCPOINT | MQGMO_SYNCPOINT_IF_PERSISTENT)
+	return opts | MQGMO_SYNCPOINT
+}
+
+/*
+RunInSyncpoint calls the function with a Tx that can be used for putting and
+getting messages in a single unit of work. If the function returns nil, the
+work is committed. If it returns an error or panics, the work is backed out and the
+error (or panic) is passed back to the caller.
+
+When the function or the commit fails with MQRC_BACKED_OUT or MQRC_CALL_INTERRUPTED,
+the whole function is run again after a delay given by the default BackoffPolicy. Only those
+two reasons are retried here; other errors that IsRetryable accepts, such as a full queue,
+are returned straight away. The function must therefore be safe to repeat. The context
+can be used to stop waiting for a retry.
+
+If the connection fails during the commit itself, there is no way to know whether the
+unit of work was committed. That is never retried, as the work might then be done twice.
+Instead a CommitOutcomeUnknownError is returned, and the application has to decide what to do.
+*/
+func (x *MQQueueManager) RunInSyncpoint(ctx context.Context, fn func(tx Tx) error) error {
+	return x.RunInSyncpointWithPolicy(ctx, nil, fn)
+}
+
+/*
+RunInSyncpointWithPolicy is the same as RunInSyncpoint but with an explicit
+retry policy. A nil policy uses the values from NewBackoffPolicy.
+*/
+func (x *MQQueueManager) RunInSyncpointWithPolicy(ctx context.Context, policy *BackoffPolicy, fn func(tx Tx) error) error {
+	var err error
+
+	traceEntry("RunInSyncpoint")
+
+	if policy == nil {
+		policy = NewBackoffPolicy()
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for attempt := 1; ; attempt++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			traceExitErr("RunInSyncpoint", 1, ctxErr)
+			return ctxErr
+		}
+
+		err = x.runInSyncpointOnce(fn)
+		if err == nil || !isTransientSyncpointError(err) {
+			break
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			break
+		}
+
+		d := policy.jitteredDelay(attempt)
+		logTrace("RunInSyncpoint: attempt %d failed with %v. Retrying after %v", attempt, err, d)
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			traceExitErr("RunInSyncpoint", 2, ctx.Err())
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+
+	traceExitErr("RunInSyncpoint", 0, err)
+	return err
+}
+
+func (x *MQQueueManager) runInSyncpointOnce(fn func(tx Tx) error) error {
+	tx := Tx{qMgr: x}
+
+	// A panic in the application function still has to release anything
+	// done in the unit of work. We then let the panic carry on up the stack.
+	defer func() {
+		if r := recover(); r != nil {
+			if backErr := x.Back(); backErr != nil {
+				logError("RunInSyncpoint: backout after panic failed: %v", backErr)
+			}
+			panic(r)
+		}
+	}()
+
+	err := fn(tx)
+	if err != nil {
+		if backErr := x.Back(); backErr != nil {
+			logTrace("RunInSyncpoint: backout failed: %v", backErr)
+		}
+		return err
+	}
+
+	err = x.Cmit()
+	if isCommitOutcomeUnknown(err) {
+		return &CommitOutcomeUnknownError{Err: err}
+	}
+	return err
+}
+
+/*
+CommitOutcomeUnknownError is returned by RunInSyncpoint when the connection to
+the queue manager failed during MQCMIT. The unit of work might have been committed or
+backed out. Err is the error from the commit.
+*/
+type CommitOutcomeUnknownError struct {
+	Err error
+}
+
+func (e *CommitOutcomeUnknownError) Error() string {
+	return "outcome of commit is unknown: " + e.Err.Error()
+}
+
+func (e *CommitOutcomeUnknownError) Unwrap() error {
+	return e.Err
+}
+
+// Reasons from MQCMIT that mean the queue manager might or might not have
+// completed the commit before the connection failed
+func isCommitOutcomeUnknown(err error) bool {
+	var mqreturn *MQReturn
+	if !errors.As(err, &mqreturn) {
+		return false
+	}
+	switch mqreturn.MQRC {
+	case MQRC_CALL_INTERRUPTED, MQRC_CONNECTION_BROKEN, MQRC_RECONNECT_FAILED:
+		return true
+	}
+	return false
+}
+
+// Which failures mean the unit of work did not happen, but might succeed
+// if it is simply tried again. This is deliberately narrower than IsRetryable:
+// conditions such as a full queue are not going to clear in the time the retries take.
+func isTransientSyncpointError(err error) bool {
+	var unknown *CommitOutcomeUnknownError
+	if errors.As(err, &unknown) {
+		return false
+	}
+
+	var mqreturn *MQReturn
+	if errors.As(err, &mqreturn) {
+		switch mqreturn.MQRC {
+		case MQRC_BACKED_OUT, MQRC_CALL_INTERRUPTED:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,438 @@
+package ibmmq
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file lets the TLS configuration for a client connection be given as PEM files,
+as commonly provided by certificate managers, instead of as a key repository.
+Set the TLSOptions field in the MQCNO and Connx checks the files each time
+it makes a connection: certificates must be present, readable and in date, and the
+OnEvent callback is told when they change or are about to expire.
+
+PEM key repositories need MQ 9.3 or later. The private key, the certificates and any CA
+certificates are combined into a single file, which is named in the KeyRepository field of
+the MQSCO. The file is only readable by the owner, and is in a directory private to these
+options. Its name comes from a hash of the contents, so every connection made with the
+same material uses the same key repository. The files are removed by Close.
+
+Certificates are not reloaded into existing connections. The MQ client initialises its
+TLS environment once, and only reads the key repository again when there are no TLS
+connections left in the process. If a connection is made with new material while others
+are still active, MQCONNX gives the MQRC_SSL_ALREADY_INITIALIZED warning and the existing
+material continues to be used. Connx returns that warning as an error, but the connection
+has been made and must be used or disconnected; the Pool and ReconnectingQueueManager
+treat it as success. To move to new certificates, the application must disconnect all of
+its TLS connections and then reconnect.
+*/
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+TLSEventType says why the OnEvent callback was called
+*/
+type TLSEventType int
+
+const (
+	TLSEventChanged  TLSEventType = iota + 1 // The files have changed and the new contents are valid
+	TLSEventInvalid                          // The files cannot be used
+	TLSEventExpiring                         // A certificate expires within ExpiryWarning
+)
+
+/*
+String returns a printable version of the event type
+*/
+func (t TLSEventType) String() string {
+	switch t {
+	case TLSEventChanged:
+		return "Changed"
+	case TLSEventInvalid:
+		return "Invalid"
+	case TLSEventExpiring:
+		return "Expiring"
+	default:
+		return "Unknown"
+	}
+}
+
+/*
+TLSEvent is passed to the OnEvent callback
+*/
+type TLSEvent struct {
+	Type     TLSEventType
+	File     string    // The file that the event refers to
+	Subject  string    // For Expiring events, the certificate's subject
+	NotAfter time.Time // For Expiring events, when the certificate expires
+	Err      error     // For Invalid events, what is wrong
+}
+
+/*
+TLSOptions describes the TLS configuration for a client connection. The fields
+should not be changed once the structure is in use.
+*/
+type TLSOptions struct {
+	CertFile         string // PEM certificate chain for the client. Can also contain the key
+	KeyFile          string // PEM private key. Empty if it is in CertFile
+	KeyPassword      string // If the private key is encrypted
+	CAFile           string // PEM CA certificates to trust. Empty to use MQ's default trust store
+	CipherSpec       string // Set in the MQCD if there is one
+	PeerName         string // Set in the MQCD if there is one
+	CertificateLabel string
+
+	ExpiryWarning time.Duration // Raise TLSEventExpiring when a certificate expires within this time
+	CheckInterval time.Duration // How often Watch looks at the files
+	OnEvent       func(TLSEvent)
+
+	mutex   sync.Mutex
+	stamps  string // Modification times and sizes of the files when last checked
+	checked time.Time
+	keyRing []byte // The contents of the key repository file
+	err     error
+	warned  map[string]time.Time // Certificates that have already had an Expiring event
+	pending []TLSEvent           // Delivered once the mutex is released
+	tempDir string               // Private directory for the key repository files
+}
+
+/*
+NewTLSOptions fills in default values for the TLSOptions structure
+*/
+func NewTLSOptions(certFile string, keyFile string, caFile string) *TLSOptions {
+	o := new(TLSOptions)
+	o.CertFile = certFile
+	o.KeyFile = keyFile
+	o.CAFile = caFile
+	o.ExpiryWarning = 7 * 24 * time.Hour
+	o.CheckInterval = 30 * time.Second
+	return o
+}
+
+/*
+Validate checks that the files exist and contain usable, in-date
+certificates, and that the key matches the certificate
+*/
+func (o *TLSOptions) Validate() error {
+	_, err := o.material()
+	return err
+}
+
+// Build the CNO that is passed to the MQI. The substructures are copied
+// so that the application's structures are not changed.
+func (o *TLSOptions) apply(gocno *MQCNO) (*MQCNO, error) {
+	keyRing, err := o.material()
+	if err != nil {
+		return nil, err
+	}
+	keyRepo, err := o.writeKeyRepository(keyRing)
+	if err != nil {
+		return nil, err
+	}
+
+	cno := *gocno
+	sco := NewMQSCO()
+	if gocno.SSLConfig != nil {
+		*sco = *gocno.SSLConfig
+	}
+	sco.KeyRepository = keyRepo
+	sco.KeyRepoPassword = o.KeyPassword
+	if o.CertificateLabel != "" {
+		sco.CertificateLabel = o.CertificateLabel
+	}
+	cno.SSLConfig = sco
+
+	if gocno.ClientConn != nil {
+		cd := *gocno.ClientConn
+		if o.CipherSpec != "" {
+			cd.SSLCipherSpec = o.CipherSpec
+		}
+		if o.PeerName != "" {
+			cd.SSLPeerName = o.PeerName
+		}
+		cno.ClientConn = &cd
+	}
+
+	return &cno, nil
+}
+
+// Record the state of the files so we can tell if they change
+func (o *TLSOptions) fileStamps() string {
+	var sb strings.Builder
+	for _, f := range []string{o.CertFile, o.KeyFile, o.CAFile} {
+		if f == "" {
+			continue
+		}
+		if fi, err := os.Stat(f); err == nil {
+			fmt.Fprintf(&sb, "%s:%d:%d;", f, fi.ModTime().UnixNano(), fi.Size())
+		} else {
+			fmt.Fprintf(&sb, "%s:missing;", f)
+		}
+	}
+	return sb.String()
+}
+
+// Return the contents of the key repository, checking the files again if they have changed
+// since last time. Events are raised for changes and for certificates that are about to expire.
+func (o *TLSOptions) material() ([]byte, error) {
+	o.mutex.Lock()
+
+	// Unchanged files are still checked now and then, as certificates expire
+	stamps := o.fileStamps()
+	changed := stamps != o.stamps
+	if changed || time.Since(o.checked) >= o.CheckInterval {
+		first := o.stamps == ""
+		o.stamps = stamps
+		o.checked = time.Now()
+		o.keyRing, o.err = o.load()
+
+		if o.err != nil {
+			logError("TLSOptions: %v", o.err)
+			o.event(TLSEvent{Type: TLSEventInvalid, File: o.CertFile, Err: o.err})
+		} else if changed && !first {
+			logTrace("TLSOptions: Using new material from %s", o.CertFile)
+			o.event(TLSEvent{Type: TLSEventChanged, File: o.CertFile})
+		}
+	}
+
+	keyRing, err := o.keyRing, o.err
+	events := o.pending
+	o.pending = nil
+	o.mutex.Unlock()
+
+	// The callback is not called with the mutex held, so it is free to use these options
+	if o.OnEvent != nil {
+		for _, ev := range events {
+			o.OnEvent(ev)
+		}
+	}
+	return keyRing, err
+}
+
+func (o *TLSOptions) event(ev TLSEvent) {
+	o.pending = append(o.pending, ev)
+}
+
+// Read and check the files, and combine them into the contents of a key repository
+func (o *TLSOptions) load() ([]byte, error) {
+	if o.CertFile == "" {
+		return nil, fmt.Errorf("no certificate file given")
+	}
+	certPEM, err := os.ReadFile(o.CertFile)
+	if err != nil {
+		return nil, err
+	}
+	if err = o.checkCerts(o.CertFile, certPEM); err != nil {
+		return nil, err
+	}
+
+	keyPEM := certPEM
+	if o.KeyFile != "" && o.KeyFile != o.CertFile {
+		if keyPEM, err = os.ReadFile(o.KeyFile); err != nil {
+			return nil, err
+		}
+	}
+	encrypted, err := checkKey(o.KeyFile, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	// An encrypted key can only be checked by MQ, which has the password
+	if !encrypted {
+		if _, err = tls.X509KeyPair(certPEM, keyPEM); err != nil {
+			return nil, fmt.Errorf("%s: key does not match certificate: %v", o.CertFile, err)
+		}
+	}
+
+	keyRing := append([]byte{}, certPEM...)
+	if o.KeyFile != "" && o.KeyFile != o.CertFile {
+		keyRing = append(append(keyRing, '\n'), keyPEM...)
+	}
+
+	if o.CAFile != "" {
+		caPEM, err := os.ReadFile(o.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		if err = o.checkCerts(o.CAFile, caPEM); err != nil {
+			return nil, err
+		}
+		keyRing = append(append(keyRing, '\n'), caPEM...)
+	}
+	return keyRing, nil
+}
+
+// Check every certificate in the file is in date, warning about those that
+// will expire soon
+func (o *TLSOptions) checkCerts(file string, b []byte) error {
+	now := time.Now()
+	count := 0
+
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		count++
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+		subject := cert.Subject.String()
+		if now.Before(cert.NotBefore) {
+			return fmt.Errorf("%s: certificate %q is not valid until %v", file, subject, cert.NotBefore)
+		}
+		if now.After(cert.NotAfter) {
+			return fmt.Errorf("%s: certificate %q expired at %v", file, subject, cert.NotAfter)
+		}
+
+		if o.ExpiryWarning > 0 && cert.NotAfter.Sub(now) < o.ExpiryWarning {
+			if o.warned == nil {
+				o.warned = make(map[string]time.Time)
+			}
+			if !o.warned[subject].Equal(cert.NotAfter) {
+				o.warned[subject] = cert.NotAfter
+				o.event(TLSEvent{Type: TLSEventExpiring, File: file, Subject: subject, NotAfter: cert.NotAfter})
+			}
+		}
+	}
+
+	if count == 0 {
+		return fmt.Errorf("%s: no certificates found", file)
+	}
+	return nil
+}
+
+// Find the private key, returning whether it is encrypted
+func checkKey(file string, b []byte) (bool, error) {
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			return false, fmt.Errorf("%s: no private key found", file)
+		}
+		if block.Type == "ENCRYPTED PRIVATE KEY" || (strings.HasSuffix(block.Type, "PRIVATE KEY") && block.Headers["Proc-Type"] != "") {
+			return true, nil
+		}
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			return false, nil
+		}
+	}
+}
+
+// Write the key repository file, unless it already exists. The name depends on the
+// contents, so connections with the same material share one file, and MQ sees the same
+// KeyRepository each time. The directory is created without access for other users.
+func (o *TLSOptions) writeKeyRepository(keyRing []byte) (string, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.tempDir == "" {
+		d, err := os.MkdirTemp("", "mqgo-tls-")
+		if err != nil {
+			return "", err
+		}
+		o.tempDir = d
+	}
+
+	sum := sha256.Sum256(keyRing)
+	file := filepath.Join(o.tempDir, "key-"+hex.EncodeToString(sum[:8])+".pem")
+	if _, err := os.Stat(file); err == nil {
+		return file, nil
+	}
+
+	// Write to a temporary name first so that a concurrent connection
+	// never sees a partly-written file
+	f, err := os.CreateTemp(o.tempDir, "tmp-*.pem")
+	if err != nil {
+		return "", err
+	}
+	if err = f.Chmod(0600); err == nil {
+		_, err = f.Write(keyRing)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), file)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return file, nil
+}
+
+/*
+Watch checks the files every CheckInterval until the context is done, so that
+OnEvent is called when they change or when a certificate is close to expiring,
+even if no new connections are being made.
+*/
+func (o *TLSOptions) Watch(ctx context.Context) {
+	interval := o.CheckInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		o.material()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				o.material()
+			}
+		}
+	}()
+}
+
+/*
+Close removes the private directory and the key repository files in it. It should be called when
+no more connections are going to be made with these options.
+*/
+func (o *TLSOptions) Close() error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.tempDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(o.tempDir)
+	o.tempDir = ""
+	o.stamps = ""
+	return err
+}
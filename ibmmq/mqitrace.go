@@ -39,10 +39,20 @@ func SetTrace(b bool) {
 }
 
 func logTrace(format string, v ...interface{}) {
+	if tracing {
+		if l := getLogger(); l != nil {
+			l.Debug(logMessage(format, v...))
+			return
+		}
+	}
 	logInternal(tracing, format, v...)
 }
 
 func logError(format string, v ...interface{}) {
+	if l := getLogger(); l != nil {
+		l.Error(logMessage(format, v...))
+		return
+	}
 	logInternal(true, "ERROR: "+format, v...)
 }
 
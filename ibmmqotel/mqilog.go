@@ -24,6 +24,8 @@ import (
 	"os"
 	"strings"
 	"time"
+
+	mq "github.com/ibm-messaging/mq-golang/v5/ibmmq"
 )
 
 /*
@@ -38,8 +40,13 @@ func SetTrace(b bool) {
 	tracing = b
 }
 
+// The trace and error messages go to the structured logger given to mq.SetLogger
+// if there is one. Trace messages are still only written when tracing is on.
 func logTrace(format string, v ...interface{}) {
-	if tracing {
+	structuredLogger := mq.GetLogger()
+	if tracing && structuredLogger != nil {
+		structuredLogger.Debug(strings.TrimSuffix(fmt.Sprintf(format, v...), "\n"))
+	} else if tracing {
 		d := time.Now().Format("2006-01-02T15:04:05.000")
 		fmt.Fprintf(os.Stderr, "[ibmmqotel] (D) %s : ", d)
 		fmt.Fprintf(os.Stderr, format, v...)
@@ -50,6 +57,10 @@ func logTrace(format string, v ...interface{}) {
 }
 
 func logError(format string, v ...interface{}) {
+	if structuredLogger := mq.GetLogger(); structuredLogger != nil {
+		structuredLogger.Error(strings.TrimSuffix(fmt.Sprintf(format, v...), "\n"))
+		return
+	}
 	d := time.Now().Format("2006-01-02T15:04:05.000")
 	fmt.Fprintf(os.Stderr, "[ibmmqotel] (E) %s : ", d)
 	fmt.Fprintf(os.Stderr, format, v...)
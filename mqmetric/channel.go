@@ -433,6 +433,9 @@ func parseChlStatusData(instanceType int32, cfh *ibmmq.MQCFH, buf []byte) string
 	// Parse it once to extract the fields that are needed for the map key
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -507,6 +510,9 @@ func parseChlStatusData(instanceType int32, cfh *ibmmq.MQCFH, buf []byte) string
 	offset = 0
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -687,6 +693,9 @@ func parseChannelAttrData(cfh *ibmmq.MQCFH, buf []byte, infoMap map[string]*ObjI
 	// Parse it once to extract the fields that are needed for the map key
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -705,6 +714,9 @@ func parseChannelAttrData(cfh *ibmmq.MQCFH, buf []byte, infoMap map[string]*ObjI
 	offset = 0
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
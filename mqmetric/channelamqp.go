@@ -298,6 +298,9 @@ func parseAMQPChlData(instanceType int32, cfh *ibmmq.MQCFH, buf []byte) string {
 	// Parse it once to extract the fields that are needed for the map key
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -339,6 +342,9 @@ func parseAMQPChlData(instanceType int32, cfh *ibmmq.MQCFH, buf []byte) string {
 	offset = 0
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -466,6 +472,9 @@ func parseAMQPChannelAttrData(cfh *ibmmq.MQCFH, buf []byte, infoMap map[string]*
 	// Parse it once to extract the fields that are needed for the map key
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -484,6 +493,9 @@ func parseAMQPChannelAttrData(cfh *ibmmq.MQCFH, buf []byte, infoMap map[string]*
 	offset = 0
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
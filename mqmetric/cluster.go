@@ -157,6 +157,9 @@ func parseClusterData(cfh *ibmmq.MQCFH, buf []byte) string {
 	// Parse it once to extract the fields that are needed for the map key
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -179,6 +182,9 @@ func parseClusterData(cfh *ibmmq.MQCFH, buf []byte) string {
 	offset = 0
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
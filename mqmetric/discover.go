@@ -105,6 +105,7 @@ const NativeHAKeyPrefix = "@NATIVEHA@"
 const ClassNameQ = "STATQ"
 
 const maxBufSize = 100 * 1024 * 1024 // 100 MB
+const statusReplyBufSize = 32768     // Initial size for reading replies; grows as needed
 
 const defaultMaxQDepth = 5000
 
@@ -1011,14 +1012,27 @@ func inquireObjectsWithFilter(objectPatternsList string, objectType int32, filte
 		}
 
 		// Use a loop to make sure we're looking at the proper response from a
-		// z/OS queue manager using the MQCFT_XR mechanism. There is a 2ary loop
-		// inside getWithoutTruncation to try to get a complete message
+		// z/OS queue manager using the MQCFT_XR mechanism. GetAutoSize deals with
+		// the buffer being too small for a complete message
 		for xr := true; xr; {
-			buf, datalen, err = getWithoutTruncation(ci.si.statusReplyQObj)
+			// Reset the MD and GMO on each iteration to ensure we don't get mixed up
+			// with anything that gets modified (like the CCSID)
+			getmqmd := ibmmq.NewMQMD()
+			gmo := ibmmq.NewMQGMO()
+			gmo.Options = ibmmq.MQGMO_NO_SYNCPOINT
+			gmo.Options |= ibmmq.MQGMO_FAIL_IF_QUIESCING
+			gmo.Options |= ibmmq.MQGMO_WAIT
+			gmo.Options |= ibmmq.MQGMO_CONVERT
+			gmo.WaitInterval = 30 * 1000
+			buf, err = ci.si.statusReplyQObj.GetAutoSize(getmqmd, gmo, statusReplyBufSize, maxBufSize)
+			datalen = len(buf)
 			//logTrace("Buf starts %v",buf[0:128])
 			if err == nil {
-				cfh, offset := ibmmq.ReadPCFHeader(buf)
-				if cfh.CompCode != ibmmq.MQCC_OK {
+				cfh, offset, perr := ibmmq.ParsePCFHeader(buf)
+				if perr != nil {
+					xr = false
+					return objectList, perr
+				} else if cfh.CompCode != ibmmq.MQCC_OK {
 					xr = false
 					return objectList, fmt.Errorf("PCF command %s [%d] failed with CC %s [%d] RC %s [%d]",
 						ibmmq.MQItoString("CMD", int(cfh.Command)), cfh.Command,
@@ -1049,6 +1063,9 @@ func inquireObjectsWithFilter(objectPatternsList string, objectType int32, filte
 
 					for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 						elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+						if elem == nil {
+							break
+						}
 						offset += bytesRead
 						// Have we now reached the end of the message
 						if offset >= datalen {
@@ -1496,6 +1513,9 @@ func parsePCFResponse(buf []byte) ([]*ibmmq.PCFParameter, bool) {
 		// tell us how far it got.
 		// We understand PCF Groups in ReadPCFParameter so don't need to extract them explicitly
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 
 		elemList = append(elemList, elem)
@@ -1774,55 +1794,6 @@ func trimToNull(s string) string {
 	return strings.TrimSpace(rc)
 }
 
-// Return a complete message - if the default buffer is too small, iterate until
-// we no longer get the MQRC_TRUNCATED_MSG_FAILED
-func getWithoutTruncation(hObj ibmmq.MQObject) ([]byte, int, error) {
-	var err error
-	datalen := 0
-	traceEntry("getWithoutTruncation")
-
-	ci := getConnection(GetConnectionKey())
-
-	if ci.si.statusReplyBuf == nil {
-		// Pick a default initial size for this session's reply buffer. If it needs to grow, it will.
-		// There's no shrinking done later though as the size is likely to remain needed for the
-		// lifetime of the collector
-		ci.si.statusReplyBuf = make([]byte, 32768)
-	}
-
-	for trunc := true; trunc; {
-		// Now get the response. Reset the MD and GMO on each iteration to ensure we don't get mixed up
-		// with anything that gets modified (like the CCSID) even on failed/truncated GETs.
-		md := ibmmq.NewMQMD()
-		gmo := ibmmq.NewMQGMO()
-		gmo.Options = ibmmq.MQGMO_NO_SYNCPOINT
-		gmo.Options |= ibmmq.MQGMO_FAIL_IF_QUIESCING
-		gmo.Options |= ibmmq.MQGMO_WAIT
-		gmo.Options |= ibmmq.MQGMO_CONVERT
-		gmo.WaitInterval = 30 * 1000
-		logTrace("getWithoutTruncation: Trying MQGET with buffer size %d gmo.Options %x md.ccsid %d", len(ci.si.statusReplyBuf), gmo.Options, md.CodedCharSetId)
-		datalen, err = hObj.Get(md, gmo, ci.si.statusReplyBuf)
-		if err != nil {
-			mqreturn := err.(*ibmmq.MQReturn)
-			if mqreturn.MQCC != ibmmq.MQCC_OK && mqreturn.MQRC == ibmmq.MQRC_TRUNCATED_MSG_FAILED && len(ci.si.statusReplyBuf) < maxBufSize {
-				// Double the size, apart from capping it at 100MB
-				ci.si.statusReplyBuf = append(ci.si.statusReplyBuf, make([]byte, len(ci.si.statusReplyBuf))...)
-				if len(ci.si.statusReplyBuf) > maxBufSize {
-					ci.si.statusReplyBuf = ci.si.statusReplyBuf[0:maxBufSize]
-				}
-			} else {
-				traceExitF("getWithoutTruncation", 1, "BufSize %d Error %v", len(ci.si.statusReplyBuf), err)
-				return ci.si.statusReplyBuf, datalen, err
-			}
-		} else {
-			trunc = false
-		}
-	}
-
-	traceExit("getWithoutTruncation", 0)
-	return ci.si.statusReplyBuf, datalen, err
-}
-
 // Convert a string into something containing only valid UTF8 characters
 func printableStringUTF8(s string) string {
 	if utf8.ValidString(s) {
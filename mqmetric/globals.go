@@ -42,7 +42,6 @@ type sessionInfo struct {
 
 	statusReplyQObj       ibmmq.MQObject
 	statusReplyQReadAhead bool
-	statusReplyBuf        []byte
 
 	statisticsQObj       ibmmq.MQObject
 	statisticsQReadAhead bool
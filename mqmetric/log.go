@@ -21,6 +21,9 @@ package mqmetric
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/ibm-messaging/mq-golang/v5/ibmmq"
 )
 
 // Setup for the 7 levels of logging that logrus allows, even if we don't
@@ -37,27 +40,43 @@ type Logger struct {
 
 var logger *Logger = nil
 
+// SetLogger sets the functions for each level of logging. If a structured logger
+// has been given to ibmmq.SetLogger, it is used instead, with trace messages
+// written at the Debug level.
 func SetLogger(l *Logger) {
 	logger = l
 }
 
+// The structured loggers do not take format strings
+func logMessage(format string, v ...interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintf(format, v...), "\n")
+}
+
 func logTrace(format string, v ...interface{}) {
-	if logger != nil && logger.Trace != nil {
+	if structuredLogger := ibmmq.GetLogger(); structuredLogger != nil {
+		structuredLogger.Debug(logMessage(format, v...))
+	} else if logger != nil && logger.Trace != nil {
 		logger.Trace(format, v...)
 	}
 }
 func logDebug(format string, v ...interface{}) {
-	if logger != nil && logger.Debug != nil {
+	if structuredLogger := ibmmq.GetLogger(); structuredLogger != nil {
+		structuredLogger.Debug(logMessage(format, v...))
+	} else if logger != nil && logger.Debug != nil {
 		logger.Debug(format, v...)
 	}
 }
 func logInfo(format string, v ...interface{}) {
-	if logger != nil && logger.Info != nil {
+	if structuredLogger := ibmmq.GetLogger(); structuredLogger != nil {
+		structuredLogger.Info(logMessage(format, v...))
+	} else if logger != nil && logger.Info != nil {
 		logger.Info(format, v...)
 	}
 }
 func logWarn(format string, v ...interface{}) {
-	if logger != nil && logger.Warn != nil {
+	if structuredLogger := ibmmq.GetLogger(); structuredLogger != nil {
+		structuredLogger.Warn(logMessage(format, v...))
+	} else if logger != nil && logger.Warn != nil {
 		logger.Warn(format, v...)
 	}
 }
@@ -65,7 +84,9 @@ func logWarn(format string, v ...interface{}) {
 // Errors should be reported always. Also use this for what you might
 // think of as warnings.
 func logError(format string, v ...interface{}) {
-	if logger != nil && logger.Error != nil {
+	if structuredLogger := ibmmq.GetLogger(); structuredLogger != nil {
+		structuredLogger.Error(logMessage(format, v...))
+	} else if logger != nil && logger.Error != nil {
 		logger.Error(format, v...)
 	} else {
 		fmt.Printf(format, v...)
@@ -869,6 +869,9 @@ func parseInqSubData(cfh *ibmmq.MQCFH, buf []byte) (string, string) {
 
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
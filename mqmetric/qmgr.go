@@ -439,6 +439,9 @@ func parseQMgrStatusData(instanceType int32, cfh *ibmmq.MQCFH, buf []byte) strin
 	// Parse it once to extract the fields that are needed for the map key
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -462,6 +465,9 @@ func parseQMgrStatusData(instanceType int32, cfh *ibmmq.MQCFH, buf []byte) strin
 	hostname := DUMMY_STRING
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -507,7 +513,7 @@ func parseQMgrStatusData(instanceType int32, cfh *ibmmq.MQCFH, buf []byte) strin
 
 // Given a PCF response message, parse it to extract the desired statistics
 func parseQMgrActiveProcesses(cfh *ibmmq.MQCFH, buf []byte) bool {
-	//var elem *ibmmq.PCFParameter
+	var elem *ibmmq.PCFParameter
 
 	traceEntry("parseQMgrActiveProcesses")
 	process := false
@@ -523,7 +529,10 @@ func parseQMgrActiveProcesses(cfh *ibmmq.MQCFH, buf []byte) bool {
 
 	// Parse it to look for successful queries
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
-		_, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -428,6 +428,9 @@ func parseStatisticsMsg(buf []byte, datalen int) {
 
 	for parmAvail && cfh.CompCode != mq.MQCC_FAILED {
 		elem, bytesRead = mq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
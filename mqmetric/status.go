@@ -31,7 +31,6 @@ import (
 var (
 	timeTravelWarningIssued  = false
 	persistenceWarningIssued = false
-	clearQBuf                = make([]byte, 32768)
 )
 
 /*
@@ -172,52 +171,6 @@ func statusTimeEpoch(d string, t string) int64 {
 	return epoch
 }
 
-func getMsgWithoutTruncation(hObj ibmmq.MQObject) (*ibmmq.MQMD, int, error) {
-	var err error
-	var md *ibmmq.MQMD
-
-	traceEntry("getMsgWithoutTruncation")
-
-	msgLen := 0
-	for trunc := true; trunc; {
-		// Now get the response. Reset the MD and GMO on each iteration to ensure we don't get mixed up
-		// with anything that gets modified (like the CCSID) even on failed/truncated GETs.
-		md = ibmmq.NewMQMD()
-		gmo := ibmmq.NewMQGMO()
-		gmo.Options = ibmmq.MQGMO_NO_SYNCPOINT
-		gmo.Options |= ibmmq.MQGMO_FAIL_IF_QUIESCING
-		gmo.Options |= ibmmq.MQGMO_NO_WAIT
-		gmo.Options |= ibmmq.MQGMO_CONVERT
-
-		// logTrace("clearQWithoutTruncation: Trying MQGET with clearQBuffer size %d ", len(clearQBuf))
-		msgLen, err = hObj.Get(md, gmo, clearQBuf)
-		if err != nil {
-			mqreturn := err.(*ibmmq.MQReturn)
-			if mqreturn.MQCC != ibmmq.MQCC_OK && mqreturn.MQRC == ibmmq.MQRC_TRUNCATED_MSG_FAILED && len(clearQBuf) < maxBufSize {
-				// Double the size, apart from capping it at 100MB
-				clearQBuf = append(clearQBuf, make([]byte, len(clearQBuf))...)
-				if len(clearQBuf) > maxBufSize {
-					clearQBuf = clearQBuf[0:maxBufSize]
-				}
-			} else {
-				if mqreturn.MQRC != ibmmq.MQRC_NO_MSG_AVAILABLE {
-					traceExitF("getMsgWithoutTruncation", 1, "BufSize %d Error %v", len(clearQBuf), err)
-					return md, msgLen, err
-				} else {
-					// Quit cleanly
-					trunc = false
-					// err = nil
-				}
-			}
-		} else {
-			trunc = false
-		}
-	}
-
-	traceExit("getMsgWithoutTruncation", 0)
-	return md, msgLen, err
-}
-
 func clearQ(hObj ibmmq.MQObject, usingReadAhead bool) {
 	var err error
 	var getmqmd *ibmmq.MQMD
@@ -244,7 +197,13 @@ func clearQ(hObj ibmmq.MQObject, usingReadAhead bool) {
 
 		} else {
 			// logDebug("Reverting to clearMsgWithoutTruncation")
-			getmqmd, _, err = getMsgWithoutTruncation(hObj)
+			getmqmd = ibmmq.NewMQMD()
+			gmo := ibmmq.NewMQGMO()
+			gmo.Options = ibmmq.MQGMO_NO_SYNCPOINT
+			gmo.Options |= ibmmq.MQGMO_FAIL_IF_QUIESCING
+			gmo.Options |= ibmmq.MQGMO_NO_WAIT
+			gmo.Options |= ibmmq.MQGMO_CONVERT
+			_, err = hObj.GetAutoSize(getmqmd, gmo, statusReplyBufSize, maxBufSize)
 		}
 
 		// logDebug("clearQ: got message with err %v p=%d", err, getmqmd.Persistence)
@@ -358,7 +317,13 @@ func statusGetReply(correlId []byte) (*ibmmq.MQCFH, []byte, bool, error) {
 	allDone := false
 	datalen, err := ci.si.statusReplyQObj.Get(getmqmd, gmo, replyBuf)
 	if err == nil {
-		cfh, offset = ibmmq.ReadPCFHeader(replyBuf)
+		cfh, offset, err = ibmmq.ParsePCFHeader(replyBuf[:datalen])
+		if err != nil {
+			// Without a valid header we cannot tell if more replies are coming
+			logError("StatusGetReply error : %v", err)
+			traceExitErr("statusGetReply", 4, err)
+			return nil, nil, true, err
+		}
 
 		if cfh.Control == ibmmq.MQCFC_LAST {
 			allDone = true
@@ -192,6 +192,9 @@ func parseUsageData(cfh *ibmmq.MQCFH, buf []byte) string {
 	// Parse it once to extract the fields that are needed for the map key
 	for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 		elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+		if elem == nil {
+			break
+		}
 		offset += bytesRead
 		// Have we now reached the end of the message
 		if offset >= datalen {
@@ -254,6 +257,9 @@ func parseUsageData(cfh *ibmmq.MQCFH, buf []byte) string {
 		offset = 0
 		for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 			elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+			if elem == nil {
+				break
+			}
 			offset += bytesRead
 			// Have we now reached the end of the message
 			if offset >= datalen {
@@ -274,6 +280,9 @@ func parseUsageData(cfh *ibmmq.MQCFH, buf []byte) string {
 		offset = 0
 		for parmAvail && cfh.CompCode != ibmmq.MQCC_FAILED {
 			elem, bytesRead = ibmmq.ReadPCFParameter(buf[offset:])
+			if elem == nil {
+				break
+			}
 			offset += bytesRead
 			// Have we now reached the end of the message
 			if offset >= datalen {
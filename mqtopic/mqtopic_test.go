@@ -0,0 +1,146 @@
+/*
+Copyright (c) IBM Corporation 2026
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mqtopic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		scheme  WildcardScheme
+		topic   string
+		match   bool
+	}{
+		{"Sport/Tennis", WildcardTopic, "Sport/Tennis", true},
+		{"Sport/#", WildcardTopic, "Sport", true},
+		{"Sport/#", WildcardTopic, "Sport/Tennis/Results", true},
+		{"Sport/+", WildcardTopic, "Sport", false},
+		{"Sport/+", WildcardTopic, "Sport/Tennis", true},
+		{"Sport/+", WildcardTopic, "Sport/Tennis/Results", false},
+		{"Sport/#/Results", WildcardTopic, "Sport/Results", true},
+		{"Sport/#/Results", WildcardTopic, "Sport/Tennis/Men/Results", true},
+		{"Sport/#/Results", WildcardTopic, "Sport/Tennis/Fixtures", false},
+		{"+/+", WildcardTopic, "/Sport", true},
+		{"Sport/Ten+", WildcardTopic, "Sport/Tennis", false},
+		{"Sport/Ten+", WildcardTopic, "Sport/Ten+", true},
+		{"#", WildcardTopic, "a/b/c", true},
+		{"Sport*", WildcardChar, "Sport/Tennis/Results", true},
+		{"Sport/Tennis/???", WildcardChar, "Sport/Tennis/Men", true},
+		{"Sport/Tennis/???", WildcardChar, "Sport/Tennis/Women", false},
+		{"*/Results", WildcardChar, "Sport/Tennis/Results", true},
+		{"Sport/%*", WildcardChar, "Sport/*", true},
+		{"Sport/%*", WildcardChar, "Sport/Tennis", false},
+		{"Sport/#", WildcardChar, "Sport/Tennis", false},
+	}
+
+	for _, tc := range testCases {
+		got, err := Match(tc.pattern, tc.scheme, tc.topic)
+		if err != nil || got != tc.match {
+			t.Errorf("Match(%q, %v, %q) = %v, %v. Expected %v", tc.pattern, tc.scheme, tc.topic, got, err, tc.match)
+		}
+	}
+
+	if _, err := Compile("Sport/%x", WildcardChar); err == nil {
+		t.Errorf("Expected error for invalid escape")
+	}
+	if _, err := Compile("", WildcardTopic); !errors.Is(err, ErrEmptyTopic) {
+		t.Errorf("Expected ErrEmptyTopic. Got %v", err)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r := NewResolver()
+	if err := r.Define("SPORT", "Sport"); err != nil {
+		t.Fatal(err)
+	}
+	r.Define("TENNIS", "Sport/Tennis")
+
+	testCases := []struct {
+		name     string
+		str      string
+		expected string
+	}{
+		{"SPORT", "Tennis/#", "Sport/Tennis/#"},
+		{"SPORT  ", "", "Sport"},
+		{"", "News", "News"},
+		{BaseTopicObject, "News", "News"},
+	}
+	for _, tc := range testCases {
+		got, err := r.Resolve(tc.name, tc.str)
+		if err != nil || got != tc.expected {
+			t.Errorf("Resolve(%q, %q) = %q, %v. Expected %q", tc.name, tc.str, got, err, tc.expected)
+		}
+	}
+
+	if _, err := r.Resolve("MISSING", "x"); !errors.Is(err, ErrUnknownTopicObject) {
+		t.Errorf("Expected ErrUnknownTopicObject. Got %v", err)
+	}
+	if _, err := r.Resolve("", ""); !errors.Is(err, ErrEmptyTopic) {
+		t.Errorf("Expected ErrEmptyTopic. Got %v", err)
+	}
+
+	if n := r.AdminTopic("Sport/Tennis/Results"); n != "TENNIS" {
+		t.Errorf("Expected TENNIS as admin topic. Got %s", n)
+	}
+	if n := r.AdminTopic("Sporting"); n != BaseTopicObject {
+		t.Errorf("Expected %s as admin topic. Got %s", BaseTopicObject, n)
+	}
+}
+
+func TestRouter(t *testing.T) {
+	r := NewRouter()
+	patterns := []struct {
+		pattern string
+		scheme  WildcardScheme
+	}{
+		{"Sport/#", WildcardTopic},
+		{"Sport/+/Results", WildcardTopic},
+		{"Sport/#/Results", WildcardTopic},
+		{"News/#", WildcardTopic},
+		{"*Results", WildcardChar},
+	}
+	var subs []*Subscription
+	for i, p := range patterns {
+		s, err := r.Subscribe(p.pattern, p.scheme, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		subs = append(subs, s)
+	}
+
+	// Every subscription must match exactly as the Pattern does on its own
+	for _, topic := range []string{"Sport/Tennis/Results", "Sport", "News/Today", "Weather/Results", "Sport/Results"} {
+		got := make(map[int]bool)
+		for _, s := range r.Match(topic) {
+			got[s.Value.(int)] = true
+		}
+		for i, s := range subs {
+			if s.Pattern.Match(topic) != got[i] {
+				t.Errorf("Router and Pattern disagree for %q on %q", s.Pattern, topic)
+			}
+		}
+	}
+
+	if !r.Unsubscribe(subs[0]) || r.Unsubscribe(subs[0]) || r.Len() != len(subs)-1 {
+		t.Errorf("Unsubscribe did not remove exactly one subscription")
+	}
+	if len(r.Match("Sport")) != 0 {
+		t.Errorf("Removed subscription still matches")
+	}
+}
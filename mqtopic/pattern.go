@@ -0,0 +1,248 @@
+package mqtopic
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file matches topic strings against subscription patterns.
+
+With topic-based wildcards, '#' matches any number of levels, including none, and '+'
+matches exactly one level. They are only wildcards when they are the whole of a level;
+in "Sport/Ten+" the '+' is an ordinary character. '#' can appear at any level and more than
+once, as in "Sport/#/Results".
+
+With character-based wildcards, the topic string is not split into levels. '*' matches
+any sequence of characters, including '/', and '?' matches any single character.
+A '%' before '*', '?' or '%' removes its special meaning.
+*/
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+/*
+WildcardScheme chooses how wildcards in a pattern are interpreted. The values are the
+same as the MQSO_WILDCARD_TOPIC and MQSO_WILDCARD_CHAR options.
+*/
+type WildcardScheme int32
+
+const (
+	WildcardTopic WildcardScheme = 2097152 // MQSO_WILDCARD_TOPIC
+	WildcardChar  WildcardScheme = 1048576 // MQSO_WILDCARD_CHAR
+)
+
+/*
+SchemeFromOptions returns the scheme that a subscription uses, given its MQSD
+Options. Topic-based wildcards are the default.
+*/
+func SchemeFromOptions(options int32) WildcardScheme {
+	if options&int32(WildcardChar) != 0 {
+		return WildcardChar
+	}
+	return WildcardTopic
+}
+
+func (s WildcardScheme) String() string {
+	switch s {
+	case WildcardTopic:
+		return "WildcardTopic"
+	case WildcardChar:
+		return "WildcardChar"
+	}
+	return fmt.Sprintf("WildcardScheme(%d)", int32(s))
+}
+
+// Elements of a character-based pattern
+const (
+	charLiteral = iota
+	charAny     // '?'
+	charMany    // '*'
+)
+
+type charElem struct {
+	kind int
+	r    rune
+}
+
+/*
+Pattern is a compiled subscription topic string
+*/
+type Pattern struct {
+	text     string
+	scheme   WildcardScheme
+	levels   []string   // For WildcardTopic
+	elems    []charElem // For WildcardChar
+	wildcard bool
+}
+
+/*
+Compile checks a subscription topic string and prepares it for matching
+*/
+func Compile(pattern string, scheme WildcardScheme) (*Pattern, error) {
+	if err := Validate(pattern); err != nil {
+		return nil, err
+	}
+
+	p := &Pattern{text: pattern, scheme: scheme}
+	switch scheme {
+	case WildcardTopic:
+		p.levels = Split(pattern)
+		for _, l := range p.levels {
+			if l == "#" || l == "+" {
+				p.wildcard = true
+			}
+		}
+	case WildcardChar:
+		escaped := false
+		for i, r := range pattern {
+			switch {
+			case escaped:
+				if r != '*' && r != '?' && r != '%' {
+					return nil, fmt.Errorf("topic string %q: '%%' at offset %d must be followed by '*', '?' or '%%'", pattern, i-1)
+				}
+				p.elems = append(p.elems, charElem{kind: charLiteral, r: r})
+				escaped = false
+			case r == '%':
+				escaped = true
+			case r == '*':
+				p.elems = append(p.elems, charElem{kind: charMany})
+				p.wildcard = true
+			case r == '?':
+				p.elems = append(p.elems, charElem{kind: charAny})
+				p.wildcard = true
+			default:
+				p.elems = append(p.elems, charElem{kind: charLiteral, r: r})
+			}
+		}
+		if escaped {
+			return nil, fmt.Errorf("topic string %q ends with '%%'", pattern)
+		}
+	default:
+		return nil, fmt.Errorf("unknown wildcard scheme %d", int32(scheme))
+	}
+	return p, nil
+}
+
+/*
+Match compiles the pattern and tests a topic against it
+*/
+func Match(pattern string, scheme WildcardScheme, topic string) (bool, error) {
+	p, err := Compile(pattern, scheme)
+	if err != nil {
+		return false, err
+	}
+	return p.Match(topic), nil
+}
+
+/*
+String returns the pattern as it was given to Compile
+*/
+func (p *Pattern) String() string {
+	return p.text
+}
+
+/*
+Scheme returns the wildcard scheme of the pattern
+*/
+func (p *Pattern) Scheme() WildcardScheme {
+	return p.scheme
+}
+
+/*
+IsWildcard says whether the pattern can match more than one topic string
+*/
+func (p *Pattern) IsWildcard() bool {
+	return p.wildcard
+}
+
+/*
+Match tests whether a publication on the topic would be sent to a subscription
+with this pattern
+*/
+func (p *Pattern) Match(topic string) bool {
+	if p.scheme == WildcardChar {
+		return matchChars(p.elems, topic)
+	}
+	if !p.wildcard {
+		return p.text == topic
+	}
+	return matchLevels(p.levels, Split(topic))
+}
+
+// Match levels, trying each possible number of levels for a '#'
+func matchLevels(pattern []string, topic []string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case "#":
+			// Consecutive '#' levels are the same as one
+			for len(pattern) > 1 && pattern[1] == "#" {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(topic); i++ {
+				if matchLevels(pattern[1:], topic[i:]) {
+					return true
+				}
+			}
+			return false
+		case "+":
+			if len(topic) == 0 {
+				return false
+			}
+		default:
+			if len(topic) == 0 || topic[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern = pattern[1:]
+		topic = topic[1:]
+	}
+	return len(topic) == 0
+}
+
+// Match the whole topic, going back to the most recent '*' when there is a mismatch
+func matchChars(pattern []charElem, topic string) bool {
+	pi, ti := 0, 0
+	star, mark := -1, 0
+	for ti < len(topic) {
+		r, size := utf8.DecodeRuneInString(topic[ti:])
+		if pi < len(pattern) && (pattern[pi].kind == charAny || (pattern[pi].kind == charLiteral && pattern[pi].r == r)) {
+			pi++
+			ti += size
+		} else if pi < len(pattern) && pattern[pi].kind == charMany {
+			star = pi
+			mark = ti
+			pi++
+		} else if star >= 0 {
+			_, size = utf8.DecodeRuneInString(topic[mark:])
+			mark += size
+			pi = star + 1
+			ti = mark
+		} else {
+			return false
+		}
+	}
+	for pi < len(pattern) && pattern[pi].kind == charMany {
+		pi++
+	}
+	return pi == len(pattern)
+}
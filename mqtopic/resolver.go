@@ -0,0 +1,137 @@
+package mqtopic
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file builds the full topic string for an MQOPEN or MQSUB from the ObjectName
+and ObjectString fields. If both are given, the TOPICSTR of the TOPIC object, a '/' and
+the ObjectString are joined - even if that gives an empty level because the TOPICSTR
+already ends with '/'. If only one is given, it is used on its own.
+*/
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// BaseTopicObject is the TOPIC object at the root of the topic tree
+const BaseTopicObject = "SYSTEM.BASE.TOPIC"
+
+/*
+Resolver holds the definitions of TOPIC objects
+*/
+type Resolver struct {
+	mutex   sync.RWMutex
+	objects map[string]string // Object name to TOPICSTR
+}
+
+/*
+NewResolver creates a Resolver that knows about SYSTEM.BASE.TOPIC
+*/
+func NewResolver() *Resolver {
+	r := new(Resolver)
+	r.objects = map[string]string{BaseTopicObject: ""}
+	return r
+}
+
+/*
+Define adds or replaces a TOPIC object definition. As on the queue manager,
+an object other than SYSTEM.BASE.TOPIC must have a topic string.
+*/
+func (r *Resolver) Define(name string, topicString string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("topic object has no name")
+	}
+	if name != BaseTopicObject {
+		if err := Validate(topicString); err != nil {
+			return fmt.Errorf("topic object %s: %w", name, err)
+		}
+	}
+
+	r.mutex.Lock()
+	r.objects[name] = topicString
+	r.mutex.Unlock()
+	return nil
+}
+
+/*
+TopicString returns the TOPICSTR of a defined object
+*/
+func (r *Resolver) TopicString(name string) (string, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	s, ok := r.objects[strings.TrimSpace(name)]
+	return s, ok
+}
+
+/*
+Resolve gives the full topic string for an object name and object string, as they
+would be set in an MQOD or MQSD
+*/
+func (r *Resolver) Resolve(objectName string, objectString string) (string, error) {
+	objectName = strings.TrimSpace(objectName)
+
+	base := ""
+	if objectName != "" {
+		s, ok := r.TopicString(objectName)
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrUnknownTopicObject, objectName)
+		}
+		base = s
+	}
+
+	topic := objectString
+	if base != "" && objectString != "" {
+		topic = base + Separator + objectString
+	} else if base != "" {
+		topic = base
+	}
+
+	if err := Validate(topic); err != nil {
+		return "", err
+	}
+	return topic, nil
+}
+
+/*
+AdminTopic returns the name of the TOPIC object that is the closest administered
+node at or above the topic string in the tree. That is the object whose attributes,
+such as authorities and PUB/SUB settings, apply to the topic. SYSTEM.BASE.TOPIC
+is returned if there is no closer object.
+*/
+func (r *Resolver) AdminTopic(topic string) string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	best := BaseTopicObject
+	bestLen := -1
+	for name, s := range r.objects {
+		if s == "" || len(s) <= bestLen {
+			continue
+		}
+		if topic == s || strings.HasPrefix(topic, s+Separator) {
+			best = name
+			bestLen = len(s)
+		}
+	}
+	return best
+}
@@ -0,0 +1,212 @@
+package mqtopic
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file finds all the subscriptions that match a publication. Patterns with
+topic-based wildcards are held in a tree of levels, so the cost of a lookup depends
+on the depth of the topic and not on the number of subscriptions. Character-based patterns
+cannot be split by level and are checked one at a time.
+*/
+
+import (
+	"sync"
+)
+
+/*
+Subscription is an entry in a Router. Value is whatever the application wants
+to associate with the pattern, such as a channel or a callback.
+*/
+type Subscription struct {
+	Pattern *Pattern
+	Value   interface{}
+}
+
+type routerNode struct {
+	children map[string]*routerNode
+	subs     []*Subscription
+}
+
+func newRouterNode() *routerNode {
+	return &routerNode{children: make(map[string]*routerNode)}
+}
+
+/*
+Router matches publications against a set of subscriptions. It is safe for
+concurrent use.
+*/
+type Router struct {
+	mutex    sync.RWMutex
+	root     *routerNode
+	charSubs []*Subscription
+	count    int
+}
+
+/*
+NewRouter creates an empty Router
+*/
+func NewRouter() *Router {
+	r := new(Router)
+	r.root = newRouterNode()
+	return r
+}
+
+/*
+Subscribe adds a pattern to the router
+*/
+func (r *Router) Subscribe(pattern string, scheme WildcardScheme, value interface{}) (*Subscription, error) {
+	p, err := Compile(pattern, scheme)
+	if err != nil {
+		return nil, err
+	}
+	s := &Subscription{Pattern: p, Value: value}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.count++
+	if scheme == WildcardChar {
+		r.charSubs = append(r.charSubs, s)
+		return s, nil
+	}
+
+	n := r.root
+	for _, l := range p.levels {
+		c, ok := n.children[l]
+		if !ok {
+			c = newRouterNode()
+			n.children[l] = c
+		}
+		n = c
+	}
+	n.subs = append(n.subs, s)
+	return s, nil
+}
+
+/*
+Unsubscribe removes a subscription. It returns false if the subscription was
+not in the router.
+*/
+func (r *Router) Unsubscribe(s *Subscription) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if s.Pattern.scheme == WildcardChar {
+		for i, cs := range r.charSubs {
+			if cs == s {
+				r.charSubs = append(r.charSubs[:i], r.charSubs[i+1:]...)
+				r.count--
+				return true
+			}
+		}
+		return false
+	}
+
+	// Remember the path so that empty nodes can be removed
+	path := []*routerNode{r.root}
+	n := r.root
+	for _, l := range s.Pattern.levels {
+		c, ok := n.children[l]
+		if !ok {
+			return false
+		}
+		n = c
+		path = append(path, n)
+	}
+
+	found := false
+	for i, ns := range n.subs {
+		if ns == s {
+			n.subs = append(n.subs[:i], n.subs[i+1:]...)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+	r.count--
+
+	for i := len(path) - 1; i > 0; i-- {
+		if len(path[i].subs) > 0 || len(path[i].children) > 0 {
+			break
+		}
+		delete(path[i-1].children, s.Pattern.levels[i-1])
+	}
+	return true
+}
+
+/*
+Len returns the number of subscriptions in the router
+*/
+func (r *Router) Len() int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.count
+}
+
+/*
+Match returns the subscriptions that a publication on the topic would be sent to.
+Each subscription appears once, even if its pattern matches in several ways.
+*/
+func (r *Router) Match(topic string) []*Subscription {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	seen := make(map[*Subscription]bool)
+	var result []*Subscription
+	add := func(subs []*Subscription) {
+		for _, s := range subs {
+			if !seen[s] {
+				seen[s] = true
+				result = append(result, s)
+			}
+		}
+	}
+
+	r.root.match(Split(topic), add)
+	for _, s := range r.charSubs {
+		if s.Pattern.Match(topic) {
+			add([]*Subscription{s})
+		}
+	}
+	return result
+}
+
+func (n *routerNode) match(levels []string, add func([]*Subscription)) {
+	if c, ok := n.children["#"]; ok {
+		// '#' can take any number of the remaining levels
+		for i := 0; i <= len(levels); i++ {
+			c.match(levels[i:], add)
+		}
+	}
+	if len(levels) == 0 {
+		add(n.subs)
+		return
+	}
+	if c, ok := n.children["+"]; ok {
+		c.match(levels[1:], add)
+	}
+	if levels[0] != "#" && levels[0] != "+" {
+		if c, ok := n.children[levels[0]]; ok {
+			c.match(levels[1:], add)
+		}
+	}
+}
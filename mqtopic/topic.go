@@ -0,0 +1,85 @@
+/*
+Package mqtopic works with IBM MQ topic strings without needing a queue manager.
+It matches publication topics against subscription patterns using the same rules as the
+queue manager, for both of the wildcard schemes that can be chosen in the MQSD
+Options field, and it builds full topic strings from a TOPIC object and an ObjectString
+in the same way as MQOPEN and MQSUB.
+
+Applications can use it to test routing logic, or to hand out publications to
+several local consumers from a single subscription.
+
+The package does not depend on the ibmmq package, so it can be used in programs
+that are built without the MQ client libraries.
+*/
+package mqtopic
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Separator is the character between the levels of a topic string
+const Separator = "/"
+
+// MaxLength is the longest topic string that MQ accepts (MQ_TOPIC_STR_LENGTH)
+const MaxLength = 10240
+
+var (
+	// ErrEmptyTopic is returned when there is no topic string
+	ErrEmptyTopic = errors.New("topic string is empty")
+	// ErrTopicTooLong is returned for topic strings longer than MaxLength
+	ErrTopicTooLong = errors.New("topic string is too long")
+	// ErrUnknownTopicObject is returned when resolving a name that has not been defined
+	ErrUnknownTopicObject = errors.New("unknown topic object")
+)
+
+/*
+Validate checks that a topic string could be given to MQ
+*/
+func Validate(topic string) error {
+	if topic == "" {
+		return ErrEmptyTopic
+	}
+	if len(topic) > MaxLength {
+		return ErrTopicTooLong
+	}
+	if i := strings.IndexByte(topic, 0); i >= 0 {
+		return fmt.Errorf("topic string contains a null character at offset %d", i)
+	}
+	return nil
+}
+
+/*
+Split returns the levels of a topic string. Empty levels are kept, so
+"/a//b" has four levels, the first and third of which are empty.
+*/
+func Split(topic string) []string {
+	return strings.Split(topic, Separator)
+}
+
+/*
+Join builds a topic string from its levels
+*/
+func Join(levels ...string) string {
+	return strings.Join(levels, Separator)
+}
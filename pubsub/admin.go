@@ -0,0 +1,280 @@
+package pubsub
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+/*
+This file manages durable subscriptions through the command server, using the
+INQUIRE SUBSCRIPTION and DELETE SUBSCRIPTION PCF commands. This is the same
+approach that the mqmetric package uses to remove its own subscriptions.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ibm-messaging/mq-golang/v5/ibmmq"
+)
+
+/*
+DurableSubscription describes a subscription returned by ListDurable
+*/
+type DurableSubscription struct {
+	Name        string
+	SubId       string // In hex
+	TopicString string
+	Destination string
+	DestQMgr    string
+	Selector    string
+	UserData    string
+	SubType     int32 // MQSUBTYPE_*
+	Managed     bool
+}
+
+/*
+Admin sends subscription commands to the command server
+*/
+type Admin struct {
+	CommandQueue string
+	ReplyModel   string        // Model queue for the temporary reply queue
+	Wait         time.Duration // How long to wait for each reply
+
+	qMgr *ibmmq.MQQueueManager
+}
+
+/*
+NewAdmin fills in default values for the Admin structure
+*/
+func NewAdmin(qMgr *ibmmq.MQQueueManager) *Admin {
+	a := new(Admin)
+	a.CommandQueue = "SYSTEM.ADMIN.COMMAND.QUEUE"
+	a.ReplyModel = "SYSTEM.DEFAULT.MODEL.QUEUE"
+	a.Wait = 10 * time.Second
+	a.qMgr = qMgr
+	return a
+}
+
+/*
+ListDurable returns the durable subscriptions whose names start with the prefix. An
+empty prefix lists all of them, including those made by the queue manager itself.
+*/
+func (a *Admin) ListDurable(prefix string) ([]DurableSubscription, error) {
+	traceEntryF("ListDurable", "Prefix: %s", prefix)
+
+	name := &ibmmq.PCFParameter{Type: ibmmq.MQCFT_STRING, Parameter: ibmmq.MQCACF_SUB_NAME, String: []string{prefix + "*"}}
+	durable := &ibmmq.PCFParameter{Type: ibmmq.MQCFT_INTEGER, Parameter: ibmmq.MQIACF_DURABLE_SUBSCRIPTION, Int64Value: []int64{int64(ibmmq.MQSUB_DURABLE_YES)}}
+
+	var subs []DurableSubscription
+	err := a.command(ibmmq.MQCMD_INQUIRE_SUBSCRIPTION, []*ibmmq.PCFParameter{name, durable}, func(parms []*ibmmq.PCFParameter) {
+		subs = append(subs, parseSubscription(parms))
+	})
+
+	traceExitErr("ListDurable", 0, err)
+	return subs, err
+}
+
+func parseSubscription(parms []*ibmmq.PCFParameter) DurableSubscription {
+	var ds DurableSubscription
+	for _, p := range parms {
+		switch p.Parameter {
+		case ibmmq.MQCACF_SUB_NAME:
+			ds.Name = firstString(p)
+		case ibmmq.MQBACF_SUB_ID:
+			ds.SubId = firstString(p)
+		case ibmmq.MQCA_TOPIC_STRING:
+			ds.TopicString = firstString(p)
+		case ibmmq.MQCACF_DESTINATION:
+			ds.Destination = firstString(p)
+		case ibmmq.MQCACF_DESTINATION_Q_MGR:
+			ds.DestQMgr = firstString(p)
+		case ibmmq.MQCACF_SUB_SELECTOR:
+			ds.Selector = firstString(p)
+		case ibmmq.MQCACF_SUB_USER_DATA:
+			ds.UserData = firstString(p)
+		case ibmmq.MQIACF_SUB_TYPE:
+			ds.SubType = firstInt(p)
+		case ibmmq.MQIACF_DESTINATION_CLASS:
+			ds.Managed = firstInt(p) == ibmmq.MQDC_MANAGED
+		}
+	}
+	return ds
+}
+
+func firstString(p *ibmmq.PCFParameter) string {
+	if len(p.String) == 0 {
+		return ""
+	}
+	return p.String[0]
+}
+
+func firstInt(p *ibmmq.PCFParameter) int32 {
+	if len(p.Int64Value) == 0 {
+		return 0
+	}
+	return int32(p.Int64Value[0])
+}
+
+/*
+DeleteDurable removes a subscription by name
+*/
+func (a *Admin) DeleteDurable(name string) error {
+	traceEntryF("DeleteDurable", "Name: %s", name)
+
+	parm := &ibmmq.PCFParameter{Type: ibmmq.MQCFT_STRING, Parameter: ibmmq.MQCACF_SUB_NAME, String: []string{name}}
+	err := a.command(ibmmq.MQCMD_DELETE_SUBSCRIPTION, []*ibmmq.PCFParameter{parm}, nil)
+
+	traceExitErr("DeleteDurable", 0, err)
+	return err
+}
+
+/*
+CleanDurable removes the durable subscriptions whose names start with the prefix, returning
+the names of those that were deleted. An empty prefix is not allowed, as that would
+remove every durable subscription on the queue manager.
+*/
+func (a *Admin) CleanDurable(prefix string) ([]string, error) {
+	traceEntryF("CleanDurable", "Prefix: %s", prefix)
+
+	if prefix == "" {
+		err := errors.New("CleanDurable needs a prefix")
+		traceExitErr("CleanDurable", 1, err)
+		return nil, err
+	}
+
+	subs, err := a.ListDurable(prefix)
+	if err != nil {
+		traceExitErr("CleanDurable", 2, err)
+		return nil, err
+	}
+
+	var deleted []string
+	for _, s := range subs {
+		if err = a.DeleteDurable(s.Name); err != nil {
+			break
+		}
+		deleted = append(deleted, s.Name)
+	}
+
+	traceExitErr("CleanDurable", 0, err)
+	return deleted, err
+}
+
+// Send a command and pass the parameters of each response to fn. Responses
+// saying that nothing matched are not treated as errors.
+func (a *Admin) command(cmd int32, parms []*ibmmq.PCFParameter, fn func([]*ibmmq.PCFParameter)) error {
+	od := ibmmq.NewMQOD()
+	od.ObjectType = ibmmq.MQOT_Q
+	od.ObjectName = a.CommandQueue
+	cmdQ, err := a.qMgr.Open(od, ibmmq.MQOO_OUTPUT|ibmmq.MQOO_FAIL_IF_QUIESCING)
+	if err != nil {
+		return err
+	}
+	defer cmdQ.Close(0)
+
+	od = ibmmq.NewMQOD()
+	od.ObjectType = ibmmq.MQOT_Q
+	od.ObjectName = a.ReplyModel
+	od.DynamicQName = "PUBSUB.*"
+	replyQ, err := a.qMgr.Open(od, ibmmq.MQOO_INPUT_EXCLUSIVE|ibmmq.MQOO_FAIL_IF_QUIESCING)
+	if err != nil {
+		return err
+	}
+	defer replyQ.Close(0)
+
+	cfh := ibmmq.NewMQCFH()
+	cfh.Version = ibmmq.MQCFH_VERSION_3
+	cfh.Type = ibmmq.MQCFT_COMMAND_XR
+	cfh.Command = cmd
+
+	buf := make([]byte, 0)
+	for _, p := range parms {
+		cfh.ParameterCount++
+		buf = append(buf, p.Bytes()...)
+	}
+	buf = append(cfh.Bytes(), buf...)
+
+	md := ibmmq.NewMQMD()
+	md.Format = ibmmq.MQFMT_ADMIN
+	md.MsgType = ibmmq.MQMT_REQUEST
+	md.ReplyToQ = replyQ.Name
+	md.Report = ibmmq.MQRO_PASS_DISCARD_AND_EXPIRY
+	md.Expiry = int32(a.Wait/(100*time.Millisecond)) * 2
+
+	pmo := ibmmq.NewMQPMO()
+	pmo.Options = ibmmq.MQPMO_NO_SYNCPOINT | ibmmq.MQPMO_NEW_MSG_ID | ibmmq.MQPMO_FAIL_IF_QUIESCING
+	if err = cmdQ.Put(md, pmo, buf); err != nil {
+		return err
+	}
+
+	var cmdErr error
+	for {
+		getmd := ibmmq.NewMQMD()
+		getmd.CorrelId = md.MsgId
+		gmo := ibmmq.NewMQGMO()
+		gmo.Version = ibmmq.MQGMO_VERSION_2
+		gmo.MatchOptions = ibmmq.MQMO_MATCH_CORREL_ID
+		gmo.Options = ibmmq.MQGMO_NO_SYNCPOINT | ibmmq.MQGMO_WAIT | ibmmq.MQGMO_CONVERT | ibmmq.MQGMO_FAIL_IF_QUIESCING
+		gmo.WaitInterval = int32(a.Wait / time.Millisecond)
+
+		reply, err := replyQ.GetAutoSize(getmd, gmo, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		// Without a valid header there is no way to know if more replies are coming
+		rcfh, offset, err := ibmmq.ParsePCFHeader(reply)
+		if err != nil {
+			return err
+		}
+		switch {
+		case rcfh.Type == ibmmq.MQCFT_XR_SUMMARY || rcfh.Type == ibmmq.MQCFT_XR_MSG:
+			// Only from z/OS, and not interesting
+		case rcfh.CompCode == ibmmq.MQCC_FAILED:
+			switch rcfh.Reason {
+			case ibmmq.MQRC_NO_SUBSCRIPTION, ibmmq.MQRCCF_NONE_FOUND:
+			default:
+				if cmdErr == nil {
+					cmdErr = fmt.Errorf("command %s failed: %s", ibmmq.MQItoString("CMD", int(cmd)), ibmmq.MQItoString("RC", int(rcfh.Reason)))
+				}
+			}
+		case fn != nil:
+			var elems []*ibmmq.PCFParameter
+			for offset < len(reply) && err == nil {
+				var p *ibmmq.PCFParameter
+				var n int
+				if p, n, err = ibmmq.ParsePCFParameter(reply[offset:]); err == nil {
+					offset += n
+					elems = append(elems, p)
+				}
+			}
+			if err != nil {
+				if cmdErr == nil {
+					cmdErr = err
+				}
+			} else {
+				fn(elems)
+			}
+		}
+
+		if rcfh.Control == ibmmq.MQCFC_LAST {
+			return cmdErr
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package pubsub
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+import (
+	"github.com/ibm-messaging/mq-golang/v5/ibmmq"
+)
+
+/*
+Publisher sends publications to a topic
+*/
+type Publisher struct {
+	topic ibmmq.MQObject
+}
+
+/*
+NewPublisher opens a topic for publishing. The topic string is added to the
+topic string of the topic object, which can be empty.
+*/
+func NewPublisher(qMgr *ibmmq.MQQueueManager, topicObject string, topic string) (*Publisher, error) {
+	traceEntryF("NewPublisher", "Topic: %s", topic)
+
+	od := ibmmq.NewMQOD()
+	od.ObjectType = ibmmq.MQOT_TOPIC
+	od.ObjectName = topicObject
+	od.ObjectString = topic
+
+	obj, err := qMgr.Open(od, ibmmq.MQOO_OUTPUT|ibmmq.MQOO_FAIL_IF_QUIESCING)
+	if err != nil {
+		traceExitErr("NewPublisher", 1, err)
+		return nil, err
+	}
+
+	traceExit("NewPublisher")
+	return &Publisher{topic: obj}, nil
+}
+
+/*
+Publish sends the data. If retain is set, the queue manager keeps the publication
+and sends it to subscriptions that are made later.
+*/
+func (p *Publisher) Publish(data []byte, retain bool) error {
+	md := ibmmq.NewMQMD()
+	pmo := ibmmq.NewMQPMO()
+	pmo.Options = ibmmq.MQPMO_NO_SYNCPOINT | ibmmq.MQPMO_NEW_MSG_ID | ibmmq.MQPMO_FAIL_IF_QUIESCING
+	if retain {
+		pmo.Options |= ibmmq.MQPMO_RETAIN
+	}
+	return p.PublishMsg(md, pmo, data)
+}
+
+/*
+PublishMsg sends a publication with the application's own MQMD and MQPMO
+*/
+func (p *Publisher) PublishMsg(md *ibmmq.MQMD, pmo *ibmmq.MQPMO, data []byte) error {
+	return p.topic.Put(md, pmo, data)
+}
+
+/*
+Close the topic
+*/
+func (p *Publisher) Close() error {
+	return p.topic.Close(0)
+}
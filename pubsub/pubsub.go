@@ -0,0 +1,481 @@
+/*
+Package pubsub is a higher-level interface to publish/subscribe in IBM MQ. It hides
+the MQSD options and the loop of MQGET calls that are needed to receive publications,
+delivering them on a Go channel instead.
+
+	sub, err := pubsub.Subscribe(qMgr, "Price/Fruit/#", nil)
+	for pub := range sub.Publications() {
+	    fmt.Printf("%s: %s\n", pub.Topic, pub.Data)
+	}
+
+Durable subscriptions survive the application disconnecting. They are given a
+name in SubscribeOptions, and the same name resumes them later. The Admin type lists
+and removes durable subscriptions using the command server, which is useful to clean up
+after applications that have gone away without unsubscribing.
+
+A Subscription gets messages from its own goroutine, under syncpoint. Each publication is
+committed once it has been delivered on the channel, and one that is still waiting when the
+Subscription is closed is backed out so that a durable subscription gets it again when it is
+resumed. The connection must therefore be dedicated to the Subscription: other MQI calls on it
+fail with MQRC_CALL_IN_PROGRESS while the goroutine waits in MQGET or, if the connection was made
+with MQCNO_HANDLE_SHARE_BLOCK, become part of the Subscription's units of work. RequestRetained
+is passed to the goroutine, so it can be called at any time. Publish using another connection.
+*/
+package pubsub
+
+/*
+  Copyright (c) IBM Corporation 2026
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+
+   Contributors:
+     Mark Taylor - Initial Contribution
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ibm-messaging/mq-golang/v5/ibmmq"
+)
+
+// Names of the message properties that the queue manager adds to publications
+const (
+	propTopicString = "MQTopicString"
+	propIsRetained  = "MQIsRetained"
+)
+
+/*
+Publication is a message received by a Subscription
+*/
+type Publication struct {
+	Topic    string // The topic string it was published on
+	Retained bool   // The publication was retained by the queue manager, not newly published
+	MD       *ibmmq.MQMD
+	Data     []byte
+}
+
+/*
+SubscribeOptions control how the subscription is made
+*/
+type SubscribeOptions struct {
+	SubName     string // Required for durable subscriptions
+	Durable     bool
+	ResumeOnly  bool   // Resume an existing durable subscription, failing if there is none
+	Queue       string // Send publications to this queue. If empty, a managed queue is used
+	TopicObject string // Topic object whose topic string is put in front of the one given to Subscribe
+	Selector    string
+	// Use the character-based '*' and '?' wildcards instead of the topic-based '#' and '+'
+	CharWildcards bool
+	// Do not send any retained publication when the subscription is made
+	NewPublicationsOnly bool
+	// Only send publications when RequestRetained is called
+	PublicationsOnRequest bool
+
+	ChannelSize  int           // Number of publications that can be waiting to be read from the channel
+	WaitInterval time.Duration // How long each MQGET waits. Close can take up to this long
+	MaxMsgLength int           // Largest publication that can be received. 0 means no limit
+}
+
+/*
+NewSubscribeOptions fills in default values for the SubscribeOptions structure
+*/
+func NewSubscribeOptions() *SubscribeOptions {
+	o := new(SubscribeOptions)
+	o.ChannelSize = 10
+	o.WaitInterval = 1 * time.Second
+	return o
+}
+
+/*
+ErrClosed is returned by RequestRetained once the subscription has stopped delivering publications
+*/
+var ErrClosed = errors.New("subscription is closed")
+
+/*
+Subscription receives publications
+*/
+type Subscription struct {
+	qMgr    *ibmmq.MQQueueManager
+	sub     ibmmq.MQObject
+	queue   ibmmq.MQObject
+	mh      ibmmq.MQMessageHandle
+	topic   string
+	durable bool
+	opts    SubscribeOptions
+	mqi     subscriptionMQI
+
+	pubs     chan *Publication
+	requests chan chan retainedReply
+	stop     chan struct{}
+	done     chan struct{}
+
+	mutex  sync.Mutex
+	err    error
+	closed bool
+}
+
+// The MQI calls made by the delivery goroutine. Tests replace them so that
+// the goroutine can run without a queue manager.
+type subscriptionMQI interface {
+	get() (*Publication, error) // Under syncpoint, waiting for up to WaitInterval
+	cmit() error
+	back() error
+	subrq() (int32, error)
+}
+
+// The result of a RequestRetained call made by the goroutine
+type retainedReply struct {
+	numPubs int32
+	err     error
+}
+
+// Build the subscription descriptor
+func newSD(topic string, opts *SubscribeOptions) (*ibmmq.MQSD, error) {
+	if opts.Durable && opts.SubName == "" {
+		return nil, errors.New("durable subscriptions need a SubName")
+	}
+	if opts.ResumeOnly && !opts.Durable {
+		return nil, errors.New("only durable subscriptions can be resumed")
+	}
+	if topic == "" && opts.TopicObject == "" && !opts.ResumeOnly {
+		return nil, errors.New("no topic given")
+	}
+
+	sd := ibmmq.NewMQSD()
+	sd.Options = ibmmq.MQSO_FAIL_IF_QUIESCING
+	if opts.Durable {
+		sd.Options |= ibmmq.MQSO_DURABLE
+		if opts.ResumeOnly {
+			sd.Options |= ibmmq.MQSO_RESUME
+		} else {
+			sd.Options |= ibmmq.MQSO_CREATE | ibmmq.MQSO_RESUME
+		}
+	} else {
+		sd.Options |= ibmmq.MQSO_CREATE | ibmmq.MQSO_NON_DURABLE
+	}
+	if opts.Queue == "" {
+		sd.Options |= ibmmq.MQSO_MANAGED
+	}
+	if opts.CharWildcards {
+		sd.Options |= ibmmq.MQSO_WILDCARD_CHAR
+	}
+	if opts.NewPublicationsOnly {
+		sd.Options |= ibmmq.MQSO_NEW_PUBLICATIONS_ONLY
+	}
+	if opts.PublicationsOnRequest {
+		sd.Options |= ibmmq.MQSO_PUBLICATIONS_ON_REQUEST
+	}
+
+	sd.ObjectName = opts.TopicObject
+	sd.ObjectString = topic
+	sd.SubName = opts.SubName
+	sd.SelectionString = opts.Selector
+	return sd, nil
+}
+
+/*
+Subscribe creates or resumes a subscription, and starts delivering its publications
+on the Publications channel. A nil opts uses the defaults, which make a non-durable
+subscription with a managed queue.
+*/
+func Subscribe(qMgr *ibmmq.MQQueueManager, topic string, opts *SubscribeOptions) (*Subscription, error) {
+	traceEntryF("Subscribe", "Topic: %s", topic)
+
+	if opts == nil {
+		opts = NewSubscribeOptions()
+	}
+	sd, err := newSD(topic, opts)
+	if err != nil {
+		traceExitErr("Subscribe", 1, err)
+		return nil, err
+	}
+
+	s := &Subscription{qMgr: qMgr, durable: opts.Durable, opts: *opts}
+
+	if opts.Queue != "" {
+		od := ibmmq.NewMQOD()
+		od.ObjectType = ibmmq.MQOT_Q
+		od.ObjectName = opts.Queue
+		s.queue, err = qMgr.Open(od, ibmmq.MQOO_INPUT_AS_Q_DEF|ibmmq.MQOO_FAIL_IF_QUIESCING)
+		if err != nil {
+			traceExitErr("Subscribe", 2, err)
+			return nil, err
+		}
+	}
+
+	s.sub, err = qMgr.Sub(sd, &s.queue)
+	if err != nil {
+		if opts.Queue != "" {
+			s.queue.Close(0)
+		}
+		err = fmt.Errorf("cannot subscribe to topic '%s': %w", topic, err)
+		traceExitErr("Subscribe", 3, err)
+		return nil, err
+	}
+
+	s.topic = sd.ResObjectString
+	if s.topic == "" {
+		s.topic = topic
+	}
+
+	s.mh, err = qMgr.CrtMH(ibmmq.NewMQCMHO())
+	if err != nil {
+		s.sub.Close(0)
+		s.queue.Close(0)
+		traceExitErr("Subscribe", 4, err)
+		return nil, err
+	}
+
+	s.mqi = &subscriptionCalls{s: s}
+	s.start()
+
+	traceExit("Subscribe")
+	return s, nil
+}
+
+// Create the channels and start the goroutine
+func (s *Subscription) start() {
+	size := s.opts.ChannelSize
+	if size < 0 {
+		size = 0
+	}
+	s.pubs = make(chan *Publication, size)
+	s.requests = make(chan chan retainedReply)
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run()
+}
+
+/*
+Publications returns the channel on which publications are delivered. It is closed when the
+subscription is closed or when getting messages fails; Err then says why.
+*/
+func (s *Subscription) Publications() <-chan *Publication {
+	return s.pubs
+}
+
+/*
+Topic returns the full topic string of the subscription, as resolved by the queue manager
+*/
+func (s *Subscription) Topic() string {
+	return s.topic
+}
+
+/*
+Err returns the error that stopped delivery, if there was one
+*/
+func (s *Subscription) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.err
+}
+
+// Accept warnings such as a truncated or unconverted message
+func isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mqret *ibmmq.MQReturn
+	if errors.As(err, &mqret) && mqret.MQCC == ibmmq.MQCC_WARNING {
+		return false
+	}
+	return true
+}
+
+// The MQI calls made on the subscription's own handles
+type subscriptionCalls struct {
+	s *Subscription
+}
+
+func (c *subscriptionCalls) get() (*Publication, error) {
+	s := c.s
+	md := ibmmq.NewMQMD()
+	gmo := ibmmq.NewMQGMO()
+	gmo.Version = ibmmq.MQGMO_VERSION_4
+	gmo.Options = ibmmq.MQGMO_SYNCPOINT | ibmmq.MQGMO_WAIT | ibmmq.MQGMO_FAIL_IF_QUIESCING
+	gmo.Options |= ibmmq.MQGMO_CONVERT | ibmmq.MQGMO_PROPERTIES_IN_HANDLE
+	gmo.WaitInterval = int32(s.opts.WaitInterval / time.Millisecond)
+	gmo.MsgHandle = s.mh
+
+	data, err := s.queue.GetAutoSize(md, gmo, 0, s.opts.MaxMsgLength)
+	if isFailure(err) {
+		return nil, err
+	}
+
+	pub := &Publication{MD: md, Data: data, Topic: s.topic}
+	impo := ibmmq.NewMQIMPO()
+	pd := ibmmq.NewMQPD()
+	if _, v, err := s.mh.InqMP(impo, pd, propTopicString); err == nil {
+		if t, ok := v.(string); ok {
+			pub.Topic = t
+		}
+	}
+	if _, v, err := s.mh.InqMP(impo, pd, propIsRetained); err == nil {
+		if r, ok := v.(bool); ok {
+			pub.Retained = r
+		}
+	}
+	return pub, nil
+}
+
+func (c *subscriptionCalls) cmit() error {
+	return c.s.qMgr.Cmit()
+}
+
+func (c *subscriptionCalls) back() error {
+	return c.s.qMgr.Back()
+}
+
+func (c *subscriptionCalls) subrq() (int32, error) {
+	sro := ibmmq.NewMQSRO()
+	err := c.s.sub.Subrq(sro, ibmmq.MQSR_ACTION_PUBLICATION)
+	return sro.NumPubs, err
+}
+
+// Get publications until Close is called or there is an error. MQSUBRQ is issued
+// here too, between the MQGET calls, as the connection is busy during them.
+func (s *Subscription) run() {
+	defer close(s.done)
+	defer close(s.pubs)
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case reply := <-s.requests:
+			s.requestRetained(reply)
+			continue
+		default:
+		}
+
+		pub, err := s.mqi.get()
+		if errors.Is(err, ibmmq.ErrNoMsgAvailable) {
+			continue
+		}
+		if err != nil {
+			s.fail(err)
+			return
+		}
+		if !s.deliver(pub) {
+			return
+		}
+	}
+}
+
+// Send the publication on the channel and commit its MQGET. If the subscription is
+// closed first, the MQGET is backed out so that the publication stays on the queue.
+func (s *Subscription) deliver(pub *Publication) bool {
+	for {
+		select {
+		case s.pubs <- pub:
+			if err := s.mqi.cmit(); err != nil {
+				s.fail(err)
+				return false
+			}
+			return true
+		case reply := <-s.requests:
+			s.requestRetained(reply)
+		case <-s.stop:
+			if err := s.mqi.back(); err != nil {
+				logError("Subscription to %s cannot back out an undelivered publication: %v", s.topic, err)
+			}
+			return false
+		}
+	}
+}
+
+func (s *Subscription) requestRetained(reply chan retainedReply) {
+	n, err := s.mqi.subrq()
+	reply <- retainedReply{numPubs: n, err: err}
+}
+
+func (s *Subscription) fail(err error) {
+	logError("Subscription to %s stopped: %v", s.topic, err)
+	s.mutex.Lock()
+	s.err = err
+	s.mutex.Unlock()
+}
+
+/*
+RequestRetained asks for the retained publication on each topic that the subscription matches to be
+sent again. It is normally used with the PublicationsOnRequest option. The return value is the
+number of publications that will be sent. The request is made by the subscription's goroutine
+once its current MQGET returns, so it can take up to WaitInterval. ErrClosed is returned
+if the subscription has stopped delivering publications.
+*/
+func (s *Subscription) RequestRetained() (int32, error) {
+	traceEntry("RequestRetained")
+
+	reply := make(chan retainedReply, 1)
+	select {
+	case s.requests <- reply:
+	case <-s.done:
+		traceExitErr("RequestRetained", 1, ErrClosed)
+		return 0, ErrClosed
+	}
+	r := <-reply
+
+	traceExitErr("RequestRetained", 0, r.err)
+	return r.numPubs, r.err
+}
+
+// Stop the goroutine, then close the handles. A durable subscription is removed if remove is set.
+func (s *Subscription) close(remove bool) error {
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mutex.Unlock()
+
+	close(s.stop)
+	<-s.done
+	s.mh.DltMH(ibmmq.NewMQDMHO())
+
+	closeOptions := int32(0)
+	if remove && s.durable {
+		closeOptions = ibmmq.MQCO_REMOVE_SUB
+	}
+	err := s.sub.Close(closeOptions)
+
+	// A managed queue is deleted once it is closed, unless a durable subscription still uses it
+	if err2 := s.queue.Close(0); err == nil {
+		err = err2
+	}
+	return err
+}
+
+/*
+Close stops delivering publications. A durable subscription remains, and publications
+continue to be kept for it until it is resumed.
+*/
+func (s *Subscription) Close() error {
+	traceEntry("SubClose")
+	err := s.close(false)
+	traceExitErr("SubClose", 0, err)
+	return err
+}
+
+/*
+Unsubscribe stops delivering publications and removes the subscription, even if it is durable
+*/
+func (s *Subscription) Unsubscribe() error {
+	traceEntry("Unsubscribe")
+	err := s.close(true)
+	traceExitErr("Unsubscribe", 0, err)
+	return err
+}
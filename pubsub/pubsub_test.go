@@ -0,0 +1,139 @@
+/*
+Copyright (c) IBM Corporation 2026
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package pubsub
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ibm-messaging/mq-golang/v5/ibmmq"
+)
+
+func TestNewSD(t *testing.T) {
+	opts := NewSubscribeOptions()
+	sd, err := newSD("Price/#", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ibmmq.MQSO_FAIL_IF_QUIESCING | ibmmq.MQSO_CREATE | ibmmq.MQSO_NON_DURABLE | ibmmq.MQSO_MANAGED
+	if sd.Options != want {
+		t.Errorf("Options for default subscription are %d. Expected %d", sd.Options, want)
+	}
+
+	opts.Durable = true
+	opts.SubName = "APP.SUB"
+	opts.Queue = "APP.Q"
+	opts.CharWildcards = true
+	sd, err = newSD("Price/*", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = ibmmq.MQSO_FAIL_IF_QUIESCING | ibmmq.MQSO_DURABLE | ibmmq.MQSO_CREATE | ibmmq.MQSO_RESUME | ibmmq.MQSO_WILDCARD_CHAR
+	if sd.Options != want || sd.SubName != "APP.SUB" {
+		t.Errorf("Options for durable subscription are %d. Expected %d", sd.Options, want)
+	}
+
+	opts.SubName = ""
+	if _, err = newSD("Price/*", opts); err == nil {
+		t.Errorf("Expected error for durable subscription without a name")
+	}
+}
+
+// Stands in for the MQI calls made by the delivery goroutine
+type fakeMQI struct {
+	pubs    []*Publication
+	gets    chan int
+	numPubs int32
+
+	cmits int
+	backs int
+}
+
+func (f *fakeMQI) get() (*Publication, error) {
+	select {
+	case f.gets <- 1:
+	default:
+	}
+	if len(f.pubs) == 0 {
+		time.Sleep(10 * time.Millisecond)
+		return nil, ibmmq.ErrNoMsgAvailable
+	}
+	pub := f.pubs[0]
+	f.pubs = f.pubs[1:]
+	return pub, nil
+}
+
+func (f *fakeMQI) cmit() error {
+	f.cmits++
+	return nil
+}
+
+func (f *fakeMQI) back() error {
+	f.backs++
+	return nil
+}
+
+func (f *fakeMQI) subrq() (int32, error) {
+	return f.numPubs, nil
+}
+
+func newFakeSubscription(f *fakeMQI) *Subscription {
+	opts := NewSubscribeOptions()
+	opts.ChannelSize = 0
+	s := &Subscription{topic: "Price/Fruit", opts: *opts, mqi: f}
+	s.start()
+	return s
+}
+
+func TestDeliverCommitsAndBacksOut(t *testing.T) {
+	f := &fakeMQI{gets: make(chan int, 10)}
+	f.pubs = []*Publication{{Data: []byte("1")}, {Data: []byte("2")}}
+	s := newFakeSubscription(f)
+
+	<-f.gets
+	if pub := <-s.Publications(); string(pub.Data) != "1" {
+		t.Errorf("Received publication %s. Expected 1", pub.Data)
+	}
+
+	// Wait for the second publication to be got, then close with it undelivered
+	<-f.gets
+	close(s.stop)
+	<-s.done
+
+	if f.cmits != 1 || f.backs != 1 {
+		t.Errorf("Subscription made %d commits and %d backouts. Expected 1 of each", f.cmits, f.backs)
+	}
+	if _, ok := <-s.Publications(); ok {
+		t.Errorf("Publications channel was not closed")
+	}
+}
+
+func TestRequestRetained(t *testing.T) {
+	f := &fakeMQI{gets: make(chan int, 100), numPubs: 3}
+	s := newFakeSubscription(f)
+
+	n, err := s.RequestRetained()
+	if err != nil || n != 3 {
+		t.Errorf("RequestRetained returned %d, %v. Expected 3", n, err)
+	}
+
+	close(s.stop)
+	<-s.done
+	if _, err = s.RequestRetained(); !errors.Is(err, ErrClosed) {
+		t.Errorf("RequestRetained after close returned %v. Expected ErrClosed", err)
+	}
+}
@@ -264,6 +264,11 @@ func getReplies() error {
 
 			cfh, offset := ibmmq.ReadPCFHeader(buffer)
 			//fmt.Printf("CFH is %+v\n",cfh)
+			if cfh == nil {
+				// Not a PCF response, so skip it and wait for the next one
+				fmt.Println("Reply is not a valid PCF message")
+				continue
+			}
 
 			reason := cfh.Reason
 			if cfh.Control == ibmmq.MQCFC_LAST {
@@ -278,6 +283,9 @@ func getReplies() error {
 			// starting at the new offset is an easy way to step through
 			for offset < datalen {
 				pcfParm, bytesRead := ibmmq.ReadPCFParameter(buffer[offset:])
+				if pcfParm == nil {
+					break
+				}
 				printPcfParm(pcfParm)
 				offset += bytesRead
 			}